@@ -0,0 +1,158 @@
+/*
+Copyright © 2025 Juan David Cabrera Duran juandavid.juandis@gmail.com
+*/
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"product-service/internal/adapters/persistence/pricing_repository"
+	"product-service/internal/adapters/persistence/product_repository"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/usecases"
+	"product-service/internal/infrastructure"
+	"product-service/pkg/logger"
+
+	"github.com/spf13/cobra"
+)
+
+var ratesFile string
+
+// exchangeRatesCmd represents the exchange-rates command
+var exchangeRatesCmd = &cobra.Command{
+	Use:   "exchange-rates",
+	Short: "Manage currency exchange rates",
+	Long: `Manage the exchange rates used to convert base prices into other currencies.
+
+Examples:
+  # Import rates from a CSV file with base_currency,quote_currency,rate columns
+  product-service exchange-rates import --file rates.csv
+
+  # Show the configured rates
+  product-service exchange-rates list`,
+}
+
+var exchangeRatesImportCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import exchange rates from a CSV file",
+	Long: `Import exchange rates from a CSV file. Each row holds base_currency,
+quote_currency and rate (1 base = rate quote); an optional header row is
+skipped. The whole file is applied in one transaction, so an invalid row
+leaves the stored rates untouched.`,
+	RunE: runExchangeRatesImport,
+}
+
+var exchangeRatesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the configured exchange rates",
+	RunE:  runExchangeRatesList,
+}
+
+func init() {
+	rootCmd.AddCommand(exchangeRatesCmd)
+	exchangeRatesCmd.AddCommand(exchangeRatesImportCmd, exchangeRatesListCmd)
+
+	exchangeRatesImportCmd.Flags().StringVar(&ratesFile, "file", "", "CSV file with base_currency,quote_currency,rate rows (\"-\" reads stdin)")
+	_ = exchangeRatesImportCmd.MarkFlagRequired("file")
+}
+
+func runExchangeRatesImport(cmd *cobra.Command, args []string) error {
+	var source io.Reader = cmd.InOrStdin()
+	if ratesFile != "-" {
+		file, err := os.Open(ratesFile)
+		if err != nil {
+			return fmt.Errorf("failed to open rates file: %w", err)
+		}
+		defer file.Close()
+		source = file
+	}
+
+	rates, err := readExchangeRatesCSV(source)
+	if err != nil {
+		return err
+	}
+
+	return withPricingUseCases(func(ctx context.Context, pricing usecases.PricingUseCases, log logger.Logger) error {
+		imported, err := pricing.ImportExchangeRates(ctx, rates)
+		if err != nil {
+			log.Error("Exchange rate import failed", "error", err)
+			return err
+		}
+
+		log.Info("Exchange rates imported successfully", "count", len(imported))
+		return nil
+	})
+}
+
+func runExchangeRatesList(cmd *cobra.Command, args []string) error {
+	return withPricingUseCases(func(ctx context.Context, pricing usecases.PricingUseCases, log logger.Logger) error {
+		rates, err := pricing.ListExchangeRates(ctx)
+		if err != nil {
+			log.Error("Failed to list exchange rates", "error", err)
+			return err
+		}
+
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "BASE\tQUOTE\tRATE\tUPDATED AT")
+		for _, rate := range rates {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", rate.BaseCurrency, rate.QuoteCurrency, rate.Rate, rate.UpdatedAt.Format("2006-01-02 15:04:05 MST"))
+		}
+		return w.Flush()
+	})
+}
+
+// readExchangeRatesCSV parses base_currency,quote_currency,rate rows,
+// skipping a header row when present
+func readExchangeRatesCSV(r io.Reader) ([]dto.ExchangeRateDTO, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	var rates []dto.ExchangeRateDTO
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid rates file: %w", err)
+		}
+
+		if line == 1 && strings.EqualFold(strings.TrimSpace(record[2]), "rate") {
+			continue
+		}
+
+		rates = append(rates, dto.ExchangeRateDTO{
+			BaseCurrency:  strings.TrimSpace(record[0]),
+			QuoteCurrency: strings.TrimSpace(record[1]),
+			Rate:          strings.TrimSpace(record[2]),
+		})
+	}
+
+	if len(rates) == 0 {
+		return nil, errors.New("rates file contains no exchange rates")
+	}
+	return rates, nil
+}
+
+// withPricingUseCases runs fn with pricing use cases bound to the configured database
+func withPricingUseCases(fn func(ctx context.Context, pricing usecases.PricingUseCases, log logger.Logger) error) error {
+	return withConnections(func(ctx context.Context, connections *infrastructure.DatabaseConnections, log logger.Logger) error {
+		db := connections.GetGormDB()
+		pricing := usecases.NewPricingUseCases(
+			pricing_repository.NewGormPriceListRepository(db),
+			pricing_repository.NewGormExchangeRateRepository(db),
+			product_repository.NewGormProductRepository(db),
+			log,
+		)
+		return fn(ctx, pricing, log)
+	})
+}
@@ -0,0 +1,195 @@
+/*
+Copyright © 2025 Juan David Cabrera Duran juandavid.juandis@gmail.com
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"product-service/internal/adapters/persistence/attribute_repository"
+	"product-service/internal/adapters/persistence/brand_repository"
+	"product-service/internal/adapters/persistence/category_repository"
+	"product-service/internal/adapters/persistence/product_repository"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/usecases"
+	"product-service/internal/domain/entities"
+	"product-service/internal/infrastructure"
+	"product-service/pkg/logger"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	exportOutput        string
+	exportFormat        string
+	exportColumns       []string
+	exportQuery         string
+	exportCategory      string
+	exportBrand         string
+	exportStatus        string
+	exportTags          []string
+	exportInStock       string
+	exportMinPrice      string
+	exportMaxPrice      string
+	exportPriceCurrency string
+	exportAttributes    map[string]string
+)
+
+// exportCmd represents the export command
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the catalog as CSV, JSON Lines or XLSX",
+	Long: `Export every product matching the filters, which work like the search
+endpoint's. Products are read from a database cursor and written as they
+arrive, so catalogs of any size export in constant memory.
+
+Columns default to id, sku, gtin, name, description, price, currency,
+category, brand, stock, status, tags, created_at and updated_at; attributes
+are selected as attributes.<key>. Exported CSV files import back as is.
+
+Examples:
+  # Dump the whole catalog as CSV
+  product-service export --output catalog.csv
+
+  # Active laptops with 16 GB of memory or more, as a spreadsheet
+  product-service export --output laptops.xlsx --category laptops --status active --attr memory_gb.min=16
+
+  # Selected columns as JSON Lines on stdout
+  product-service export --format jsonl --columns sku,price,stock,attributes.color`,
+	RunE: runExport,
+}
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+
+	exportCmd.Flags().StringVar(&exportOutput, "output", "-", "file to write (\"-\" writes stdout)")
+	exportCmd.Flags().StringVar(&exportFormat, "format", "", "csv, jsonl or xlsx (default from the output extension, else csv)")
+	exportCmd.Flags().StringSliceVar(&exportColumns, "columns", nil, "columns to export, in order")
+	exportCmd.Flags().StringVar(&exportQuery, "query", "", "match name, description or SKU")
+	exportCmd.Flags().StringVar(&exportCategory, "category", "", "category path or name, including its descendants")
+	exportCmd.Flags().StringVar(&exportBrand, "brand", "", "brand name or alias")
+	exportCmd.Flags().StringVar(&exportStatus, "status", "", "lifecycle status")
+	exportCmd.Flags().StringSliceVar(&exportTags, "tag", nil, "tag every exported product carries (repeatable)")
+	exportCmd.Flags().StringVar(&exportInStock, "in-stock", "", "true or false")
+	exportCmd.Flags().StringVar(&exportMinPrice, "min-price", "", "minimum price in --price-currency")
+	exportCmd.Flags().StringVar(&exportMaxPrice, "max-price", "", "maximum price in --price-currency")
+	exportCmd.Flags().StringVar(&exportPriceCurrency, "price-currency", "USD", "currency of --min-price and --max-price")
+	exportCmd.Flags().StringToStringVar(&exportAttributes, "attr", nil, "attribute filters as key=value, key.min=value or key.max=value")
+}
+
+func runExport(cmd *cobra.Command, args []string) error {
+	request, err := exportRequest()
+	if err != nil {
+		return err
+	}
+
+	return withExportUseCases(func(ctx context.Context, exports usecases.ExportUseCases, log logger.Logger) error {
+		export, err := exports.PrepareExport(ctx, request)
+		if err != nil {
+			log.Error("Invalid export", "error", err)
+			return err
+		}
+
+		output := cmd.OutOrStdout()
+		var file *os.File
+		if exportOutput != "-" {
+			file, err = os.Create(exportOutput)
+			if err != nil {
+				return fmt.Errorf("failed to create export file: %w", err)
+			}
+			defer file.Close()
+			output = file
+		}
+
+		if err := exports.WriteExport(ctx, export, output); err != nil {
+			log.Error("Product export failed", "error", err)
+			return err
+		}
+		if file == nil {
+			return nil
+		}
+		fmt.Fprintf(cmd.ErrOrStderr(), "Export written to %s\n", exportOutput)
+		return file.Close()
+	})
+}
+
+// exportRequest builds the export request from the flags
+func exportRequest() (*dto.ExportRequestDTO, error) {
+	format := strings.ToLower(exportFormat)
+	if format == "" && exportOutput != "-" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(exportOutput)), ".")
+		if !entities.ExportFormat(format).IsValid() {
+			format = ""
+		}
+	}
+
+	filters := dto.ProductSearchRequestDTO{
+		Query:    exportQuery,
+		Category: exportCategory,
+		Brand:    exportBrand,
+		Tags:     exportTags,
+	}
+	if exportStatus != "" {
+		status := entities.ProductStatus(exportStatus)
+		filters.Status = &status
+	}
+	if exportInStock != "" {
+		inStock := exportInStock == "true"
+		if !inStock && exportInStock != "false" {
+			return nil, fmt.Errorf("--in-stock must be true or false")
+		}
+		filters.InStock = &inStock
+	}
+	prices := []struct {
+		flag   string
+		target **entities.Money
+	}{{exportMinPrice, &filters.MinPrice}, {exportMaxPrice, &filters.MaxPrice}}
+	for _, price := range prices {
+		if price.flag == "" {
+			continue
+		}
+		value, err := entities.ParseMoney(price.flag, strings.ToUpper(exportPriceCurrency))
+		if err != nil {
+			return nil, fmt.Errorf("invalid price filter %q: %w", price.flag, err)
+		}
+		*price.target = &value
+	}
+	for key, value := range exportAttributes {
+		operator := entities.AttributeEquals
+		if trimmed, ok := strings.CutSuffix(key, ".min"); ok {
+			key, operator = trimmed, entities.AttributeMin
+		} else if trimmed, ok := strings.CutSuffix(key, ".max"); ok {
+			key, operator = trimmed, entities.AttributeMax
+		}
+		filters.Attributes = append(filters.Attributes, dto.AttributeFilterDTO{Key: key, Operator: operator, Value: value})
+	}
+	sort.Slice(filters.Attributes, func(i, j int) bool {
+		return filters.Attributes[i].Key < filters.Attributes[j].Key
+	})
+
+	return &dto.ExportRequestDTO{
+		Format:  entities.ExportFormat(format),
+		Columns: exportColumns,
+		Filters: filters,
+	}, nil
+}
+
+// withExportUseCases runs fn with export use cases bound to the configured database
+func withExportUseCases(fn func(ctx context.Context, exports usecases.ExportUseCases, log logger.Logger) error) error {
+	return withConnections(func(ctx context.Context, connections *infrastructure.DatabaseConnections, log logger.Logger) error {
+		db := connections.GetGormDB()
+		exports := usecases.NewExportUseCases(
+			product_repository.NewGormProductRepository(db),
+			attribute_repository.NewGormAttributeRepository(db),
+			category_repository.NewGormCategoryRepository(db),
+			brand_repository.NewGormBrandRepository(db),
+			log,
+		)
+		return fn(ctx, exports, log)
+	})
+}
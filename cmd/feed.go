@@ -0,0 +1,129 @@
+/*
+Copyright © 2025 Juan David Cabrera Duran juandavid.juandis@gmail.com
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"text/tabwriter"
+	"time"
+
+	"product-service/internal/adapters/persistence/product_repository"
+	"product-service/internal/adapters/storage"
+	"product-service/internal/application/usecases"
+	"product-service/internal/config"
+	"product-service/internal/domain/entities"
+	"product-service/internal/infrastructure"
+	"product-service/pkg/logger"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	feedFormats []string
+	feedForce   bool
+)
+
+// feedCmd represents the feed command
+var feedCmd = &cobra.Command{
+	Use:   "feed",
+	Short: "Manage product feeds for Google Merchant Center and other marketplaces",
+	Long: `Manage the product feeds served at /api/v1/feeds/<format>. Feeds list the
+products that are active and in stock and are cached under feeds.directory.
+The server regenerates them every feeds.interval once products change.
+
+Formats:
+  google-rss  Google Merchant Center RSS 2.0 (google.xml)
+  google-tsv  Google Merchant Center tab-separated values (google.tsv)
+  xml         plain XML for other partners (products.xml)
+
+GTIN is the product's barcode, or its gtin attribute when it has none; MPN
+and condition are read from the mpn and condition attributes.
+
+Examples:
+  # Regenerate the feeds whose products changed
+  product-service feed generate
+
+  # Rebuild the Google feed even if nothing changed
+  product-service feed generate --format google-rss --force`,
+}
+
+var feedGenerateCmd = &cobra.Command{
+	Use:   "generate",
+	Short: "Generate the product feeds",
+	RunE:  runFeedGenerate,
+}
+
+func init() {
+	rootCmd.AddCommand(feedCmd)
+	feedCmd.AddCommand(feedGenerateCmd)
+
+	feedGenerateCmd.Flags().StringSliceVar(&feedFormats, "format", nil, "feed formats to generate (default all)")
+	feedGenerateCmd.Flags().BoolVar(&feedForce, "force", false, "generate even if no product changed")
+}
+
+func runFeedGenerate(cmd *cobra.Command, args []string) error {
+	formats := entities.FeedFormats
+	if len(feedFormats) > 0 {
+		formats = nil
+		for _, name := range feedFormats {
+			format := entities.FeedFormat(name)
+			if !format.IsValid() {
+				return fmt.Errorf("unknown feed format %q", name)
+			}
+			formats = append(formats, format)
+		}
+	}
+
+	return withFeedUseCases(func(ctx context.Context, feeds usecases.FeedUseCases, directory string, log logger.Logger) error {
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "FORMAT\tFILE\tITEMS\tGENERATED\tSTATUS")
+		for _, format := range formats {
+			feed, err := feeds.GenerateFeed(ctx, format, feedForce)
+			if err != nil {
+				log.Error("Failed to generate feed", "format", format, "error", err)
+				return err
+			}
+			status := "unchanged"
+			if feed.Regenerated {
+				status = "regenerated"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n", format, filepath.Join(directory, format.FileName()), feed.Items,
+				feed.GeneratedAt.Format(time.RFC3339), status)
+		}
+		return w.Flush()
+	})
+}
+
+// withFeedUseCases runs fn with feed use cases bound to the configured
+// database, media storage and feed directory
+func withFeedUseCases(fn func(ctx context.Context, feeds usecases.FeedUseCases, directory string, log logger.Logger) error) error {
+	cfg, err := config.Load(configFile, env)
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+	media, err := storage.New(cfg.Media)
+	if err != nil {
+		return fmt.Errorf("failed to initialize media storage: %w", err)
+	}
+
+	return withConnections(func(ctx context.Context, connections *infrastructure.DatabaseConnections, log logger.Logger) error {
+		db := connections.GetGormDB()
+		feeds := usecases.NewFeedUseCases(
+			product_repository.NewGormFeedRepository(db),
+			product_repository.NewGormProductRepository(db),
+			product_repository.NewGormMediaRepository(db),
+			media,
+			usecases.FeedOptions{
+				Directory:  cfg.Feeds.Directory,
+				Title:      cfg.Feeds.Title,
+				StoreURL:   cfg.Feeds.StoreURL,
+				ProductURL: cfg.Feeds.ProductURL,
+			},
+			log,
+		)
+		return fn(ctx, feeds, cfg.Feeds.Directory, log)
+	})
+}
@@ -0,0 +1,167 @@
+/*
+Copyright © 2025 Juan David Cabrera Duran juandavid.juandis@gmail.com
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"product-service/internal/adapters/persistence/attribute_repository"
+	"product-service/internal/adapters/persistence/brand_repository"
+	"product-service/internal/adapters/persistence/category_repository"
+	"product-service/internal/adapters/persistence/job_repository"
+	"product-service/internal/adapters/persistence/product_repository"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/usecases"
+	"product-service/internal/domain/entities"
+	"product-service/internal/infrastructure"
+	"product-service/pkg/logger"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	importFile     string
+	importMapping  map[string]string
+	importCurrency string
+	importDryRun   bool
+	importReport   string
+)
+
+// importCmd represents the import command
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import products from a CSV file",
+	Long: `Import products from a CSV file, creating new SKUs and updating existing
+ones. Every row is validated like a new product, so the file needs sku,
+name, price and category columns; gtin, description, currency, brand,
+stock, tags (separated by "|") and attributes.<key> columns are optional.
+Rows that fail are written to an error report and do not stop the import.
+
+The file is read row by row, so large files are never loaded into memory.
+
+Examples:
+  # Import a supplier catalog priced in euros
+  product-service import --file products.csv --currency EUR
+
+  # Check a file without writing anything
+  product-service import --file products.csv --dry-run
+
+  # Read columns named differently from the product fields
+  product-service import --file supplier.csv --map sku="Item Number" --map attributes.color=Colour`,
+	RunE: runImport,
+}
+
+func init() {
+	rootCmd.AddCommand(importCmd)
+
+	importCmd.Flags().StringVar(&importFile, "file", "", "CSV file to import (\"-\" reads stdin)")
+	importCmd.Flags().StringToStringVar(&importMapping, "map", nil, "field=column pairs for columns named differently from their field")
+	importCmd.Flags().StringVar(&importCurrency, "currency", "", "currency of the prices when the file has no currency column")
+	importCmd.Flags().BoolVar(&importDryRun, "dry-run", false, "validate every row and report what would change without writing")
+	importCmd.Flags().StringVar(&importReport, "report", "", "where to write the error report (default <file>.errors.csv)")
+	_ = importCmd.MarkFlagRequired("file")
+}
+
+func runImport(cmd *cobra.Command, args []string) error {
+	var source io.Reader = cmd.InOrStdin()
+	fileName := "stdin"
+	if importFile != "-" {
+		file, err := os.Open(importFile)
+		if err != nil {
+			return fmt.Errorf("failed to open import file: %w", err)
+		}
+		defer file.Close()
+		source = file
+		fileName = filepath.Base(importFile)
+	}
+
+	request := &dto.ImportRequestDTO{
+		FileName: fileName,
+		Mapping:  entities.ImportMapping(importMapping),
+		Currency: importCurrency,
+		DryRun:   importDryRun,
+	}
+
+	return withImportUseCases(func(ctx context.Context, imports usecases.ImportUseCases, log logger.Logger) error {
+		result, err := imports.RunImport(ctx, request, source)
+		if err != nil {
+			log.Error("Product import failed", "error", err)
+			return err
+		}
+
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+		fmt.Fprintf(w, "IMPORT\t%d\n", result.ID)
+		fmt.Fprintf(w, "STATUS\t%s\n", result.Status)
+		fmt.Fprintf(w, "DRY RUN\t%t\n", result.DryRun)
+		fmt.Fprintf(w, "ROWS\t%d\n", result.TotalRows)
+		fmt.Fprintf(w, "CREATED\t%d\n", result.Created)
+		fmt.Fprintf(w, "UPDATED\t%d\n", result.Updated)
+		fmt.Fprintf(w, "FAILED\t%d\n", result.Failed)
+		if result.Error != "" {
+			fmt.Fprintf(w, "ERROR\t%s\n", result.Error)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+
+		if result.Failed > 0 {
+			if err := writeImportReport(ctx, cmd, imports, result.ID); err != nil {
+				log.Error("Failed to write import error report", "error", err)
+				return err
+			}
+		}
+		if result.Status == entities.ImportFailed {
+			return fmt.Errorf("import stopped: %s", result.Error)
+		}
+		return nil
+	})
+}
+
+// writeImportReport saves the error report of an import next to the file
+// unless --report says otherwise
+func writeImportReport(ctx context.Context, cmd *cobra.Command, imports usecases.ImportUseCases, id uint) error {
+	path := importReport
+	if path == "" {
+		path = "import-errors.csv"
+		if importFile != "-" {
+			path = strings.TrimSuffix(importFile, filepath.Ext(importFile)) + ".errors.csv"
+		}
+	}
+
+	report, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create error report: %w", err)
+	}
+	defer report.Close()
+
+	if err := imports.WriteErrorReport(ctx, id, report); err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.ErrOrStderr(), "Error report written to %s\n", path)
+	return report.Close()
+}
+
+// withImportUseCases runs fn with import use cases bound to the configured database
+func withImportUseCases(fn func(ctx context.Context, imports usecases.ImportUseCases, log logger.Logger) error) error {
+	return withConnections(func(ctx context.Context, connections *infrastructure.DatabaseConnections, log logger.Logger) error {
+		db := connections.GetGormDB()
+		imports := usecases.NewImportUseCases(
+			product_repository.NewGormImportRepository(db),
+			job_repository.NewGormJobRepository(db),
+			product_repository.NewGormProductRepository(db),
+			attribute_repository.NewGormAttributeRepository(db),
+			category_repository.NewGormCategoryRepository(db),
+			brand_repository.NewGormBrandRepository(db),
+			usecases.ImportOptions{},
+			log,
+		)
+		return fn(ctx, imports, log)
+	})
+}
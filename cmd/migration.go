@@ -4,9 +4,21 @@ Copyright © 2025 Juan David Cabrera Duran juandavid.juandis@gmail.com
 package cmd
 
 import (
+	"context"
 	"fmt"
-	"product-service/internal/adapters/persistence/product_repository"
+	"io"
+	"os"
+	"text/tabwriter"
 
+	"product-service/internal/adapters/persistence/attribute_repository"
+	"product-service/internal/adapters/persistence/brand_repository"
+	"product-service/internal/adapters/persistence/category_repository"
+	"product-service/internal/adapters/persistence/collection_repository"
+	"product-service/internal/adapters/persistence/job_repository"
+	"product-service/internal/adapters/persistence/migrations"
+	"product-service/internal/adapters/persistence/pricing_repository"
+	"product-service/internal/adapters/persistence/product_repository"
+	"product-service/internal/adapters/persistence/promotion_repository"
 	"product-service/internal/config"
 	"product-service/internal/infrastructure"
 	"product-service/pkg/logger"
@@ -15,39 +27,230 @@ import (
 )
 
 var (
-	dryRun bool
+	dryRun        bool
+	migrationsDir string
+	downSteps     int
 )
 
 // migrationCmd represents the migration command
 var migrationCmd = &cobra.Command{
 	Use:   "migration",
 	Short: "Run database migrations",
-	Long: `Run database migrations to create and update tables.
+	Long: `Run versioned SQL database migrations.
 
-This command will:
-- Create missing tables
-- Add new columns to existing tables  
-- Update column types if needed
-- Create indexes
+Migrations are ordered, checksummed up/down SQL files recorded in the
+schema_migrations table. A Postgres advisory lock ensures only one process
+migrates at a time. Without a subcommand this behaves like "migration up".
 
 Examples:
-  # Run migrations
-  user-service migration
+  # Apply pending migrations
+  product-service migration up
 
   # Preview what would be executed
-  user-service migration --dry-run`,
-	RunE: runMigration,
+  product-service migration up --dry-run
+  product-service migration dry-run
+
+  # Roll back the last two migrations
+  product-service migration down --steps 2
+
+  # Show applied and pending migrations
+  product-service migration status
+
+  # Create a new empty migration pair
+  product-service migration create add_product_barcodes
+
+  # Compare the GORM models with the live schema
+  product-service migration drift`,
+	RunE: runMigrationUp,
+}
+
+var migrationUpCmd = &cobra.Command{
+	Use:   "up",
+	Short: "Apply all pending migrations",
+	RunE:  runMigrationUp,
+}
+
+var migrationDryRunCmd = &cobra.Command{
+	Use:   "dry-run",
+	Short: "Print the pending migrations without applying them",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dryRun = true
+		return runMigrationUp(cmd, args)
+	},
+}
+
+var migrationDownCmd = &cobra.Command{
+	Use:   "down",
+	Short: "Roll back the most recently applied migrations",
+	RunE:  runMigrationDown,
+}
+
+var migrationStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show applied and pending migrations",
+	RunE:  runMigrationStatus,
+}
+
+var migrationCreateCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Create a new empty up/down migration pair",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runMigrationCreate,
+}
+
+var migrationDriftCmd = &cobra.Command{
+	Use:   "drift",
+	Short: "Detect drift between the GORM models and the database schema",
+	RunE:  runMigrationDrift,
 }
 
 func init() {
 	rootCmd.AddCommand(migrationCmd)
+	migrationCmd.AddCommand(migrationUpCmd, migrationDryRunCmd, migrationDownCmd, migrationStatusCmd, migrationCreateCmd, migrationDriftCmd)
+
+	migrationCmd.PersistentFlags().StringVar(&migrationsDir, "dir", "", "read migrations from this directory instead of the ones embedded in the binary")
+	migrationCmd.Flags().BoolVar(&dryRun, "dry-run", false, "print pending migrations without applying them")
+	migrationUpCmd.Flags().BoolVar(&dryRun, "dry-run", false, "print pending migrations without applying them")
+	migrationDownCmd.Flags().BoolVar(&dryRun, "dry-run", false, "print the migrations that would be rolled back")
+	migrationDownCmd.Flags().IntVar(&downSteps, "steps", 1, "number of migrations to roll back")
 }
 
-func runMigration(cmd *cobra.Command, args []string) error {
-	// Initialize logging
+func runMigrationUp(cmd *cobra.Command, args []string) error {
+	return withMigrator(func(ctx context.Context, migrator *migrations.Migrator, log logger.Logger) error {
+		applied, err := migrator.Up(ctx, dryRun)
+		if err != nil {
+			log.Error("Migration failed", "error", err)
+			return err
+		}
+
+		if dryRun {
+			printMigrationScripts(cmd.OutOrStdout(), applied, false)
+			log.Info("Dry run completed", "pending", len(applied))
+			return nil
+		}
+
+		log.Info("Database migration completed successfully", "applied", len(applied))
+		return nil
+	})
+}
+
+func runMigrationDown(cmd *cobra.Command, args []string) error {
+	return withMigrator(func(ctx context.Context, migrator *migrations.Migrator, log logger.Logger) error {
+		rolledBack, err := migrator.Down(ctx, downSteps, dryRun)
+		if err != nil {
+			log.Error("Rollback failed", "error", err)
+			return err
+		}
+
+		if dryRun {
+			printMigrationScripts(cmd.OutOrStdout(), rolledBack, true)
+			log.Info("Dry run completed", "to_roll_back", len(rolledBack))
+			return nil
+		}
+
+		log.Info("Rollback completed successfully", "rolled_back", len(rolledBack))
+		return nil
+	})
+}
+
+func runMigrationStatus(cmd *cobra.Command, args []string) error {
+	return withMigrator(func(ctx context.Context, migrator *migrations.Migrator, log logger.Logger) error {
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			log.Error("Failed to read migration status", "error", err)
+			return err
+		}
+
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+		for _, status := range statuses {
+			state := "pending"
+			appliedAt := "-"
+			if status.Applied {
+				state = "applied"
+				appliedAt = status.AppliedAt.Format("2006-01-02 15:04:05 MST")
+			}
+			if status.Modified {
+				state = "modified"
+			}
+			if status.Missing {
+				state = "missing"
+			}
+			fmt.Fprintf(w, "%04d\t%s\t%s\t%s\n", status.Version, status.Name, state, appliedAt)
+		}
+		return w.Flush()
+	})
+}
+
+func runMigrationCreate(cmd *cobra.Command, args []string) error {
 	log := logger.New(env)
 
-	log.Info("Starting database migration...")
+	dir := migrationsDir
+	if dir == "" {
+		dir = migrations.DefaultDir
+	}
+
+	upPath, downPath, err := migrations.Create(dir, args[0])
+	if err != nil {
+		log.Error("Failed to create migration", "error", err)
+		return err
+	}
+
+	log.Info("Migration created", "up", upPath, "down", downPath)
+	return nil
+}
+
+func runMigrationDrift(cmd *cobra.Command, args []string) error {
+	return withConnections(func(ctx context.Context, connections *infrastructure.DatabaseConnections, log logger.Logger) error {
+		drifts, err := migrations.CheckDrift(connections.GetGormDB().WithContext(ctx), getAllModels()...)
+		if err != nil {
+			log.Error("Drift check failed", "error", err)
+			return err
+		}
+
+		if len(drifts) == 0 {
+			log.Info("No drift detected between models and database schema")
+			return nil
+		}
+
+		for _, drift := range drifts {
+			fmt.Fprintln(cmd.OutOrStdout(), drift.String())
+		}
+		log.Warn("Schema drift detected", "differences", len(drifts))
+		return fmt.Errorf("schema drift detected: %d differences", len(drifts))
+	})
+}
+
+// withMigrator loads the migrations and runs fn with a migrator bound to the
+// configured database
+func withMigrator(fn func(ctx context.Context, migrator *migrations.Migrator, log logger.Logger) error) error {
+	return withConnections(func(ctx context.Context, connections *infrastructure.DatabaseConnections, log logger.Logger) error {
+		source := migrations.Source()
+		if migrationsDir != "" {
+			source = os.DirFS(migrationsDir)
+		}
+
+		loaded, err := migrations.Load(source)
+		if err != nil {
+			log.Error("Failed to load migrations", "error", err)
+			return err
+		}
+		log.Info("Migrations loaded", "count", len(loaded))
+
+		sqlDB, err := connections.GetGormDB().DB()
+		if err != nil {
+			return fmt.Errorf("failed to get underlying sql.DB: %w", err)
+		}
+
+		return fn(ctx, migrations.NewMigrator(sqlDB, loaded, log), log)
+	})
+}
+
+// withConnections loads the configuration, opens the database connections
+// and runs fn with them
+func withConnections(fn func(ctx context.Context, connections *infrastructure.DatabaseConnections, log logger.Logger) error) error {
+	// Initialize logging
+	log := logger.New(env)
 
 	// Load configuration
 	cfg, err := config.Load(configFile, env)
@@ -75,36 +278,54 @@ func runMigration(cmd *cobra.Command, args []string) error {
 		}
 	}()
 
-	log.Info("Database connection established successfully")
-
-	if err := runDatabaseMigrations(connections, log); err != nil {
-		log.Error("Migration failed", "error", err)
-		return err
-	}
-
-	log.Info("Database migration completed successfully")
-	return nil
+	return fn(context.Background(), connections, log)
 }
 
-func runDatabaseMigrations(connections *infrastructure.DatabaseConnections, log logger.Logger) error {
-	// Get the GORM database instance
-	db := connections.GetGormDB()
-
-	models := getAllModels()
-
-	log.Info("Running AutoMigrate", "models_count", len(models))
-
-	if err := db.AutoMigrate(models...); err != nil {
-		return fmt.Errorf("failed to run AutoMigrate: %w", err)
+func printMigrationScripts(w io.Writer, list []migrations.Migration, down bool) {
+	if len(list) == 0 {
+		fmt.Fprintln(w, "-- nothing to do")
+		return
+	}
+	for _, migration := range list {
+		script := migration.UpSQL
+		direction := "up"
+		if down {
+			script = migration.DownSQL
+			direction = "down"
+		}
+		fmt.Fprintf(w, "-- >>> %s (%s)\n%s\n", migration.ID(), direction, script)
 	}
-
-	log.Info("All migrations completed successfully")
-	return nil
 }
 
-// getAllModels returns all database models that need migration
+// getAllModels returns all database models checked for drift
 func getAllModels() []interface{} {
 	return []interface{}{
 		&product_repository.ProductModel{},
+		&pricing_repository.PriceListModel{},
+		&pricing_repository.ProductPriceModel{},
+		&pricing_repository.ExchangeRateModel{},
+		&product_repository.PriceChangeModel{},
+		&promotion_repository.PromotionModel{},
+		&promotion_repository.PromotionTargetModel{},
+		&promotion_repository.PromotionCategoryModel{},
+		&promotion_repository.PromotionBrandModel{},
+		&product_repository.ProductOptionModel{},
+		&product_repository.ProductVariantModel{},
+		&category_repository.CategoryModel{},
+		&attribute_repository.AttributeDefinitionModel{},
+		&brand_repository.BrandModel{},
+		&brand_repository.BrandNameModel{},
+		&product_repository.ProductMediaModel{},
+		&product_repository.TagModel{},
+		&product_repository.ProductTagModel{},
+		&product_repository.StatusTransitionModel{},
+		&product_repository.ProductRevisionModel{},
+		&product_repository.AuditEntryModel{},
+		&product_repository.ProductVersionModel{},
+		&product_repository.ProductImportModel{},
+		&product_repository.ImportErrorModel{},
+		&collection_repository.CollectionModel{},
+		&collection_repository.CollectionProductModel{},
+		&job_repository.JobModel{},
 	}
 }
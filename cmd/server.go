@@ -5,6 +5,8 @@ package cmd
 
 import (
 	"context"
+	"errors"
+	nethttp "net/http"
 	"os"
 	"os/signal"
 	"product-service/internal/adapters/http"
@@ -56,6 +58,22 @@ func runServer(cmd *cobra.Command, args []string) error {
 		"port", cfg.Server.Port,
 		"log_level", cfg.Logging.Level)
 
+	// Initialize tracing before anything that emits spans
+	tracerProvider, err := infrastructure.NewTracerProvider(context.Background(), cfg, log)
+	if err != nil {
+		log.Fatal("Failed to initialize tracing", "error", err)
+		return err
+	}
+
+	// Flush pending spans on exit
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := tracerProvider.Shutdown(ctx); err != nil {
+			log.Error("Failed to shutdown tracer provider", "error", err)
+		}
+	}()
+
 	// Initialize database connections
 	log.Info("Initializing database connections...")
 	connections, err := infrastructure.NewDatabaseConnections(cfg, log)
@@ -80,7 +98,8 @@ func runServer(cmd *cobra.Command, args []string) error {
 
 	// Start server in goroutine
 	go func() {
-		if err := server.Start(); err != nil {
+		// Shutdown makes Start return ErrServerClosed while jobs still drain
+		if err := server.Start(); err != nil && !errors.Is(err, nethttp.ErrServerClosed) {
 			log.Fatal("Server failed to start", "error", err)
 		}
 	}()
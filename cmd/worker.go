@@ -0,0 +1,102 @@
+/*
+Copyright © 2025 Juan David Cabrera Duran juandavid.juandis@gmail.com
+*/
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"product-service/internal/adapters/storage"
+	"product-service/internal/config"
+	"product-service/internal/infrastructure"
+	"product-service/pkg/logger"
+
+	"github.com/spf13/cobra"
+)
+
+var workerConcurrency int
+
+// workerCmd represents the worker command
+var workerCmd = &cobra.Command{
+	Use:   "worker",
+	Short: "Run background jobs without serving HTTP",
+	Long: `Run the background jobs queued through the API: catalog exports, CSV
+product imports (product_import jobs), bulk repricing and feed generation.
+Any number of workers, and servers with jobs.in_server enabled, share the
+queue in the database; each job runs once. Import jobs read the files the
+servers spooled, so workers must share imports.spool_directory with them.
+
+On SIGINT or SIGTERM the worker stops taking jobs and waits up to 30 seconds
+for the running ones. Jobs still running then are interrupted and queued
+again for another worker.
+
+Examples:
+  # Run jobs in dedicated processes and keep the servers for requests
+  PRODUCT_SERVICE_JOBS_IN_SERVER=false product-service server
+  product-service worker --concurrency 8`,
+	RunE: runWorker,
+}
+
+func init() {
+	rootCmd.AddCommand(workerCmd)
+
+	workerCmd.Flags().IntVar(&workerConcurrency, "concurrency", 0, "jobs to run at once (default jobs.workers)")
+}
+
+func runWorker(cmd *cobra.Command, args []string) error {
+	// Initialize logging
+	log := logger.New(env)
+
+	// Load configuration
+	cfg, err := config.Load(configFile, env)
+	if err != nil {
+		log.Fatal("Failed to load configuration", "error", err)
+		return err
+	}
+	if cmd.Flags().Changed("concurrency") {
+		cfg.Jobs.Workers = workerConcurrency
+	}
+
+	media, err := storage.New(cfg.Media)
+	if err != nil {
+		log.Fatal("Failed to initialize media storage", "error", err)
+		return err
+	}
+
+	// Initialize database connections
+	log.Info("Initializing database connections...")
+	connections, err := infrastructure.NewDatabaseConnections(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to initialize database connections", "error", err)
+		return err
+	}
+
+	// Ensure connections are closed on exit
+	defer func() {
+		if err := connections.Close(); err != nil {
+			log.Error("Failed to close database connections", "error", err)
+		}
+	}()
+
+	worker := infrastructure.NewJobServices(cfg, connections, media, log).Worker
+
+	worker.Start(context.Background())
+
+	// Wait for interrupt signal to drain the worker
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+	<-quit
+
+	log.Info("Shutting down worker...")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	worker.Stop(ctx)
+
+	log.Info("Worker exited")
+	return nil
+}
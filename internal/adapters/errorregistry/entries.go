@@ -0,0 +1,175 @@
+package errorregistry
+
+import (
+	"net/http"
+
+	domainErrors "product-service/internal/domain/errors"
+
+	"google.golang.org/grpc/codes"
+)
+
+// Transport-level codes that do not originate in the domain
+const (
+	CodeInvalidRequest   = "INVALID_REQUEST"
+	CodeInvalidID        = "INVALID_ID"
+	CodeValidation       = "VALIDATION_ERROR"
+	CodeRouteNotFound    = "ROUTE_NOT_FOUND"
+	CodeMethodNotAllowed = "METHOD_NOT_ALLOWED"
+	CodePayloadTooLarge  = "PAYLOAD_TOO_LARGE"
+	CodeUnsupportedMedia = "UNSUPPORTED_MEDIA_TYPE"
+	CodeRequestTimeout   = "REQUEST_TIMEOUT"
+	CodeInternal         = "INTERNAL_ERROR"
+)
+
+// defaultEntries lists every error code the service returns. New domain
+// errors must be added here, and to the i18n message catalogs, so clients get
+// a stable status and a localized title.
+func defaultEntries() []Entry {
+	return []Entry{
+		// Request errors
+		{Code: CodeInvalidRequest, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Invalid request"},
+		{Code: CodeInvalidID, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Invalid identifier"},
+		{Code: CodeValidation, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Validation failed"},
+		{Code: CodeRouteNotFound, HTTPStatus: http.StatusNotFound, GRPCCode: codes.Unimplemented, Title: "Route not found"},
+		{Code: CodeMethodNotAllowed, HTTPStatus: http.StatusMethodNotAllowed, GRPCCode: codes.Unimplemented, Title: "Method not allowed"},
+		{Code: CodePayloadTooLarge, HTTPStatus: http.StatusRequestEntityTooLarge, GRPCCode: codes.ResourceExhausted, Title: "Payload too large"},
+		{Code: CodeUnsupportedMedia, HTTPStatus: http.StatusUnsupportedMediaType, GRPCCode: codes.InvalidArgument, Title: "Unsupported media type"},
+		{Code: CodeRequestTimeout, HTTPStatus: http.StatusServiceUnavailable, GRPCCode: codes.DeadlineExceeded, Title: "Request timed out"},
+		{Code: CodeInternal, HTTPStatus: http.StatusInternalServerError, GRPCCode: codes.Internal, Title: "Internal error"},
+
+		// Product lookup and uniqueness
+		{Code: domainErrors.ErrProductNotFound.Code, HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound, Title: "Product not found"},
+		{Code: domainErrors.ErrProductAlreadyExists.Code, HTTPStatus: http.StatusConflict, GRPCCode: codes.AlreadyExists, Title: "Product already exists"},
+		{Code: domainErrors.ErrProductGTINAlreadyExists.Code, HTTPStatus: http.StatusConflict, GRPCCode: codes.AlreadyExists, Title: "GTIN already in use"},
+
+		// Product validation
+		{Code: domainErrors.ErrInvalidProductName.Code, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Invalid product name"},
+		{Code: domainErrors.ErrInvalidProductSKU.Code, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Invalid SKU"},
+		{Code: domainErrors.ErrInvalidProductGTIN.Code, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Invalid GTIN"},
+		{Code: domainErrors.ErrInvalidProductPrice.Code, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Invalid price"},
+		{Code: domainErrors.ErrInvalidProductStock.Code, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Invalid stock"},
+		{Code: domainErrors.ErrInvalidProductCategory.Code, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Invalid category"},
+		{Code: domainErrors.ErrInsufficientStock.Code, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.FailedPrecondition, Title: "Insufficient stock"},
+
+		// Product state
+		{Code: domainErrors.ErrProductInactive.Code, HTTPStatus: http.StatusUnprocessableEntity, GRPCCode: codes.FailedPrecondition, Title: "Product inactive"},
+		{Code: domainErrors.ErrProductDiscontinued.Code, HTTPStatus: http.StatusUnprocessableEntity, GRPCCode: codes.FailedPrecondition, Title: "Product discontinued"},
+		{Code: domainErrors.ErrProductOutOfStock.Code, HTTPStatus: http.StatusUnprocessableEntity, GRPCCode: codes.FailedPrecondition, Title: "Product out of stock"},
+		{Code: domainErrors.ErrProductNotAvailable.Code, HTTPStatus: http.StatusUnprocessableEntity, GRPCCode: codes.FailedPrecondition, Title: "Product not available"},
+
+		// Persistence failures
+		{Code: domainErrors.ErrFailedToCheckProductExistance.Code, HTTPStatus: http.StatusInternalServerError, GRPCCode: codes.Internal, Title: "Failed to check product existence"},
+		{Code: domainErrors.ErrFailedToCreateProduct.Code, HTTPStatus: http.StatusInternalServerError, GRPCCode: codes.Internal, Title: "Failed to create product"},
+		{Code: domainErrors.ErrFailedToGenerateSKU.Code, HTTPStatus: http.StatusInternalServerError, GRPCCode: codes.Internal, Title: "Failed to generate SKU"},
+		{Code: domainErrors.ErrFailedToUpdateProduct.Code, HTTPStatus: http.StatusInternalServerError, GRPCCode: codes.Internal, Title: "Failed to update product"},
+		{Code: domainErrors.ErrFailedToDeleteProduct.Code, HTTPStatus: http.StatusInternalServerError, GRPCCode: codes.Internal, Title: "Failed to delete product"},
+		{Code: domainErrors.ErrFailedToListProducts.Code, HTTPStatus: http.StatusInternalServerError, GRPCCode: codes.Internal, Title: "Failed to list products"},
+		{Code: domainErrors.ErrFailedToSearchProducts.Code, HTTPStatus: http.StatusInternalServerError, GRPCCode: codes.Internal, Title: "Failed to search products"},
+		{Code: domainErrors.ErrFailedToUpdateStock.Code, HTTPStatus: http.StatusInternalServerError, GRPCCode: codes.Internal, Title: "Failed to update stock"},
+		{Code: domainErrors.ErrFailedToUpdatePrice.Code, HTTPStatus: http.StatusInternalServerError, GRPCCode: codes.Internal, Title: "Failed to update price"},
+		{Code: domainErrors.ErrFailedToUpdatePricing.Code, HTTPStatus: http.StatusInternalServerError, GRPCCode: codes.Internal, Title: "Failed to update pricing"},
+
+		// Price lists and exchange rates
+		{Code: domainErrors.ErrUnsupportedCurrency.Code, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Unsupported currency"},
+		{Code: domainErrors.ErrPriceListNotFound.Code, HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound, Title: "Price list not found"},
+		{Code: domainErrors.ErrPriceListAlreadyExists.Code, HTTPStatus: http.StatusConflict, GRPCCode: codes.AlreadyExists, Title: "Price list already exists"},
+		{Code: domainErrors.ErrInvalidPriceList.Code, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Invalid price list"},
+		{Code: domainErrors.ErrProductPriceNotFound.Code, HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound, Title: "Product price not found"},
+		{Code: domainErrors.ErrExchangeRateNotFound.Code, HTTPStatus: http.StatusUnprocessableEntity, GRPCCode: codes.FailedPrecondition, Title: "Exchange rate not found"},
+		{Code: domainErrors.ErrInvalidExchangeRate.Code, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Invalid exchange rate"},
+
+		// Price history and scheduling
+		{Code: domainErrors.ErrPriceChangeNotFound.Code, HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound, Title: "Price change not found"},
+		{Code: domainErrors.ErrPriceChangeNotCancellable.Code, HTTPStatus: http.StatusConflict, GRPCCode: codes.FailedPrecondition, Title: "Price change not cancellable"},
+		{Code: domainErrors.ErrInvalidPriceSchedule.Code, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Invalid price schedule"},
+
+		// Promotions
+		{Code: domainErrors.ErrPromotionNotFound.Code, HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound, Title: "Promotion not found"},
+		{Code: domainErrors.ErrInvalidPromotion.Code, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Invalid promotion"},
+		{Code: domainErrors.ErrFailedToEvaluatePromotions.Code, HTTPStatus: http.StatusInternalServerError, GRPCCode: codes.Internal, Title: "Failed to evaluate promotions"},
+
+		// Variant errors
+		{Code: domainErrors.ErrVariantNotFound.Code, HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound, Title: "Variant Not Found"},
+		{Code: domainErrors.ErrVariantAlreadyExists.Code, HTTPStatus: http.StatusConflict, GRPCCode: codes.AlreadyExists, Title: "Variant Already Exists"},
+		{Code: domainErrors.ErrInvalidVariant.Code, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Invalid Variant"},
+		{Code: domainErrors.ErrInvalidProductOptions.Code, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Invalid Product Options"},
+
+		// Attribute errors
+		{Code: domainErrors.ErrAttributeDefinitionNotFound.Code, HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound, Title: "Attribute Definition Not Found"},
+		{Code: domainErrors.ErrInvalidAttributeDefinition.Code, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Invalid Attribute Definition"},
+		{Code: domainErrors.ErrInvalidProductAttributes.Code, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Invalid Product Attributes"},
+
+		// Category
+		{Code: domainErrors.ErrCategoryNotFound.Code, HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound, Title: "Category not found"},
+		{Code: domainErrors.ErrCategoryAlreadyExists.Code, HTTPStatus: http.StatusConflict, GRPCCode: codes.AlreadyExists, Title: "Category already exists"},
+		{Code: domainErrors.ErrInvalidCategoryChange.Code, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Invalid category change"},
+		{Code: domainErrors.ErrCategoryInUse.Code, HTTPStatus: http.StatusConflict, GRPCCode: codes.FailedPrecondition, Title: "Category in use"},
+
+		// Brand errors
+		{Code: domainErrors.ErrBrandNotFound.Code, HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound, Title: "Brand Not Found"},
+		{Code: domainErrors.ErrBrandAlreadyExists.Code, HTTPStatus: http.StatusConflict, GRPCCode: codes.AlreadyExists, Title: "Brand Already Exists"},
+		{Code: domainErrors.ErrInvalidBrand.Code, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Invalid Brand"},
+		{Code: domainErrors.ErrInvalidProductBrand.Code, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Invalid Product Brand"},
+
+		// Media errors
+		{Code: domainErrors.ErrMediaNotFound.Code, HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound, Title: "Media Not Found"},
+		{Code: domainErrors.ErrInvalidMedia.Code, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Invalid Media"},
+		{Code: domainErrors.ErrUnsupportedMediaFormat.Code, HTTPStatus: http.StatusUnsupportedMediaType, GRPCCode: codes.InvalidArgument, Title: "Unsupported Media Format"},
+		{Code: domainErrors.ErrMediaTooLarge.Code, HTTPStatus: http.StatusRequestEntityTooLarge, GRPCCode: codes.InvalidArgument, Title: "Media Too Large"},
+		{Code: domainErrors.ErrFailedToStoreMedia.Code, HTTPStatus: http.StatusInternalServerError, GRPCCode: codes.Internal, Title: "Failed to store media"},
+
+		// Tags and collections
+		{Code: domainErrors.ErrTagNotFound.Code, HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound, Title: "Tag Not Found"},
+		{Code: domainErrors.ErrTagAlreadyExists.Code, HTTPStatus: http.StatusConflict, GRPCCode: codes.AlreadyExists, Title: "Tag Already Exists"},
+		{Code: domainErrors.ErrInvalidTag.Code, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Invalid Tag"},
+		{Code: domainErrors.ErrCollectionNotFound.Code, HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound, Title: "Collection Not Found"},
+		{Code: domainErrors.ErrCollectionAlreadyExists.Code, HTTPStatus: http.StatusConflict, GRPCCode: codes.AlreadyExists, Title: "Collection Already Exists"},
+		{Code: domainErrors.ErrInvalidCollection.Code, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Invalid Collection"},
+
+		// Product lifecycle
+		{Code: domainErrors.ErrInvalidStatusTransition.Code, HTTPStatus: http.StatusConflict, GRPCCode: codes.FailedPrecondition, Title: "Invalid status transition"},
+		{Code: domainErrors.ErrTransitionGuardFailed.Code, HTTPStatus: http.StatusUnprocessableEntity, GRPCCode: codes.FailedPrecondition, Title: "Status transition conditions not met"},
+
+		// Product revisions
+		{Code: domainErrors.ErrRevisionNotFound.Code, HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound, Title: "Revision not found"},
+		{Code: domainErrors.ErrInvalidRevision.Code, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Invalid revision"},
+		{Code: domainErrors.ErrRevisionNotPending.Code, HTTPStatus: http.StatusConflict, GRPCCode: codes.FailedPrecondition, Title: "Revision not pending"},
+		{Code: domainErrors.ErrRevisionNotApproved.Code, HTTPStatus: http.StatusConflict, GRPCCode: codes.FailedPrecondition, Title: "Revision not approved"},
+		{Code: domainErrors.ErrRevisionSelfApproval.Code, HTTPStatus: http.StatusForbidden, GRPCCode: codes.PermissionDenied, Title: "Revision self-approval"},
+		{Code: domainErrors.ErrRevisionStale.Code, HTTPStatus: http.StatusConflict, GRPCCode: codes.Aborted, Title: "Revision out of date"},
+
+		// Audit trail
+		{Code: domainErrors.ErrInvalidAuditQuery.Code, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Invalid audit query"},
+		{Code: domainErrors.ErrFailedToQueryAudit.Code, HTTPStatus: http.StatusInternalServerError, GRPCCode: codes.Internal, Title: "Failed to query audit trail"},
+		{Code: domainErrors.ErrProductVersionNotFound.Code, HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound, Title: "Product version not found"},
+
+		// Product imports
+		{Code: domainErrors.ErrImportNotFound.Code, HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound, Title: "Import not found"},
+		{Code: domainErrors.ErrInvalidImport.Code, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Invalid import"},
+		{Code: domainErrors.ErrImportNotFinished.Code, HTTPStatus: http.StatusConflict, GRPCCode: codes.FailedPrecondition, Title: "Import not finished"},
+		{Code: domainErrors.ErrFailedToImportProducts.Code, HTTPStatus: http.StatusInternalServerError, GRPCCode: codes.Internal, Title: "Failed to import products"},
+
+		// Product exports
+		{Code: domainErrors.ErrInvalidExport.Code, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Invalid export"},
+		{Code: domainErrors.ErrFailedToExportProducts.Code, HTTPStatus: http.StatusInternalServerError, GRPCCode: codes.Internal, Title: "Failed to export products"},
+
+		// Product feeds
+		{Code: domainErrors.ErrInvalidFeedFormat.Code, HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound, Title: "Unknown feed"},
+		{Code: domainErrors.ErrFailedToGenerateFeed.Code, HTTPStatus: http.StatusInternalServerError, GRPCCode: codes.Internal, Title: "Failed to generate feed"},
+
+		// Product batches
+		{Code: domainErrors.ErrInvalidBatch.Code, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Invalid batch"},
+		{Code: domainErrors.ErrBatchTooLarge.Code, HTTPStatus: http.StatusRequestEntityTooLarge, GRPCCode: codes.InvalidArgument, Title: "Batch too large"},
+		{Code: domainErrors.ErrInvalidBatchOperation.Code, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Invalid batch operation"},
+		{Code: domainErrors.ErrBatchRolledBack.Code, HTTPStatus: http.StatusFailedDependency, GRPCCode: codes.Aborted, Title: "Batch rolled back"},
+		{Code: domainErrors.ErrFailedToRunBatch.Code, HTTPStatus: http.StatusInternalServerError, GRPCCode: codes.Internal, Title: "Failed to run batch"},
+
+		// Background jobs
+		{Code: domainErrors.ErrJobNotFound.Code, HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound, Title: "Job not found"},
+		{Code: domainErrors.ErrInvalidJob.Code, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Invalid job"},
+		{Code: domainErrors.ErrInvalidReprice.Code, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Title: "Invalid repricing"},
+		{Code: domainErrors.ErrJobAlreadyFinished.Code, HTTPStatus: http.StatusConflict, GRPCCode: codes.FailedPrecondition, Title: "Job already finished"},
+		{Code: domainErrors.ErrJobResultNotAvailable.Code, HTTPStatus: http.StatusConflict, GRPCCode: codes.FailedPrecondition, Title: "Job result not available"},
+		{Code: domainErrors.ErrFailedToEnqueueJob.Code, HTTPStatus: http.StatusInternalServerError, GRPCCode: codes.Internal, Title: "Failed to enqueue job"},
+	}
+}
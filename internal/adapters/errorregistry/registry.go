@@ -0,0 +1,132 @@
+// Package errorregistry is the single place where machine-readable error codes
+// are mapped to their transport representation (HTTP status, gRPC code) and to
+// the human-facing title and documentation URI used in problem responses.
+package errorregistry
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+)
+
+// DocsBasePath is where the error catalog is served; each entry's Type URI
+// points at its own page under it
+const DocsBasePath = "/api/v1/errors"
+
+// Entry describes how a single error code is exposed to clients
+type Entry struct {
+	Code       string     `json:"code"`
+	HTTPStatus int        `json:"http_status"`
+	GRPCCode   codes.Code `json:"-"`
+	Title      string     `json:"title"`
+	Type       string     `json:"type"`
+}
+
+// GRPCCodeName returns the canonical name of the entry's gRPC code
+func (e Entry) GRPCCodeName() string {
+	return e.GRPCCode.String()
+}
+
+// Registry maps error codes to entries. It is safe for concurrent use.
+type Registry struct {
+	mu      sync.RWMutex
+	entries map[string]Entry
+}
+
+// New creates an empty registry
+func New() *Registry {
+	return &Registry{entries: make(map[string]Entry)}
+}
+
+// Register adds an entry. The Type URI is derived from the code when empty.
+// Registering the same code twice is an error.
+func (r *Registry) Register(entry Entry) error {
+	if entry.Code == "" {
+		return fmt.Errorf("error code is required")
+	}
+	if entry.HTTPStatus == 0 {
+		return fmt.Errorf("http status is required for error code %s", entry.Code)
+	}
+	if entry.Title == "" {
+		entry.Title = http.StatusText(entry.HTTPStatus)
+	}
+	if entry.Type == "" {
+		entry.Type = DocsBasePath + "/" + entry.Code
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.entries[entry.Code]; exists {
+		return fmt.Errorf("error code %s is already registered", entry.Code)
+	}
+	r.entries[entry.Code] = entry
+	return nil
+}
+
+// MustRegister is like Register but panics on error
+func (r *Registry) MustRegister(entries ...Entry) {
+	for _, entry := range entries {
+		if err := r.Register(entry); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// Lookup returns the entry registered for code
+func (r *Registry) Lookup(code string) (Entry, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entry, ok := r.entries[code]
+	return entry, ok
+}
+
+// Resolve returns the entry registered for code. Unregistered codes resolve
+// to a 500 entry that keeps the original code, since an unknown code means a
+// missing registration rather than a client mistake.
+func (r *Registry) Resolve(code string) Entry {
+	if entry, ok := r.Lookup(code); ok {
+		return entry
+	}
+	return Entry{
+		Code:       code,
+		HTTPStatus: http.StatusInternalServerError,
+		GRPCCode:   codes.Unknown,
+		Title:      http.StatusText(http.StatusInternalServerError),
+		Type:       "about:blank",
+	}
+}
+
+// Entries returns every registered entry sorted by code
+func (r *Registry) Entries() []Entry {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entries := make([]Entry, 0, len(r.entries))
+	for _, entry := range r.entries {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Code < entries[j].Code
+	})
+	return entries
+}
+
+var (
+	defaultRegistry     *Registry
+	defaultRegistryOnce sync.Once
+)
+
+// Default returns the process-wide registry populated with every code the
+// service can return
+func Default() *Registry {
+	defaultRegistryOnce.Do(func() {
+		defaultRegistry = New()
+		defaultRegistry.MustRegister(defaultEntries()...)
+	})
+	return defaultRegistry
+}
@@ -0,0 +1,186 @@
+package errorregistry
+
+import (
+	"net/http"
+	"testing"
+
+	domainErrors "product-service/internal/domain/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestDefault_RegistersDomainErrors(t *testing.T) {
+	domainCodes := []*domainErrors.DomainError{
+		domainErrors.ErrProductNotFound,
+		domainErrors.ErrProductAlreadyExists,
+		domainErrors.ErrProductGTINAlreadyExists,
+		domainErrors.ErrInvalidProductName,
+		domainErrors.ErrInvalidProductSKU,
+		domainErrors.ErrInvalidProductGTIN,
+		domainErrors.ErrInvalidProductPrice,
+		domainErrors.ErrInvalidProductStock,
+		domainErrors.ErrInvalidProductCategory,
+		domainErrors.ErrProductInactive,
+		domainErrors.ErrProductDiscontinued,
+		domainErrors.ErrProductOutOfStock,
+		domainErrors.ErrInsufficientStock,
+		domainErrors.ErrProductNotAvailable,
+		domainErrors.ErrFailedToCheckProductExistance,
+		domainErrors.ErrFailedToCreateProduct,
+		domainErrors.ErrFailedToGenerateSKU,
+		domainErrors.ErrFailedToUpdateProduct,
+		domainErrors.ErrFailedToDeleteProduct,
+		domainErrors.ErrFailedToListProducts,
+		domainErrors.ErrFailedToSearchProducts,
+		domainErrors.ErrFailedToUpdateStock,
+		domainErrors.ErrFailedToUpdatePrice,
+		domainErrors.ErrFailedToUpdatePricing,
+		domainErrors.ErrUnsupportedCurrency,
+		domainErrors.ErrPriceListNotFound,
+		domainErrors.ErrPriceListAlreadyExists,
+		domainErrors.ErrInvalidPriceList,
+		domainErrors.ErrProductPriceNotFound,
+		domainErrors.ErrExchangeRateNotFound,
+		domainErrors.ErrInvalidExchangeRate,
+		domainErrors.ErrPriceChangeNotFound,
+		domainErrors.ErrPriceChangeNotCancellable,
+		domainErrors.ErrInvalidPriceSchedule,
+		domainErrors.ErrPromotionNotFound,
+		domainErrors.ErrInvalidPromotion,
+		domainErrors.ErrFailedToEvaluatePromotions,
+		domainErrors.ErrVariantNotFound,
+		domainErrors.ErrVariantAlreadyExists,
+		domainErrors.ErrInvalidVariant,
+		domainErrors.ErrInvalidProductOptions,
+		domainErrors.ErrAttributeDefinitionNotFound,
+		domainErrors.ErrInvalidAttributeDefinition,
+		domainErrors.ErrInvalidProductAttributes,
+		domainErrors.ErrCategoryNotFound,
+		domainErrors.ErrCategoryAlreadyExists,
+		domainErrors.ErrInvalidCategoryChange,
+		domainErrors.ErrCategoryInUse,
+		domainErrors.ErrBrandNotFound,
+		domainErrors.ErrBrandAlreadyExists,
+		domainErrors.ErrInvalidBrand,
+		domainErrors.ErrInvalidProductBrand,
+		domainErrors.ErrMediaNotFound,
+		domainErrors.ErrInvalidMedia,
+		domainErrors.ErrUnsupportedMediaFormat,
+		domainErrors.ErrMediaTooLarge,
+		domainErrors.ErrFailedToStoreMedia,
+		domainErrors.ErrTagNotFound,
+		domainErrors.ErrTagAlreadyExists,
+		domainErrors.ErrInvalidTag,
+		domainErrors.ErrCollectionNotFound,
+		domainErrors.ErrCollectionAlreadyExists,
+		domainErrors.ErrInvalidCollection,
+		domainErrors.ErrInvalidStatusTransition,
+		domainErrors.ErrTransitionGuardFailed,
+		domainErrors.ErrRevisionNotFound,
+		domainErrors.ErrInvalidRevision,
+		domainErrors.ErrRevisionNotPending,
+		domainErrors.ErrRevisionNotApproved,
+		domainErrors.ErrRevisionSelfApproval,
+		domainErrors.ErrRevisionStale,
+		domainErrors.ErrInvalidAuditQuery,
+		domainErrors.ErrFailedToQueryAudit,
+		domainErrors.ErrProductVersionNotFound,
+		domainErrors.ErrImportNotFound,
+		domainErrors.ErrInvalidImport,
+		domainErrors.ErrImportNotFinished,
+		domainErrors.ErrFailedToImportProducts,
+		domainErrors.ErrInvalidExport,
+		domainErrors.ErrFailedToExportProducts,
+		domainErrors.ErrInvalidFeedFormat,
+		domainErrors.ErrFailedToGenerateFeed,
+		domainErrors.ErrInvalidBatch,
+		domainErrors.ErrBatchTooLarge,
+		domainErrors.ErrInvalidBatchOperation,
+		domainErrors.ErrBatchRolledBack,
+		domainErrors.ErrFailedToRunBatch,
+		domainErrors.ErrJobNotFound,
+		domainErrors.ErrInvalidJob,
+		domainErrors.ErrInvalidReprice,
+		domainErrors.ErrJobAlreadyFinished,
+		domainErrors.ErrJobResultNotAvailable,
+		domainErrors.ErrFailedToEnqueueJob,
+	}
+
+	for _, domainErr := range domainCodes {
+		t.Run(domainErr.Code, func(t *testing.T) {
+			entry, ok := Default().Lookup(domainErr.Code)
+			require.True(t, ok)
+			assert.NotZero(t, entry.HTTPStatus)
+			assert.NotEmpty(t, entry.Title)
+			assert.Equal(t, DocsBasePath+"/"+domainErr.Code, entry.Type)
+		})
+	}
+}
+
+func TestDefault_StatusMapping(t *testing.T) {
+	tests := []struct {
+		code       string
+		httpStatus int
+		grpcCode   codes.Code
+	}{
+		{domainErrors.ErrProductNotFound.Code, http.StatusNotFound, codes.NotFound},
+		{domainErrors.ErrProductAlreadyExists.Code, http.StatusConflict, codes.AlreadyExists},
+		{domainErrors.ErrInvalidProductPrice.Code, http.StatusBadRequest, codes.InvalidArgument},
+		{domainErrors.ErrProductDiscontinued.Code, http.StatusUnprocessableEntity, codes.FailedPrecondition},
+		{domainErrors.ErrFailedToCreateProduct.Code, http.StatusInternalServerError, codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			entry := Default().Resolve(tt.code)
+			assert.Equal(t, tt.httpStatus, entry.HTTPStatus)
+			assert.Equal(t, tt.grpcCode, entry.GRPCCode)
+		})
+	}
+}
+
+func TestRegistry_Resolve_UnknownCode(t *testing.T) {
+	registry := New()
+
+	entry := registry.Resolve("NOT_REGISTERED")
+
+	assert.Equal(t, "NOT_REGISTERED", entry.Code)
+	assert.Equal(t, http.StatusInternalServerError, entry.HTTPStatus)
+	assert.Equal(t, "about:blank", entry.Type)
+}
+
+func TestRegistry_Register(t *testing.T) {
+	registry := New()
+
+	err := registry.Register(Entry{Code: "TEST_CODE", HTTPStatus: http.StatusTeapot})
+	require.NoError(t, err)
+
+	entry, ok := registry.Lookup("TEST_CODE")
+	require.True(t, ok)
+	assert.Equal(t, http.StatusText(http.StatusTeapot), entry.Title)
+	assert.Equal(t, DocsBasePath+"/TEST_CODE", entry.Type)
+
+	// Duplicate codes are rejected
+	err = registry.Register(Entry{Code: "TEST_CODE", HTTPStatus: http.StatusBadRequest})
+	assert.Error(t, err)
+
+	// Missing fields are rejected
+	assert.Error(t, registry.Register(Entry{HTTPStatus: http.StatusBadRequest}))
+	assert.Error(t, registry.Register(Entry{Code: "NO_STATUS"}))
+}
+
+func TestRegistry_Entries_Sorted(t *testing.T) {
+	registry := New()
+	registry.MustRegister(
+		Entry{Code: "B_CODE", HTTPStatus: http.StatusBadRequest},
+		Entry{Code: "A_CODE", HTTPStatus: http.StatusBadRequest},
+	)
+
+	entries := registry.Entries()
+
+	require.Len(t, entries, 2)
+	assert.Equal(t, "A_CODE", entries[0].Code)
+	assert.Equal(t, "B_CODE", entries[1].Code)
+}
@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"product-service/internal/adapters/errorregistry"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/usecases"
+	"product-service/pkg/logger"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+type AttributeHandler struct {
+	attributeUseCases usecases.AttributeUseCases
+	validator         *validator.Validate
+	logger            logger.Logger
+}
+
+func NewAttributeHandler(attributeUseCases usecases.AttributeUseCases, log logger.Logger) *AttributeHandler {
+	return &AttributeHandler{
+		attributeUseCases: attributeUseCases,
+		validator:         validator.New(),
+		logger:            log.With("component", "attribute_handler"),
+	}
+}
+
+// GetSchema handles GET /api/v1/admin/categories/:id/attributes
+func (h *AttributeHandler) GetSchema(c echo.Context) error {
+	categoryID, err := h.parseID(c, "id")
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid category ID format")
+	}
+
+	response, err := h.attributeUseCases.GetSchema(c.Request().Context(), categoryID)
+	if err != nil {
+		return h.handleError(c, err, "Failed to get attribute schema")
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// SaveDefinition handles PUT /api/v1/admin/categories/:id/attributes/:key
+func (h *AttributeHandler) SaveDefinition(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	categoryID, err := h.parseID(c, "id")
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid category ID format")
+	}
+	key := c.Param("key")
+
+	var request dto.AttributeDefinitionRequestDTO
+	if err := c.Bind(&request); err != nil {
+		log.Warn("Failed to bind request body",
+			"error", err)
+		return respondProblem(c, errorregistry.CodeInvalidRequest, "Invalid request body format")
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		log.Warn("Request validation failed",
+			"error", err)
+		return respondProblem(c, errorregistry.CodeValidation, "Request validation failed", validationFieldErrors(c, err)...)
+	}
+
+	response, err := h.attributeUseCases.SaveDefinition(c.Request().Context(), categoryID, key, &request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to save attribute definition")
+	}
+
+	log.Info("Attribute definition saved successfully",
+		"category_id", categoryID,
+		"key", key)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// DeleteDefinition handles DELETE /api/v1/admin/categories/:id/attributes/:key
+func (h *AttributeHandler) DeleteDefinition(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	categoryID, err := h.parseID(c, "id")
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid category ID format")
+	}
+	key := c.Param("key")
+
+	if err := h.attributeUseCases.DeleteDefinition(c.Request().Context(), categoryID, key); err != nil {
+		return h.handleError(c, err, "Failed to delete attribute definition")
+	}
+
+	log.Info("Attribute definition deleted successfully",
+		"category_id", categoryID,
+		"key", key)
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *AttributeHandler) parseID(c echo.Context, name string) (uint, error) {
+	param := c.Param(name)
+	id, err := strconv.ParseUint(param, 10, 32)
+	if err != nil {
+		h.logger.Ctx(c.Request().Context()).Warn("Invalid ID parameter",
+			"param", name,
+			"value", param,
+			"error", err)
+		return 0, err
+	}
+	return uint(id), nil
+}
+
+func (h *AttributeHandler) handleError(c echo.Context, err error, logMessage string) error {
+	h.logger.Ctx(c.Request().Context()).Error(logMessage,
+		"error", err)
+
+	return respondDomainError(c, err)
+}
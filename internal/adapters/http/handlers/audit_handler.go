@@ -0,0 +1,157 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"product-service/internal/adapters/errorregistry"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/usecases"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+type AuditHandler struct {
+	auditUseCases usecases.AuditUseCases
+	validator     *validator.Validate
+	logger        logger.Logger
+}
+
+func NewAuditHandler(auditUseCases usecases.AuditUseCases, log logger.Logger) *AuditHandler {
+	return &AuditHandler{
+		auditUseCases: auditUseCases,
+		validator:     validator.New(),
+		logger:        log.With("component", "audit_handler"),
+	}
+}
+
+// GetProductAudit handles GET /api/v1/products/:id/audit
+// Query parameters: the filters of ListAudit except product_id
+func (h *AuditHandler) GetProductAudit(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		log.Warn("Invalid product ID parameter",
+			"id_param", idParam,
+			"error", err)
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid product ID format")
+	}
+
+	query, ok, err := h.parseQuery(c)
+	if !ok {
+		return err
+	}
+
+	response, err := h.auditUseCases.ListProductAudit(c.Request().Context(), uint(id), query)
+	if err != nil {
+		return h.handleError(c, err, "Failed to get product audit trail")
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// ListAudit handles GET /api/v1/admin/audit
+// Query parameters: product_id, actor, operation, request_id, field (e.g.
+// price or attributes.color), from and to (RFC 3339), page, page_size
+func (h *AuditHandler) ListAudit(c echo.Context) error {
+	query, ok, err := h.parseQuery(c)
+	if !ok {
+		return err
+	}
+
+	response, err := h.auditUseCases.ListAudit(c.Request().Context(), query)
+	if err != nil {
+		return h.handleError(c, err, "Failed to query audit trail")
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// parseQuery builds and validates an audit query from the query string. When
+// the query is rejected the problem response is already written and ok is
+// false.
+func (h *AuditHandler) parseQuery(c echo.Context) (*dto.AuditQueryDTO, bool, error) {
+	log := h.logger.Ctx(c.Request().Context())
+
+	query, err := parseAuditQuery(c)
+	if err != nil {
+		log.Warn("Invalid audit query parameters",
+			"error", err)
+		return nil, false, h.handleError(c, err, "Invalid audit query parameters")
+	}
+
+	if err := h.validator.Struct(query); err != nil {
+		log.Warn("Audit query validation failed",
+			"error", err)
+		return nil, false, respondProblem(c, errorregistry.CodeValidation, "Request validation failed", validationFieldErrors(c, err)...)
+	}
+
+	return query, true, nil
+}
+
+// parseAuditQuery reads the audit filters from the query string
+func parseAuditQuery(c echo.Context) (*dto.AuditQueryDTO, error) {
+	query := &dto.AuditQueryDTO{
+		Actor:     c.QueryParam("actor"),
+		Operation: entities.AuditOperation(c.QueryParam("operation")),
+		RequestID: c.QueryParam("request_id"),
+		Field:     c.QueryParam("field"),
+		PageSize:  10,
+	}
+
+	if productIDParam := c.QueryParam("product_id"); productIDParam != "" {
+		productID, err := strconv.ParseUint(productIDParam, 10, 32)
+		if err != nil {
+			return nil, invalidAuditQuery("product_id", "product_id must be a product ID")
+		}
+		query.ProductID = uint(productID)
+	}
+	if pageParam := c.QueryParam("page"); pageParam != "" {
+		page, err := strconv.Atoi(pageParam)
+		if err != nil || page < 0 {
+			return nil, invalidAuditQuery("page", "page must be a non-negative integer")
+		}
+		query.Page = page
+	}
+	if sizeParam := c.QueryParam("page_size"); sizeParam != "" {
+		pageSize, err := strconv.Atoi(sizeParam)
+		if err != nil {
+			return nil, invalidAuditQuery("page_size", "page_size must be an integer")
+		}
+		query.PageSize = pageSize
+	}
+
+	for param, target := range map[string]**time.Time{"from": &query.From, "to": &query.To} {
+		if value := c.QueryParam(param); value != "" {
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return nil, invalidAuditQuery(param, param+" must be an RFC 3339 timestamp")
+			}
+			*target = &parsed
+		}
+	}
+
+	return query, nil
+}
+
+func invalidAuditQuery(field, message string) error {
+	return &domainErrors.DomainError{
+		Code:    domainErrors.ErrInvalidAuditQuery.Code,
+		Message: message,
+		Field:   field,
+	}
+}
+
+func (h *AuditHandler) handleError(c echo.Context, err error, logMessage string) error {
+	h.logger.Ctx(c.Request().Context()).Error(logMessage,
+		"error", err)
+
+	return respondDomainError(c, err)
+}
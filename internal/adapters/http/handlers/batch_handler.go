@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"product-service/internal/adapters/errorregistry"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/usecases"
+	"product-service/internal/domain/entities"
+	"product-service/pkg/logger"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+type BatchHandler struct {
+	batchUseCases usecases.BatchUseCases
+	validator     *validator.Validate
+	logger        logger.Logger
+}
+
+func NewBatchHandler(batchUseCases usecases.BatchUseCases, log logger.Logger) *BatchHandler {
+	return &BatchHandler{
+		batchUseCases: batchUseCases,
+		validator:     validator.New(),
+		logger:        log.With("component", "batch_handler"),
+	}
+}
+
+// batchResponse is a batch outcome with the status of every operation
+type batchResponse struct {
+	*dto.BatchResponseDTO
+	Results []batchResultResponse `json:"results"`
+}
+
+// batchResultResponse is the outcome of one operation with the status the
+// single-product endpoint would have responded with, and its problem when
+// the operation failed
+type batchResultResponse struct {
+	*dto.BatchResultDTO
+	Status int      `json:"status"`
+	Error  *Problem `json:"error,omitempty"`
+}
+
+// RunBatch handles POST /api/v1/products/batch
+// The body lists operations (create, update, stock, price, status) that name
+// their product by id or sku; "atomic": true applies all of them or none.
+// Responds 200 when every operation succeeded and 207 otherwise, with a
+// status and, for failures, a problem per operation.
+func (h *BatchHandler) RunBatch(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	var request dto.BatchRequestDTO
+	if err := c.Bind(&request); err != nil {
+		log.Warn("Failed to bind request body",
+			"error", err)
+		return respondProblem(c, errorregistry.CodeInvalidRequest, "Invalid request body format")
+	}
+
+	if fieldErrors := h.validateOperations(c, &request); len(fieldErrors) > 0 {
+		log.Warn("Request validation failed",
+			"invalid_fields", len(fieldErrors))
+		return respondProblem(c, errorregistry.CodeValidation, "Request validation failed", fieldErrors...)
+	}
+
+	log.Info("Batch request received",
+		"operations", len(request.Operations),
+		"atomic", request.Atomic)
+
+	response, err := h.batchUseCases.RunBatch(c.Request().Context(), &request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to run batch")
+	}
+
+	log.Info("Batch finished",
+		"succeeded", response.Succeeded,
+		"failed", response.Failed,
+		"rolled_back", response.RolledBack)
+
+	body := batchResponse{
+		BatchResponseDTO: response,
+		Results:          make([]batchResultResponse, 0, len(response.Results)),
+	}
+	for _, result := range response.Results {
+		rendered := batchResultResponse{BatchResultDTO: result, Status: http.StatusOK}
+		switch {
+		case result.Err != nil:
+			problem := domainProblem(c, result.Err)
+			rendered.Error = &problem
+			rendered.Status = problem.Status
+		case result.Action == entities.BatchCreate:
+			rendered.Status = http.StatusCreated
+		}
+		body.Results = append(body.Results, rendered)
+	}
+
+	status := http.StatusOK
+	if response.Failed > 0 {
+		status = http.StatusMultiStatus
+	}
+	return c.JSON(status, body)
+}
+
+// validateOperations validates each operation on its own so field errors
+// say which operation they belong to, e.g. operations[2].Stock
+func (h *BatchHandler) validateOperations(c echo.Context, request *dto.BatchRequestDTO) []FieldError {
+	var fieldErrors []FieldError
+	if err := h.validator.Var(request.Operations, "required,min=1"); err != nil {
+		for _, fieldError := range validationFieldErrors(c, err) {
+			fieldError.Field = "operations"
+			fieldErrors = append(fieldErrors, fieldError)
+		}
+		return fieldErrors
+	}
+
+	for i, operation := range request.Operations {
+		if operation == nil {
+			fieldErrors = append(fieldErrors, FieldError{
+				Field:   fmt.Sprintf("operations[%d]", i),
+				Code:    "required",
+				Message: localizerFor(c).Validation("required", "operation", ""),
+			})
+			continue
+		}
+		if err := h.validator.Struct(operation); err != nil {
+			for _, fieldError := range validationFieldErrors(c, err) {
+				fieldError.Field = fmt.Sprintf("operations[%d].%s", i, fieldError.Field)
+				fieldErrors = append(fieldErrors, fieldError)
+			}
+		}
+	}
+	return fieldErrors
+}
+
+func (h *BatchHandler) handleError(c echo.Context, err error, logMessage string) error {
+	h.logger.Ctx(c.Request().Context()).Error(logMessage,
+		"error", err)
+
+	return respondDomainError(c, err)
+}
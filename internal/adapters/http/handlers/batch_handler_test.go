@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"product-service/internal/application/dto"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockBatchUseCases implements the BatchUseCases interface for testing
+type MockBatchUseCases struct {
+	mock.Mock
+}
+
+func (m *MockBatchUseCases) RunBatch(ctx context.Context, request *dto.BatchRequestDTO) (*dto.BatchResponseDTO, error) {
+	args := m.Called(ctx, request)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.BatchResponseDTO), args.Error(1)
+}
+
+func setupTestBatchHandler() (*BatchHandler, *MockBatchUseCases) {
+	mockUseCases := new(MockBatchUseCases)
+	handler := NewBatchHandler(mockUseCases, logger.New("test"))
+	return handler, mockUseCases
+}
+
+func newBatchContext(body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/products/batch", bytes.NewBufferString(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	return echo.New().NewContext(req, rec), rec
+}
+
+func TestBatchHandler_RunBatch_PerOperationStatus(t *testing.T) {
+	// Setup
+	handler, mockUseCases := setupTestBatchHandler()
+
+	mockUseCases.On("RunBatch", mock.Anything, mock.MatchedBy(func(request *dto.BatchRequestDTO) bool {
+		return len(request.Operations) == 3 && request.Operations[1].SKU == "IPH15-128GB" && *request.Operations[1].Stock == 42
+	})).Return(&dto.BatchResponseDTO{
+		Succeeded: 2,
+		Failed:    1,
+		Results: []*dto.BatchResultDTO{
+			{Index: 0, Action: entities.BatchCreate, Product: &dto.ProductResponseDTO{ID: 3, SKU: "IPAD-AIR-11"}},
+			{Index: 1, Action: entities.BatchStock, Product: &dto.ProductResponseDTO{ID: 1, SKU: "IPH15-128GB", Stock: 42}},
+			{Index: 2, Action: entities.BatchStock, Err: domainErrors.ErrProductNotFound},
+		},
+	}, nil)
+
+	body := `{"operations":[
+		{"action":"create","product":{"name":"iPad Air","sku":"IPAD-AIR-11","price":{"amount":"599.00","currency":"USD"},"category":"Electronics"}},
+		{"action":"stock","sku":"IPH15-128GB","stock":42},
+		{"action":"stock","sku":"UNKNOWN-SKU","stock":1}]}`
+	c, rec := newBatchContext(body)
+
+	// Execute
+	err := handler.RunBatch(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusMultiStatus, rec.Code)
+
+	var response struct {
+		Succeeded int `json:"succeeded"`
+		Failed    int `json:"failed"`
+		Results   []struct {
+			Index   int                     `json:"index"`
+			Status  int                     `json:"status"`
+			Product *dto.ProductResponseDTO `json:"product"`
+			Error   *Problem                `json:"error"`
+		} `json:"results"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, 2, response.Succeeded)
+	require.Len(t, response.Results, 3)
+	assert.Equal(t, http.StatusCreated, response.Results[0].Status)
+	assert.Equal(t, http.StatusOK, response.Results[1].Status)
+	assert.Equal(t, 42, response.Results[1].Product.Stock)
+	assert.Equal(t, http.StatusNotFound, response.Results[2].Status)
+	require.NotNil(t, response.Results[2].Error)
+	assert.Equal(t, domainErrors.ErrProductNotFound.Code, response.Results[2].Error.Code)
+	assert.Nil(t, response.Results[2].Product)
+
+	mockUseCases.AssertExpectations(t)
+}
+
+func TestBatchHandler_RunBatch_ValidationError(t *testing.T) {
+	// Setup
+	handler, mockUseCases := setupTestBatchHandler()
+	c, rec := newBatchContext(`{"operations":[{"action":"stock","id":1,"stock":1},{"action":"delete","id":2}]}`)
+
+	// Execute
+	err := handler.RunBatch(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var problem Problem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+	require.Len(t, problem.Errors, 1)
+	assert.Equal(t, "operations[1].Action", problem.Errors[0].Field)
+	mockUseCases.AssertNotCalled(t, "RunBatch", mock.Anything, mock.Anything)
+}
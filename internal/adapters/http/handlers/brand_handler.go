@@ -0,0 +1,177 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"product-service/internal/adapters/errorregistry"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/usecases"
+	"product-service/internal/domain/entities"
+	"product-service/pkg/logger"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+type BrandHandler struct {
+	brandUseCases usecases.BrandUseCases
+	validator     *validator.Validate
+	logger        logger.Logger
+}
+
+func NewBrandHandler(brandUseCases usecases.BrandUseCases, log logger.Logger) *BrandHandler {
+	return &BrandHandler{
+		brandUseCases: brandUseCases,
+		validator:     validator.New(),
+		logger:        log.With("component", "brand_handler"),
+	}
+}
+
+// BrandListResponse wraps the brand list
+type BrandListResponse struct {
+	Brands []*dto.BrandResponseDTO `json:"brands"`
+}
+
+// ListBrands handles GET /api/v1/brands
+// Query parameters: status (active or inactive)
+func (h *BrandHandler) ListBrands(c echo.Context) error {
+	status := c.QueryParam("status")
+	if status != "" && status != string(entities.BrandStatusActive) && status != string(entities.BrandStatusInactive) {
+		return respondProblem(c, errorregistry.CodeInvalidRequest, "status must be active or inactive")
+	}
+
+	brands, err := h.brandUseCases.ListBrands(c.Request().Context(), status)
+	if err != nil {
+		return h.handleError(c, err, "Failed to list brands")
+	}
+
+	return c.JSON(http.StatusOK, BrandListResponse{Brands: brands})
+}
+
+// GetBrand handles GET /api/v1/brands/:id
+func (h *BrandHandler) GetBrand(c echo.Context) error {
+	id, err := h.parseID(c)
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid brand ID format")
+	}
+
+	response, err := h.brandUseCases.GetBrand(c.Request().Context(), id)
+	if err != nil {
+		return h.handleError(c, err, "Failed to get brand")
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// CreateBrand handles POST /api/v1/admin/brands
+func (h *BrandHandler) CreateBrand(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	var request dto.CreateBrandRequestDTO
+	if ok, err := h.bind(c, &request); !ok {
+		return err
+	}
+
+	response, err := h.brandUseCases.CreateBrand(c.Request().Context(), &request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to create brand")
+	}
+
+	log.Info("Brand created successfully",
+		"brand_id", response.ID,
+		"name", response.Name)
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+// UpdateBrand handles PATCH /api/v1/admin/brands/:id
+func (h *BrandHandler) UpdateBrand(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	id, err := h.parseID(c)
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid brand ID format")
+	}
+
+	var request dto.UpdateBrandRequestDTO
+	if ok, err := h.bind(c, &request); !ok {
+		return err
+	}
+
+	response, err := h.brandUseCases.UpdateBrand(c.Request().Context(), id, &request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to update brand")
+	}
+
+	log.Info("Brand updated successfully",
+		"brand_id", id,
+		"name", response.Name)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// MergeBrand handles POST /api/v1/admin/brands/:id/merge
+func (h *BrandHandler) MergeBrand(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	id, err := h.parseID(c)
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid brand ID format")
+	}
+
+	var request dto.MergeBrandRequestDTO
+	if ok, err := h.bind(c, &request); !ok {
+		return err
+	}
+
+	response, err := h.brandUseCases.MergeBrand(c.Request().Context(), id, &request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to merge brand")
+	}
+
+	log.Info("Brand merged successfully",
+		"brand_id", id,
+		"target_id", request.TargetID)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// bind parses and validates a request body. When the body is rejected the
+// problem response is already written and ok is false.
+func (h *BrandHandler) bind(c echo.Context, request interface{}) (bool, error) {
+	log := h.logger.Ctx(c.Request().Context())
+
+	if err := c.Bind(request); err != nil {
+		log.Warn("Failed to bind request body",
+			"error", err)
+		return false, respondProblem(c, errorregistry.CodeInvalidRequest, "Invalid request body format")
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		log.Warn("Request validation failed",
+			"error", err)
+		return false, respondProblem(c, errorregistry.CodeValidation, "Request validation failed", validationFieldErrors(c, err)...)
+	}
+
+	return true, nil
+}
+
+func (h *BrandHandler) parseID(c echo.Context) (uint, error) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		h.logger.Ctx(c.Request().Context()).Warn("Invalid brand ID parameter",
+			"id_param", idParam,
+			"error", err)
+		return 0, err
+	}
+	return uint(id), nil
+}
+
+func (h *BrandHandler) handleError(c echo.Context, err error, logMessage string) error {
+	h.logger.Ctx(c.Request().Context()).Error(logMessage,
+		"error", err)
+
+	return respondDomainError(c, err)
+}
@@ -0,0 +1,242 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"product-service/internal/adapters/errorregistry"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/usecases"
+	"product-service/pkg/logger"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+type CategoryHandler struct {
+	categoryUseCases usecases.CategoryUseCases
+	validator        *validator.Validate
+	logger           logger.Logger
+}
+
+func NewCategoryHandler(categoryUseCases usecases.CategoryUseCases, log logger.Logger) *CategoryHandler {
+	return &CategoryHandler{
+		categoryUseCases: categoryUseCases,
+		validator:        validator.New(),
+		logger:           log.With("component", "category_handler"),
+	}
+}
+
+// CategoryTreeResponse wraps the category tree
+type CategoryTreeResponse struct {
+	Categories []*dto.CategoryResponseDTO `json:"categories"`
+}
+
+// ListCategories handles GET /api/v1/categories
+func (h *CategoryHandler) ListCategories(c echo.Context) error {
+	categories, err := h.categoryUseCases.ListCategories(c.Request().Context())
+	if err != nil {
+		return h.handleError(c, err, "Failed to list categories")
+	}
+
+	return c.JSON(http.StatusOK, CategoryTreeResponse{Categories: categories})
+}
+
+// GetCategory handles GET /api/v1/categories/:id
+func (h *CategoryHandler) GetCategory(c echo.Context) error {
+	id, err := h.parseID(c)
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid category ID format")
+	}
+
+	response, err := h.categoryUseCases.GetCategory(c.Request().Context(), id)
+	if err != nil {
+		return h.handleError(c, err, "Failed to get category")
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// CreateCategory handles POST /api/v1/admin/categories
+func (h *CategoryHandler) CreateCategory(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	var request dto.CreateCategoryRequestDTO
+	if ok, err := h.bind(c, &request); !ok {
+		return err
+	}
+
+	response, err := h.categoryUseCases.CreateCategory(c.Request().Context(), &request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to create category")
+	}
+
+	log.Info("Category created successfully",
+		"category_id", response.ID,
+		"path", response.Path)
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+// RenameCategory handles PATCH /api/v1/admin/categories/:id
+func (h *CategoryHandler) RenameCategory(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	id, err := h.parseID(c)
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid category ID format")
+	}
+
+	var request dto.RenameCategoryRequestDTO
+	if ok, err := h.bind(c, &request); !ok {
+		return err
+	}
+
+	response, err := h.categoryUseCases.RenameCategory(c.Request().Context(), id, &request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to rename category")
+	}
+
+	log.Info("Category renamed successfully",
+		"category_id", id,
+		"path", response.Path)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// SetSKURules handles PUT /api/v1/admin/categories/:id/sku-rules
+func (h *CategoryHandler) SetSKURules(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	id, err := h.parseID(c)
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid category ID format")
+	}
+
+	var request dto.SKURulesRequestDTO
+	if ok, err := h.bind(c, &request); !ok {
+		return err
+	}
+
+	response, err := h.categoryUseCases.SetSKURules(c.Request().Context(), id, &request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to set category SKU rules")
+	}
+
+	log.Info("Category SKU rules set successfully",
+		"category_id", id,
+		"sku_pattern", response.SKUPattern,
+		"sku_template", response.SKUTemplate)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// MoveCategory handles POST /api/v1/admin/categories/:id/move
+func (h *CategoryHandler) MoveCategory(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	id, err := h.parseID(c)
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid category ID format")
+	}
+
+	var request dto.MoveCategoryRequestDTO
+	if ok, err := h.bind(c, &request); !ok {
+		return err
+	}
+
+	response, err := h.categoryUseCases.MoveCategory(c.Request().Context(), id, &request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to move category")
+	}
+
+	log.Info("Category moved successfully",
+		"category_id", id,
+		"path", response.Path)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// MergeCategory handles POST /api/v1/admin/categories/:id/merge
+func (h *CategoryHandler) MergeCategory(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	id, err := h.parseID(c)
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid category ID format")
+	}
+
+	var request dto.MergeCategoryRequestDTO
+	if ok, err := h.bind(c, &request); !ok {
+		return err
+	}
+
+	response, err := h.categoryUseCases.MergeCategory(c.Request().Context(), id, &request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to merge category")
+	}
+
+	log.Info("Category merged successfully",
+		"category_id", id,
+		"target_id", request.TargetID)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// DeleteCategory handles DELETE /api/v1/admin/categories/:id
+func (h *CategoryHandler) DeleteCategory(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	id, err := h.parseID(c)
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid category ID format")
+	}
+
+	if err := h.categoryUseCases.DeleteCategory(c.Request().Context(), id); err != nil {
+		return h.handleError(c, err, "Failed to delete category")
+	}
+
+	log.Info("Category deleted successfully",
+		"category_id", id)
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// bind parses and validates a request body. When the body is rejected the
+// problem response is already written and ok is false.
+func (h *CategoryHandler) bind(c echo.Context, request interface{}) (bool, error) {
+	log := h.logger.Ctx(c.Request().Context())
+
+	if err := c.Bind(request); err != nil {
+		log.Warn("Failed to bind request body",
+			"error", err)
+		return false, respondProblem(c, errorregistry.CodeInvalidRequest, "Invalid request body format")
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		log.Warn("Request validation failed",
+			"error", err)
+		return false, respondProblem(c, errorregistry.CodeValidation, "Request validation failed", validationFieldErrors(c, err)...)
+	}
+
+	return true, nil
+}
+
+func (h *CategoryHandler) parseID(c echo.Context) (uint, error) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		h.logger.Ctx(c.Request().Context()).Warn("Invalid category ID parameter",
+			"id_param", idParam,
+			"error", err)
+		return 0, err
+	}
+	return uint(id), nil
+}
+
+func (h *CategoryHandler) handleError(c echo.Context, err error, logMessage string) error {
+	h.logger.Ctx(c.Request().Context()).Error(logMessage,
+		"error", err)
+
+	return respondDomainError(c, err)
+}
@@ -0,0 +1,204 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"product-service/internal/adapters/errorregistry"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/usecases"
+	"product-service/pkg/logger"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+type CollectionHandler struct {
+	collectionUseCases usecases.CollectionUseCases
+	variantUseCases    usecases.VariantUseCases
+	mediaUseCases      usecases.MediaUseCases
+	validator          *validator.Validate
+	logger             logger.Logger
+}
+
+func NewCollectionHandler(collectionUseCases usecases.CollectionUseCases, variantUseCases usecases.VariantUseCases, mediaUseCases usecases.MediaUseCases, log logger.Logger) *CollectionHandler {
+	return &CollectionHandler{
+		collectionUseCases: collectionUseCases,
+		variantUseCases:    variantUseCases,
+		mediaUseCases:      mediaUseCases,
+		validator:          validator.New(),
+		logger:             log.With("component", "collection_handler"),
+	}
+}
+
+// CollectionListResponse wraps the collection list
+type CollectionListResponse struct {
+	Collections []*dto.CollectionResponseDTO `json:"collections"`
+}
+
+// ListCollections handles GET /api/v1/collections
+func (h *CollectionHandler) ListCollections(c echo.Context) error {
+	collections, err := h.collectionUseCases.ListCollections(c.Request().Context())
+	if err != nil {
+		return h.handleError(c, err, "Failed to list collections")
+	}
+
+	return c.JSON(http.StatusOK, CollectionListResponse{Collections: collections})
+}
+
+// GetCollection handles GET /api/v1/collections/:ref where ref is an ID or
+// a slug
+func (h *CollectionHandler) GetCollection(c echo.Context) error {
+	response, err := h.collectionUseCases.GetCollection(c.Request().Context(), c.Param("ref"))
+	if err != nil {
+		return h.handleError(c, err, "Failed to get collection")
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// ListCollectionProducts handles GET /api/v1/collections/:ref/products
+// Query parameters: page, page_size
+func (h *CollectionHandler) ListCollectionProducts(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	page := 0
+	pageSize := 10
+
+	if pageParam := c.QueryParam("page"); pageParam != "" {
+		if p, err := strconv.Atoi(pageParam); err == nil && p >= 0 {
+			page = p
+		}
+	}
+
+	if sizeParam := c.QueryParam("page_size"); sizeParam != "" {
+		if ps, err := strconv.Atoi(sizeParam); err == nil && ps > 0 && ps <= 100 {
+			pageSize = ps
+		}
+	}
+
+	response, err := h.collectionUseCases.ListCollectionProducts(c.Request().Context(), c.Param("ref"), page, pageSize)
+	if err != nil {
+		return h.handleError(c, err, "Failed to list collection products")
+	}
+
+	if err := h.variantUseCases.ApplyVariantAvailability(c.Request().Context(), response.Products); err != nil {
+		return h.handleError(c, err, "Failed to derive product availability from variants")
+	}
+
+	if err := h.mediaUseCases.ApplyMedia(c.Request().Context(), response.Products); err != nil {
+		return h.handleError(c, err, "Failed to load product media")
+	}
+
+	log.Info("Collection products listed successfully",
+		"collection", c.Param("ref"),
+		"count", len(response.Products),
+		"page", page)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// CreateCollection handles POST /api/v1/admin/collections
+func (h *CollectionHandler) CreateCollection(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	var request dto.CreateCollectionRequestDTO
+	if ok, err := h.bind(c, &request); !ok {
+		return err
+	}
+
+	response, err := h.collectionUseCases.CreateCollection(c.Request().Context(), &request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to create collection")
+	}
+
+	log.Info("Collection created successfully",
+		"collection_id", response.ID,
+		"slug", response.Slug)
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+// UpdateCollection handles PATCH /api/v1/admin/collections/:id
+func (h *CollectionHandler) UpdateCollection(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	id, err := h.parseID(c)
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid collection ID format")
+	}
+
+	var request dto.UpdateCollectionRequestDTO
+	if ok, err := h.bind(c, &request); !ok {
+		return err
+	}
+
+	response, err := h.collectionUseCases.UpdateCollection(c.Request().Context(), id, &request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to update collection")
+	}
+
+	log.Info("Collection updated successfully",
+		"collection_id", id,
+		"slug", response.Slug)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// DeleteCollection handles DELETE /api/v1/admin/collections/:id
+func (h *CollectionHandler) DeleteCollection(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	id, err := h.parseID(c)
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid collection ID format")
+	}
+
+	if err := h.collectionUseCases.DeleteCollection(c.Request().Context(), id); err != nil {
+		return h.handleError(c, err, "Failed to delete collection")
+	}
+
+	log.Info("Collection deleted successfully",
+		"collection_id", id)
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// bind parses and validates a request body. When the body is rejected the
+// problem response is already written and ok is false.
+func (h *CollectionHandler) bind(c echo.Context, request interface{}) (bool, error) {
+	log := h.logger.Ctx(c.Request().Context())
+
+	if err := c.Bind(request); err != nil {
+		log.Warn("Failed to bind request body",
+			"error", err)
+		return false, respondProblem(c, errorregistry.CodeInvalidRequest, "Invalid request body format")
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		log.Warn("Request validation failed",
+			"error", err)
+		return false, respondProblem(c, errorregistry.CodeValidation, "Request validation failed", validationFieldErrors(c, err)...)
+	}
+
+	return true, nil
+}
+
+func (h *CollectionHandler) parseID(c echo.Context) (uint, error) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		h.logger.Ctx(c.Request().Context()).Warn("Invalid collection ID parameter",
+			"id_param", idParam,
+			"error", err)
+		return 0, err
+	}
+	return uint(id), nil
+}
+
+func (h *CollectionHandler) handleError(c echo.Context, err error, logMessage string) error {
+	h.logger.Ctx(c.Request().Context()).Error(logMessage,
+		"error", err)
+
+	return respondDomainError(c, err)
+}
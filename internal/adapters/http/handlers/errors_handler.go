@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"net/http"
+
+	"product-service/internal/adapters/errorregistry"
+	"product-service/pkg/logger"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ErrorsHandler struct {
+	registry *errorregistry.Registry
+	logger   logger.Logger
+}
+
+func NewErrorsHandler(registry *errorregistry.Registry, log logger.Logger) *ErrorsHandler {
+	return &ErrorsHandler{
+		registry: registry,
+		logger:   log.With("component", "errors_handler"),
+	}
+}
+
+// ErrorCatalogEntry documents a single error code
+type ErrorCatalogEntry struct {
+	Code       string `json:"code"`
+	Title      string `json:"title"`
+	HTTPStatus int    `json:"http_status"`
+	GRPCCode   string `json:"grpc_code"`
+	Type       string `json:"type"`
+}
+
+// ErrorCatalogResponse lists every error code the service can return
+type ErrorCatalogResponse struct {
+	Errors []ErrorCatalogEntry `json:"errors"`
+	Total  int                 `json:"total"`
+}
+
+// ListErrors handles GET /api/v1/errors
+func (h *ErrorsHandler) ListErrors(c echo.Context) error {
+	entries := h.registry.Entries()
+
+	response := ErrorCatalogResponse{
+		Errors: make([]ErrorCatalogEntry, 0, len(entries)),
+		Total:  len(entries),
+	}
+	for _, entry := range entries {
+		response.Errors = append(response.Errors, toErrorCatalogEntry(entry))
+	}
+
+	h.logger.Ctx(c.Request().Context()).Debug("Error catalog listed",
+		"count", response.Total)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetError handles GET /api/v1/errors/:code
+func (h *ErrorsHandler) GetError(c echo.Context) error {
+	code := c.Param("code")
+
+	entry, ok := h.registry.Lookup(code)
+	if !ok {
+		return respondProblem(c, errorregistry.CodeRouteNotFound, "Unknown error code: "+code)
+	}
+
+	return c.JSON(http.StatusOK, toErrorCatalogEntry(entry))
+}
+
+func toErrorCatalogEntry(entry errorregistry.Entry) ErrorCatalogEntry {
+	return ErrorCatalogEntry{
+		Code:       entry.Code,
+		Title:      entry.Title,
+		HTTPStatus: entry.HTTPStatus,
+		GRPCCode:   entry.GRPCCodeName(),
+		Type:       entry.Type,
+	}
+}
@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"product-service/internal/adapters/errorregistry"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/usecases"
+	"product-service/internal/domain/entities"
+	"product-service/pkg/logger"
+	"product-service/pkg/xlsx"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// exportContentTypes are the media types of the export formats
+var exportContentTypes = map[entities.ExportFormat]string{
+	entities.ExportCSV:       "text/csv; charset=utf-8",
+	entities.ExportJSONLines: "application/x-ndjson",
+	entities.ExportXLSX:      xlsx.ContentType,
+}
+
+type ExportHandler struct {
+	exportUseCases usecases.ExportUseCases
+	validator      *validator.Validate
+	logger         logger.Logger
+}
+
+func NewExportHandler(exportUseCases usecases.ExportUseCases, log logger.Logger) *ExportHandler {
+	return &ExportHandler{
+		exportUseCases: exportUseCases,
+		validator:      validator.New(),
+		logger:         log.With("component", "export_handler"),
+	}
+}
+
+// ExportProducts handles GET /api/v1/products/export
+// format is csv (default), jsonl or xlsx and columns a comma separated
+// selection of the exported fields (see entities.DefaultExportColumns), with
+// attributes as attributes.<key>. The remaining parameters filter as in
+// SearchProducts, except that every match is exported. The file is streamed
+// as the products are read.
+func (h *ExportHandler) ExportProducts(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	filters, err := parseSearchRequest(c)
+	if err != nil {
+		log.Warn("Invalid export filters",
+			"error", err)
+		return h.handleError(c, err, "Invalid export filters")
+	}
+	request := &dto.ExportRequestDTO{
+		Format:  entities.ExportFormat(strings.ToLower(c.QueryParam("format"))),
+		Columns: queryColumns(c),
+		Filters: *filters,
+	}
+	if err := h.validator.Struct(request); err != nil {
+		log.Warn("Export validation failed",
+			"error", err)
+		return respondProblem(c, errorregistry.CodeValidation, "Request validation failed", validationFieldErrors(c, err)...)
+	}
+
+	// Problems can only be reported until the file starts
+	export, err := h.exportUseCases.PrepareExport(c.Request().Context(), request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to prepare export")
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, exportContentTypes[export.Format])
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", export.FileName()))
+	c.Response().WriteHeader(http.StatusOK)
+
+	if err := h.exportUseCases.WriteExport(c.Request().Context(), export, c.Response()); err != nil {
+		log.Error("Failed to stream export",
+			"format", export.Format,
+			"error", err)
+	}
+	return nil
+}
+
+// queryColumns reads the column selection from comma separated and repeated
+// columns parameters
+func queryColumns(c echo.Context) []string {
+	var columns []string
+	for _, value := range c.QueryParams()["columns"] {
+		for _, column := range strings.Split(value, ",") {
+			if column = strings.TrimSpace(column); column != "" {
+				columns = append(columns, column)
+			}
+		}
+	}
+	return columns
+}
+
+func (h *ExportHandler) handleError(c echo.Context, err error, logMessage string) error {
+	h.logger.Ctx(c.Request().Context()).Error(logMessage,
+		"error", err)
+
+	return respondDomainError(c, err)
+}
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"product-service/internal/application/usecases"
+	"product-service/internal/domain/entities"
+	"product-service/pkg/logger"
+
+	"github.com/labstack/echo/v4"
+)
+
+// feedContentTypes are the media types of the feed formats
+var feedContentTypes = map[entities.FeedFormat]string{
+	entities.FeedGoogleRSS: "application/rss+xml; charset=utf-8",
+	entities.FeedGoogleTSV: "text/tab-separated-values; charset=utf-8",
+	entities.FeedXML:       "application/xml; charset=utf-8",
+}
+
+type FeedHandler struct {
+	feedUseCases usecases.FeedUseCases
+	logger       logger.Logger
+}
+
+func NewFeedHandler(feedUseCases usecases.FeedUseCases, log logger.Logger) *FeedHandler {
+	return &FeedHandler{
+		feedUseCases: feedUseCases,
+		logger:       log.With("component", "feed_handler"),
+	}
+}
+
+// GetFeed handles GET /api/v1/feeds/:format
+// format is google-rss, google-tsv or xml. The cached file is served, after
+// regenerating it when products changed; Last-Modified is when it was
+// generated, so merchant crawlers can fetch it conditionally.
+func (h *FeedHandler) GetFeed(c echo.Context) error {
+	format := entities.FeedFormat(c.Param("format"))
+
+	feed, file, err := h.feedUseCases.OpenFeed(c.Request().Context(), format)
+	if err != nil {
+		return h.handleError(c, err, "Failed to open feed")
+	}
+	defer file.Close()
+
+	c.Response().Header().Set(echo.HeaderContentType, feedContentTypes[format])
+	c.Response().Header().Set("X-Feed-Items", fmt.Sprint(feed.Items))
+	http.ServeContent(c.Response(), c.Request(), format.FileName(), feed.GeneratedAt, file)
+	return nil
+}
+
+func (h *FeedHandler) handleError(c echo.Context, err error, logMessage string) error {
+	h.logger.Ctx(c.Request().Context()).Error(logMessage,
+		"error", err)
+
+	return respondDomainError(c, err)
+}
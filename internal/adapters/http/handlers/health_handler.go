@@ -52,10 +52,9 @@ type MetricsResponse struct {
 
 // Health returns basic service health status
 func (h *HealthHandler) Health(c echo.Context) error {
-	requestID := c.Response().Header().Get(echo.HeaderXRequestID)
+	log := h.logger.Ctx(c.Request().Context())
 
-	h.logger.Debug("Health check requested",
-		"request_id", requestID,
+	log.Debug("Health check requested",
 		"remote_ip", c.RealIP(),
 		"user_agent", c.Request().UserAgent())
 
@@ -73,10 +72,9 @@ func (h *HealthHandler) Health(c echo.Context) error {
 // Ready checks if the service is ready to accept requests
 // This is where you'd add database connectivity checks, etc.
 func (h *HealthHandler) Ready(c echo.Context) error {
-	requestID := c.Response().Header().Get(echo.HeaderXRequestID)
+	log := h.logger.Ctx(c.Request().Context())
 
-	h.logger.Info("Readiness check requested",
-		"request_id", requestID,
+	log.Info("Readiness check requested",
 		"remote_ip", c.RealIP())
 
 	// Create context with timeout for health checks
@@ -97,10 +95,9 @@ func (h *HealthHandler) Ready(c echo.Context) error {
 				"status":  "unhealthy",
 				"message": err.Error(),
 			}
-			h.logger.Warn("Component unhealthy during readiness check",
+			log.Warn("Component unhealthy during readiness check",
 				"component", component,
-				"error", err.Error(),
-				"request_id", requestID)
+				"error", err.Error())
 		} else {
 			responseChecks[component] = map[string]interface{}{
 				"status":  "healthy",
@@ -125,21 +122,19 @@ func (h *HealthHandler) Ready(c echo.Context) error {
 		Checks:    responseChecks,
 	}
 
-	h.logger.Info("Readiness check completed",
+	log.Info("Readiness check completed",
 		"status", status,
 		"checks_count", len(responseChecks),
-		"healthy", allHealthy,
-		"request_id", requestID)
+		"healthy", allHealthy)
 
 	return c.JSON(httpStatus, response)
 }
 
 // Live checks if the service is alive (minimal check)
 func (h *HealthHandler) Live(c echo.Context) error {
-	requestID := c.Response().Header().Get(echo.HeaderXRequestID)
+	log := h.logger.Ctx(c.Request().Context())
 
-	h.logger.Debug("Liveness check requested",
-		"request_id", requestID,
+	log.Debug("Liveness check requested",
 		"remote_ip", c.RealIP())
 
 	response := HealthResponse{
@@ -155,10 +150,9 @@ func (h *HealthHandler) Live(c echo.Context) error {
 
 // Metrics returns service metrics and runtime information
 func (h *HealthHandler) Metrics(c echo.Context) error {
-	requestID := c.Response().Header().Get(echo.HeaderXRequestID)
+	log := h.logger.Ctx(c.Request().Context())
 
-	h.logger.Debug("Metrics requested",
-		"request_id", requestID,
+	log.Debug("Metrics requested",
 		"remote_ip", c.RealIP())
 
 	var m runtime.MemStats
@@ -178,11 +172,10 @@ func (h *HealthHandler) Metrics(c echo.Context) error {
 	response.Runtime.MemorySys = m.Sys
 	response.Runtime.GCCount = m.NumGC
 
-	h.logger.Info("Metrics collected",
+	log.Info("Metrics collected",
 		"goroutines", response.Runtime.Goroutines,
 		"memory_alloc_mb", response.Runtime.MemoryAlloc/1024/1024,
-		"gc_count", response.Runtime.GCCount,
-		"request_id", requestID)
+		"gc_count", response.Runtime.GCCount)
 
 	return c.JSON(http.StatusOK, response)
 }
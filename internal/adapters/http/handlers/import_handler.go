@@ -0,0 +1,206 @@
+package handlers
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"product-service/internal/adapters/errorregistry"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/usecases"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// importMappingPrefix starts the query parameters that map product fields to
+// CSV columns, e.g. map.sku=Item%20Number
+const importMappingPrefix = "map."
+
+type ImportHandler struct {
+	importUseCases usecases.ImportUseCases
+	validator      *validator.Validate
+	logger         logger.Logger
+}
+
+func NewImportHandler(importUseCases usecases.ImportUseCases, log logger.Logger) *ImportHandler {
+	return &ImportHandler{
+		importUseCases: importUseCases,
+		validator:      validator.New(),
+		logger:         log.With("component", "import_handler"),
+	}
+}
+
+// StartImport handles POST /api/v1/products/imports
+// The body is the CSV file, either as is (text/csv) or as the file field of a
+// multipart form. Query parameters: dry_run, currency, file_name and
+// map.<field>=<column header> for each column named differently from its
+// field. Responds 202 with the queued import.
+func (h *ImportHandler) StartImport(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	request, err := parseImportRequest(c)
+	if err != nil {
+		log.Warn("Invalid import parameters",
+			"error", err)
+		return h.handleError(c, err, "Invalid import parameters")
+	}
+	if err := h.validator.Struct(request); err != nil {
+		log.Warn("Import parameters validation failed",
+			"error", err)
+		return respondProblem(c, errorregistry.CodeValidation, "Request validation failed", validationFieldErrors(c, err)...)
+	}
+
+	// The use case caps the size of the file while it spools it
+	source, fileName, err := importSource(c)
+	if err != nil {
+		log.Warn("Failed to read import upload",
+			"error", err)
+		return respondProblem(c, errorregistry.CodeInvalidRequest, "Request body must be a CSV file or multipart/form-data with a file field")
+	}
+	if request.FileName == "" {
+		request.FileName = fileName
+	}
+
+	response, err := h.importUseCases.StartImport(c.Request().Context(), request, source)
+	if err != nil {
+		return h.handleError(c, err, "Failed to start import")
+	}
+
+	log.Info("Import queued",
+		"import_id", response.ID,
+		"dry_run", response.DryRun)
+
+	c.Response().Header().Set(echo.HeaderLocation, fmt.Sprintf("%s/%d", c.Request().URL.Path, response.ID))
+	return c.JSON(http.StatusAccepted, response)
+}
+
+// GetImport handles GET /api/v1/products/imports/:id
+func (h *ImportHandler) GetImport(c echo.Context) error {
+	id, err := h.parseID(c)
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid import ID format")
+	}
+
+	response, err := h.importUseCases.GetImport(c.Request().Context(), id)
+	if err != nil {
+		return h.handleError(c, err, "Failed to get import")
+	}
+
+	if response.IsFinished() && response.Failed > 0 {
+		response.ErrorReportURL = c.Request().URL.Path + "/errors"
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetErrorReport handles GET /api/v1/products/imports/:id/errors
+// Responds with a CSV download of row,sku,field,code,message lines
+func (h *ImportHandler) GetErrorReport(c echo.Context) error {
+	id, err := h.parseID(c)
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid import ID format")
+	}
+
+	// Check before streaming, once the body starts problems can't be sent
+	productImport, err := h.importUseCases.GetImport(c.Request().Context(), id)
+	if err != nil {
+		return h.handleError(c, err, "Failed to get import")
+	}
+	if !productImport.IsFinished() {
+		return h.handleError(c, domainErrors.ErrImportNotFinished, "Import error report requested before the import finished")
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=\"import-%d-errors.csv\"", id))
+	c.Response().WriteHeader(http.StatusOK)
+
+	if err := h.importUseCases.WriteErrorReport(c.Request().Context(), id, c.Response()); err != nil {
+		h.logger.Ctx(c.Request().Context()).Error("Failed to stream import error report",
+			"import_id", id,
+			"error", err)
+	}
+	return nil
+}
+
+// parseImportRequest reads the import options from the query string
+func parseImportRequest(c echo.Context) (*dto.ImportRequestDTO, error) {
+	request := &dto.ImportRequestDTO{
+		FileName: c.QueryParam("file_name"),
+		Currency: strings.ToUpper(c.QueryParam("currency")),
+	}
+
+	if dryRun := c.QueryParam("dry_run"); dryRun != "" {
+		value, err := strconv.ParseBool(dryRun)
+		if err != nil {
+			return nil, invalidImport("dry_run", "dry_run must be true or false")
+		}
+		request.DryRun = value
+	}
+
+	for name, values := range c.QueryParams() {
+		if field, ok := strings.CutPrefix(name, importMappingPrefix); ok && len(values) > 0 {
+			if request.Mapping == nil {
+				request.Mapping = entities.ImportMapping{}
+			}
+			request.Mapping[field] = values[0]
+		}
+	}
+
+	return request, nil
+}
+
+// importSource returns the CSV file of the request without buffering it: the
+// file part of a multipart form, or else the body itself
+func importSource(c echo.Context) (io.Reader, string, error) {
+	mediaType, _, err := mime.ParseMediaType(c.Request().Header.Get(echo.HeaderContentType))
+	if err != nil || mediaType != echo.MIMEMultipartForm {
+		return c.Request().Body, "", nil
+	}
+
+	reader, err := c.Request().MultipartReader()
+	if err != nil {
+		return nil, "", err
+	}
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			return nil, "", err
+		}
+		if part.FormName() == "file" {
+			return part, part.FileName(), nil
+		}
+	}
+}
+
+func invalidImport(field, message string) error {
+	return &domainErrors.DomainError{
+		Code:    domainErrors.ErrInvalidImport.Code,
+		Message: message,
+		Field:   field,
+	}
+}
+
+func (h *ImportHandler) parseID(c echo.Context) (uint, error) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		h.logger.Ctx(c.Request().Context()).Warn("Invalid import ID parameter",
+			"id_param", idParam,
+			"error", err)
+		return 0, err
+	}
+	return uint(id), nil
+}
+
+func (h *ImportHandler) handleError(c echo.Context, err error, logMessage string) error {
+	h.logger.Ctx(c.Request().Context()).Error(logMessage,
+		"error", err)
+
+	return respondDomainError(c, err)
+}
@@ -0,0 +1,264 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"product-service/internal/adapters/errorregistry"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/usecases"
+	"product-service/internal/domain/entities"
+	"product-service/pkg/logger"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// jobsPath is where queued jobs can be followed
+const jobsPath = "/api/v1/jobs"
+
+type JobHandler struct {
+	jobUseCases usecases.JobUseCases
+	validator   *validator.Validate
+	logger      logger.Logger
+}
+
+func NewJobHandler(jobUseCases usecases.JobUseCases, log logger.Logger) *JobHandler {
+	return &JobHandler{
+		jobUseCases: jobUseCases,
+		validator:   validator.New(),
+		logger:      log.With("component", "job_handler"),
+	}
+}
+
+// EnqueueExport handles POST /api/v1/products/export/jobs
+// Takes the query parameters of GET /api/v1/products/export and writes the
+// file in the background. Responds 202 with the queued job; the file is
+// downloaded from its result_url once it succeeded.
+func (h *JobHandler) EnqueueExport(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	filters, err := parseSearchRequest(c)
+	if err != nil {
+		log.Warn("Invalid export filters",
+			"error", err)
+		return h.handleError(c, err, "Invalid export filters")
+	}
+	request := &dto.ExportRequestDTO{
+		Format:  entities.ExportFormat(strings.ToLower(c.QueryParam("format"))),
+		Columns: queryColumns(c),
+		Filters: *filters,
+	}
+	if err := h.validator.Struct(request); err != nil {
+		log.Warn("Export validation failed",
+			"error", err)
+		return respondProblem(c, errorregistry.CodeValidation, "Request validation failed", validationFieldErrors(c, err)...)
+	}
+
+	response, err := h.jobUseCases.EnqueueExport(c.Request().Context(), request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to enqueue export")
+	}
+	return h.respondAccepted(c, response)
+}
+
+// EnqueueReprice handles POST /api/v1/products/reprice
+// The body sets either percent (e.g. "-15") or amount (e.g. {"amount":
+// "-5.00","currency":"USD"}), and an optional reason for the price history.
+// The query parameters filter the products as in SearchProducts, except
+// that every match is repriced. Responds 202 with the queued job.
+func (h *JobHandler) EnqueueReprice(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	var request dto.RepriceRequestDTO
+	if err := c.Bind(&request); err != nil {
+		log.Warn("Failed to bind request body",
+			"error", err)
+		return respondProblem(c, errorregistry.CodeInvalidRequest, "Invalid request body format")
+	}
+
+	// The filters come from the query string, like those of a search
+	filters, err := parseSearchRequest(c)
+	if err != nil {
+		log.Warn("Invalid reprice filters",
+			"error", err)
+		return h.handleError(c, err, "Invalid reprice filters")
+	}
+	request.Filters = *filters
+
+	if err := h.validator.Struct(&request); err != nil {
+		log.Warn("Request validation failed",
+			"error", err)
+		return respondProblem(c, errorregistry.CodeValidation, "Request validation failed", validationFieldErrors(c, err)...)
+	}
+
+	response, err := h.jobUseCases.EnqueueReprice(c.Request().Context(), &request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to enqueue repricing")
+	}
+	return h.respondAccepted(c, response)
+}
+
+// EnqueueFeeds handles POST /api/v1/feeds/jobs
+// The body may list formats, every feed by default, and set force to
+// regenerate feeds whose products did not change. Responds 202 with the
+// queued job.
+func (h *JobHandler) EnqueueFeeds(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	var request dto.FeedJobRequestDTO
+	if c.Request().ContentLength != 0 {
+		if err := c.Bind(&request); err != nil {
+			log.Warn("Failed to bind request body",
+				"error", err)
+			return respondProblem(c, errorregistry.CodeInvalidRequest, "Invalid request body format")
+		}
+	}
+	if err := h.validator.Struct(&request); err != nil {
+		log.Warn("Request validation failed",
+			"error", err)
+		return respondProblem(c, errorregistry.CodeValidation, "Request validation failed", validationFieldErrors(c, err)...)
+	}
+
+	response, err := h.jobUseCases.EnqueueFeeds(c.Request().Context(), &request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to enqueue feed generation")
+	}
+	return h.respondAccepted(c, response)
+}
+
+// ListJobs handles GET /api/v1/jobs
+// Query parameters: type, status, page, page_size
+func (h *JobHandler) ListJobs(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	query := &dto.JobQueryDTO{
+		Type:     entities.JobType(c.QueryParam("type")),
+		Status:   entities.JobStatus(c.QueryParam("status")),
+		PageSize: 10,
+	}
+	if page := c.QueryParam("page"); page != "" {
+		value, err := strconv.Atoi(page)
+		if err != nil {
+			return respondProblem(c, errorregistry.CodeInvalidRequest, "page must be an integer")
+		}
+		query.Page = value
+	}
+	if pageSize := c.QueryParam("page_size"); pageSize != "" {
+		value, err := strconv.Atoi(pageSize)
+		if err != nil {
+			return respondProblem(c, errorregistry.CodeInvalidRequest, "page_size must be an integer")
+		}
+		query.PageSize = value
+	}
+	if err := h.validator.Struct(query); err != nil {
+		log.Warn("Job query validation failed",
+			"error", err)
+		return respondProblem(c, errorregistry.CodeValidation, "Request validation failed", validationFieldErrors(c, err)...)
+	}
+
+	response, err := h.jobUseCases.ListJobs(c.Request().Context(), query)
+	if err != nil {
+		return h.handleError(c, err, "Failed to list jobs")
+	}
+
+	for _, job := range response.Jobs {
+		setResultURL(job)
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetJob handles GET /api/v1/jobs/:id
+// Responds with the job's status, progress and, once finished, its result
+// or error
+func (h *JobHandler) GetJob(c echo.Context) error {
+	id, err := h.parseID(c)
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid job ID format")
+	}
+
+	response, err := h.jobUseCases.GetJob(c.Request().Context(), id)
+	if err != nil {
+		return h.handleError(c, err, "Failed to get job")
+	}
+
+	setResultURL(response)
+	return c.JSON(http.StatusOK, response)
+}
+
+// CancelJob handles POST /api/v1/jobs/:id/cancel
+// Queued jobs are cancelled right away; running jobs stop at their worker's
+// next heartbeat and keep what they already did. Responds 409 for finished
+// jobs.
+func (h *JobHandler) CancelJob(c echo.Context) error {
+	id, err := h.parseID(c)
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid job ID format")
+	}
+
+	response, err := h.jobUseCases.CancelJob(c.Request().Context(), id)
+	if err != nil {
+		return h.handleError(c, err, "Failed to cancel job")
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetJobResult handles GET /api/v1/jobs/:id/result
+// Downloads the file of a succeeded export job
+func (h *JobHandler) GetJobResult(c echo.Context) error {
+	id, err := h.parseID(c)
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid job ID format")
+	}
+
+	result, file, err := h.jobUseCases.OpenJobResult(c.Request().Context(), id)
+	if err != nil {
+		return h.handleError(c, err, "Failed to open job result")
+	}
+	defer file.Close()
+
+	c.Response().Header().Set(echo.HeaderContentType, exportContentTypes[result.Format])
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", result.FileName))
+	http.ServeContent(c.Response(), c.Request(), result.FileName, time.Time{}, file)
+	return nil
+}
+
+// respondAccepted responds 202 with a queued job and where to follow it
+func (h *JobHandler) respondAccepted(c echo.Context, response *dto.JobResponseDTO) error {
+	h.logger.Ctx(c.Request().Context()).Info("Job queued",
+		"job_id", response.ID,
+		"type", response.Type)
+
+	c.Response().Header().Set(echo.HeaderLocation, fmt.Sprintf("%s/%d", jobsPath, response.ID))
+	return c.JSON(http.StatusAccepted, response)
+}
+
+// setResultURL links the file of succeeded export jobs
+func setResultURL(response *dto.JobResponseDTO) {
+	if response.Type == entities.JobProductExport && response.Status == entities.JobSucceeded {
+		response.ResultURL = fmt.Sprintf("%s/%d/result", jobsPath, response.ID)
+	}
+}
+
+func (h *JobHandler) parseID(c echo.Context) (uint, error) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		h.logger.Ctx(c.Request().Context()).Warn("Invalid job ID parameter",
+			"id_param", idParam,
+			"error", err)
+		return 0, err
+	}
+	return uint(id), nil
+}
+
+func (h *JobHandler) handleError(c echo.Context, err error, logMessage string) error {
+	h.logger.Ctx(c.Request().Context()).Error(logMessage,
+		"error", err)
+
+	return respondDomainError(c, err)
+}
@@ -0,0 +1,246 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"product-service/internal/adapters/errorregistry"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/usecases"
+	domainErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// multipartOverhead allows for the form boundaries and fields sent along
+// with the file when capping the request body
+const multipartOverhead = 64 << 10
+
+type MediaHandler struct {
+	mediaUseCases usecases.MediaUseCases
+	validator     *validator.Validate
+	logger        logger.Logger
+}
+
+func NewMediaHandler(mediaUseCases usecases.MediaUseCases, log logger.Logger) *MediaHandler {
+	return &MediaHandler{
+		mediaUseCases: mediaUseCases,
+		validator:     validator.New(),
+		logger:        log.With("component", "media_handler"),
+	}
+}
+
+// MediaListResponse wraps a product's media in display order
+type MediaListResponse struct {
+	ProductID uint                    `json:"product_id"`
+	Media     []*dto.MediaResponseDTO `json:"media"`
+}
+
+// UploadMedia handles POST /api/v1/products/:id/media
+// Multipart form fields: file (required), alt_text, primary (true or false)
+func (h *MediaHandler) UploadMedia(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	productID, err := h.parseID(c, "id")
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid product ID format")
+	}
+
+	maxSize := h.mediaUseCases.MaxUploadSize()
+	c.Request().Body = http.MaxBytesReader(c.Response(), c.Request().Body, maxSize+multipartOverhead)
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return h.handleError(c, tooLargeError(maxSize), "Media upload too large")
+		}
+		log.Warn("Failed to read multipart upload",
+			"error", err)
+		return respondProblem(c, errorregistry.CodeInvalidRequest, "Request must be multipart/form-data with a file field")
+	}
+	if fileHeader.Size > maxSize {
+		return h.handleError(c, tooLargeError(maxSize), "Media upload too large")
+	}
+
+	upload := &dto.MediaUploadDTO{
+		FileName: fileHeader.Filename,
+		AltText:  c.FormValue("alt_text"),
+	}
+	if primary := c.FormValue("primary"); primary != "" {
+		upload.Primary, err = strconv.ParseBool(primary)
+		if err != nil {
+			return respondProblem(c, errorregistry.CodeInvalidRequest, "primary must be true or false")
+		}
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return h.handleError(c, err, "Failed to open uploaded file")
+	}
+	defer file.Close()
+
+	upload.Data, err = io.ReadAll(io.LimitReader(file, maxSize+1))
+	if err != nil {
+		return h.handleError(c, err, "Failed to read uploaded file")
+	}
+
+	response, err := h.mediaUseCases.UploadMedia(c.Request().Context(), productID, upload)
+	if err != nil {
+		return h.handleError(c, err, "Failed to upload media")
+	}
+
+	log.Info("Media uploaded successfully",
+		"product_id", productID,
+		"media_id", response.ID,
+		"content_type", response.ContentType)
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+// ListMedia handles GET /api/v1/products/:id/media
+func (h *MediaHandler) ListMedia(c echo.Context) error {
+	productID, err := h.parseID(c, "id")
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid product ID format")
+	}
+
+	media, err := h.mediaUseCases.ListMedia(c.Request().Context(), productID)
+	if err != nil {
+		return h.handleError(c, err, "Failed to list media")
+	}
+
+	return c.JSON(http.StatusOK, MediaListResponse{ProductID: productID, Media: media})
+}
+
+// UpdateMedia handles PATCH /api/v1/products/:id/media/:media_id
+func (h *MediaHandler) UpdateMedia(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	productID, err := h.parseID(c, "id")
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid product ID format")
+	}
+	mediaID, err := h.parseID(c, "media_id")
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid media ID format")
+	}
+
+	var request dto.UpdateMediaRequestDTO
+	if ok, err := h.bind(c, &request); !ok {
+		return err
+	}
+
+	response, err := h.mediaUseCases.UpdateMedia(c.Request().Context(), productID, mediaID, &request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to update media")
+	}
+
+	log.Info("Media updated successfully",
+		"product_id", productID,
+		"media_id", mediaID)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// ReorderMedia handles PUT /api/v1/products/:id/media/order
+func (h *MediaHandler) ReorderMedia(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	productID, err := h.parseID(c, "id")
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid product ID format")
+	}
+
+	var request dto.ReorderMediaRequestDTO
+	if ok, err := h.bind(c, &request); !ok {
+		return err
+	}
+
+	media, err := h.mediaUseCases.ReorderMedia(c.Request().Context(), productID, &request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to reorder media")
+	}
+
+	log.Info("Media reordered successfully",
+		"product_id", productID)
+
+	return c.JSON(http.StatusOK, MediaListResponse{ProductID: productID, Media: media})
+}
+
+// DeleteMedia handles DELETE /api/v1/products/:id/media/:media_id
+func (h *MediaHandler) DeleteMedia(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	productID, err := h.parseID(c, "id")
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid product ID format")
+	}
+	mediaID, err := h.parseID(c, "media_id")
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid media ID format")
+	}
+
+	if err := h.mediaUseCases.DeleteMedia(c.Request().Context(), productID, mediaID); err != nil {
+		return h.handleError(c, err, "Failed to delete media")
+	}
+
+	log.Info("Media deleted successfully",
+		"product_id", productID,
+		"media_id", mediaID)
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func tooLargeError(maxSize int64) error {
+	return &domainErrors.DomainError{
+		Code:    domainErrors.ErrMediaTooLarge.Code,
+		Message: fmt.Sprintf("file exceeds the %d byte limit", maxSize),
+		Field:   "file",
+	}
+}
+
+// bind parses and validates a request body. When the body is rejected the
+// problem response is already written and ok is false.
+func (h *MediaHandler) bind(c echo.Context, request interface{}) (bool, error) {
+	log := h.logger.Ctx(c.Request().Context())
+
+	if err := c.Bind(request); err != nil {
+		log.Warn("Failed to bind request body",
+			"error", err)
+		return false, respondProblem(c, errorregistry.CodeInvalidRequest, "Invalid request body format")
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		log.Warn("Request validation failed",
+			"error", err)
+		return false, respondProblem(c, errorregistry.CodeValidation, "Request validation failed", validationFieldErrors(c, err)...)
+	}
+
+	return true, nil
+}
+
+func (h *MediaHandler) parseID(c echo.Context, name string) (uint, error) {
+	param := c.Param(name)
+	id, err := strconv.ParseUint(param, 10, 32)
+	if err != nil {
+		h.logger.Ctx(c.Request().Context()).Warn("Invalid ID parameter",
+			"param", name,
+			"value", param,
+			"error", err)
+		return 0, err
+	}
+	return uint(id), nil
+}
+
+func (h *MediaHandler) handleError(c echo.Context, err error, logMessage string) error {
+	h.logger.Ctx(c.Request().Context()).Error(logMessage,
+		"error", err)
+
+	return respondDomainError(c, err)
+}
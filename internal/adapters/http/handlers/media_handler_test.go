@@ -0,0 +1,186 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"product-service/internal/application/dto"
+	"product-service/pkg/logger"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockMediaUseCases implements the MediaUseCases interface for testing
+type MockMediaUseCases struct {
+	mock.Mock
+}
+
+func (m *MockMediaUseCases) UploadMedia(ctx context.Context, productID uint, upload *dto.MediaUploadDTO) (*dto.MediaResponseDTO, error) {
+	args := m.Called(ctx, productID, upload)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.MediaResponseDTO), args.Error(1)
+}
+
+func (m *MockMediaUseCases) ListMedia(ctx context.Context, productID uint) ([]*dto.MediaResponseDTO, error) {
+	args := m.Called(ctx, productID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*dto.MediaResponseDTO), args.Error(1)
+}
+
+func (m *MockMediaUseCases) UpdateMedia(ctx context.Context, productID, mediaID uint, request *dto.UpdateMediaRequestDTO) (*dto.MediaResponseDTO, error) {
+	args := m.Called(ctx, productID, mediaID, request)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.MediaResponseDTO), args.Error(1)
+}
+
+func (m *MockMediaUseCases) ReorderMedia(ctx context.Context, productID uint, request *dto.ReorderMediaRequestDTO) ([]*dto.MediaResponseDTO, error) {
+	args := m.Called(ctx, productID, request)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*dto.MediaResponseDTO), args.Error(1)
+}
+
+func (m *MockMediaUseCases) DeleteMedia(ctx context.Context, productID, mediaID uint) error {
+	args := m.Called(ctx, productID, mediaID)
+	return args.Error(0)
+}
+
+func (m *MockMediaUseCases) ApplyMedia(ctx context.Context, products []*dto.ProductResponseDTO) error {
+	args := m.Called(ctx, products)
+	return args.Error(0)
+}
+
+func (m *MockMediaUseCases) MaxUploadSize() int64 {
+	args := m.Called()
+	return args.Get(0).(int64)
+}
+
+func setupTestMediaHandler() (*MediaHandler, *MockMediaUseCases) {
+	mockUseCases := new(MockMediaUseCases)
+	mockUseCases.On("MaxUploadSize").Return(int64(1024)).Maybe()
+	handler := NewMediaHandler(mockUseCases, logger.New("test"))
+	return handler, mockUseCases
+}
+
+// multipartUpload builds a multipart body with a file field and extra form fields
+func multipartUpload(t *testing.T, fileName string, data []byte, fields map[string]string) (*bytes.Buffer, string) {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	for name, value := range fields {
+		require.NoError(t, writer.WriteField(name, value))
+	}
+	part, err := writer.CreateFormFile("file", fileName)
+	require.NoError(t, err)
+	_, err = part.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	return body, writer.FormDataContentType()
+}
+
+func TestMediaHandler_UploadMedia_Success(t *testing.T) {
+	// Setup
+	handler, mockUseCases := setupTestMediaHandler()
+
+	mockUseCases.On("UploadMedia", mock.Anything, uint(1), mock.MatchedBy(func(upload *dto.MediaUploadDTO) bool {
+		return upload.FileName == "front.png" && upload.AltText == "Front view" && upload.Primary && string(upload.Data) == "image-bytes"
+	})).Return(&dto.MediaResponseDTO{ID: 7, ContentType: "image/png", Primary: true}, nil)
+
+	// Create request
+	body, contentType := multipartUpload(t, "front.png", []byte("image-bytes"), map[string]string{"alt_text": "Front view", "primary": "true"})
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/products/1/media", body)
+	req.Header.Set(echo.HeaderContentType, contentType)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	// Execute
+	err := handler.UploadMedia(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, "7", extractJSONField(t, rec.Body.Bytes(), "id"))
+
+	mockUseCases.AssertExpectations(t)
+}
+
+func TestMediaHandler_UploadMedia_TooLarge(t *testing.T) {
+	// Setup
+	handler, mockUseCases := setupTestMediaHandler()
+
+	// Create request
+	body, contentType := multipartUpload(t, "huge.png", bytes.Repeat([]byte("x"), 2048), nil)
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/products/1/media", body)
+	req.Header.Set(echo.HeaderContentType, contentType)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	// Execute
+	err := handler.UploadMedia(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	assert.Equal(t, `"MEDIA_TOO_LARGE"`, extractJSONField(t, rec.Body.Bytes(), "code"))
+	mockUseCases.AssertNotCalled(t, "UploadMedia", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestMediaHandler_UploadMedia_MissingFile(t *testing.T) {
+	// Setup
+	handler, mockUseCases := setupTestMediaHandler()
+
+	// Create request
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/products/1/media", bytes.NewBufferString(`{"file":"x"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	// Execute
+	err := handler.UploadMedia(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUseCases.AssertNotCalled(t, "UploadMedia", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestMediaHandler_DeleteMedia_NoContent(t *testing.T) {
+	// Setup
+	handler, mockUseCases := setupTestMediaHandler()
+
+	mockUseCases.On("DeleteMedia", mock.Anything, uint(1), uint(7)).Return(nil)
+
+	// Create request
+	req := httptest.NewRequest(http.MethodDelete, "/api/v1/products/1/media/7", nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id", "media_id")
+	c.SetParamValues("1", "7")
+
+	// Execute
+	err := handler.DeleteMedia(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	mockUseCases.AssertExpectations(t)
+}
@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"product-service/internal/adapters/errorregistry"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/usecases"
+	"product-service/pkg/logger"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+type PriceHistoryHandler struct {
+	priceHistoryUseCases usecases.PriceHistoryUseCases
+	validator            *validator.Validate
+	logger               logger.Logger
+}
+
+func NewPriceHistoryHandler(priceHistoryUseCases usecases.PriceHistoryUseCases, log logger.Logger) *PriceHistoryHandler {
+	return &PriceHistoryHandler{
+		priceHistoryUseCases: priceHistoryUseCases,
+		validator:            validator.New(),
+		logger:               log.With("component", "price_history_handler"),
+	}
+}
+
+// GetPrices handles GET /api/v1/products/:id/prices
+func (h *PriceHistoryHandler) GetPrices(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		log.Warn("Invalid product ID parameter",
+			"id_param", idParam,
+			"error", err)
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid product ID format")
+	}
+
+	response, err := h.priceHistoryUseCases.GetPriceTimeline(c.Request().Context(), uint(id))
+	if err != nil {
+		return h.handleError(c, err, "Failed to get product prices")
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// SchedulePrice handles POST /api/v1/products/:id/prices
+func (h *PriceHistoryHandler) SchedulePrice(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		log.Warn("Invalid product ID parameter",
+			"id_param", idParam,
+			"error", err)
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid product ID format")
+	}
+
+	var request dto.SchedulePriceRequestDTO
+	if err := c.Bind(&request); err != nil {
+		log.Warn("Failed to bind request body",
+			"error", err)
+		return respondProblem(c, errorregistry.CodeInvalidRequest, "Invalid request body format")
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		log.Warn("Request validation failed",
+			"error", err)
+		return respondProblem(c, errorregistry.CodeValidation, "Request validation failed", validationFieldErrors(c, err)...)
+	}
+
+	response, err := h.priceHistoryUseCases.SchedulePriceChange(c.Request().Context(), uint(id), &request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to schedule price change")
+	}
+
+	log.Info("Price change scheduled successfully",
+		"product_id", id,
+		"change_id", response.ID,
+		"effective_from", response.EffectiveFrom)
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+// CancelScheduledPrice handles DELETE /api/v1/products/:id/prices/:change_id
+func (h *PriceHistoryHandler) CancelScheduledPrice(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		log.Warn("Invalid product ID parameter",
+			"id_param", idParam,
+			"error", err)
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid product ID format")
+	}
+
+	changeParam := c.Param("change_id")
+	changeID, err := strconv.ParseUint(changeParam, 10, 32)
+	if err != nil {
+		log.Warn("Invalid price change ID parameter",
+			"change_id_param", changeParam,
+			"error", err)
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid price change ID format")
+	}
+
+	response, err := h.priceHistoryUseCases.CancelPriceChange(c.Request().Context(), uint(id), uint(changeID))
+	if err != nil {
+		return h.handleError(c, err, "Failed to cancel price change")
+	}
+
+	log.Info("Price change cancelled successfully",
+		"product_id", id,
+		"change_id", changeID)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (h *PriceHistoryHandler) handleError(c echo.Context, err error, logMessage string) error {
+	h.logger.Ctx(c.Request().Context()).Error(logMessage,
+		"error", err)
+
+	return respondDomainError(c, err)
+}
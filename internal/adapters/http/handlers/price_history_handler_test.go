@@ -0,0 +1,177 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"product-service/internal/application/dto"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockPriceHistoryUseCases implements the PriceHistoryUseCases interface for testing
+type MockPriceHistoryUseCases struct {
+	mock.Mock
+}
+
+func (m *MockPriceHistoryUseCases) GetPriceTimeline(ctx context.Context, productID uint) (*dto.PriceTimelineDTO, error) {
+	args := m.Called(ctx, productID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.PriceTimelineDTO), args.Error(1)
+}
+
+func (m *MockPriceHistoryUseCases) SchedulePriceChange(ctx context.Context, productID uint, request *dto.SchedulePriceRequestDTO) (*dto.PriceChangeDTO, error) {
+	args := m.Called(ctx, productID, request)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.PriceChangeDTO), args.Error(1)
+}
+
+func (m *MockPriceHistoryUseCases) CancelPriceChange(ctx context.Context, productID, changeID uint) (*dto.PriceChangeDTO, error) {
+	args := m.Called(ctx, productID, changeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.PriceChangeDTO), args.Error(1)
+}
+
+func (m *MockPriceHistoryUseCases) ApplyDuePriceChanges(ctx context.Context, now time.Time) (int, error) {
+	args := m.Called(ctx, now)
+	return args.Int(0), args.Error(1)
+}
+
+func setupTestPriceHistoryHandler() (*PriceHistoryHandler, *MockPriceHistoryUseCases) {
+	mockUseCases := new(MockPriceHistoryUseCases)
+	handler := NewPriceHistoryHandler(mockUseCases, logger.New("test"))
+	return handler, mockUseCases
+}
+
+func TestPriceHistoryHandler_GetPrices_Success(t *testing.T) {
+	// Setup
+	handler, mockUseCases := setupTestPriceHistoryHandler()
+
+	effectiveFrom := time.Now().Add(24 * time.Hour).UTC()
+	expectedResponse := &dto.PriceTimelineDTO{
+		ProductID:    1,
+		CurrentPrice: entities.MustParseMoney("999.99", "USD"),
+		History: []*dto.PriceChangeDTO{{
+			ID:       1,
+			OldPrice: entities.MustParseMoney("1099.99", "USD"),
+			NewPrice: entities.MustParseMoney("999.99", "USD"),
+			Actor:    "alice",
+			Status:   entities.PriceChangeApplied,
+		}},
+		Upcoming: []*dto.PriceChangeDTO{{
+			ID:            2,
+			NewPrice:      entities.MustParseMoney("899.99", "USD"),
+			EffectiveFrom: effectiveFrom,
+			Status:        entities.PriceChangeScheduled,
+		}},
+	}
+
+	mockUseCases.On("GetPriceTimeline", mock.Anything, uint(1)).Return(expectedResponse, nil)
+
+	// Create request
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/products/1/prices", nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	// Execute
+	err := handler.GetPrices(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response dto.PriceTimelineDTO
+	err = json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+
+	require.Len(t, response.History, 1)
+	require.Len(t, response.Upcoming, 1)
+	assert.Equal(t, "alice", response.History[0].Actor)
+	assert.Equal(t, entities.MustParseMoney("899.99", "USD"), response.Upcoming[0].NewPrice)
+	assert.True(t, effectiveFrom.Equal(response.Upcoming[0].EffectiveFrom))
+
+	mockUseCases.AssertExpectations(t)
+}
+
+func TestPriceHistoryHandler_SchedulePrice_Created(t *testing.T) {
+	// Setup
+	handler, mockUseCases := setupTestPriceHistoryHandler()
+
+	effectiveFrom := time.Date(2030, 11, 28, 0, 0, 0, 0, time.UTC)
+	effectiveTo := effectiveFrom.Add(72 * time.Hour)
+
+	mockUseCases.On("SchedulePriceChange", mock.Anything, uint(1), mock.MatchedBy(func(request *dto.SchedulePriceRequestDTO) bool {
+		return request.Price.Equal(entities.MustParseMoney("799.99", "USD")) &&
+			request.EffectiveFrom.Equal(effectiveFrom) &&
+			request.EffectiveTo != nil && request.EffectiveTo.Equal(effectiveTo) &&
+			request.Reason == "Black Friday"
+	})).Return(&dto.PriceChangeDTO{
+		ID:            7,
+		NewPrice:      entities.MustParseMoney("799.99", "USD"),
+		EffectiveFrom: effectiveFrom,
+		EffectiveTo:   &effectiveTo,
+		Status:        entities.PriceChangeScheduled,
+	}, nil)
+
+	// Create request
+	body := `{"price":{"amount":"799.99","currency":"USD"},"reason":"Black Friday",` +
+		`"effective_from":"2030-11-28T00:00:00Z","effective_to":"2030-12-01T00:00:00Z"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/products/1/prices", bytes.NewBufferString(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	// Execute
+	err := handler.SchedulePrice(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, `"scheduled"`, extractJSONField(t, rec.Body.Bytes(), "status"))
+
+	mockUseCases.AssertExpectations(t)
+}
+
+func TestPriceHistoryHandler_CancelScheduledPrice_NotCancellable(t *testing.T) {
+	// Setup
+	handler, mockUseCases := setupTestPriceHistoryHandler()
+
+	mockUseCases.On("CancelPriceChange", mock.Anything, uint(1), uint(7)).Return(nil, domainErrors.ErrPriceChangeNotCancellable)
+
+	// Create request
+	req := httptest.NewRequest(http.MethodDelete, "/api/v1/products/1/prices/7", nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id", "change_id")
+	c.SetParamValues("1", "7")
+
+	// Execute
+	err := handler.CancelScheduledPrice(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Equal(t, `"PRICE_CHANGE_NOT_CANCELLABLE"`, extractJSONField(t, rec.Body.Bytes(), "code"))
+
+	mockUseCases.AssertExpectations(t)
+}
@@ -0,0 +1,238 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"product-service/internal/adapters/errorregistry"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/usecases"
+	"product-service/pkg/logger"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+type PricingHandler struct {
+	pricingUseCases usecases.PricingUseCases
+	validator       *validator.Validate
+	logger          logger.Logger
+}
+
+func NewPricingHandler(pricingUseCases usecases.PricingUseCases, log logger.Logger) *PricingHandler {
+	return &PricingHandler{
+		pricingUseCases: pricingUseCases,
+		validator:       validator.New(),
+		logger:          log.With("component", "pricing_handler"),
+	}
+}
+
+// PriceListsResponse wraps a list of price lists
+type PriceListsResponse struct {
+	PriceLists []*dto.PriceListResponseDTO `json:"price_lists"`
+	Total      int                         `json:"total"`
+}
+
+// ProductPricesResponse wraps a list of explicit product prices
+type ProductPricesResponse struct {
+	Prices []*dto.ProductPriceResponseDTO `json:"prices"`
+	Total  int                            `json:"total"`
+}
+
+// ExchangeRatesResponse wraps a list of exchange rates
+type ExchangeRatesResponse struct {
+	Rates []*dto.ExchangeRateDTO `json:"rates"`
+	Total int                    `json:"total"`
+}
+
+// CreatePriceList handles POST /api/v1/admin/price-lists
+func (h *PricingHandler) CreatePriceList(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	var request dto.CreatePriceListRequestDTO
+	if err := c.Bind(&request); err != nil {
+		log.Warn("Failed to bind request body",
+			"error", err)
+		return respondProblem(c, errorregistry.CodeInvalidRequest, "Invalid request body format")
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		log.Warn("Request validation failed",
+			"error", err)
+		return respondProblem(c, errorregistry.CodeValidation, "Request validation failed", validationFieldErrors(c, err)...)
+	}
+
+	response, err := h.pricingUseCases.CreatePriceList(c.Request().Context(), &request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to create price list")
+	}
+
+	log.Info("Price list created successfully",
+		"code", response.Code)
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+// ListPriceLists handles GET /api/v1/admin/price-lists
+func (h *PricingHandler) ListPriceLists(c echo.Context) error {
+	priceLists, err := h.pricingUseCases.ListPriceLists(c.Request().Context())
+	if err != nil {
+		return h.handleError(c, err, "Failed to list price lists")
+	}
+
+	return c.JSON(http.StatusOK, PriceListsResponse{PriceLists: priceLists, Total: len(priceLists)})
+}
+
+// ListProductPrices handles GET /api/v1/admin/price-lists/:code/prices
+func (h *PricingHandler) ListProductPrices(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	var productID uint64
+	if productParam := c.QueryParam("product_id"); productParam != "" {
+		var err error
+		productID, err = strconv.ParseUint(productParam, 10, 32)
+		if err != nil {
+			log.Warn("Invalid product ID parameter",
+				"id_param", productParam,
+				"error", err)
+			return respondProblem(c, errorregistry.CodeInvalidID, "Invalid product ID format")
+		}
+	}
+
+	prices, err := h.pricingUseCases.ListProductPrices(c.Request().Context(), c.Param("code"), uint(productID))
+	if err != nil {
+		return h.handleError(c, err, "Failed to list product prices")
+	}
+
+	return c.JSON(http.StatusOK, ProductPricesResponse{Prices: prices, Total: len(prices)})
+}
+
+// SetProductPrice handles PUT /api/v1/admin/price-lists/:code/prices/:product_id
+func (h *PricingHandler) SetProductPrice(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	idParam := c.Param("product_id")
+	productID, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		log.Warn("Invalid product ID parameter",
+			"id_param", idParam,
+			"error", err)
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid product ID format")
+	}
+
+	var request dto.SetProductPriceRequestDTO
+	if err := c.Bind(&request); err != nil {
+		log.Warn("Failed to bind request body",
+			"error", err)
+		return respondProblem(c, errorregistry.CodeInvalidRequest, "Invalid request body format")
+	}
+
+	response, err := h.pricingUseCases.SetProductPrice(c.Request().Context(), c.Param("code"), uint(productID), request.Price)
+	if err != nil {
+		return h.handleError(c, err, "Failed to set product price")
+	}
+
+	log.Info("Product price set successfully",
+		"price_list", response.PriceList,
+		"product_id", response.ProductID,
+		"price", response.Price.String())
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// DeleteProductPrice handles DELETE /api/v1/admin/price-lists/:code/prices/:product_id/:currency
+func (h *PricingHandler) DeleteProductPrice(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	idParam := c.Param("product_id")
+	productID, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		log.Warn("Invalid product ID parameter",
+			"id_param", idParam,
+			"error", err)
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid product ID format")
+	}
+
+	if err := h.pricingUseCases.DeleteProductPrice(c.Request().Context(), c.Param("code"), uint(productID), c.Param("currency")); err != nil {
+		return h.handleError(c, err, "Failed to delete product price")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ListExchangeRates handles GET /api/v1/admin/exchange-rates
+func (h *PricingHandler) ListExchangeRates(c echo.Context) error {
+	rates, err := h.pricingUseCases.ListExchangeRates(c.Request().Context())
+	if err != nil {
+		return h.handleError(c, err, "Failed to list exchange rates")
+	}
+
+	return c.JSON(http.StatusOK, ExchangeRatesResponse{Rates: rates, Total: len(rates)})
+}
+
+// SetExchangeRate handles PUT /api/v1/admin/exchange-rates/:base/:quote
+func (h *PricingHandler) SetExchangeRate(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	var request dto.ExchangeRateDTO
+	if err := c.Bind(&request); err != nil {
+		log.Warn("Failed to bind request body",
+			"error", err)
+		return respondProblem(c, errorregistry.CodeInvalidRequest, "Invalid request body format")
+	}
+	request.BaseCurrency = c.Param("base")
+	request.QuoteCurrency = c.Param("quote")
+
+	return h.importRates(c, []dto.ExchangeRateDTO{request})
+}
+
+// ImportExchangeRates handles POST /api/v1/admin/exchange-rates/import
+func (h *PricingHandler) ImportExchangeRates(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	var request dto.ExchangeRateImportRequestDTO
+	if err := c.Bind(&request); err != nil {
+		log.Warn("Failed to bind request body",
+			"error", err)
+		return respondProblem(c, errorregistry.CodeInvalidRequest, "Invalid request body format")
+	}
+
+	return h.importRates(c, request.Rates)
+}
+
+// DeleteExchangeRate handles DELETE /api/v1/admin/exchange-rates/:base/:quote
+func (h *PricingHandler) DeleteExchangeRate(c echo.Context) error {
+	if err := h.pricingUseCases.DeleteExchangeRate(c.Request().Context(), c.Param("base"), c.Param("quote")); err != nil {
+		return h.handleError(c, err, "Failed to delete exchange rate")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *PricingHandler) importRates(c echo.Context, rates []dto.ExchangeRateDTO) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	if err := h.validator.Struct(dto.ExchangeRateImportRequestDTO{Rates: rates}); err != nil {
+		log.Warn("Request validation failed",
+			"error", err)
+		return respondProblem(c, errorregistry.CodeValidation, "Request validation failed", validationFieldErrors(c, err)...)
+	}
+
+	imported, err := h.pricingUseCases.ImportExchangeRates(c.Request().Context(), rates)
+	if err != nil {
+		return h.handleError(c, err, "Failed to import exchange rates")
+	}
+
+	log.Info("Exchange rates stored successfully",
+		"count", len(imported))
+
+	return c.JSON(http.StatusOK, ExchangeRatesResponse{Rates: imported, Total: len(imported)})
+}
+
+// handleError logs err and renders it as a problem response
+func (h *PricingHandler) handleError(c echo.Context, err error, logMessage string) error {
+	h.logger.Ctx(c.Request().Context()).Error(logMessage,
+		"error", err)
+
+	return respondDomainError(c, err)
+}
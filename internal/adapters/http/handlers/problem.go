@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"product-service/internal/adapters/errorregistry"
+	"product-service/internal/adapters/i18n"
+	domainErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// MIMEApplicationProblemJSON is the media type defined by RFC 7807
+	MIMEApplicationProblemJSON = "application/problem+json"
+
+	HeaderAcceptLanguage  = "Accept-Language"
+	HeaderContentLanguage = "Content-Language"
+)
+
+// Problem is an RFC 7807 problem details document extended with the
+// machine-readable error code, the request ID and per-field errors
+type Problem struct {
+	Type      string       `json:"type"`
+	Title     string       `json:"title"`
+	Status    int          `json:"status"`
+	Detail    string       `json:"detail,omitempty"`
+	Instance  string       `json:"instance,omitempty"`
+	Code      string       `json:"code"`
+	RequestID string       `json:"request_id,omitempty"`
+	Errors    []FieldError `json:"errors,omitempty"`
+}
+
+// FieldError describes why a single request field was rejected
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// localizerFor negotiates the response language from the Accept-Language header
+func localizerFor(c echo.Context) *i18n.Localizer {
+	return i18n.NewLocalizer(i18n.Default(), c.Request().Header.Get(HeaderAcceptLanguage))
+}
+
+// respondProblem renders the registry entry for code as application/problem+json.
+// Title and detail are localized; the code is not.
+func respondProblem(c echo.Context, code, detail string, fieldErrors ...FieldError) error {
+	return writeProblem(c, newProblem(c, code, detail, fieldErrors...))
+}
+
+// respondDomainError renders err as a problem. Domain errors keep their code;
+// anything else is reported as an internal error without leaking details.
+func respondDomainError(c echo.Context, err error) error {
+	return writeProblem(c, domainProblem(c, err))
+}
+
+// newProblem builds the localized problem for the registry entry of code
+func newProblem(c echo.Context, code, detail string, fieldErrors ...FieldError) Problem {
+	entry := errorregistry.Default().Resolve(code)
+	localizer := localizerFor(c)
+
+	return Problem{
+		Type:      entry.Type,
+		Title:     localizer.Title(entry.Code, entry.Title),
+		Status:    entry.HTTPStatus,
+		Detail:    localizer.Detail(entry.Code, detail),
+		Instance:  c.Request().URL.Path,
+		Code:      entry.Code,
+		RequestID: c.Response().Header().Get(echo.HeaderXRequestID),
+		Errors:    fieldErrors,
+	}
+}
+
+// domainProblem builds the problem respondDomainError renders for err
+func domainProblem(c echo.Context, err error) Problem {
+	var domainErr *domainErrors.DomainError
+	if !errors.As(err, &domainErr) {
+		return newProblem(c, errorregistry.CodeInternal, "An internal error occurred")
+	}
+
+	var fieldErrors []FieldError
+	if domainErr.Field != "" {
+		fieldErrors = append(fieldErrors, FieldError{
+			Field:   domainErr.Field,
+			Code:    domainErr.Code,
+			Message: localizerFor(c).Detail(domainErr.Code, domainErr.Message),
+		})
+	}
+
+	return newProblem(c, domainErr.Code, domainErr.Message, fieldErrors...)
+}
+
+func writeProblem(c echo.Context, problem Problem) error {
+	c.Response().Header().Set(echo.HeaderContentType, MIMEApplicationProblemJSON)
+	c.Response().Header().Set(HeaderContentLanguage, localizerFor(c).Language().String())
+	c.Response().Header().Add(echo.HeaderVary, HeaderAcceptLanguage)
+	return c.JSON(problem.Status, problem)
+}
+
+// ProblemErrorHandler replaces Echo's default error handler so that errors
+// raised outside the handlers (unknown routes, timeouts, panics) are rendered
+// as problems too
+func ProblemErrorHandler(log logger.Logger) echo.HTTPErrorHandler {
+	return func(err error, c echo.Context) {
+		if c.Response().Committed {
+			return
+		}
+
+		var renderErr error
+		var httpErr *echo.HTTPError
+		switch {
+		case errors.As(err, &httpErr):
+			renderErr = respondProblem(c, codeForStatus(httpErr.Code), http.StatusText(httpErr.Code))
+		default:
+			log.Ctx(c.Request().Context()).Error("Unhandled error", "error", err)
+			renderErr = respondDomainError(c, err)
+		}
+
+		if renderErr != nil {
+			log.Ctx(c.Request().Context()).Error("Failed to render problem response", "error", renderErr)
+		}
+	}
+}
+
+// codeForStatus maps the statuses Echo produces on its own to registry codes
+func codeForStatus(status int) string {
+	switch status {
+	case http.StatusNotFound:
+		return errorregistry.CodeRouteNotFound
+	case http.StatusMethodNotAllowed:
+		return errorregistry.CodeMethodNotAllowed
+	case http.StatusServiceUnavailable:
+		return errorregistry.CodeRequestTimeout
+	case http.StatusRequestEntityTooLarge:
+		return errorregistry.CodePayloadTooLarge
+	case http.StatusUnsupportedMediaType:
+		return errorregistry.CodeUnsupportedMedia
+	case http.StatusBadRequest:
+		return errorregistry.CodeInvalidRequest
+	default:
+		return errorregistry.CodeInternal
+	}
+}
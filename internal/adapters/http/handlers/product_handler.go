@@ -2,11 +2,17 @@ package handlers
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
+	"product-service/internal/adapters/errorregistry"
 	"product-service/internal/application/dto"
 	"product-service/internal/application/usecases"
+	"product-service/internal/domain/entities"
 	domainErrors "product-service/internal/domain/errors"
 	"product-service/pkg/logger"
 
@@ -15,75 +21,58 @@ import (
 )
 
 type ProductHandler struct {
-	productUseCases usecases.ProductUseCases
-	validator       *validator.Validate
-	logger          logger.Logger
+	productUseCases   usecases.ProductUseCases
+	pricingUseCases   usecases.PricingUseCases
+	promotionUseCases usecases.PromotionUseCases
+	variantUseCases   usecases.VariantUseCases
+	mediaUseCases     usecases.MediaUseCases
+	validator         *validator.Validate
+	logger            logger.Logger
 }
 
-func NewProductHandler(productUseCases usecases.ProductUseCases, log logger.Logger) *ProductHandler {
+func NewProductHandler(productUseCases usecases.ProductUseCases, pricingUseCases usecases.PricingUseCases, promotionUseCases usecases.PromotionUseCases, variantUseCases usecases.VariantUseCases, mediaUseCases usecases.MediaUseCases, log logger.Logger) *ProductHandler {
 	return &ProductHandler{
-		productUseCases: productUseCases,
-		validator:       validator.New(),
-		logger:          log.With("component", "product_handler"),
+		productUseCases:   productUseCases,
+		pricingUseCases:   pricingUseCases,
+		promotionUseCases: promotionUseCases,
+		variantUseCases:   variantUseCases,
+		mediaUseCases:     mediaUseCases,
+		validator:         validator.New(),
+		logger:            log.With("component", "product_handler"),
 	}
 }
 
-// ErrorResponse represents an error response
-type ErrorResponse struct {
-	Error   string                 `json:"error"`
-	Message string                 `json:"message"`
-	Details map[string]interface{} `json:"details,omitempty"`
-}
-
 // CreateProduct handles POST /api/v1/products
 func (h *ProductHandler) CreateProduct(c echo.Context) error {
-	requestID := c.Response().Header().Get(echo.HeaderXRequestID)
+	log := h.logger.Ctx(c.Request().Context())
 
-	h.logger.Info("Create product request received",
-		"request_id", requestID,
+	log.Info("Create product request received",
 		"remote_ip", c.RealIP(),
 		"user_agent", c.Request().UserAgent())
 
 	// Parse request body
 	var request dto.CreateProductRequestDTO
 	if err := c.Bind(&request); err != nil {
-		h.logger.Warn("Failed to bind request body",
-			"request_id", requestID,
+		log.Warn("Failed to bind request body",
 			"error", err)
-		return c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error:   "INVALID_REQUEST",
-			Message: "Invalid request body format",
-		})
+		return respondProblem(c, errorregistry.CodeInvalidRequest, "Invalid request body format")
 	}
 
 	// Validate request
 	if err := h.validator.Struct(request); err != nil {
-		h.logger.Warn("Request validation failed",
-			"request_id", requestID,
+		log.Warn("Request validation failed",
 			"error", err)
 
-		details := make(map[string]interface{})
-		if validationErrors, ok := err.(validator.ValidationErrors); ok {
-			for _, fieldError := range validationErrors {
-				details[fieldError.Field()] = getValidationErrorMessage(fieldError)
-			}
-		}
-
-		return c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error:   "VALIDATION_ERROR",
-			Message: "Request validation failed",
-			Details: details,
-		})
+		return respondProblem(c, errorregistry.CodeValidation, "Request validation failed", validationFieldErrors(c, err)...)
 	}
 
 	// Execute use case
 	response, err := h.productUseCases.CreateProduct(c.Request().Context(), &request)
 	if err != nil {
-		return h.handleError(c, err, requestID, "Failed to create product")
+		return h.handleError(c, err, "Failed to create product")
 	}
 
-	h.logger.Info("Product created successfully",
-		"request_id", requestID,
+	log.Info("Product created successfully",
 		"product_id", response.ID,
 		"sku", response.SKU)
 
@@ -92,35 +81,45 @@ func (h *ProductHandler) CreateProduct(c echo.Context) error {
 
 // GetProduct handles GET /api/v1/products/:id
 func (h *ProductHandler) GetProduct(c echo.Context) error {
-	requestID := c.Response().Header().Get(echo.HeaderXRequestID)
+	log := h.logger.Ctx(c.Request().Context())
 
 	// Parse product ID from path parameter
 	idParam := c.Param("id")
 	id, err := strconv.ParseUint(idParam, 10, 32)
 	if err != nil {
-		h.logger.Warn("Invalid product ID parameter",
-			"request_id", requestID,
+		log.Warn("Invalid product ID parameter",
 			"id_param", idParam,
 			"error", err)
-		return c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error:   "INVALID_ID",
-			Message: "Invalid product ID format",
-		})
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid product ID format")
 	}
 
-	h.logger.Info("Get product request received",
-		"request_id", requestID,
+	log.Info("Get product request received",
 		"product_id", id,
 		"remote_ip", c.RealIP())
 
+	asOf, err := parseAsOf(c)
+	if err != nil {
+		return h.handleError(c, err, "Invalid as_of parameter")
+	}
+	if asOf != nil {
+		response, err := h.productUseCases.GetProductAsOf(c.Request().Context(), uint(id), *asOf)
+		if err != nil {
+			return h.handleError(c, err, "Failed to get product as of time")
+		}
+		return h.respondAsOf(c, response, *asOf)
+	}
+
 	// Execute use case
 	response, err := h.productUseCases.GetProductByID(c.Request().Context(), uint(id))
 	if err != nil {
-		return h.handleError(c, err, requestID, "Failed to get product")
+		return h.handleError(c, err, "Failed to get product")
+	}
+
+	if err := h.enrichProducts(c, nil, response); err != nil {
+		return h.handleError(c, err, "Failed to complete product response")
 	}
 
-	h.logger.Info("Product retrieved successfully",
-		"request_id", requestID,
+	log.Info("Product retrieved successfully",
 		"product_id", response.ID)
 
 	return c.JSON(http.StatusOK, response)
@@ -128,100 +127,144 @@ func (h *ProductHandler) GetProduct(c echo.Context) error {
 
 // GetProductBySKU handles GET /api/v1/products/sku/:sku
 func (h *ProductHandler) GetProductBySKU(c echo.Context) error {
-	requestID := c.Response().Header().Get(echo.HeaderXRequestID)
+	log := h.logger.Ctx(c.Request().Context())
 
 	sku := c.Param("sku")
 	if sku == "" {
-		h.logger.Warn("Empty SKU parameter",
-			"request_id", requestID)
-		return c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error:   "INVALID_SKU",
-			Message: "SKU parameter is required",
-		})
+		log.Warn("Empty SKU parameter")
+		return respondProblem(c, domainErrors.ErrInvalidProductSKU.Code, "SKU parameter is required")
 	}
 
-	h.logger.Info("Get product by SKU request received",
-		"request_id", requestID,
+	log.Info("Get product by SKU request received",
 		"sku", sku,
 		"remote_ip", c.RealIP())
 
+	asOf, err := parseAsOf(c)
+	if err != nil {
+		return h.handleError(c, err, "Invalid as_of parameter")
+	}
+	if asOf != nil {
+		response, err := h.productUseCases.GetProductBySKUAsOf(c.Request().Context(), sku, *asOf)
+		if err != nil {
+			return h.handleError(c, err, "Failed to get product by SKU as of time")
+		}
+		return h.respondAsOf(c, response, *asOf)
+	}
+
 	// Execute use case
 	response, err := h.productUseCases.GetProductBySKU(c.Request().Context(), sku)
 	if err != nil {
-		return h.handleError(c, err, requestID, "Failed to get product by SKU")
+		return h.handleError(c, err, "Failed to get product by SKU")
 	}
 
-	h.logger.Info("Product retrieved by SKU successfully",
-		"request_id", requestID,
+	if err := h.enrichProducts(c, nil, response); err != nil {
+		return h.handleError(c, err, "Failed to complete product response")
+	}
+
+	log.Info("Product retrieved by SKU successfully",
 		"product_id", response.ID,
 		"sku", response.SKU)
 
 	return c.JSON(http.StatusOK, response)
 }
 
-// UpdateProduct handles PUT /api/v1/products/:id
+// GetProductByGTIN handles GET /api/v1/products/barcode/:code; the code may be
+// any GTIN-8, UPC-A, EAN-13 or GTIN-14 form of the product's barcode
+func (h *ProductHandler) GetProductByGTIN(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	code := c.Param("code")
+
+	log.Info("Get product by GTIN request received",
+		"gtin", code,
+		"remote_ip", c.RealIP())
+
+	response, err := h.productUseCases.GetProductByGTIN(c.Request().Context(), code)
+	if err != nil {
+		return h.handleError(c, err, "Failed to get product by GTIN")
+	}
+
+	if err := h.enrichProducts(c, nil, response); err != nil {
+		return h.handleError(c, err, "Failed to complete product response")
+	}
+
+	log.Info("Product retrieved by GTIN successfully",
+		"product_id", response.ID,
+		"gtin", response.GTIN)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// parseAsOf reads the optional as_of query parameter, an RFC 3339 timestamp
+// selecting a point-in-time read
+func parseAsOf(c echo.Context) (*time.Time, error) {
+	asOfParam := c.QueryParam("as_of")
+	if asOfParam == "" {
+		return nil, nil
+	}
+
+	asOf, err := time.Parse(time.RFC3339, asOfParam)
+	if err != nil {
+		return nil, domainErrors.NewProductValidationError("as_of", "as_of must be an RFC 3339 timestamp")
+	}
+	return &asOf, nil
+}
+
+// respondAsOf renders a product as it was at asOf
+func (h *ProductHandler) respondAsOf(c echo.Context, response *dto.ProductResponseDTO, asOf time.Time) error {
+	if err := h.enrichProducts(c, &asOf, response); err != nil {
+		return h.handleError(c, err, "Failed to complete product response")
+	}
+
+	h.logger.Ctx(c.Request().Context()).Info("Product retrieved as of time",
+		"product_id", response.ID,
+		"as_of", asOf)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// UpdateProduct handles PUT /api/v1/admin/products/:id, editing the live
+// product without review
 func (h *ProductHandler) UpdateProduct(c echo.Context) error {
-	requestID := c.Response().Header().Get(echo.HeaderXRequestID)
+	log := h.logger.Ctx(c.Request().Context())
 
 	// Parse product ID from path parameter
 	idParam := c.Param("id")
 	id, err := strconv.ParseUint(idParam, 10, 32)
 	if err != nil {
-		h.logger.Warn("Invalid product ID parameter",
-			"request_id", requestID,
+		log.Warn("Invalid product ID parameter",
 			"id_param", idParam,
 			"error", err)
-		return c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error:   "INVALID_ID",
-			Message: "Invalid product ID format",
-		})
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid product ID format")
 	}
 
 	// Parse request body
 	var request dto.UpdateProductRequestDTO
 	if err := c.Bind(&request); err != nil {
-		h.logger.Warn("Failed to bind request body",
-			"request_id", requestID,
+		log.Warn("Failed to bind request body",
 			"error", err)
-		return c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error:   "INVALID_REQUEST",
-			Message: "Invalid request body format",
-		})
+		return respondProblem(c, errorregistry.CodeInvalidRequest, "Invalid request body format")
 	}
 
 	// Validate request
 	if err := h.validator.Struct(request); err != nil {
-		h.logger.Warn("Request validation failed",
-			"request_id", requestID,
+		log.Warn("Request validation failed",
 			"error", err)
 
-		details := make(map[string]interface{})
-		if validationErrors, ok := err.(validator.ValidationErrors); ok {
-			for _, fieldError := range validationErrors {
-				details[fieldError.Field()] = getValidationErrorMessage(fieldError)
-			}
-		}
-
-		return c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error:   "VALIDATION_ERROR",
-			Message: "Request validation failed",
-			Details: details,
-		})
+		return respondProblem(c, errorregistry.CodeValidation, "Request validation failed", validationFieldErrors(c, err)...)
 	}
 
-	h.logger.Info("Update product request received",
-		"request_id", requestID,
+	log.Info("Update product request received",
 		"product_id", id,
 		"remote_ip", c.RealIP())
 
 	// Execute use case
 	response, err := h.productUseCases.UpdateProduct(c.Request().Context(), uint(id), &request)
 	if err != nil {
-		return h.handleError(c, err, requestID, "Failed to update product")
+		return h.handleError(c, err, "Failed to update product")
 	}
 
-	h.logger.Info("Product updated successfully",
-		"request_id", requestID,
+	log.Info("Product updated successfully",
 		"product_id", response.ID)
 
 	return c.JSON(http.StatusOK, response)
@@ -229,58 +272,44 @@ func (h *ProductHandler) UpdateProduct(c echo.Context) error {
 
 // UpdateProductStock handles PATCH /api/v1/products/:id/stock
 func (h *ProductHandler) UpdateProductStock(c echo.Context) error {
-	requestID := c.Response().Header().Get(echo.HeaderXRequestID)
+	log := h.logger.Ctx(c.Request().Context())
 
 	// Parse product ID from path parameter
 	idParam := c.Param("id")
 	id, err := strconv.ParseUint(idParam, 10, 32)
 	if err != nil {
-		h.logger.Warn("Invalid product ID parameter",
-			"request_id", requestID,
+		log.Warn("Invalid product ID parameter",
 			"id_param", idParam,
 			"error", err)
-		return c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error:   "INVALID_ID",
-			Message: "Invalid product ID format",
-		})
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid product ID format")
 	}
 
 	// Parse request body
 	var request dto.StockUpdateRequestDTO
 	if err := c.Bind(&request); err != nil {
-		h.logger.Warn("Failed to bind request body",
-			"request_id", requestID,
+		log.Warn("Failed to bind request body",
 			"error", err)
-		return c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error:   "INVALID_REQUEST",
-			Message: "Invalid request body format",
-		})
+		return respondProblem(c, errorregistry.CodeInvalidRequest, "Invalid request body format")
 	}
 
 	// Validate request
 	if err := h.validator.Struct(request); err != nil {
-		h.logger.Warn("Request validation failed",
-			"request_id", requestID,
+		log.Warn("Request validation failed",
 			"error", err)
-		return c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error:   "VALIDATION_ERROR",
-			Message: "Request validation failed",
-		})
+		return respondProblem(c, errorregistry.CodeValidation, "Request validation failed", validationFieldErrors(c, err)...)
 	}
 
-	h.logger.Info("Update product stock request received",
-		"request_id", requestID,
+	log.Info("Update product stock request received",
 		"product_id", id,
 		"new_stock", request.Stock)
 
 	// Execute use case
 	response, err := h.productUseCases.UpdateProductStock(c.Request().Context(), uint(id), request.Stock)
 	if err != nil {
-		return h.handleError(c, err, requestID, "Failed to update product stock")
+		return h.handleError(c, err, "Failed to update product stock")
 	}
 
-	h.logger.Info("Product stock updated successfully",
-		"request_id", requestID,
+	log.Info("Product stock updated successfully",
 		"product_id", response.ID,
 		"new_stock", response.Stock)
 
@@ -289,94 +318,74 @@ func (h *ProductHandler) UpdateProductStock(c echo.Context) error {
 
 // UpdateProductPrice handles PATCH /api/v1/products/:id/price
 func (h *ProductHandler) UpdateProductPrice(c echo.Context) error {
-	requestID := c.Response().Header().Get(echo.HeaderXRequestID)
+	log := h.logger.Ctx(c.Request().Context())
 
 	// Parse product ID from path parameter
 	idParam := c.Param("id")
 	id, err := strconv.ParseUint(idParam, 10, 32)
 	if err != nil {
-		h.logger.Warn("Invalid product ID parameter",
-			"request_id", requestID,
+		log.Warn("Invalid product ID parameter",
 			"id_param", idParam,
 			"error", err)
-		return c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error:   "INVALID_ID",
-			Message: "Invalid product ID format",
-		})
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid product ID format")
 	}
 
 	// Parse request body
 	var request dto.PriceUpdateRequestDTO
 	if err := c.Bind(&request); err != nil {
-		h.logger.Warn("Failed to bind request body",
-			"request_id", requestID,
+		log.Warn("Failed to bind request body",
 			"error", err)
-		return c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error:   "INVALID_REQUEST",
-			Message: "Invalid request body format",
-		})
+		return respondProblem(c, errorregistry.CodeInvalidRequest, "Invalid request body format")
 	}
 
 	// Validate request
 	if err := h.validator.Struct(request); err != nil {
-		h.logger.Warn("Request validation failed",
-			"request_id", requestID,
+		log.Warn("Request validation failed",
 			"error", err)
-		return c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error:   "VALIDATION_ERROR",
-			Message: "Request validation failed",
-		})
+		return respondProblem(c, errorregistry.CodeValidation, "Request validation failed", validationFieldErrors(c, err)...)
 	}
 
-	h.logger.Info("Update product price request received",
-		"request_id", requestID,
+	log.Info("Update product price request received",
 		"product_id", id,
-		"new_price", request.Price)
+		"new_price", request.Price.String())
 
 	// Execute use case
-	response, err := h.productUseCases.UpdateProductPrice(c.Request().Context(), uint(id), request.Price)
+	response, err := h.productUseCases.UpdateProductPrice(c.Request().Context(), uint(id), request.Price, request.Reason)
 	if err != nil {
-		return h.handleError(c, err, requestID, "Failed to update product price")
+		return h.handleError(c, err, "Failed to update product price")
 	}
 
-	h.logger.Info("Product price updated successfully",
-		"request_id", requestID,
+	log.Info("Product price updated successfully",
 		"product_id", response.ID,
-		"new_price", response.Price)
+		"new_price", response.Price.String())
 
 	return c.JSON(http.StatusOK, response)
 }
 
 // ActivateProduct handles PATCH /api/v1/products/:id/activate
 func (h *ProductHandler) ActivateProduct(c echo.Context) error {
-	requestID := c.Response().Header().Get(echo.HeaderXRequestID)
+	log := h.logger.Ctx(c.Request().Context())
 
 	// Parse product ID from path parameter
 	idParam := c.Param("id")
 	id, err := strconv.ParseUint(idParam, 10, 32)
 	if err != nil {
-		h.logger.Warn("Invalid product ID parameter",
-			"request_id", requestID,
+		log.Warn("Invalid product ID parameter",
 			"id_param", idParam,
 			"error", err)
-		return c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error:   "INVALID_ID",
-			Message: "Invalid product ID format",
-		})
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid product ID format")
 	}
 
-	h.logger.Info("Activate product request received",
-		"request_id", requestID,
+	log.Info("Activate product request received",
 		"product_id", id)
 
 	// Execute use case
 	response, err := h.productUseCases.ActivateProduct(c.Request().Context(), uint(id))
 	if err != nil {
-		return h.handleError(c, err, requestID, "Failed to activate product")
+		return h.handleError(c, err, "Failed to activate product")
 	}
 
-	h.logger.Info("Product activated successfully",
-		"request_id", requestID,
+	log.Info("Product activated successfully",
 		"product_id", response.ID)
 
 	return c.JSON(http.StatusOK, response)
@@ -384,34 +393,28 @@ func (h *ProductHandler) ActivateProduct(c echo.Context) error {
 
 // DeactivateProduct handles PATCH /api/v1/products/:id/deactivate
 func (h *ProductHandler) DeactivateProduct(c echo.Context) error {
-	requestID := c.Response().Header().Get(echo.HeaderXRequestID)
+	log := h.logger.Ctx(c.Request().Context())
 
 	// Parse product ID from path parameter
 	idParam := c.Param("id")
 	id, err := strconv.ParseUint(idParam, 10, 32)
 	if err != nil {
-		h.logger.Warn("Invalid product ID parameter",
-			"request_id", requestID,
+		log.Warn("Invalid product ID parameter",
 			"id_param", idParam,
 			"error", err)
-		return c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error:   "INVALID_ID",
-			Message: "Invalid product ID format",
-		})
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid product ID format")
 	}
 
-	h.logger.Info("Deactivate product request received",
-		"request_id", requestID,
+	log.Info("Deactivate product request received",
 		"product_id", id)
 
 	// Execute use case
 	response, err := h.productUseCases.DeactivateProduct(c.Request().Context(), uint(id))
 	if err != nil {
-		return h.handleError(c, err, requestID, "Failed to deactivate product")
+		return h.handleError(c, err, "Failed to deactivate product")
 	}
 
-	h.logger.Info("Product deactivated successfully",
-		"request_id", requestID,
+	log.Info("Product deactivated successfully",
 		"product_id", response.ID)
 
 	return c.JSON(http.StatusOK, response)
@@ -419,45 +422,107 @@ func (h *ProductHandler) DeactivateProduct(c echo.Context) error {
 
 // DiscontinueProduct handles PATCH /api/v1/products/:id/discontinue
 func (h *ProductHandler) DiscontinueProduct(c echo.Context) error {
-	requestID := c.Response().Header().Get(echo.HeaderXRequestID)
+	log := h.logger.Ctx(c.Request().Context())
 
 	// Parse product ID from path parameter
 	idParam := c.Param("id")
 	id, err := strconv.ParseUint(idParam, 10, 32)
 	if err != nil {
-		h.logger.Warn("Invalid product ID parameter",
-			"request_id", requestID,
+		log.Warn("Invalid product ID parameter",
 			"id_param", idParam,
 			"error", err)
-		return c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error:   "INVALID_ID",
-			Message: "Invalid product ID format",
-		})
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid product ID format")
 	}
 
-	h.logger.Info("Discontinue product request received",
-		"request_id", requestID,
+	log.Info("Discontinue product request received",
 		"product_id", id)
 
 	// Execute use case
 	response, err := h.productUseCases.DiscontinueProduct(c.Request().Context(), uint(id))
 	if err != nil {
-		return h.handleError(c, err, requestID, "Failed to discontinue product")
+		return h.handleError(c, err, "Failed to discontinue product")
 	}
 
-	h.logger.Info("Product discontinued successfully",
-		"request_id", requestID,
+	log.Info("Product discontinued successfully",
 		"product_id", response.ID)
 
 	return c.JSON(http.StatusOK, response)
 }
 
+// TransitionProduct handles POST /api/v1/products/:id/transitions
+func (h *ProductHandler) TransitionProduct(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	// Parse product ID from path parameter
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		log.Warn("Invalid product ID parameter",
+			"id_param", idParam,
+			"error", err)
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid product ID format")
+	}
+
+	// Parse request body
+	var request dto.TransitionRequestDTO
+	if err := c.Bind(&request); err != nil {
+		log.Warn("Failed to bind request body",
+			"error", err)
+		return respondProblem(c, errorregistry.CodeInvalidRequest, "Invalid request body format")
+	}
+
+	// Validate request
+	if err := h.validator.Struct(request); err != nil {
+		log.Warn("Request validation failed",
+			"error", err)
+		return respondProblem(c, errorregistry.CodeValidation, "Request validation failed", validationFieldErrors(c, err)...)
+	}
+
+	log.Info("Transition product request received",
+		"product_id", id,
+		"status", request.Status)
+
+	// Execute use case
+	response, err := h.productUseCases.TransitionProduct(c.Request().Context(), uint(id), &request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to transition product")
+	}
+
+	log.Info("Product transitioned successfully",
+		"product_id", response.ID,
+		"status", response.Status)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// ListTransitions handles GET /api/v1/products/:id/transitions
+func (h *ProductHandler) ListTransitions(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	// Parse product ID from path parameter
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		log.Warn("Invalid product ID parameter",
+			"id_param", idParam,
+			"error", err)
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid product ID format")
+	}
+
+	response, err := h.productUseCases.ListTransitions(c.Request().Context(), uint(id))
+	if err != nil {
+		return h.handleError(c, err, "Failed to list product transitions")
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
 // ListProducts handles GET /api/v1/products
+// Query parameters: page, page_size, tag (see queryTags)
 func (h *ProductHandler) ListProducts(c echo.Context) error {
-	requestID := c.Response().Header().Get(echo.HeaderXRequestID)
+	log := h.logger.Ctx(c.Request().Context())
 
-	h.logger.Info("List products request received",
-		"request_id", requestID,
+	log.Info("List products request received",
 		"remote_ip", c.RealIP())
 
 	// Parse query parameters
@@ -476,92 +541,250 @@ func (h *ProductHandler) ListProducts(c echo.Context) error {
 		}
 	}
 
-	h.logger.Info("List products parameters",
-		"request_id", requestID,
+	tags := queryTags(c)
+
+	log.Info("List products parameters",
 		"page", page,
-		"page_size", pageSize)
+		"page_size", pageSize,
+		"tags", tags)
 
 	// Execute use case
-	response, err := h.productUseCases.ListProducts(c.Request().Context(), page, pageSize)
+	response, err := h.productUseCases.ListProducts(c.Request().Context(), page, pageSize, tags)
 	if err != nil {
-		return h.handleError(c, err, requestID, "Failed to list products")
+		return h.handleError(c, err, "Failed to list products")
+	}
+
+	if err := h.enrichProducts(c, nil, response.Products...); err != nil {
+		return h.handleError(c, err, "Failed to complete product responses")
 	}
 
-	h.logger.Info("Products listed successfully",
-		"request_id", requestID,
+	log.Info("Products listed successfully",
 		"count", len(response.Products),
 		"page", page)
 
 	return c.JSON(http.StatusOK, response)
 }
 
-// handleError handles different types of errors and returns appropriate HTTP responses
-func (h *ProductHandler) handleError(c echo.Context, err error, requestID, logMessage string) error {
-	h.logger.Error(logMessage,
-		"request_id", requestID,
-		"error", err)
+// SearchProducts handles GET /api/v1/products/search.
+// Besides q, category (a path or name) or category_id, which include the
+// category's descendants, brand, min_price, max_price (in price_currency,
+// default USD), in_stock, status and tag (see queryTags), custom attributes
+// filter as attr.<key>=<value>, attr.<key>.min=<value> and
+// attr.<key>.max=<value>.
+func (h *ProductHandler) SearchProducts(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	request, err := parseSearchRequest(c)
+	if err != nil {
+		log.Warn("Invalid search parameters",
+			"error", err)
+		return h.handleError(c, err, "Invalid search parameters")
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		log.Warn("Search validation failed",
+			"error", err)
+		return respondProblem(c, errorregistry.CodeValidation, "Request validation failed", validationFieldErrors(c, err)...)
+	}
+
+	response, err := h.productUseCases.SearchProducts(c.Request().Context(), request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to search products")
+	}
+
+	if err := h.enrichProducts(c, nil, response.Products...); err != nil {
+		return h.handleError(c, err, "Failed to complete product responses")
+	}
+
+	log.Info("Products searched successfully",
+		"count", len(response.Products),
+		"total", response.Total)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// parseSearchRequest builds a search request from the query string
+func parseSearchRequest(c echo.Context) (*dto.ProductSearchRequestDTO, error) {
+	request := &dto.ProductSearchRequestDTO{
+		Query:    c.QueryParam("q"),
+		Category: c.QueryParam("category"),
+		Brand:    c.QueryParam("brand"),
+		Tags:     queryTags(c),
+		PageSize: 10,
+	}
+
+	if pageParam := c.QueryParam("page"); pageParam != "" {
+		page, err := strconv.Atoi(pageParam)
+		if err != nil || page < 0 {
+			return nil, domainErrors.NewProductValidationError("page", "page must be a non-negative integer")
+		}
+		request.Page = page
+	}
+	if sizeParam := c.QueryParam("page_size"); sizeParam != "" {
+		pageSize, err := strconv.Atoi(sizeParam)
+		if err != nil {
+			return nil, domainErrors.NewProductValidationError("page_size", "page_size must be an integer")
+		}
+		request.PageSize = pageSize
+	}
 
-	// Handle domain errors
-	var domainErr *domainErrors.DomainError
-	if errors.As(err, &domainErr) {
-		switch domainErr.Code {
-		case domainErrors.ErrProductNotFound.Code:
-			return c.JSON(http.StatusNotFound, ErrorResponse{
-				Error:   domainErr.Code,
-				Message: domainErr.Message,
-			})
-		case domainErrors.ErrProductAlreadyExists.Code:
-			return c.JSON(http.StatusConflict, ErrorResponse{
-				Error:   domainErr.Code,
-				Message: domainErr.Message,
-			})
-		case domainErrors.ErrInvalidProductName.Code,
-			domainErrors.ErrInvalidProductSKU.Code,
-			domainErrors.ErrInvalidProductPrice.Code,
-			domainErrors.ErrInvalidProductStock.Code,
-			domainErrors.ErrInvalidProductCategory.Code,
-			domainErrors.ErrInsufficientStock.Code:
-			return c.JSON(http.StatusBadRequest, ErrorResponse{
-				Error:   domainErr.Code,
-				Message: domainErr.Message,
-			})
-		case domainErrors.ErrProductInactive.Code,
-			domainErrors.ErrProductDiscontinued.Code,
-			domainErrors.ErrProductOutOfStock.Code,
-			domainErrors.ErrProductNotAvailable.Code:
-			return c.JSON(http.StatusUnprocessableEntity, ErrorResponse{
-				Error:   domainErr.Code,
-				Message: domainErr.Message,
-			})
-		default:
-			return c.JSON(http.StatusBadRequest, ErrorResponse{
-				Error:   domainErr.Code,
-				Message: domainErr.Message,
-			})
+	currency := c.QueryParam("price_currency")
+	if currency == "" {
+		currency = "USD"
+	}
+	for param, target := range map[string]**entities.Money{"min_price": &request.MinPrice, "max_price": &request.MaxPrice} {
+		if value := c.QueryParam(param); value != "" {
+			price, err := entities.ParseMoney(value, currency)
+			if err != nil {
+				return nil, domainErrors.NewProductValidationError(param, err.Error())
+			}
+			*target = &price
+		}
+	}
+
+	if categoryIDParam := c.QueryParam("category_id"); categoryIDParam != "" {
+		categoryID, err := strconv.ParseUint(categoryIDParam, 10, 32)
+		if err != nil {
+			return nil, domainErrors.NewProductValidationError("category_id", "category_id must be a category ID")
+		}
+		id := uint(categoryID)
+		request.CategoryID = &id
+	}
+
+	if inStockParam := c.QueryParam("in_stock"); inStockParam != "" {
+		inStock, err := strconv.ParseBool(inStockParam)
+		if err != nil {
+			return nil, domainErrors.NewProductValidationError("in_stock", "in_stock must be true or false")
 		}
+		request.InStock = &inStock
+	}
+
+	if statusParam := c.QueryParam("status"); statusParam != "" {
+		status := entities.ProductStatus(statusParam)
+		request.Status = &status
 	}
 
-	// Handle generic errors
-	return c.JSON(http.StatusInternalServerError, ErrorResponse{
-		Error:   "INTERNAL_ERROR",
-		Message: "An internal error occurred",
+	for name, values := range c.QueryParams() {
+		key, found := strings.CutPrefix(name, "attr.")
+		if !found || len(values) == 0 {
+			continue
+		}
+		operator := entities.AttributeEquals
+		if trimmed, ok := strings.CutSuffix(key, ".min"); ok {
+			key, operator = trimmed, entities.AttributeMin
+		} else if trimmed, ok := strings.CutSuffix(key, ".max"); ok {
+			key, operator = trimmed, entities.AttributeMax
+		}
+		request.Attributes = append(request.Attributes, dto.AttributeFilterDTO{Key: key, Operator: operator, Value: values[0]})
+	}
+	sort.Slice(request.Attributes, func(i, j int) bool {
+		if request.Attributes[i].Key != request.Attributes[j].Key {
+			return request.Attributes[i].Key < request.Attributes[j].Key
+		}
+		return request.Attributes[i].Operator < request.Attributes[j].Operator
 	})
+
+	return request, nil
 }
 
-// getValidationErrorMessage returns a user-friendly validation error message
-func getValidationErrorMessage(fieldError validator.FieldError) string {
-	switch fieldError.Tag() {
-	case "required":
-		return "This field is required"
-	case "min":
-		return "Minimum value is " + fieldError.Param()
-	case "max":
-		return "Maximum value is " + fieldError.Param()
-	case "gte":
-		return "Value must be greater than or equal to " + fieldError.Param()
-	case "lte":
-		return "Value must be less than or equal to " + fieldError.Param()
-	default:
-		return "Invalid value"
+// enrichProducts completes product read responses: availability derived from
+// variants, media, promotions and, when the request has a currency query
+// parameter, resolved prices. Every product read goes through it. For
+// point-in-time reads asOf is set: variants and media are not versioned and
+// are left out, promotions are evaluated at asOf unless the at query
+// parameter says otherwise, and prices resolve at today's rates.
+func (h *ProductHandler) enrichProducts(c echo.Context, asOf *time.Time, products ...*dto.ProductResponseDTO) error {
+	ctx := c.Request().Context()
+
+	if asOf == nil {
+		if err := h.variantUseCases.ApplyVariantAvailability(ctx, products); err != nil {
+			return fmt.Errorf("derive availability from variants: %w", err)
+		}
+		if err := h.mediaUseCases.ApplyMedia(ctx, products); err != nil {
+			return fmt.Errorf("load media: %w", err)
+		}
+	}
+
+	var err error
+	if asOf != nil && c.QueryParam("at") == "" {
+		err = h.promotionUseCases.ApplyPromotions(ctx, products, *asOf)
+	} else {
+		err = h.applyPromotions(c, products...)
+	}
+	if err != nil {
+		return fmt.Errorf("evaluate promotions: %w", err)
+	}
+
+	if err := h.resolveCurrency(c, products...); err != nil {
+		return fmt.Errorf("resolve prices: %w", err)
+	}
+	return nil
+}
+
+// resolveCurrency fills ResolvedPrice on each product when the request has a
+// currency query parameter; price_list optionally selects a non-default list
+func (h *ProductHandler) resolveCurrency(c echo.Context, products ...*dto.ProductResponseDTO) error {
+	currency := c.QueryParam("currency")
+	if currency == "" {
+		return nil
+	}
+
+	return h.pricingUseCases.ResolvePrices(c.Request().Context(), products, currency, c.QueryParam("price_list"))
+}
+
+// applyPromotions fills EffectivePrice and AppliedPromotions on each product.
+// Promotions are evaluated now, or at the RFC 3339 time in the at query parameter.
+func (h *ProductHandler) applyPromotions(c echo.Context, products ...*dto.ProductResponseDTO) error {
+	at := time.Now()
+	if atParam := c.QueryParam("at"); atParam != "" {
+		parsed, err := time.Parse(time.RFC3339, atParam)
+		if err != nil {
+			return domainErrors.NewProductValidationError("at", "at must be an RFC 3339 timestamp")
+		}
+		at = parsed
+	}
+
+	return h.promotionUseCases.ApplyPromotions(c.Request().Context(), products, at)
+}
+
+// handleError logs err and renders it as a problem response
+func (h *ProductHandler) handleError(c echo.Context, err error, logMessage string) error {
+	h.logger.Ctx(c.Request().Context()).Error(logMessage,
+		"error", err)
+
+	return respondDomainError(c, err)
+}
+
+// validationFieldErrors converts validator errors into localized problem field errors
+func validationFieldErrors(c echo.Context, err error) []FieldError {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return nil
+	}
+
+	localizer := localizerFor(c)
+	fieldErrors := make([]FieldError, 0, len(validationErrors))
+	for _, fieldError := range validationErrors {
+		fieldErrors = append(fieldErrors, FieldError{
+			Field:   fieldError.Field(),
+			Code:    fieldError.Tag(),
+			Message: localizer.Validation(fieldError.Tag(), fieldError.Field(), fieldError.Param()),
+		})
+	}
+	return fieldErrors
+}
+
+// queryTags collects the tag query parameter, which may repeat and may hold
+// comma-separated tags: ?tag=summer-sale&tag=new-arrivals or
+// ?tag=summer-sale,new-arrivals
+func queryTags(c echo.Context) []string {
+	var tags []string
+	for _, value := range c.QueryParams()["tag"] {
+		for _, tag := range strings.Split(value, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				tags = append(tags, tag)
+			}
+		}
 	}
+	return tags
 }
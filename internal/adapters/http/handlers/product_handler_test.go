@@ -10,7 +10,9 @@ import (
 	"product-service/internal/domain/entities"
 	domainErrors "product-service/internal/domain/errors"
 	"product-service/pkg/logger"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
@@ -47,6 +49,30 @@ func (m *MockProductUseCases) GetProductBySKU(ctx context.Context, sku string) (
 	return args.Get(0).(*dto.ProductResponseDTO), args.Error(1)
 }
 
+func (m *MockProductUseCases) GetProductByGTIN(ctx context.Context, gtin string) (*dto.ProductResponseDTO, error) {
+	args := m.Called(ctx, gtin)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ProductResponseDTO), args.Error(1)
+}
+
+func (m *MockProductUseCases) GetProductAsOf(ctx context.Context, id uint, at time.Time) (*dto.ProductResponseDTO, error) {
+	args := m.Called(ctx, id, at)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ProductResponseDTO), args.Error(1)
+}
+
+func (m *MockProductUseCases) GetProductBySKUAsOf(ctx context.Context, sku string, at time.Time) (*dto.ProductResponseDTO, error) {
+	args := m.Called(ctx, sku, at)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ProductResponseDTO), args.Error(1)
+}
+
 func (m *MockProductUseCases) UpdateProduct(ctx context.Context, id uint, request *dto.UpdateProductRequestDTO) (*dto.ProductResponseDTO, error) {
 	args := m.Called(ctx, id, request)
 	if args.Get(0) == nil {
@@ -63,8 +89,8 @@ func (m *MockProductUseCases) UpdateProductStock(ctx context.Context, id uint, s
 	return args.Get(0).(*dto.ProductResponseDTO), args.Error(1)
 }
 
-func (m *MockProductUseCases) UpdateProductPrice(ctx context.Context, id uint, price float64) (*dto.ProductResponseDTO, error) {
-	args := m.Called(ctx, id, price)
+func (m *MockProductUseCases) UpdateProductPrice(ctx context.Context, id uint, price entities.Money, reason string) (*dto.ProductResponseDTO, error) {
+	args := m.Called(ctx, id, price, reason)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -95,19 +121,185 @@ func (m *MockProductUseCases) DiscontinueProduct(ctx context.Context, id uint) (
 	return args.Get(0).(*dto.ProductResponseDTO), args.Error(1)
 }
 
-func (m *MockProductUseCases) ListProducts(ctx context.Context, page, pageSize int) (*dto.ProductListResponseDTO, error) {
-	args := m.Called(ctx, page, pageSize)
+func (m *MockProductUseCases) TransitionProduct(ctx context.Context, id uint, request *dto.TransitionRequestDTO) (*dto.ProductResponseDTO, error) {
+	args := m.Called(ctx, id, request)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ProductResponseDTO), args.Error(1)
+}
+
+func (m *MockProductUseCases) ListTransitions(ctx context.Context, id uint) (*dto.TransitionListResponseDTO, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.TransitionListResponseDTO), args.Error(1)
+}
+
+func (m *MockProductUseCases) ListProducts(ctx context.Context, page, pageSize int, tags []string) (*dto.ProductListResponseDTO, error) {
+	args := m.Called(ctx, page, pageSize, tags)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*dto.ProductListResponseDTO), args.Error(1)
 }
 
+func (m *MockProductUseCases) SearchProducts(ctx context.Context, request *dto.ProductSearchRequestDTO) (*dto.ProductListResponseDTO, error) {
+	args := m.Called(ctx, request)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ProductListResponseDTO), args.Error(1)
+}
+
+// MockPricingUseCases implements the PricingUseCases interface for testing
+type MockPricingUseCases struct {
+	mock.Mock
+}
+
+func (m *MockPricingUseCases) CreatePriceList(ctx context.Context, request *dto.CreatePriceListRequestDTO) (*dto.PriceListResponseDTO, error) {
+	args := m.Called(ctx, request)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.PriceListResponseDTO), args.Error(1)
+}
+
+func (m *MockPricingUseCases) ListPriceLists(ctx context.Context) ([]*dto.PriceListResponseDTO, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*dto.PriceListResponseDTO), args.Error(1)
+}
+
+func (m *MockPricingUseCases) SetProductPrice(ctx context.Context, priceListCode string, productID uint, price entities.Money) (*dto.ProductPriceResponseDTO, error) {
+	args := m.Called(ctx, priceListCode, productID, price)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ProductPriceResponseDTO), args.Error(1)
+}
+
+func (m *MockPricingUseCases) DeleteProductPrice(ctx context.Context, priceListCode string, productID uint, currency string) error {
+	args := m.Called(ctx, priceListCode, productID, currency)
+	return args.Error(0)
+}
+
+func (m *MockPricingUseCases) ListProductPrices(ctx context.Context, priceListCode string, productID uint) ([]*dto.ProductPriceResponseDTO, error) {
+	args := m.Called(ctx, priceListCode, productID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*dto.ProductPriceResponseDTO), args.Error(1)
+}
+
+func (m *MockPricingUseCases) ListExchangeRates(ctx context.Context) ([]*dto.ExchangeRateDTO, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*dto.ExchangeRateDTO), args.Error(1)
+}
+
+func (m *MockPricingUseCases) ImportExchangeRates(ctx context.Context, rates []dto.ExchangeRateDTO) ([]*dto.ExchangeRateDTO, error) {
+	args := m.Called(ctx, rates)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*dto.ExchangeRateDTO), args.Error(1)
+}
+
+func (m *MockPricingUseCases) DeleteExchangeRate(ctx context.Context, base, quote string) error {
+	args := m.Called(ctx, base, quote)
+	return args.Error(0)
+}
+
+func (m *MockPricingUseCases) ResolvePrices(ctx context.Context, products []*dto.ProductResponseDTO, currency, priceListCode string) error {
+	args := m.Called(ctx, products, currency, priceListCode)
+	return args.Error(0)
+}
+
+// MockPromotionUseCases implements the PromotionUseCases interface for testing
+type MockPromotionUseCases struct {
+	mock.Mock
+}
+
+func (m *MockPromotionUseCases) CreatePromotion(ctx context.Context, request *dto.PromotionRequestDTO) (*dto.PromotionResponseDTO, error) {
+	args := m.Called(ctx, request)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.PromotionResponseDTO), args.Error(1)
+}
+
+func (m *MockPromotionUseCases) GetPromotion(ctx context.Context, id uint) (*dto.PromotionResponseDTO, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.PromotionResponseDTO), args.Error(1)
+}
+
+func (m *MockPromotionUseCases) UpdatePromotion(ctx context.Context, id uint, request *dto.PromotionRequestDTO) (*dto.PromotionResponseDTO, error) {
+	args := m.Called(ctx, id, request)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.PromotionResponseDTO), args.Error(1)
+}
+
+func (m *MockPromotionUseCases) DeletePromotion(ctx context.Context, id uint) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockPromotionUseCases) ListPromotions(ctx context.Context) ([]*dto.PromotionResponseDTO, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*dto.PromotionResponseDTO), args.Error(1)
+}
+
+func (m *MockPromotionUseCases) ApplyPromotions(ctx context.Context, products []*dto.ProductResponseDTO, at time.Time) error {
+	args := m.Called(ctx, products, at)
+	return args.Error(0)
+}
+
 func setupTestHandler() (*ProductHandler, *MockProductUseCases) {
+	handler, mockUseCases, _ := setupTestHandlerWithPricing()
+	return handler, mockUseCases
+}
+
+// setupTestHandlerWithPricing leaves promotions out of the picture: they
+// apply no discount
+func setupTestHandlerWithPricing() (*ProductHandler, *MockProductUseCases, *MockPricingUseCases) {
+	mockPromotions := new(MockPromotionUseCases)
+	mockPromotions.On("ApplyPromotions", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	handler, mockUseCases, mockPricing, _ := setupTestHandlerWithPromotions(mockPromotions)
+	return handler, mockUseCases, mockPricing
+}
+
+// setupTestHandlerWithPromotions treats every product as having no variants
+func setupTestHandlerWithPromotions(mockPromotions *MockPromotionUseCases) (*ProductHandler, *MockProductUseCases, *MockPricingUseCases, *MockPromotionUseCases) {
+	mockVariants := new(MockVariantUseCases)
+	mockVariants.On("ApplyVariantAvailability", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	handler, mockUseCases, mockPricing := setupTestHandlerWithVariants(mockPromotions, mockVariants)
+	return handler, mockUseCases, mockPricing, mockPromotions
+}
+
+func setupTestHandlerWithVariants(mockPromotions *MockPromotionUseCases, mockVariants *MockVariantUseCases) (*ProductHandler, *MockProductUseCases, *MockPricingUseCases) {
 	mockUseCases := new(MockProductUseCases)
+	mockPricing := new(MockPricingUseCases)
+	mockMedia := new(MockMediaUseCases)
+	mockMedia.On("ApplyMedia", mock.Anything, mock.Anything).Return(nil).Maybe()
 	log := logger.New("test")
-	handler := NewProductHandler(mockUseCases, log)
-	return handler, mockUseCases
+	handler := NewProductHandler(mockUseCases, mockPricing, mockPromotions, mockVariants, mockMedia, log)
+	return handler, mockUseCases, mockPricing
 }
 
 func TestProductHandler_CreateProduct_Success(t *testing.T) {
@@ -118,7 +310,7 @@ func TestProductHandler_CreateProduct_Success(t *testing.T) {
 		Name:        "iPhone 15",
 		Description: "Latest Apple smartphone",
 		SKU:         "IPH15-128GB",
-		Price:       999.99,
+		Price:       entities.MustParseMoney("999.99", "USD"),
 		Category:    "Electronics",
 		Brand:       "Apple",
 		Stock:       100,
@@ -129,7 +321,7 @@ func TestProductHandler_CreateProduct_Success(t *testing.T) {
 		Name:        "iPhone 15",
 		Description: "Latest Apple smartphone",
 		SKU:         "IPH15-128GB",
-		Price:       999.99,
+		Price:       entities.MustParseMoney("999.99", "USD"),
 		Category:    "Electronics",
 		Brand:       "Apple",
 		Stock:       100,
@@ -174,9 +366,9 @@ func TestProductHandler_CreateProduct_ValidationError(t *testing.T) {
 	requestBody := dto.CreateProductRequestDTO{
 		Name:        "", // Required field missing
 		Description: "Description",
-		SKU:         "AB",  // Too short
-		Price:       -10.0, // Invalid negative price
-		Category:    "",    // Required field missing
+		SKU:         "AB",                                    // Too short
+		Price:       entities.MustParseMoney("-10.0", "USD"), // Invalid negative price
+		Category:    "",                                      // Required field missing
 		Brand:       "Brand",
 		Stock:       -5, // Invalid negative stock
 	}
@@ -196,12 +388,12 @@ func TestProductHandler_CreateProduct_ValidationError(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, http.StatusBadRequest, rec.Code)
 
-	var response ErrorResponse
+	var response Problem
 	err = json.Unmarshal(rec.Body.Bytes(), &response)
 	require.NoError(t, err)
 
-	assert.Equal(t, "VALIDATION_ERROR", response.Error)
-	assert.NotNil(t, response.Details)
+	assert.Equal(t, "VALIDATION_ERROR", response.Code)
+	assert.NotEmpty(t, response.Errors)
 }
 
 func TestProductHandler_CreateProduct_ProductAlreadyExists(t *testing.T) {
@@ -212,7 +404,7 @@ func TestProductHandler_CreateProduct_ProductAlreadyExists(t *testing.T) {
 		Name:        "iPhone 15",
 		Description: "Latest Apple smartphone",
 		SKU:         "EXISTING-SKU",
-		Price:       999.99,
+		Price:       entities.MustParseMoney("999.99", "USD"),
 		Category:    "Electronics",
 		Brand:       "Apple",
 		Stock:       100,
@@ -235,11 +427,11 @@ func TestProductHandler_CreateProduct_ProductAlreadyExists(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, http.StatusConflict, rec.Code)
 
-	var response ErrorResponse
+	var response Problem
 	err = json.Unmarshal(rec.Body.Bytes(), &response)
 	require.NoError(t, err)
 
-	assert.Equal(t, "PRODUCT_ALREADY_EXISTS", response.Error)
+	assert.Equal(t, "PRODUCT_ALREADY_EXISTS", response.Code)
 	mockUseCases.AssertExpectations(t)
 }
 
@@ -252,7 +444,7 @@ func TestProductHandler_GetProduct_Success(t *testing.T) {
 		Name:        "iPhone 15",
 		Description: "Latest Apple smartphone",
 		SKU:         "IPH15-128GB",
-		Price:       999.99,
+		Price:       entities.MustParseMoney("999.99", "USD"),
 		Category:    "Electronics",
 		Brand:       "Apple",
 		Stock:       100,
@@ -289,6 +481,331 @@ func TestProductHandler_GetProduct_Success(t *testing.T) {
 	mockUseCases.AssertExpectations(t)
 }
 
+func TestProductHandler_GetProduct_WithCurrency(t *testing.T) {
+	// Setup
+	handler, mockUseCases, mockPricing := setupTestHandlerWithPricing()
+
+	product := &dto.ProductResponseDTO{
+		ID:    1,
+		Name:  "iPhone 15",
+		Price: entities.MustParseMoney("100.00", "USD"),
+	}
+
+	mockUseCases.On("GetProductByID", mock.Anything, uint(1)).Return(product, nil)
+	mockPricing.On("ResolvePrices", mock.Anything, []*dto.ProductResponseDTO{product}, "EUR", "").
+		Run(func(args mock.Arguments) {
+			products := args.Get(1).([]*dto.ProductResponseDTO)
+			products[0].ResolvedPrice = &dto.ResolvedPriceDTO{
+				Price:        entities.MustParseMoney("92.00", "EUR"),
+				Source:       entities.PriceSourceConverted,
+				Converted:    true,
+				ExchangeRate: "0.92",
+			}
+		}).
+		Return(nil)
+
+	// Create request
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/products/1?currency=EUR", nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	// Execute
+	err := handler.GetProduct(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response dto.ProductResponseDTO
+	err = json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+
+	require.NotNil(t, response.ResolvedPrice)
+	assert.Equal(t, entities.MustParseMoney("92.00", "EUR"), response.ResolvedPrice.Price)
+	assert.True(t, response.ResolvedPrice.Converted)
+	assert.Equal(t, entities.PriceSourceConverted, response.ResolvedPrice.Source)
+
+	mockUseCases.AssertExpectations(t)
+	mockPricing.AssertExpectations(t)
+}
+
+func TestProductHandler_GetProduct_UnsupportedCurrency(t *testing.T) {
+	// Setup
+	handler, mockUseCases, mockPricing := setupTestHandlerWithPricing()
+
+	product := &dto.ProductResponseDTO{ID: 1, Price: entities.MustParseMoney("100.00", "USD")}
+
+	mockUseCases.On("GetProductByID", mock.Anything, uint(1)).Return(product, nil)
+	mockPricing.On("ResolvePrices", mock.Anything, mock.Anything, "XYZ", "").Return(domainErrors.ErrUnsupportedCurrency)
+
+	// Create request
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/products/1?currency=XYZ", nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	// Execute
+	err := handler.GetProduct(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var response Problem
+	err = json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, domainErrors.ErrUnsupportedCurrency.Code, response.Code)
+}
+
+func TestProductHandler_ProductReads_WithCurrency(t *testing.T) {
+	asOf := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		target string
+		param  string
+		value  string
+		read   func(h *ProductHandler, c echo.Context) error
+		expect func(m *MockProductUseCases, product *dto.ProductResponseDTO)
+	}{
+		{
+			name:   "by SKU",
+			target: "/api/v1/products/sku/IPH15-128GB?currency=EUR",
+			param:  "sku",
+			value:  "IPH15-128GB",
+			read:   (*ProductHandler).GetProductBySKU,
+			expect: func(m *MockProductUseCases, product *dto.ProductResponseDTO) {
+				m.On("GetProductBySKU", mock.Anything, "IPH15-128GB").Return(product, nil)
+			},
+		},
+		{
+			name:   "by GTIN",
+			target: "/api/v1/products/barcode/4006381333931?currency=EUR",
+			param:  "code",
+			value:  "4006381333931",
+			read:   (*ProductHandler).GetProductByGTIN,
+			expect: func(m *MockProductUseCases, product *dto.ProductResponseDTO) {
+				m.On("GetProductByGTIN", mock.Anything, "4006381333931").Return(product, nil)
+			},
+		},
+		{
+			name:   "by ID as of a time",
+			target: "/api/v1/products/1?as_of=2026-03-01T09:30:00Z&currency=EUR",
+			param:  "id",
+			value:  "1",
+			read:   (*ProductHandler).GetProduct,
+			expect: func(m *MockProductUseCases, product *dto.ProductResponseDTO) {
+				m.On("GetProductAsOf", mock.Anything, uint(1), asOf).Return(product, nil)
+			},
+		},
+		{
+			name:   "by SKU as of a time",
+			target: "/api/v1/products/sku/IPH15-128GB?as_of=2026-03-01T09:30:00Z&currency=EUR",
+			param:  "sku",
+			value:  "IPH15-128GB",
+			read:   (*ProductHandler).GetProductBySKU,
+			expect: func(m *MockProductUseCases, product *dto.ProductResponseDTO) {
+				m.On("GetProductBySKUAsOf", mock.Anything, "IPH15-128GB", asOf).Return(product, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			handler, mockUseCases, mockPricing := setupTestHandlerWithPricing()
+
+			product := &dto.ProductResponseDTO{ID: 1, SKU: "IPH15-128GB", Price: entities.MustParseMoney("100.00", "USD")}
+			tt.expect(mockUseCases, product)
+			mockPricing.On("ResolvePrices", mock.Anything, []*dto.ProductResponseDTO{product}, "EUR", "").
+				Run(func(args mock.Arguments) {
+					args.Get(1).([]*dto.ProductResponseDTO)[0].ResolvedPrice = &dto.ResolvedPriceDTO{
+						Price:     entities.MustParseMoney("92.00", "EUR"),
+						Source:    entities.PriceSourceConverted,
+						Converted: true,
+					}
+				}).
+				Return(nil)
+
+			// Create request
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			c.SetParamNames(tt.param)
+			c.SetParamValues(tt.value)
+
+			// Execute
+			err := tt.read(handler, c)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var response dto.ProductResponseDTO
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			require.NotNil(t, response.ResolvedPrice)
+			assert.Equal(t, entities.MustParseMoney("92.00", "EUR"), response.ResolvedPrice.Price)
+
+			mockUseCases.AssertExpectations(t)
+			mockPricing.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProductHandler_GetProduct_WithPromotions(t *testing.T) {
+	// Setup
+	handler, mockUseCases, _, mockPromotions := setupTestHandlerWithPromotions(new(MockPromotionUseCases))
+
+	product := &dto.ProductResponseDTO{ID: 1, Price: entities.MustParseMoney("100.00", "USD")}
+	at := time.Date(2030, 11, 28, 12, 0, 0, 0, time.UTC)
+
+	mockUseCases.On("GetProductByID", mock.Anything, uint(1)).Return(product, nil)
+	mockPromotions.On("ApplyPromotions", mock.Anything, []*dto.ProductResponseDTO{product}, at).
+		Run(func(args mock.Arguments) {
+			args.Get(1).([]*dto.ProductResponseDTO)[0].ApplyPriceEvaluation(&entities.PriceEvaluation{
+				BasePrice:      entities.MustParseMoney("100.00", "USD"),
+				EffectivePrice: entities.MustParseMoney("80.00", "USD"),
+				Applied: []entities.AppliedPromotion{{
+					PromotionID:  3,
+					Name:         "Black Friday",
+					DiscountType: entities.DiscountPercentage,
+					Percentage:   "20",
+					Discount:     entities.MustParseMoney("20.00", "USD"),
+					PriceAfter:   entities.MustParseMoney("80.00", "USD"),
+				}},
+			})
+		}).Return(nil)
+
+	// Create request
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/products/1?at=2030-11-28T12:00:00Z", nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	// Execute
+	err := handler.GetProduct(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"amount":"100.00","currency":"USD"}`, extractJSONField(t, rec.Body.Bytes(), "price"))
+	assert.JSONEq(t, `{"amount":"80.00","currency":"USD"}`, extractJSONField(t, rec.Body.Bytes(), "effective_price"))
+
+	var response dto.ProductResponseDTO
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Len(t, response.AppliedPromotions, 1)
+	assert.Equal(t, "Black Friday", response.AppliedPromotions[0].Name)
+
+	mockPromotions.AssertExpectations(t)
+}
+
+func TestProductHandler_GetProduct_InvalidAt(t *testing.T) {
+	// Setup
+	handler, mockUseCases, _, mockPromotions := setupTestHandlerWithPromotions(new(MockPromotionUseCases))
+
+	mockUseCases.On("GetProductByID", mock.Anything, uint(1)).Return(&dto.ProductResponseDTO{ID: 1, Price: entities.MustParseMoney("100.00", "USD")}, nil)
+
+	// Create request
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/products/1?at=tomorrow", nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	// Execute
+	err := handler.GetProduct(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockPromotions.AssertNotCalled(t, "ApplyPromotions", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestProductHandler_GetProduct_AsOf(t *testing.T) {
+	// Setup
+	mockVariants := new(MockVariantUseCases)
+	mockPromotions := new(MockPromotionUseCases)
+	handler, mockUseCases, _ := setupTestHandlerWithVariants(mockPromotions, mockVariants)
+
+	asOf := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+	product := &dto.ProductResponseDTO{
+		ID:     1,
+		Price:  entities.MustParseMoney("899.00", "USD"),
+		Status: entities.ProductStatusInactive,
+		AsOf:   &asOf,
+	}
+
+	mockUseCases.On("GetProductAsOf", mock.Anything, uint(1), asOf).Return(product, nil)
+	mockPromotions.On("ApplyPromotions", mock.Anything, []*dto.ProductResponseDTO{product}, asOf).Return(nil)
+
+	// Create request
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/products/1?as_of=2026-03-01T09:30:00Z", nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	// Execute
+	err := handler.GetProduct(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"amount":"899.00","currency":"USD"}`, extractJSONField(t, rec.Body.Bytes(), "price"))
+	assert.JSONEq(t, `"inactive"`, extractJSONField(t, rec.Body.Bytes(), "status"))
+	assert.JSONEq(t, `"2026-03-01T09:30:00Z"`, extractJSONField(t, rec.Body.Bytes(), "as_of"))
+
+	mockUseCases.AssertNotCalled(t, "GetProductByID", mock.Anything, mock.Anything)
+	mockVariants.AssertNotCalled(t, "ApplyVariantAvailability", mock.Anything, mock.Anything)
+	mockPromotions.AssertExpectations(t)
+}
+
+func TestProductHandler_GetProductBySKU_AsOf(t *testing.T) {
+	tests := []struct {
+		name       string
+		asOf       string
+		err        error
+		wantStatus int
+		wantCode   string
+	}{
+		{"before the first version", "2020-01-01T00:00:00Z", domainErrors.ErrProductVersionNotFound, http.StatusNotFound, "PRODUCT_VERSION_NOT_FOUND"},
+		{"not a timestamp", "yesterday", nil, http.StatusBadRequest, "VALIDATION_ERROR"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			handler, mockUseCases := setupTestHandler()
+			if tt.err != nil {
+				mockUseCases.On("GetProductBySKUAsOf", mock.Anything, "IPH15-128GB", mock.Anything).Return(nil, tt.err)
+			}
+
+			// Create request
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/products/sku/IPH15-128GB?as_of="+tt.asOf, nil)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			c.SetParamNames("sku")
+			c.SetParamValues("IPH15-128GB")
+
+			// Execute
+			err := handler.GetProductBySKU(c)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			var response Problem
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, tt.wantCode, response.Code)
+			mockUseCases.AssertNotCalled(t, "GetProductBySKU", mock.Anything, mock.Anything)
+		})
+	}
+}
+
 func TestProductHandler_GetProduct_NotFound(t *testing.T) {
 	// Setup
 	handler, mockUseCases := setupTestHandler()
@@ -309,11 +826,77 @@ func TestProductHandler_GetProduct_NotFound(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, http.StatusNotFound, rec.Code)
 
-	var response ErrorResponse
+	var response Problem
+	err = json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+
+	assert.Equal(t, "PRODUCT_NOT_FOUND", response.Code)
+	assert.Equal(t, http.StatusNotFound, response.Status)
+	assert.Equal(t, "/api/v1/errors/PRODUCT_NOT_FOUND", response.Type)
+	assert.Equal(t, MIMEApplicationProblemJSON, rec.Header().Get(echo.HeaderContentType))
+	mockUseCases.AssertExpectations(t)
+}
+
+func TestProductHandler_GetProduct_NotFound_Localized(t *testing.T) {
+	// Setup
+	handler, mockUseCases := setupTestHandler()
+
+	mockUseCases.On("GetProductByID", mock.Anything, uint(999)).Return(nil, domainErrors.ErrProductNotFound)
+
+	// Create request
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/products/999", nil)
+	req.Header.Set(HeaderAcceptLanguage, "es-CO,es;q=0.9,en;q=0.5")
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("999")
+
+	// Execute
+	err := handler.GetProduct(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Equal(t, "es", rec.Header().Get(HeaderContentLanguage))
+
+	var response Problem
 	err = json.Unmarshal(rec.Body.Bytes(), &response)
 	require.NoError(t, err)
 
-	assert.Equal(t, "PRODUCT_NOT_FOUND", response.Error)
+	assert.Equal(t, "PRODUCT_NOT_FOUND", response.Code)
+	assert.Equal(t, "Producto no encontrado", response.Detail)
+	mockUseCases.AssertExpectations(t)
+}
+
+func TestProductHandler_GetProduct_UnregisteredDomainError(t *testing.T) {
+	// Setup
+	handler, mockUseCases := setupTestHandler()
+
+	unknownErr := domainErrors.NewProductBusinessRuleError("SOMETHING_NEW", "Something new went wrong")
+	mockUseCases.On("GetProductByID", mock.Anything, uint(1)).Return(nil, unknownErr)
+
+	// Create request
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/products/1", nil)
+	req.Header.Set(echo.HeaderXRequestID, "req-123")
+	rec := httptest.NewRecorder()
+	rec.Header().Set(echo.HeaderXRequestID, "req-123")
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	// Execute
+	err := handler.GetProduct(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	var response Problem
+	err = json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+
+	assert.Equal(t, "SOMETHING_NEW", response.Code)
+	assert.Equal(t, "req-123", response.RequestID)
 	mockUseCases.AssertExpectations(t)
 }
 
@@ -335,11 +918,11 @@ func TestProductHandler_GetProduct_InvalidID(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, http.StatusBadRequest, rec.Code)
 
-	var response ErrorResponse
+	var response Problem
 	err = json.Unmarshal(rec.Body.Bytes(), &response)
 	require.NoError(t, err)
 
-	assert.Equal(t, "INVALID_ID", response.Error)
+	assert.Equal(t, "INVALID_ID", response.Code)
 }
 
 func TestProductHandler_GetProductBySKU_Success(t *testing.T) {
@@ -351,7 +934,7 @@ func TestProductHandler_GetProductBySKU_Success(t *testing.T) {
 		Name:        "iPhone 15",
 		Description: "Latest Apple smartphone",
 		SKU:         "IPH15-128GB",
-		Price:       999.99,
+		Price:       entities.MustParseMoney("999.99", "USD"),
 		Category:    "Electronics",
 		Brand:       "Apple",
 		Status:      entities.ProductStatusActive,
@@ -390,7 +973,7 @@ func TestProductHandler_UpdateProduct_Success(t *testing.T) {
 	// Setup
 	handler, mockUseCases := setupTestHandler()
 
-	newPrice := 899.99
+	newPrice := entities.MustParseMoney("899.99", "USD")
 	requestBody := dto.UpdateProductRequestDTO{
 		Name:        "iPhone 15 Pro",
 		Description: "Updated description",
@@ -402,7 +985,7 @@ func TestProductHandler_UpdateProduct_Success(t *testing.T) {
 		Name:        "iPhone 15 Pro",
 		Description: "Updated description",
 		SKU:         "IPH15-128GB",
-		Price:       899.99,
+		Price:       entities.MustParseMoney("899.99", "USD"),
 		Category:    "Electronics",
 		Brand:       "Apple",
 		Stock:       100,
@@ -416,7 +999,7 @@ func TestProductHandler_UpdateProduct_Success(t *testing.T) {
 
 	// Create request
 	jsonBody, _ := json.Marshal(requestBody)
-	req := httptest.NewRequest(http.MethodPut, "/api/v1/products/1", bytes.NewBuffer(jsonBody))
+	req := httptest.NewRequest(http.MethodPut, "/api/v1/admin/products/1", bytes.NewBuffer(jsonBody))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 
 	rec := httptest.NewRecorder()
@@ -493,21 +1076,21 @@ func TestProductHandler_UpdateProductPrice_Success(t *testing.T) {
 	handler, mockUseCases := setupTestHandler()
 
 	requestBody := dto.PriceUpdateRequestDTO{
-		Price: 799.99,
+		Price: entities.MustParseMoney("799.99", "USD"),
 	}
 
 	expectedResponse := &dto.ProductResponseDTO{
 		ID:          1,
 		Name:        "iPhone 15",
 		SKU:         "IPH15-128GB",
-		Price:       799.99,
+		Price:       entities.MustParseMoney("799.99", "USD"),
 		Status:      entities.ProductStatusActive,
 		IsActive:    true,
 		IsInStock:   true,
 		IsAvailable: true,
 	}
 
-	mockUseCases.On("UpdateProductPrice", mock.Anything, uint(1), 799.99).Return(expectedResponse, nil)
+	mockUseCases.On("UpdateProductPrice", mock.Anything, uint(1), entities.MustParseMoney("799.99", "USD"), "").Return(expectedResponse, nil)
 
 	// Create request
 	jsonBody, _ := json.Marshal(requestBody)
@@ -530,7 +1113,38 @@ func TestProductHandler_UpdateProductPrice_Success(t *testing.T) {
 	err = json.Unmarshal(rec.Body.Bytes(), &response)
 	require.NoError(t, err)
 
-	assert.Equal(t, 799.99, response.Price)
+	assert.Equal(t, entities.MustParseMoney("799.99", "USD"), response.Price)
+
+	mockUseCases.AssertExpectations(t)
+}
+
+func TestProductHandler_UpdateProductPrice_LegacyNumericPrice(t *testing.T) {
+	// Setup
+	handler, mockUseCases := setupTestHandler()
+
+	expectedResponse := &dto.ProductResponseDTO{
+		ID:    1,
+		Price: entities.MustParseMoney("799.99", "USD"),
+	}
+
+	mockUseCases.On("UpdateProductPrice", mock.Anything, uint(1), entities.MustParseMoney("799.99", entities.DefaultCurrency), "").Return(expectedResponse, nil)
+
+	// Create request with a bare number as sent by older clients
+	req := httptest.NewRequest(http.MethodPatch, "/api/v1/products/1/price", bytes.NewBufferString(`{"price": 799.99}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	// Execute
+	err := handler.UpdateProductPrice(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"amount":"799.99","currency":"USD"}`, extractJSONField(t, rec.Body.Bytes(), "price"))
 
 	mockUseCases.AssertExpectations(t)
 }
@@ -616,6 +1230,53 @@ func TestProductHandler_DiscontinueProduct_Success(t *testing.T) {
 	mockUseCases.AssertExpectations(t)
 }
 
+func TestProductHandler_TransitionProduct_Invalid(t *testing.T) {
+	// Setup
+	handler, mockUseCases := setupTestHandler()
+
+	request := &dto.TransitionRequestDTO{Status: entities.ProductStatusActive, Reason: "back in the catalog"}
+	mockUseCases.On("TransitionProduct", mock.Anything, uint(1), request).Return(nil, domainErrors.ErrInvalidStatusTransition)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/products/1/transitions", strings.NewReader(`{"status":"active","reason":"back in the catalog"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	// Execute
+	err := handler.TransitionProduct(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	var response Problem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, "INVALID_STATUS_TRANSITION", response.Code)
+	mockUseCases.AssertExpectations(t)
+}
+
+func TestProductHandler_TransitionProduct_UnknownStatus(t *testing.T) {
+	// Setup
+	handler, mockUseCases := setupTestHandler()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/products/1/transitions", strings.NewReader(`{"status":"deleted"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	// Execute
+	err := handler.TransitionProduct(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUseCases.AssertNotCalled(t, "TransitionProduct", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestProductHandler_ListProducts_Success(t *testing.T) {
 	// Setup
 	handler, mockUseCases := setupTestHandler()
@@ -625,7 +1286,7 @@ func TestProductHandler_ListProducts_Success(t *testing.T) {
 			ID:          1,
 			Name:        "iPhone 15",
 			SKU:         "IPH15-128GB",
-			Price:       999.99,
+			Price:       entities.MustParseMoney("999.99", "USD"),
 			Status:      entities.ProductStatusActive,
 			IsActive:    true,
 			IsInStock:   true,
@@ -635,7 +1296,7 @@ func TestProductHandler_ListProducts_Success(t *testing.T) {
 			ID:          2,
 			Name:        "Samsung Galaxy S24",
 			SKU:         "SGS24-128GB",
-			Price:       899.99,
+			Price:       entities.MustParseMoney("899.99", "USD"),
 			Status:      entities.ProductStatusActive,
 			IsActive:    true,
 			IsInStock:   true,
@@ -650,7 +1311,7 @@ func TestProductHandler_ListProducts_Success(t *testing.T) {
 		PageSize: 10,
 	}
 
-	mockUseCases.On("ListProducts", mock.Anything, 0, 10).Return(expectedResponse, nil)
+	mockUseCases.On("ListProducts", mock.Anything, 0, 10, []string(nil)).Return(expectedResponse, nil)
 
 	// Create request
 	req := httptest.NewRequest(http.MethodGet, "/api/v1/products", nil)
@@ -686,7 +1347,7 @@ func TestProductHandler_ListProducts_WithPagination(t *testing.T) {
 		PageSize: 5,
 	}
 
-	mockUseCases.On("ListProducts", mock.Anything, 2, 5).Return(expectedResponse, nil)
+	mockUseCases.On("ListProducts", mock.Anything, 2, 5, []string(nil)).Return(expectedResponse, nil)
 
 	// Create request with pagination parameters
 	req := httptest.NewRequest(http.MethodGet, "/api/v1/products?page=2&page_size=5", nil)
@@ -709,3 +1370,139 @@ func TestProductHandler_ListProducts_WithPagination(t *testing.T) {
 
 	mockUseCases.AssertExpectations(t)
 }
+
+func TestProductHandler_ListProducts_TagFilter(t *testing.T) {
+	// Setup
+	handler, mockUseCases := setupTestHandler()
+
+	expectedResponse := &dto.ProductListResponseDTO{
+		Products: []*dto.ProductResponseDTO{},
+		Page:     0,
+		PageSize: 10,
+	}
+
+	mockUseCases.On("ListProducts", mock.Anything, 0, 10, []string{"summer-sale", "new-arrivals", "clearance"}).Return(expectedResponse, nil)
+
+	// Create request mixing repeated and comma-separated tags
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/products?tag=summer-sale,new-arrivals&tag=clearance", nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	// Execute
+	err := handler.ListProducts(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	mockUseCases.AssertExpectations(t)
+}
+
+func TestProductHandler_SearchProducts_ParsesAttributeFilters(t *testing.T) {
+	// Setup
+	handler, mockUseCases := setupTestHandler()
+
+	minPrice := entities.MustParseMoney("500", "USD")
+	inStock := true
+	expectedRequest := &dto.ProductSearchRequestDTO{
+		Query:    "macbook",
+		Category: "Laptops",
+		MinPrice: &minPrice,
+		InStock:  &inStock,
+		Page:     1,
+		PageSize: 20,
+		Attributes: []dto.AttributeFilterDTO{
+			{Key: "color", Operator: entities.AttributeEquals, Value: "Silver"},
+			{Key: "storage_gb", Operator: entities.AttributeMax, Value: "512"},
+			{Key: "storage_gb", Operator: entities.AttributeMin, Value: "128"},
+		},
+	}
+	mockUseCases.On("SearchProducts", mock.Anything, expectedRequest).Return(&dto.ProductListResponseDTO{
+		Products: []*dto.ProductResponseDTO{{ID: 7, Name: "MacBook Air", SKU: "MBA-13"}},
+		Total:    21,
+		Page:     1,
+		PageSize: 20,
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/products/search?q=macbook&category=Laptops&min_price=500&in_stock=true&page=1&page_size=20"+
+		"&attr.storage_gb.min=128&attr.storage_gb.max=512&attr.color=Silver", nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	// Execute
+	err := handler.SearchProducts(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "21", extractJSONField(t, rec.Body.Bytes(), "total"))
+
+	mockUseCases.AssertExpectations(t)
+}
+
+func TestProductHandler_SearchProducts_InvalidPrice(t *testing.T) {
+	// Setup
+	handler, mockUseCases := setupTestHandler()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/products/search?max_price=cheap", nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	// Execute
+	err := handler.SearchProducts(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUseCases.AssertNotCalled(t, "SearchProducts", mock.Anything, mock.Anything)
+}
+
+func extractJSONField(t *testing.T, body []byte, field string) string {
+	t.Helper()
+	var fields map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(body, &fields))
+	return string(fields[field])
+}
+
+func TestProductHandler_GetProduct_DerivesAvailabilityFromVariants(t *testing.T) {
+	// Setup
+	mockPromotions := new(MockPromotionUseCases)
+	mockPromotions.On("ApplyPromotions", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockVariants := new(MockVariantUseCases)
+	handler, mockUseCases, _ := setupTestHandlerWithVariants(mockPromotions, mockVariants)
+
+	product := &dto.ProductResponseDTO{ID: 1, Price: entities.MustParseMoney("20.00", "USD"), IsActive: true}
+
+	mockUseCases.On("GetProductByID", mock.Anything, uint(1)).Return(product, nil)
+	mockVariants.On("ApplyVariantAvailability", mock.Anything, []*dto.ProductResponseDTO{product}).
+		Run(func(args mock.Arguments) {
+			args.Get(1).([]*dto.ProductResponseDTO)[0].ApplyVariantSummary(entities.VariantSummary{
+				VariantCount:   3,
+				AvailableCount: 1,
+				TotalStock:     4,
+			})
+		}).Return(nil)
+
+	// Create request
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/products/1", nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	// Execute
+	err := handler.GetProduct(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response dto.ProductResponseDTO
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.True(t, response.HasVariants)
+	assert.Equal(t, 3, response.VariantCount)
+	assert.Equal(t, 4, response.Stock)
+	assert.True(t, response.IsAvailable)
+
+	mockVariants.AssertExpectations(t)
+}
@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"product-service/internal/adapters/errorregistry"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/usecases"
+	"product-service/pkg/logger"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+type PromotionHandler struct {
+	promotionUseCases usecases.PromotionUseCases
+	validator         *validator.Validate
+	logger            logger.Logger
+}
+
+func NewPromotionHandler(promotionUseCases usecases.PromotionUseCases, log logger.Logger) *PromotionHandler {
+	return &PromotionHandler{
+		promotionUseCases: promotionUseCases,
+		validator:         validator.New(),
+		logger:            log.With("component", "promotion_handler"),
+	}
+}
+
+// PromotionsResponse wraps a list of promotions
+type PromotionsResponse struct {
+	Promotions []*dto.PromotionResponseDTO `json:"promotions"`
+	Total      int                         `json:"total"`
+}
+
+// CreatePromotion handles POST /api/v1/admin/promotions
+func (h *PromotionHandler) CreatePromotion(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	request, err := h.bindPromotion(c)
+	if err != nil || request == nil {
+		return err
+	}
+
+	response, err := h.promotionUseCases.CreatePromotion(c.Request().Context(), request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to create promotion")
+	}
+
+	log.Info("Promotion created successfully",
+		"promotion_id", response.ID)
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+// ListPromotions handles GET /api/v1/admin/promotions
+func (h *PromotionHandler) ListPromotions(c echo.Context) error {
+	promotions, err := h.promotionUseCases.ListPromotions(c.Request().Context())
+	if err != nil {
+		return h.handleError(c, err, "Failed to list promotions")
+	}
+
+	return c.JSON(http.StatusOK, PromotionsResponse{Promotions: promotions, Total: len(promotions)})
+}
+
+// GetPromotion handles GET /api/v1/admin/promotions/:id
+func (h *PromotionHandler) GetPromotion(c echo.Context) error {
+	id, err := h.parseID(c)
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid promotion ID format")
+	}
+
+	response, err := h.promotionUseCases.GetPromotion(c.Request().Context(), id)
+	if err != nil {
+		return h.handleError(c, err, "Failed to get promotion")
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// UpdatePromotion handles PUT /api/v1/admin/promotions/:id
+func (h *PromotionHandler) UpdatePromotion(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	id, err := h.parseID(c)
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid promotion ID format")
+	}
+
+	request, err := h.bindPromotion(c)
+	if err != nil || request == nil {
+		return err
+	}
+
+	response, err := h.promotionUseCases.UpdatePromotion(c.Request().Context(), id, request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to update promotion")
+	}
+
+	log.Info("Promotion updated successfully",
+		"promotion_id", response.ID)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// DeletePromotion handles DELETE /api/v1/admin/promotions/:id
+func (h *PromotionHandler) DeletePromotion(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	id, err := h.parseID(c)
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid promotion ID format")
+	}
+
+	if err := h.promotionUseCases.DeletePromotion(c.Request().Context(), id); err != nil {
+		return h.handleError(c, err, "Failed to delete promotion")
+	}
+
+	log.Info("Promotion deleted successfully",
+		"promotion_id", id)
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// bindPromotion parses and validates a promotion request body. When the body
+// is rejected the problem response is already written and request is nil.
+func (h *PromotionHandler) bindPromotion(c echo.Context) (*dto.PromotionRequestDTO, error) {
+	log := h.logger.Ctx(c.Request().Context())
+
+	var request dto.PromotionRequestDTO
+	if err := c.Bind(&request); err != nil {
+		log.Warn("Failed to bind request body",
+			"error", err)
+		return nil, respondProblem(c, errorregistry.CodeInvalidRequest, "Invalid request body format")
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		log.Warn("Request validation failed",
+			"error", err)
+		return nil, respondProblem(c, errorregistry.CodeValidation, "Request validation failed", validationFieldErrors(c, err)...)
+	}
+
+	return &request, nil
+}
+
+func (h *PromotionHandler) parseID(c echo.Context) (uint, error) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		h.logger.Ctx(c.Request().Context()).Warn("Invalid promotion ID parameter",
+			"id_param", idParam,
+			"error", err)
+		return 0, err
+	}
+	return uint(id), nil
+}
+
+func (h *PromotionHandler) handleError(c echo.Context, err error, logMessage string) error {
+	h.logger.Ctx(c.Request().Context()).Error(logMessage,
+		"error", err)
+
+	return respondDomainError(c, err)
+}
@@ -0,0 +1,263 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"product-service/internal/adapters/errorregistry"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/usecases"
+	"product-service/internal/domain/entities"
+	"product-service/pkg/logger"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+type RevisionHandler struct {
+	revisionUseCases usecases.RevisionUseCases
+	validator        *validator.Validate
+	logger           logger.Logger
+}
+
+func NewRevisionHandler(revisionUseCases usecases.RevisionUseCases, log logger.Logger) *RevisionHandler {
+	return &RevisionHandler{
+		revisionUseCases: revisionUseCases,
+		validator:        validator.New(),
+		logger:           log.With("component", "revision_handler"),
+	}
+}
+
+// SubmitRevision handles POST /api/v1/products/:id/revisions
+func (h *RevisionHandler) SubmitRevision(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	productID, err := h.parseID(c, "id")
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid product ID format")
+	}
+
+	var request dto.SubmitRevisionRequestDTO
+	if ok, err := h.bind(c, &request); !ok {
+		return err
+	}
+
+	response, err := h.revisionUseCases.SubmitRevision(c.Request().Context(), productID, &request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to submit revision")
+	}
+
+	log.Info("Revision submitted successfully",
+		"product_id", productID,
+		"revision_id", response.ID)
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+// DraftProductUpdate handles PUT /api/v1/products/:id. The update is drafted
+// as a revision for review instead of going live; admins edit the live
+// product through PUT /api/v1/admin/products/:id.
+func (h *RevisionHandler) DraftProductUpdate(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	productID, err := h.parseID(c, "id")
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid product ID format")
+	}
+
+	var request dto.SubmitRevisionRequestDTO
+	if ok, err := h.bind(c, &request.Changes); !ok {
+		return err
+	}
+
+	response, err := h.revisionUseCases.SubmitRevision(c.Request().Context(), productID, &request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to draft product update")
+	}
+
+	log.Info("Product update drafted for review",
+		"product_id", productID,
+		"revision_id", response.ID)
+
+	return c.JSON(http.StatusAccepted, response)
+}
+
+// ListProductRevisions handles GET /api/v1/products/:id/revisions
+// Query parameters: status (pending, approved, rejected or published)
+func (h *RevisionHandler) ListProductRevisions(c echo.Context) error {
+	productID, err := h.parseID(c, "id")
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid product ID format")
+	}
+
+	status, ok := parseRevisionStatus(c.QueryParam("status"))
+	if !ok {
+		return respondProblem(c, errorregistry.CodeInvalidRequest, "status must be pending, approved, rejected or published")
+	}
+
+	response, err := h.revisionUseCases.ListRevisions(c.Request().Context(), productID, status)
+	if err != nil {
+		return h.handleError(c, err, "Failed to list product revisions")
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// ListRevisions handles GET /api/v1/revisions, the review queue
+// Query parameters: status (defaults to pending)
+func (h *RevisionHandler) ListRevisions(c echo.Context) error {
+	statusParam := c.QueryParam("status")
+	if statusParam == "" {
+		statusParam = string(entities.RevisionPending)
+	}
+
+	status, ok := parseRevisionStatus(statusParam)
+	if !ok {
+		return respondProblem(c, errorregistry.CodeInvalidRequest, "status must be pending, approved, rejected or published")
+	}
+
+	response, err := h.revisionUseCases.ListRevisions(c.Request().Context(), 0, status)
+	if err != nil {
+		return h.handleError(c, err, "Failed to list revisions")
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetRevision handles GET /api/v1/revisions/:id
+func (h *RevisionHandler) GetRevision(c echo.Context) error {
+	id, err := h.parseID(c, "id")
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid revision ID format")
+	}
+
+	response, err := h.revisionUseCases.GetRevision(c.Request().Context(), id)
+	if err != nil {
+		return h.handleError(c, err, "Failed to get revision")
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// ApproveRevision handles POST /api/v1/admin/revisions/:id/approve
+func (h *RevisionHandler) ApproveRevision(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	id, err := h.parseID(c, "id")
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid revision ID format")
+	}
+
+	var request dto.ReviewRevisionRequestDTO
+	if ok, err := h.bind(c, &request); !ok {
+		return err
+	}
+
+	response, err := h.revisionUseCases.ApproveRevision(c.Request().Context(), id, &request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to approve revision")
+	}
+
+	log.Info("Revision approved successfully",
+		"revision_id", id,
+		"product_id", response.ProductID)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// RejectRevision handles POST /api/v1/admin/revisions/:id/reject
+func (h *RevisionHandler) RejectRevision(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	id, err := h.parseID(c, "id")
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid revision ID format")
+	}
+
+	var request dto.ReviewRevisionRequestDTO
+	if ok, err := h.bind(c, &request); !ok {
+		return err
+	}
+
+	response, err := h.revisionUseCases.RejectRevision(c.Request().Context(), id, &request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to reject revision")
+	}
+
+	log.Info("Revision rejected successfully",
+		"revision_id", id,
+		"product_id", response.ProductID)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// PublishRevision handles POST /api/v1/admin/revisions/:id/publish
+func (h *RevisionHandler) PublishRevision(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	id, err := h.parseID(c, "id")
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid revision ID format")
+	}
+
+	response, err := h.revisionUseCases.PublishRevision(c.Request().Context(), id)
+	if err != nil {
+		return h.handleError(c, err, "Failed to publish revision")
+	}
+
+	log.Info("Revision published successfully",
+		"revision_id", id,
+		"product_id", response.ProductID)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// parseRevisionStatus accepts an empty status, meaning any, or a known one
+func parseRevisionStatus(value string) (entities.RevisionStatus, bool) {
+	status := entities.RevisionStatus(value)
+	switch status {
+	case "", entities.RevisionPending, entities.RevisionApproved, entities.RevisionRejected, entities.RevisionPublished:
+		return status, true
+	}
+	return "", false
+}
+
+// bind parses and validates a request body. When the body is rejected the
+// problem response is already written and ok is false.
+func (h *RevisionHandler) bind(c echo.Context, request interface{}) (bool, error) {
+	log := h.logger.Ctx(c.Request().Context())
+
+	if err := c.Bind(request); err != nil {
+		log.Warn("Failed to bind request body",
+			"error", err)
+		return false, respondProblem(c, errorregistry.CodeInvalidRequest, "Invalid request body format")
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		log.Warn("Request validation failed",
+			"error", err)
+		return false, respondProblem(c, errorregistry.CodeValidation, "Request validation failed", validationFieldErrors(c, err)...)
+	}
+
+	return true, nil
+}
+
+func (h *RevisionHandler) parseID(c echo.Context, name string) (uint, error) {
+	idParam := c.Param(name)
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		h.logger.Ctx(c.Request().Context()).Warn("Invalid ID parameter",
+			"param", name,
+			"value", idParam,
+			"error", err)
+		return 0, err
+	}
+	return uint(id), nil
+}
+
+func (h *RevisionHandler) handleError(c echo.Context, err error, logMessage string) error {
+	h.logger.Ctx(c.Request().Context()).Error(logMessage,
+		"error", err)
+
+	return respondDomainError(c, err)
+}
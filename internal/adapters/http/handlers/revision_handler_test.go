@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"product-service/internal/application/dto"
+	"product-service/internal/domain/entities"
+	"product-service/pkg/logger"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockRevisionUseCases implements the RevisionUseCases interface for testing
+type MockRevisionUseCases struct {
+	mock.Mock
+}
+
+func (m *MockRevisionUseCases) SubmitRevision(ctx context.Context, productID uint, request *dto.SubmitRevisionRequestDTO) (*dto.RevisionResponseDTO, error) {
+	args := m.Called(ctx, productID, request)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.RevisionResponseDTO), args.Error(1)
+}
+
+func (m *MockRevisionUseCases) ListRevisions(ctx context.Context, productID uint, status entities.RevisionStatus) (*dto.RevisionListResponseDTO, error) {
+	args := m.Called(ctx, productID, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.RevisionListResponseDTO), args.Error(1)
+}
+
+func (m *MockRevisionUseCases) GetRevision(ctx context.Context, id uint) (*dto.RevisionResponseDTO, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.RevisionResponseDTO), args.Error(1)
+}
+
+func (m *MockRevisionUseCases) ApproveRevision(ctx context.Context, id uint, request *dto.ReviewRevisionRequestDTO) (*dto.RevisionResponseDTO, error) {
+	args := m.Called(ctx, id, request)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.RevisionResponseDTO), args.Error(1)
+}
+
+func (m *MockRevisionUseCases) RejectRevision(ctx context.Context, id uint, request *dto.ReviewRevisionRequestDTO) (*dto.RevisionResponseDTO, error) {
+	args := m.Called(ctx, id, request)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.RevisionResponseDTO), args.Error(1)
+}
+
+func (m *MockRevisionUseCases) PublishRevision(ctx context.Context, id uint) (*dto.RevisionResponseDTO, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.RevisionResponseDTO), args.Error(1)
+}
+
+func setupTestRevisionHandler() (*RevisionHandler, *MockRevisionUseCases) {
+	mockUseCases := new(MockRevisionUseCases)
+	handler := NewRevisionHandler(mockUseCases, logger.New("test"))
+	return handler, mockUseCases
+}
+
+func TestRevisionHandler_DraftProductUpdate_SubmitsRevision(t *testing.T) {
+	// Setup
+	handler, mockUseCases := setupTestRevisionHandler()
+
+	price := entities.MustParseMoney("899.99", "USD")
+	mockUseCases.On("SubmitRevision", mock.Anything, uint(1), mock.MatchedBy(func(request *dto.SubmitRevisionRequestDTO) bool {
+		return request.Changes.Name == "iPhone 15 Pro" && request.Changes.Price.Equal(price)
+	})).Return(&dto.RevisionResponseDTO{
+		ID:        7,
+		ProductID: 1,
+		Status:    entities.RevisionPending,
+	}, nil)
+
+	body := `{"name":"iPhone 15 Pro","price":{"amount":"899.99","currency":"USD"}}`
+	req := httptest.NewRequest(http.MethodPut, "/api/v1/products/1", bytes.NewBufferString(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	// Execute
+	err := handler.DraftProductUpdate(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, rec.Code, "a public update is staged, not applied")
+
+	var response dto.RevisionResponseDTO
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, uint(7), response.ID)
+	assert.Equal(t, entities.RevisionPending, response.Status)
+
+	mockUseCases.AssertExpectations(t)
+}
+
+func TestRevisionHandler_DraftProductUpdate_InvalidID(t *testing.T) {
+	// Setup
+	handler, mockUseCases := setupTestRevisionHandler()
+
+	req := httptest.NewRequest(http.MethodPut, "/api/v1/products/abc", bytes.NewBufferString(`{"name":"iPhone 15 Pro"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("abc")
+
+	// Execute
+	err := handler.DraftProductUpdate(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUseCases.AssertNotCalled(t, "SubmitRevision", mock.Anything, mock.Anything, mock.Anything)
+}
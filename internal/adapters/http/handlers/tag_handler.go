@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"product-service/internal/adapters/errorregistry"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/usecases"
+	"product-service/pkg/logger"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+type TagHandler struct {
+	tagUseCases usecases.TagUseCases
+	validator   *validator.Validate
+	logger      logger.Logger
+}
+
+func NewTagHandler(tagUseCases usecases.TagUseCases, log logger.Logger) *TagHandler {
+	return &TagHandler{
+		tagUseCases: tagUseCases,
+		validator:   validator.New(),
+		logger:      log.With("component", "tag_handler"),
+	}
+}
+
+// TagListResponse wraps the tag list
+type TagListResponse struct {
+	Tags []*dto.TagResponseDTO `json:"tags"`
+}
+
+// ListTags handles GET /api/v1/tags
+func (h *TagHandler) ListTags(c echo.Context) error {
+	tags, err := h.tagUseCases.ListTags(c.Request().Context())
+	if err != nil {
+		return h.handleError(c, err, "Failed to list tags")
+	}
+
+	return c.JSON(http.StatusOK, TagListResponse{Tags: tags})
+}
+
+// CreateTag handles POST /api/v1/admin/tags
+func (h *TagHandler) CreateTag(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	var request dto.CreateTagRequestDTO
+	if ok, err := h.bind(c, &request); !ok {
+		return err
+	}
+
+	response, err := h.tagUseCases.CreateTag(c.Request().Context(), &request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to create tag")
+	}
+
+	log.Info("Tag created successfully",
+		"tag_id", response.ID,
+		"name", response.Name)
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+// RenameTag handles PATCH /api/v1/admin/tags/:id
+func (h *TagHandler) RenameTag(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	id, err := h.parseID(c)
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid tag ID format")
+	}
+
+	var request dto.RenameTagRequestDTO
+	if ok, err := h.bind(c, &request); !ok {
+		return err
+	}
+
+	response, err := h.tagUseCases.RenameTag(c.Request().Context(), id, &request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to rename tag")
+	}
+
+	log.Info("Tag renamed successfully",
+		"tag_id", id,
+		"name", response.Name)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// DeleteTag handles DELETE /api/v1/admin/tags/:id and untags every product
+func (h *TagHandler) DeleteTag(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	id, err := h.parseID(c)
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid tag ID format")
+	}
+
+	if err := h.tagUseCases.DeleteTag(c.Request().Context(), id); err != nil {
+		return h.handleError(c, err, "Failed to delete tag")
+	}
+
+	log.Info("Tag deleted successfully",
+		"tag_id", id)
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// bind parses and validates a request body. When the body is rejected the
+// problem response is already written and ok is false.
+func (h *TagHandler) bind(c echo.Context, request interface{}) (bool, error) {
+	log := h.logger.Ctx(c.Request().Context())
+
+	if err := c.Bind(request); err != nil {
+		log.Warn("Failed to bind request body",
+			"error", err)
+		return false, respondProblem(c, errorregistry.CodeInvalidRequest, "Invalid request body format")
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		log.Warn("Request validation failed",
+			"error", err)
+		return false, respondProblem(c, errorregistry.CodeValidation, "Request validation failed", validationFieldErrors(c, err)...)
+	}
+
+	return true, nil
+}
+
+func (h *TagHandler) parseID(c echo.Context) (uint, error) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		h.logger.Ctx(c.Request().Context()).Warn("Invalid tag ID parameter",
+			"id_param", idParam,
+			"error", err)
+		return 0, err
+	}
+	return uint(id), nil
+}
+
+func (h *TagHandler) handleError(c echo.Context, err error, logMessage string) error {
+	h.logger.Ctx(c.Request().Context()).Error(logMessage,
+		"error", err)
+
+	return respondDomainError(c, err)
+}
@@ -0,0 +1,246 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"product-service/internal/adapters/errorregistry"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/usecases"
+	"product-service/internal/domain/entities"
+	"product-service/pkg/logger"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+type VariantHandler struct {
+	variantUseCases usecases.VariantUseCases
+	validator       *validator.Validate
+	logger          logger.Logger
+}
+
+func NewVariantHandler(variantUseCases usecases.VariantUseCases, log logger.Logger) *VariantHandler {
+	return &VariantHandler{
+		variantUseCases: variantUseCases,
+		validator:       validator.New(),
+		logger:          log.With("component", "variant_handler"),
+	}
+}
+
+// ProductOptionsResponse wraps a product's option axes
+type ProductOptionsResponse struct {
+	ProductID uint                      `json:"product_id"`
+	Options   []*entities.ProductOption `json:"options"`
+}
+
+// GeneratedVariantsResponse lists the variants created by a matrix generation
+type GeneratedVariantsResponse struct {
+	Variants []*dto.VariantResponseDTO `json:"variants"`
+	Created  int                       `json:"created"`
+}
+
+// GetVariants handles GET /api/v1/products/:id/variants
+func (h *VariantHandler) GetVariants(c echo.Context) error {
+	productID, err := h.parseID(c, "id")
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid product ID format")
+	}
+
+	response, err := h.variantUseCases.GetVariants(c.Request().Context(), productID)
+	if err != nil {
+		return h.handleError(c, err, "Failed to get product variants")
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetOptions handles GET /api/v1/products/:id/options
+func (h *VariantHandler) GetOptions(c echo.Context) error {
+	productID, err := h.parseID(c, "id")
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid product ID format")
+	}
+
+	response, err := h.variantUseCases.GetVariants(c.Request().Context(), productID)
+	if err != nil {
+		return h.handleError(c, err, "Failed to get product options")
+	}
+
+	return c.JSON(http.StatusOK, ProductOptionsResponse{ProductID: productID, Options: response.Options})
+}
+
+// SetOptions handles PUT /api/v1/products/:id/options
+func (h *VariantHandler) SetOptions(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	productID, err := h.parseID(c, "id")
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid product ID format")
+	}
+
+	var request dto.SetProductOptionsRequestDTO
+	if ok, err := h.bind(c, &request); !ok {
+		return err
+	}
+
+	options, err := h.variantUseCases.SetOptions(c.Request().Context(), productID, &request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to set product options")
+	}
+
+	log.Info("Product options updated successfully",
+		"product_id", productID,
+		"options", len(options))
+
+	return c.JSON(http.StatusOK, ProductOptionsResponse{ProductID: productID, Options: options})
+}
+
+// CreateVariant handles POST /api/v1/products/:id/variants
+func (h *VariantHandler) CreateVariant(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	productID, err := h.parseID(c, "id")
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid product ID format")
+	}
+
+	var request dto.CreateVariantRequestDTO
+	if ok, err := h.bind(c, &request); !ok {
+		return err
+	}
+
+	response, err := h.variantUseCases.CreateVariant(c.Request().Context(), productID, &request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to create variant")
+	}
+
+	log.Info("Variant created successfully",
+		"product_id", productID,
+		"variant_id", response.ID,
+		"sku", response.SKU)
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+// GenerateVariants handles POST /api/v1/products/:id/variants/generate
+func (h *VariantHandler) GenerateVariants(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	productID, err := h.parseID(c, "id")
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid product ID format")
+	}
+
+	var request dto.GenerateVariantsRequestDTO
+	if c.Request().ContentLength != 0 {
+		if ok, err := h.bind(c, &request); !ok {
+			return err
+		}
+	}
+
+	variants, err := h.variantUseCases.GenerateVariants(c.Request().Context(), productID, &request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to generate variants")
+	}
+
+	log.Info("Variants generated successfully",
+		"product_id", productID,
+		"created", len(variants))
+
+	return c.JSON(http.StatusCreated, GeneratedVariantsResponse{Variants: variants, Created: len(variants)})
+}
+
+// UpdateVariant handles PATCH /api/v1/products/:id/variants/:variant_id
+func (h *VariantHandler) UpdateVariant(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	productID, err := h.parseID(c, "id")
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid product ID format")
+	}
+	variantID, err := h.parseID(c, "variant_id")
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid variant ID format")
+	}
+
+	var request dto.UpdateVariantRequestDTO
+	if ok, err := h.bind(c, &request); !ok {
+		return err
+	}
+
+	response, err := h.variantUseCases.UpdateVariant(c.Request().Context(), productID, variantID, &request)
+	if err != nil {
+		return h.handleError(c, err, "Failed to update variant")
+	}
+
+	log.Info("Variant updated successfully",
+		"product_id", productID,
+		"variant_id", variantID)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// DeleteVariant handles DELETE /api/v1/products/:id/variants/:variant_id
+func (h *VariantHandler) DeleteVariant(c echo.Context) error {
+	log := h.logger.Ctx(c.Request().Context())
+
+	productID, err := h.parseID(c, "id")
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid product ID format")
+	}
+	variantID, err := h.parseID(c, "variant_id")
+	if err != nil {
+		return respondProblem(c, errorregistry.CodeInvalidID, "Invalid variant ID format")
+	}
+
+	if err := h.variantUseCases.DeleteVariant(c.Request().Context(), productID, variantID); err != nil {
+		return h.handleError(c, err, "Failed to delete variant")
+	}
+
+	log.Info("Variant deleted successfully",
+		"product_id", productID,
+		"variant_id", variantID)
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// bind parses and validates a request body. When the body is rejected the
+// problem response is already written and ok is false.
+func (h *VariantHandler) bind(c echo.Context, request interface{}) (bool, error) {
+	log := h.logger.Ctx(c.Request().Context())
+
+	if err := c.Bind(request); err != nil {
+		log.Warn("Failed to bind request body",
+			"error", err)
+		return false, respondProblem(c, errorregistry.CodeInvalidRequest, "Invalid request body format")
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		log.Warn("Request validation failed",
+			"error", err)
+		return false, respondProblem(c, errorregistry.CodeValidation, "Request validation failed", validationFieldErrors(c, err)...)
+	}
+
+	return true, nil
+}
+
+func (h *VariantHandler) parseID(c echo.Context, name string) (uint, error) {
+	param := c.Param(name)
+	id, err := strconv.ParseUint(param, 10, 32)
+	if err != nil {
+		h.logger.Ctx(c.Request().Context()).Warn("Invalid ID parameter",
+			"param", name,
+			"value", param,
+			"error", err)
+		return 0, err
+	}
+	return uint(id), nil
+}
+
+func (h *VariantHandler) handleError(c echo.Context, err error, logMessage string) error {
+	h.logger.Ctx(c.Request().Context()).Error(logMessage,
+		"error", err)
+
+	return respondDomainError(c, err)
+}
@@ -0,0 +1,211 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"product-service/internal/application/dto"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockVariantUseCases implements the VariantUseCases interface for testing
+type MockVariantUseCases struct {
+	mock.Mock
+}
+
+func (m *MockVariantUseCases) GetVariants(ctx context.Context, productID uint) (*dto.ProductVariantsResponseDTO, error) {
+	args := m.Called(ctx, productID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ProductVariantsResponseDTO), args.Error(1)
+}
+
+func (m *MockVariantUseCases) SetOptions(ctx context.Context, productID uint, request *dto.SetProductOptionsRequestDTO) ([]*entities.ProductOption, error) {
+	args := m.Called(ctx, productID, request)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.ProductOption), args.Error(1)
+}
+
+func (m *MockVariantUseCases) CreateVariant(ctx context.Context, productID uint, request *dto.CreateVariantRequestDTO) (*dto.VariantResponseDTO, error) {
+	args := m.Called(ctx, productID, request)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.VariantResponseDTO), args.Error(1)
+}
+
+func (m *MockVariantUseCases) GenerateVariants(ctx context.Context, productID uint, request *dto.GenerateVariantsRequestDTO) ([]*dto.VariantResponseDTO, error) {
+	args := m.Called(ctx, productID, request)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*dto.VariantResponseDTO), args.Error(1)
+}
+
+func (m *MockVariantUseCases) UpdateVariant(ctx context.Context, productID, variantID uint, request *dto.UpdateVariantRequestDTO) (*dto.VariantResponseDTO, error) {
+	args := m.Called(ctx, productID, variantID, request)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.VariantResponseDTO), args.Error(1)
+}
+
+func (m *MockVariantUseCases) DeleteVariant(ctx context.Context, productID, variantID uint) error {
+	args := m.Called(ctx, productID, variantID)
+	return args.Error(0)
+}
+
+func (m *MockVariantUseCases) ApplyVariantAvailability(ctx context.Context, products []*dto.ProductResponseDTO) error {
+	args := m.Called(ctx, products)
+	return args.Error(0)
+}
+
+func setupTestVariantHandler() (*VariantHandler, *MockVariantUseCases) {
+	mockUseCases := new(MockVariantUseCases)
+	handler := NewVariantHandler(mockUseCases, logger.New("test"))
+	return handler, mockUseCases
+}
+
+func TestVariantHandler_SetOptions_Success(t *testing.T) {
+	// Setup
+	handler, mockUseCases := setupTestVariantHandler()
+
+	mockUseCases.On("SetOptions", mock.Anything, uint(1), mock.MatchedBy(func(request *dto.SetProductOptionsRequestDTO) bool {
+		return len(request.Options) == 2 && request.Options[0].Name == "Size" && len(request.Options[0].Values) == 3
+	})).Return([]*entities.ProductOption{
+		{ID: 1, ProductID: 1, Name: "Size", Position: 0, Values: []string{"S", "M", "L"}},
+		{ID: 2, ProductID: 1, Name: "Color", Position: 1, Values: []string{"Red", "Blue"}},
+	}, nil)
+
+	// Create request
+	body := `{"options":[{"name":"Size","values":["S","M","L"]},{"name":"Color","values":["Red","Blue"]}]}`
+	req := httptest.NewRequest(http.MethodPut, "/api/v1/products/1/options", bytes.NewBufferString(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	// Execute
+	err := handler.SetOptions(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response ProductOptionsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Len(t, response.Options, 2)
+	assert.Equal(t, "Color", response.Options[1].Name)
+
+	mockUseCases.AssertExpectations(t)
+}
+
+func TestVariantHandler_SetOptions_ValidationError(t *testing.T) {
+	// Setup
+	handler, mockUseCases := setupTestVariantHandler()
+
+	// Create request
+	body := `{"options":[{"name":"Size","values":[]}]}`
+	req := httptest.NewRequest(http.MethodPut, "/api/v1/products/1/options", bytes.NewBufferString(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	// Execute
+	err := handler.SetOptions(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUseCases.AssertNotCalled(t, "SetOptions", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestVariantHandler_GenerateVariants_Created(t *testing.T) {
+	// Setup
+	handler, mockUseCases := setupTestVariantHandler()
+
+	mockUseCases.On("GenerateVariants", mock.Anything, uint(1), &dto.GenerateVariantsRequestDTO{Stock: 5}).Return([]*dto.VariantResponseDTO{
+		{ID: 1, ProductID: 1, SKU: "TSHIRT-S", Options: map[string]string{"Size": "S"}, Price: entities.MustParseMoney("20.00", "USD"), Stock: 5, Active: true},
+		{ID: 2, ProductID: 1, SKU: "TSHIRT-M", Options: map[string]string{"Size": "M"}, Price: entities.MustParseMoney("20.00", "USD"), Stock: 5, Active: true},
+	}, nil)
+
+	// Create request
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/products/1/variants/generate", bytes.NewBufferString(`{"stock":5}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	// Execute
+	err := handler.GenerateVariants(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, "2", extractJSONField(t, rec.Body.Bytes(), "created"))
+
+	mockUseCases.AssertExpectations(t)
+}
+
+func TestVariantHandler_CreateVariant_Conflict(t *testing.T) {
+	// Setup
+	handler, mockUseCases := setupTestVariantHandler()
+
+	mockUseCases.On("CreateVariant", mock.Anything, uint(1), mock.Anything).Return(nil, domainErrors.ErrVariantAlreadyExists)
+
+	// Create request
+	body := `{"sku":"TSHIRT-S","options":{"Size":"S"},"stock":3}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/products/1/variants", bytes.NewBufferString(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	// Execute
+	err := handler.CreateVariant(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Equal(t, `"VARIANT_ALREADY_EXISTS"`, extractJSONField(t, rec.Body.Bytes(), "code"))
+
+	mockUseCases.AssertExpectations(t)
+}
+
+func TestVariantHandler_DeleteVariant_InvalidID(t *testing.T) {
+	// Setup
+	handler, mockUseCases := setupTestVariantHandler()
+
+	// Create request
+	req := httptest.NewRequest(http.MethodDelete, "/api/v1/products/1/variants/abc", nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id", "variant_id")
+	c.SetParamValues("1", "abc")
+
+	// Execute
+	err := handler.DeleteVariant(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUseCases.AssertNotCalled(t, "DeleteVariant", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,52 @@
+package logging
+
+import (
+	"product-service/pkg/logger"
+	"product-service/pkg/requestctx"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// HeaderActorID identifies the user or system performing the request
+	HeaderActorID = "X-Actor-ID"
+	// HeaderTenantID identifies the tenant the request is made on behalf of
+	HeaderTenantID = "X-Tenant-ID"
+)
+
+// RequestContext stores the request ID, route, actor and tenant on the request
+// context and attaches log to it, so every logger obtained through
+// Logger.Ctx or logger.FromContext further down the stack carries them.
+func RequestContext(log logger.Logger) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			ctx := req.Context()
+
+			requestID := c.Response().Header().Get(echo.HeaderXRequestID)
+			actor := req.Header.Get(HeaderActorID)
+			tenant := req.Header.Get(HeaderTenantID)
+
+			ctx = requestctx.WithRequestID(ctx, requestID)
+
+			fields := []interface{}{
+				"request_id", requestID,
+				"method", req.Method,
+				"route", c.Path(),
+			}
+			if actor != "" {
+				ctx = requestctx.WithActor(ctx, actor)
+				fields = append(fields, "actor", actor)
+			}
+			if tenant != "" {
+				ctx = requestctx.WithTenant(ctx, tenant)
+				fields = append(fields, "tenant", tenant)
+			}
+
+			ctx = logger.WithContext(ctx, log, fields...)
+			c.SetRequest(req.WithContext(ctx))
+
+			return next(c)
+		}
+	}
+}
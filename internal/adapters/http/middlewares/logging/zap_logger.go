@@ -7,7 +7,7 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
-func ZapLogger(logger logger.Logger) echo.MiddlewareFunc {
+func ZapLogger(log logger.Logger) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			start := time.Now()
@@ -31,10 +31,8 @@ func ZapLogger(logger logger.Logger) echo.MiddlewareFunc {
 			// Create structured log fields
 			fields := []interface{}{
 				"time", start.Format(time.RFC3339),
-				"id", res.Header().Get(echo.HeaderXRequestID),
 				"remote_ip", c.RealIP(),
 				"host", req.Host,
-				"method", req.Method,
 				"uri", req.RequestURI,
 				"user_agent", req.UserAgent(),
 				"status", status,
@@ -49,16 +47,20 @@ func ZapLogger(logger logger.Logger) echo.MiddlewareFunc {
 				fields = append(fields, "error", err.Error())
 			}
 
+			// Request ID, method, route, actor and trace IDs come from the
+			// request context
+			log := log.Ctx(req.Context())
+
 			// Log based on status code
 			switch {
 			case status >= 500:
-				logger.Error("HTTP request completed", fields...)
+				log.Error("HTTP request completed", fields...)
 			case status >= 400:
-				logger.Warn("HTTP request completed", fields...)
+				log.Warn("HTTP request completed", fields...)
 			case status >= 300:
-				logger.Info("HTTP request completed", fields...)
+				log.Info("HTTP request completed", fields...)
 			default:
-				logger.Debug("HTTP request completed", fields...)
+				log.Debug("HTTP request completed", fields...)
 			}
 
 			return nil
@@ -0,0 +1,73 @@
+package tracing
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "product-service/http"
+
+// OpenTelemetry starts a server span for every request. The parent context is
+// extracted from the incoming W3C traceparent header and the resulting span
+// context is written back on the response so callers can correlate.
+func OpenTelemetry(serviceName string) echo.MiddlewareFunc {
+	tracer := otel.Tracer(tracerName)
+	propagator := otel.GetTextMapPropagator()
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			ctx := propagator.Extract(req.Context(), propagation.HeaderCarrier(req.Header))
+
+			route := c.Path()
+			if route == "" {
+				route = req.URL.Path
+			}
+
+			ctx, span := tracer.Start(ctx, fmt.Sprintf("%s %s", req.Method, route),
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					attribute.String("service.name", serviceName),
+					semconv.HTTPRequestMethodKey.String(req.Method),
+					semconv.HTTPRoute(route),
+					semconv.URLPath(req.URL.Path),
+					semconv.URLScheme(c.Scheme()),
+					semconv.ServerAddress(req.Host),
+					semconv.ClientAddress(c.RealIP()),
+					semconv.UserAgentOriginal(req.UserAgent()),
+				),
+			)
+			defer span.End()
+
+			c.SetRequest(req.WithContext(ctx))
+			propagator.Inject(ctx, propagation.HeaderCarrier(c.Response().Header()))
+
+			err := next(c)
+			if err != nil {
+				span.RecordError(err)
+				// Let Echo render the error so the recorded status is the real one
+				c.Error(err)
+			}
+
+			if requestID := c.Response().Header().Get(echo.HeaderXRequestID); requestID != "" {
+				span.SetAttributes(attribute.String("http.request_id", requestID))
+			}
+
+			status := c.Response().Status
+			span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+			if status >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, http.StatusText(status))
+			}
+
+			return nil
+		}
+	}
+}
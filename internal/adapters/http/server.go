@@ -2,24 +2,48 @@ package http
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"product-service/internal/adapters/errorregistry"
 	"product-service/internal/adapters/http/handlers"
 	"product-service/internal/adapters/http/middlewares/logging"
+	"product-service/internal/adapters/http/middlewares/tracing"
+	"product-service/internal/adapters/persistence/attribute_repository"
+	"product-service/internal/adapters/persistence/brand_repository"
+	"product-service/internal/adapters/persistence/category_repository"
+	"product-service/internal/adapters/persistence/collection_repository"
+	"product-service/internal/adapters/persistence/pricing_repository"
 	"product-service/internal/adapters/persistence/product_repository"
+	"product-service/internal/adapters/persistence/promotion_repository"
+	"product-service/internal/adapters/storage"
+	"product-service/internal/application/ports"
 	"product-service/internal/application/usecases"
 	"product-service/internal/config"
+	"product-service/internal/domain/entities"
 	"product-service/internal/infrastructure"
 	"product-service/pkg/logger"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 )
 
+// streamingRoutes send files of any size, so the request timeout, which
+// buffers responses, does not apply to them
+var streamingRoutes = map[string]bool{
+	"/api/v1/products/export": true,
+	"/api/v1/feeds/:format":   true,
+	"/api/v1/jobs/:id/result": true,
+}
+
 type Server struct {
 	echo        *echo.Echo
 	config      *config.Config
 	logger      logger.Logger
 	connections *infrastructure.DatabaseConnections
+	scheduler   *infrastructure.Scheduler
+	worker      *infrastructure.JobWorker
+	media       ports.MediaStorage
 }
 
 func NewServer(cfg *config.Config, log logger.Logger, connections *infrastructure.DatabaseConnections) (*Server, error) {
@@ -28,12 +52,20 @@ func NewServer(cfg *config.Config, log logger.Logger, connections *infrastructur
 	// Configure Echo
 	e.HideBanner = true
 	e.HidePort = true
+	e.HTTPErrorHandler = handlers.ProblemErrorHandler(log.With("component", "http"))
+
+	media, err := storage.New(cfg.Media)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up media storage: %w", err)
+	}
 
 	server := &Server{
 		echo:        e,
 		config:      cfg,
 		logger:      log,
 		connections: connections,
+		scheduler:   infrastructure.NewScheduler(log),
+		media:       media,
 	}
 
 	// Setup middleware
@@ -49,6 +81,12 @@ func (s *Server) setupMiddleware() {
 	// Request ID middleware
 	s.echo.Use(middleware.RequestID())
 
+	// OpenTelemetry server spans with W3C traceparent propagation
+	s.echo.Use(tracing.OpenTelemetry(s.config.Tracing.ServiceName))
+
+	// Request-scoped logger carrying request ID, route, actor and tenant
+	s.echo.Use(logging.RequestContext(s.logger))
+
 	// Replace Echo's logger with our custom Zap logger
 	s.echo.Use(logging.ZapLogger(s.logger.With("component", "http")))
 
@@ -71,8 +109,12 @@ func (s *Server) setupMiddleware() {
 		AllowHeaders: s.config.Server.CORS.AllowHeaders,
 	}))
 
-	// Request timeout middleware
+	// Request timeout middleware. It buffers whole responses, so streamed
+	// downloads skip it.
 	s.echo.Use(middleware.TimeoutWithConfig(middleware.TimeoutConfig{
+		Skipper: func(c echo.Context) bool {
+			return streamingRoutes[c.Path()]
+		},
 		Timeout: s.config.Server.ReadTimeout,
 	}))
 }
@@ -83,8 +125,109 @@ func (s *Server) setupRoutes() {
 
 	// Product repository and use cases setup
 	productRepo := product_repository.NewGormProductRepository(s.connections.GetGormDB())
-	productUseCases := usecases.NewProductUseCases(productRepo, s.logger)
-	productHandler := handlers.NewProductHandler(productUseCases, s.logger)
+	priceHistoryRepo := product_repository.NewGormPriceHistoryRepository(s.connections.GetGormDB())
+	lifecycleRepo := product_repository.NewGormLifecycleRepository(s.connections.GetGormDB())
+	attributeRepo := attribute_repository.NewGormAttributeRepository(s.connections.GetGormDB())
+	categoryRepo := category_repository.NewGormCategoryRepository(s.connections.GetGormDB())
+	brandRepo := brand_repository.NewGormBrandRepository(s.connections.GetGormDB())
+	productUseCases := usecases.NewTracedProductUseCases(usecases.NewProductUseCases(productRepo, priceHistoryRepo, lifecycleRepo, attributeRepo, categoryRepo, brandRepo, s.logger))
+
+	// Category tree
+	categoryUseCases := usecases.NewCategoryUseCases(categoryRepo, s.logger)
+	categoryHandler := handlers.NewCategoryHandler(categoryUseCases, s.logger)
+
+	// Brand registry
+	brandUseCases := usecases.NewBrandUseCases(brandRepo, s.logger)
+	brandHandler := handlers.NewBrandHandler(brandUseCases, s.logger)
+
+	// Category attribute schemas
+	attributeUseCases := usecases.NewAttributeUseCases(attributeRepo, categoryRepo, s.logger)
+	attributeHandler := handlers.NewAttributeHandler(attributeUseCases, s.logger)
+
+	// Price history and scheduled prices
+	priceHistoryUseCases := usecases.NewPriceHistoryUseCases(priceHistoryRepo, productRepo, s.logger)
+	priceHistoryHandler := handlers.NewPriceHistoryHandler(priceHistoryUseCases, s.logger)
+	s.scheduler.Every("price_changes", s.config.Scheduler.PriceInterval, func(ctx context.Context) error {
+		_, err := priceHistoryUseCases.ApplyDuePriceChanges(ctx, time.Now())
+		return err
+	})
+
+	// Price lists and exchange rates
+	priceListRepo := pricing_repository.NewGormPriceListRepository(s.connections.GetGormDB())
+	exchangeRateRepo := pricing_repository.NewGormExchangeRateRepository(s.connections.GetGormDB())
+	pricingUseCases := usecases.NewPricingUseCases(priceListRepo, exchangeRateRepo, productRepo, s.logger)
+	pricingHandler := handlers.NewPricingHandler(pricingUseCases, s.logger)
+
+	// Promotions
+	promotionRepo := promotion_repository.NewGormPromotionRepository(s.connections.GetGormDB())
+	promotionUseCases := usecases.NewPromotionUseCases(promotionRepo, categoryRepo, brandRepo, s.logger)
+	promotionHandler := handlers.NewPromotionHandler(promotionUseCases, s.logger)
+
+	// Options and variants
+	variantRepo := product_repository.NewGormVariantRepository(s.connections.GetGormDB())
+	variantUseCases := usecases.NewVariantUseCases(variantRepo, productRepo, s.logger)
+	variantHandler := handlers.NewVariantHandler(variantUseCases, s.logger)
+
+	// Product images and documents
+	mediaRepo := product_repository.NewGormMediaRepository(s.connections.GetGormDB())
+	mediaUseCases := usecases.NewMediaUseCases(mediaRepo, productRepo, s.media, usecases.MediaOptions{
+		MaxUploadSize: s.config.Media.MaxUploadSize,
+		ThumbnailSize: s.config.Media.ThumbnailSize,
+	}, s.logger)
+	mediaHandler := handlers.NewMediaHandler(mediaUseCases, s.logger)
+
+	// Tags and collections
+	tagRepo := product_repository.NewGormTagRepository(s.connections.GetGormDB())
+	tagUseCases := usecases.NewTagUseCases(tagRepo, s.logger)
+	tagHandler := handlers.NewTagHandler(tagUseCases, s.logger)
+	collectionRepo := collection_repository.NewGormCollectionRepository(s.connections.GetGormDB())
+	collectionUseCases := usecases.NewCollectionUseCases(collectionRepo, productRepo, categoryRepo, brandRepo, s.logger)
+	collectionHandler := handlers.NewCollectionHandler(collectionUseCases, variantUseCases, mediaUseCases, s.logger)
+
+	// Draft, review and publish workflow
+	revisionRepo := product_repository.NewGormRevisionRepository(s.connections.GetGormDB())
+	revisionUseCases := usecases.NewRevisionUseCases(revisionRepo, productRepo, attributeRepo, categoryRepo, brandRepo, s.logger)
+	revisionHandler := handlers.NewRevisionHandler(revisionUseCases, s.logger)
+
+	// Audit trail
+	auditRepo := product_repository.NewGormAuditRepository(s.connections.GetGormDB())
+	auditUseCases := usecases.NewAuditUseCases(auditRepo, productRepo, s.logger)
+	auditHandler := handlers.NewAuditHandler(auditUseCases, s.logger)
+
+	// Background jobs for long-running catalog operations, shared with the
+	// worker command
+	jobServices := infrastructure.NewJobServices(s.config, s.connections, s.media, s.logger)
+	jobHandler := handlers.NewJobHandler(jobServices.Jobs, s.logger)
+	s.worker = jobServices.Worker
+
+	// Bulk CSV imports, read by product import jobs
+	importHandler := handlers.NewImportHandler(jobServices.Imports, s.logger)
+
+	// Catalog exports
+	exportHandler := handlers.NewExportHandler(jobServices.Exports, s.logger)
+
+	// Merchant feeds, regenerated when products change
+	feedHandler := handlers.NewFeedHandler(jobServices.Feeds, s.logger)
+	s.scheduler.Every("feeds", s.config.Feeds.Interval, func(ctx context.Context) error {
+		var errs []error
+		for _, format := range entities.FeedFormats {
+			if _, err := jobServices.Feeds.GenerateFeed(ctx, format, false); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return errors.Join(errs...)
+	})
+
+	// Multi-product batches
+	batchUseCases := usecases.NewBatchUseCases(product_repository.NewGormBatchRepository(s.connections.GetGormDB()), attributeRepo, categoryRepo, brandRepo, usecases.BatchOptions{
+		MaxOperations: s.config.Batch.MaxOperations,
+	}, s.logger)
+	batchHandler := handlers.NewBatchHandler(batchUseCases, s.logger)
+
+	productHandler := handlers.NewProductHandler(productUseCases, pricingUseCases, promotionUseCases, variantUseCases, mediaUseCases, s.logger)
+
+	// Error catalog
+	errorsHandler := handlers.NewErrorsHandler(errorregistry.Default(), s.logger)
 
 	// API v1 routes
 	v1 := s.echo.Group("/api/v1")
@@ -97,30 +240,192 @@ func (s *Server) setupRoutes() {
 	// Metrics endpoint
 	v1.GET("/metrics", healthHandler.Metrics)
 
+	// Locally stored media files
+	if local, ok := s.media.(*storage.LocalStorage); ok {
+		s.echo.Static(s.config.Media.Local.BaseURL, local.Directory())
+	}
+
+	// Error catalog endpoints
+	v1.GET("/errors", errorsHandler.ListErrors)
+	v1.GET("/errors/:code", errorsHandler.GetError)
+
+	// Category endpoints
+	categories := v1.Group("/categories")
+	{
+		categories.GET("", categoryHandler.ListCategories)  // Category tree
+		categories.GET("/:id", categoryHandler.GetCategory) // Category with its subtree
+	}
+
+	// Brand endpoints
+	brands := v1.Group("/brands")
+	{
+		brands.GET("", brandHandler.ListBrands)   // Registered brands
+		brands.GET("/:id", brandHandler.GetBrand) // Brand with its aliases
+	}
+
+	// Merchant feed endpoints
+	v1.GET("/feeds/:format", feedHandler.GetFeed)   // Google Merchant RSS or TSV, or plain XML
+	v1.POST("/feeds/jobs", jobHandler.EnqueueFeeds) // Regenerate feeds in the background
+
+	// Tag endpoints
+	v1.GET("/tags", tagHandler.ListTags) // Tags with product counts
+
+	// Collection endpoints
+	collections := v1.Group("/collections")
+	{
+		collections.GET("", collectionHandler.ListCollections)                      // Manual and rule collections
+		collections.GET("/:ref", collectionHandler.GetCollection)                   // Collection by ID or slug
+		collections.GET("/:ref/products", collectionHandler.ListCollectionProducts) // Products of a collection, paginated
+	}
+
+	// Revision endpoints
+	revisions := v1.Group("/revisions")
+	{
+		revisions.GET("", revisionHandler.ListRevisions)   // Review queue, pending by default
+		revisions.GET("/:id", revisionHandler.GetRevision) // Revision with its diff against the live product
+	}
+
 	// Product endpoints
 	products := v1.Group("/products")
 	{
 		// Core CRUD operations
-		products.POST("", productHandler.CreateProduct)    // Create product
-		products.GET("", productHandler.ListProducts)      // List products with pagination
-		products.GET("/:id", productHandler.GetProduct)    // Get product by ID
-		products.PUT("/:id", productHandler.UpdateProduct) // Update product
+		products.POST("", productHandler.CreateProduct)          // Create product
+		products.GET("", productHandler.ListProducts)            // List products with pagination
+		products.GET("/search", productHandler.SearchProducts)   // Search with filters, including attr.<key>
+		products.GET("/:id", productHandler.GetProduct)          // Get product by ID
+		products.PUT("/:id", revisionHandler.DraftProductUpdate) // Draft an update for review
+
+		// Bulk CSV imports, upserting by SKU
+		products.POST("/imports", importHandler.StartImport)              // Queue an import of a CSV body
+		products.GET("/imports/:id", importHandler.GetImport)             // Import progress and counters
+		products.GET("/imports/:id/errors", importHandler.GetErrorReport) // Per-row error report as CSV
+
+		// Catalog exports, filtered like search
+		products.GET("/export", exportHandler.ExportProducts)   // Stream CSV, JSON Lines or XLSX
+		products.POST("/export/jobs", jobHandler.EnqueueExport) // Write the export in the background
 
-		// SKU-based operations
-		products.GET("/sku/:sku", productHandler.GetProductBySKU) // Get product by SKU
+		// Bulk repricing of the products matching search filters
+		products.POST("/reprice", jobHandler.EnqueueReprice) // Queue a percent or amount change
+
+		// Multi-product operations by ID or SKU
+		products.POST("/batch", batchHandler.RunBatch) // Create, update, stock, price and status operations
+
+		// Staged edits
+		products.POST("/:id/revisions", revisionHandler.SubmitRevision)      // Draft an edit for review
+		products.GET("/:id/revisions", revisionHandler.ListProductRevisions) // Revisions of a product
+
+		// Audit trail
+		products.GET("/:id/audit", auditHandler.GetProductAudit) // Who changed which fields, newest first
+
+		// SKU and barcode lookups
+		products.GET("/sku/:sku", productHandler.GetProductBySKU)       // Get product by SKU
+		products.GET("/barcode/:code", productHandler.GetProductByGTIN) // Get product by GTIN barcode
 
 		// Stock management
 		products.PATCH("/:id/stock", productHandler.UpdateProductStock) // Update stock only
 
 		// Price management
-		products.PATCH("/:id/price", productHandler.UpdateProductPrice) // Update price only
+		products.PATCH("/:id/price", productHandler.UpdateProductPrice)                     // Update price only
+		products.GET("/:id/prices", priceHistoryHandler.GetPrices)                          // Price history and upcoming changes
+		products.POST("/:id/prices", priceHistoryHandler.SchedulePrice)                     // Schedule a future price
+		products.DELETE("/:id/prices/:change_id", priceHistoryHandler.CancelScheduledPrice) // Cancel a scheduled price
+
+		// Options and variants
+		products.GET("/:id/options", variantHandler.GetOptions)                    // Option axes
+		products.PUT("/:id/options", variantHandler.SetOptions)                    // Replace option axes
+		products.GET("/:id/variants", variantHandler.GetVariants)                  // Options, variants and derived stock
+		products.POST("/:id/variants", variantHandler.CreateVariant)               // Add a variant
+		products.POST("/:id/variants/generate", variantHandler.GenerateVariants)   // Generate the missing variant matrix
+		products.PATCH("/:id/variants/:variant_id", variantHandler.UpdateVariant)  // Update price override, stock or active
+		products.DELETE("/:id/variants/:variant_id", variantHandler.DeleteVariant) // Delete a variant
+
+		// Images and documents
+		products.GET("/:id/media", mediaHandler.ListMedia)                // Media in display order
+		products.POST("/:id/media", mediaHandler.UploadMedia)             // Multipart upload
+		products.PUT("/:id/media/order", mediaHandler.ReorderMedia)       // Set display order
+		products.PATCH("/:id/media/:media_id", mediaHandler.UpdateMedia)  // Update alt text or primary
+		products.DELETE("/:id/media/:media_id", mediaHandler.DeleteMedia) // Delete media and its files
 
 		// Status management
+		products.GET("/:id/transitions", productHandler.ListTransitions)      // Status history and allowed transitions
+		products.POST("/:id/transitions", productHandler.TransitionProduct)   // Move to another lifecycle status
 		products.PATCH("/:id/activate", productHandler.ActivateProduct)       // Activate product
 		products.PATCH("/:id/deactivate", productHandler.DeactivateProduct)   // Deactivate product
 		products.PATCH("/:id/discontinue", productHandler.DiscontinueProduct) // Discontinue product
 	}
 
+	// Background job endpoints
+	jobs := v1.Group("/jobs")
+	{
+		jobs.GET("", jobHandler.ListJobs)                // Jobs, newest first, by type and status
+		jobs.GET("/:id", jobHandler.GetJob)              // Status, progress and result
+		jobs.POST("/:id/cancel", jobHandler.CancelJob)   // Cancel a queued or running job
+		jobs.GET("/:id/result", jobHandler.GetJobResult) // Download the file of an export job
+	}
+
+	// Admin endpoints
+	admin := v1.Group("/admin")
+	{
+		// Price lists
+		admin.POST("/price-lists", pricingHandler.CreatePriceList)
+		admin.GET("/price-lists", pricingHandler.ListPriceLists)
+		admin.GET("/price-lists/:code/prices", pricingHandler.ListProductPrices)
+		admin.PUT("/price-lists/:code/prices/:product_id", pricingHandler.SetProductPrice)
+		admin.DELETE("/price-lists/:code/prices/:product_id/:currency", pricingHandler.DeleteProductPrice)
+
+		// Exchange rates
+		admin.GET("/exchange-rates", pricingHandler.ListExchangeRates)
+		admin.POST("/exchange-rates/import", pricingHandler.ImportExchangeRates)
+		admin.PUT("/exchange-rates/:base/:quote", pricingHandler.SetExchangeRate)
+		admin.DELETE("/exchange-rates/:base/:quote", pricingHandler.DeleteExchangeRate)
+
+		// Promotions
+		admin.POST("/promotions", promotionHandler.CreatePromotion)
+		admin.GET("/promotions", promotionHandler.ListPromotions)
+		admin.GET("/promotions/:id", promotionHandler.GetPromotion)
+		admin.PUT("/promotions/:id", promotionHandler.UpdatePromotion)
+		admin.DELETE("/promotions/:id", promotionHandler.DeletePromotion)
+
+		// Categories
+		admin.POST("/categories", categoryHandler.CreateCategory)
+		admin.PATCH("/categories/:id", categoryHandler.RenameCategory)
+		admin.POST("/categories/:id/move", categoryHandler.MoveCategory)
+		admin.POST("/categories/:id/merge", categoryHandler.MergeCategory)
+		admin.PUT("/categories/:id/sku-rules", categoryHandler.SetSKURules)
+		admin.DELETE("/categories/:id", categoryHandler.DeleteCategory)
+
+		// Category attribute schemas
+		admin.GET("/categories/:id/attributes", attributeHandler.GetSchema)
+		admin.PUT("/categories/:id/attributes/:key", attributeHandler.SaveDefinition)
+		admin.DELETE("/categories/:id/attributes/:key", attributeHandler.DeleteDefinition)
+
+		// Brands
+		admin.POST("/brands", brandHandler.CreateBrand)
+		admin.PATCH("/brands/:id", brandHandler.UpdateBrand)
+		admin.POST("/brands/:id/merge", brandHandler.MergeBrand)
+
+		// Tags
+		admin.POST("/tags", tagHandler.CreateTag)
+		admin.PATCH("/tags/:id", tagHandler.RenameTag)
+		admin.DELETE("/tags/:id", tagHandler.DeleteTag)
+
+		// Collections
+		admin.POST("/collections", collectionHandler.CreateCollection)
+		admin.PATCH("/collections/:id", collectionHandler.UpdateCollection)
+		admin.DELETE("/collections/:id", collectionHandler.DeleteCollection)
+
+		// Live product edits, bypassing review
+		admin.PUT("/products/:id", productHandler.UpdateProduct)
+
+		// Revisions
+		admin.POST("/revisions/:id/approve", revisionHandler.ApproveRevision)
+		admin.POST("/revisions/:id/reject", revisionHandler.RejectRevision)
+		admin.POST("/revisions/:id/publish", revisionHandler.PublishRevision)
+
+		// Audit trail across products, filterable
+		admin.GET("/audit", auditHandler.ListAudit)
+	}
+
 	s.logRegisteredRoutes()
 }
 
@@ -138,10 +443,22 @@ func (s *Server) Start() error {
 	address := fmt.Sprintf("%s:%s", s.config.Server.Host, s.config.Server.Port)
 	s.logger.Info("Starting Product Service HTTP server", "address", address)
 
+	// Background jobs such as scheduled prices run alongside the HTTP server
+	if s.config.Scheduler.Enabled {
+		s.scheduler.Start(context.Background())
+	}
+	if s.config.Jobs.InServer {
+		s.worker.Start(context.Background())
+	}
+
 	return s.echo.Start(address)
 }
 
 func (s *Server) Shutdown(ctx context.Context) error {
 	s.logger.Info("Shutting down Product Service HTTP server...")
-	return s.echo.Shutdown(ctx)
+	err := s.echo.Shutdown(ctx)
+	// Running jobs get what is left of ctx to finish
+	s.worker.Stop(ctx)
+	s.scheduler.Stop()
+	return err
 }
@@ -0,0 +1,112 @@
+// Package i18n holds the message catalogs used to localize error titles,
+// details and validation messages. Error codes are never translated; only the
+// human-readable text that accompanies them.
+package i18n
+
+import (
+	"strings"
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+// DefaultLanguage is used when the client sends no Accept-Language header or
+// none of the requested languages is supported
+var DefaultLanguage = language.English
+
+// Catalog stores messages per language. It is safe for concurrent use.
+type Catalog struct {
+	mu       sync.RWMutex
+	messages map[language.Tag]map[string]string
+	tags     []language.Tag
+	matcher  language.Matcher
+}
+
+// NewCatalog creates an empty catalog. The default language is always
+// supported so negotiation has something to fall back to.
+func NewCatalog() *Catalog {
+	c := &Catalog{messages: make(map[language.Tag]map[string]string)}
+	c.addLanguage(DefaultLanguage)
+	return c
+}
+
+// Register adds or replaces the message for key in the given language
+func (c *Catalog) Register(tag language.Tag, key, message string) {
+	c.RegisterMessages(tag, map[string]string{key: message})
+}
+
+// RegisterMessages adds or replaces several messages in the given language
+func (c *Catalog) RegisterMessages(tag language.Tag, messages map[string]string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.addLanguage(tag)
+	for key, message := range messages {
+		c.messages[tag][key] = message
+	}
+}
+
+// addLanguage must be called with the write lock held
+func (c *Catalog) addLanguage(tag language.Tag) {
+	if _, ok := c.messages[tag]; ok {
+		return
+	}
+	c.messages[tag] = make(map[string]string)
+	c.tags = append(c.tags, tag)
+	c.matcher = language.NewMatcher(c.tags)
+}
+
+// Match negotiates the best supported language for an Accept-Language header
+func (c *Catalog) Match(acceptLanguage string) language.Tag {
+	if strings.TrimSpace(acceptLanguage) == "" {
+		return DefaultLanguage
+	}
+
+	requested, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(requested) == 0 {
+		return DefaultLanguage
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	_, index, confidence := c.matcher.Match(requested...)
+	if confidence == language.No {
+		return DefaultLanguage
+	}
+	return c.tags[index]
+}
+
+// Message returns the message registered for key in the given language
+func (c *Catalog) Message(tag language.Tag, key string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	message, ok := c.messages[tag][key]
+	return message, ok
+}
+
+// Languages returns the supported languages in registration order
+func (c *Catalog) Languages() []language.Tag {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	tags := make([]language.Tag, len(c.tags))
+	copy(tags, c.tags)
+	return tags
+}
+
+var (
+	defaultCatalog     *Catalog
+	defaultCatalogOnce sync.Once
+)
+
+// Default returns the process-wide catalog loaded with the bundled languages
+func Default() *Catalog {
+	defaultCatalogOnce.Do(func() {
+		defaultCatalog = NewCatalog()
+		defaultCatalog.RegisterMessages(language.English, englishMessages)
+		defaultCatalog.RegisterMessages(language.Spanish, spanishMessages)
+	})
+	return defaultCatalog
+}
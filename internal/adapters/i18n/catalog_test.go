@@ -0,0 +1,96 @@
+package i18n
+
+import (
+	"testing"
+
+	"product-service/internal/adapters/errorregistry"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestCatalog_Match(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		expected       language.Tag
+	}{
+		{"empty header", "", language.English},
+		{"exact spanish", "es", language.Spanish},
+		{"regional spanish", "es-CO,es;q=0.9", language.Spanish},
+		{"weighted preference", "fr;q=0.9, es;q=0.8", language.Spanish},
+		{"english preferred", "en-US,es;q=0.5", language.English},
+		{"unsupported language", "de", language.English},
+		{"malformed header", ";;;", language.English},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Default().Match(tt.acceptLanguage))
+		})
+	}
+}
+
+func TestCatalog_BundledLanguagesAreComplete(t *testing.T) {
+	for key := range englishMessages {
+		_, ok := spanishMessages[key]
+		assert.True(t, ok, "missing spanish message for %s", key)
+	}
+}
+
+func TestCatalog_EveryRegisteredCodeIsTranslated(t *testing.T) {
+	for _, entry := range errorregistry.Default().Entries() {
+		for _, tag := range Default().Languages() {
+			_, ok := Default().Message(tag, entry.Code)
+			assert.True(t, ok, "missing %s detail for %s", tag, entry.Code)
+
+			_, ok = Default().Message(tag, entry.Code+titleSuffix)
+			assert.True(t, ok, "missing %s title for %s", tag, entry.Code)
+		}
+	}
+}
+
+func TestCatalog_RegisterNewLanguageAndCode(t *testing.T) {
+	catalog := NewCatalog()
+	catalog.Register(language.Portuguese, "NEW_CODE", "Novo erro")
+
+	assert.Equal(t, language.Portuguese, catalog.Match("pt-BR"))
+
+	message, ok := catalog.Message(language.Portuguese, "NEW_CODE")
+	require.True(t, ok)
+	assert.Equal(t, "Novo erro", message)
+}
+
+func TestLocalizer_Detail(t *testing.T) {
+	english := NewLocalizer(Default(), "en")
+	spanish := NewLocalizer(Default(), "es")
+
+	// The default language keeps the detail it was given
+	assert.Equal(t, "price cannot be negative", english.Detail("VALIDATION_ERROR", "price cannot be negative"))
+
+	// Other languages translate by code
+	assert.Equal(t, "Producto no encontrado", spanish.Detail("PRODUCT_NOT_FOUND", "Product not found"))
+
+	// Unknown codes keep the original detail
+	assert.Equal(t, "Something new", spanish.Detail("UNKNOWN_CODE", "Something new"))
+}
+
+func TestLocalizer_Title(t *testing.T) {
+	spanish := NewLocalizer(Default(), "es")
+
+	assert.Equal(t, "Producto no encontrado", spanish.Title("PRODUCT_NOT_FOUND", "Product not found"))
+	assert.Equal(t, "Fallback", spanish.Title("UNKNOWN_CODE", "Fallback"))
+}
+
+func TestLocalizer_Validation(t *testing.T) {
+	english := NewLocalizer(Default(), "en")
+	spanish := NewLocalizer(Default(), "es")
+
+	assert.Equal(t, "Minimum value is 3", english.Validation("min", "SKU", "3"))
+	assert.Equal(t, "El valor mínimo es 3", spanish.Validation("min", "SKU", "3"))
+	assert.Equal(t, "Este campo es obligatorio", spanish.Validation("required", "Name", ""))
+
+	// Unknown tags fall back to the generic message
+	assert.Equal(t, "Valor inválido", spanish.Validation("email", "Email", ""))
+}
@@ -0,0 +1,74 @@
+package i18n
+
+import (
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// Message keys are the error code itself for the detail, the code with a
+// ".title" suffix for the title and "validation.<tag>" for validator tags.
+const (
+	titleSuffix        = ".title"
+	validationPrefix   = "validation."
+	validationFallback = "validation.default"
+)
+
+// Localizer resolves messages for one negotiated language
+type Localizer struct {
+	catalog *Catalog
+	tag     language.Tag
+}
+
+// NewLocalizer negotiates the language for acceptLanguage against catalog
+func NewLocalizer(catalog *Catalog, acceptLanguage string) *Localizer {
+	return &Localizer{
+		catalog: catalog,
+		tag:     catalog.Match(acceptLanguage),
+	}
+}
+
+// Language returns the negotiated language
+func (l *Localizer) Language() language.Tag {
+	return l.tag
+}
+
+// Detail localizes the detail of an error. In the default language the given
+// detail is kept as is, since it may carry specifics (e.g. which rule failed)
+// that a catalog entry keyed by code cannot.
+func (l *Localizer) Detail(code, detail string) string {
+	if l.tag == DefaultLanguage {
+		return detail
+	}
+	if message, ok := l.catalog.Message(l.tag, code); ok {
+		return message
+	}
+	return detail
+}
+
+// Title localizes the title of an error code, falling back to title
+func (l *Localizer) Title(code, title string) string {
+	if message, ok := l.catalog.Message(l.tag, code+titleSuffix); ok {
+		return message
+	}
+	return title
+}
+
+// Validation localizes the message for a failed validator tag. The {field}
+// and {param} placeholders are replaced with the field name and tag parameter.
+func (l *Localizer) Validation(validationTag, field, param string) string {
+	message, ok := l.lookup(validationPrefix + validationTag)
+	if !ok {
+		message, _ = l.lookup(validationFallback)
+	}
+
+	return strings.NewReplacer("{field}", field, "{param}", param).Replace(message)
+}
+
+// lookup finds key in the negotiated language, then in the default one
+func (l *Localizer) lookup(key string) (string, bool) {
+	if message, ok := l.catalog.Message(l.tag, key); ok {
+		return message, true
+	}
+	return l.catalog.Message(DefaultLanguage, key)
+}
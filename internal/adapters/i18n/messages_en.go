@@ -0,0 +1,257 @@
+package i18n
+
+// englishMessages is the reference catalog; every key here should have a
+// counterpart in each other bundled language
+var englishMessages = map[string]string{
+	// Request errors
+	"INVALID_REQUEST":              "Invalid request body format",
+	"INVALID_REQUEST.title":        "Invalid request",
+	"INVALID_ID":                   "Invalid product ID format",
+	"INVALID_ID.title":             "Invalid identifier",
+	"VALIDATION_ERROR":             "Request validation failed",
+	"VALIDATION_ERROR.title":       "Validation failed",
+	"ROUTE_NOT_FOUND":              "The requested route does not exist",
+	"ROUTE_NOT_FOUND.title":        "Route not found",
+	"METHOD_NOT_ALLOWED":           "The method is not allowed for this route",
+	"METHOD_NOT_ALLOWED.title":     "Method not allowed",
+	"PAYLOAD_TOO_LARGE":            "The request body is too large",
+	"PAYLOAD_TOO_LARGE.title":      "Payload too large",
+	"UNSUPPORTED_MEDIA_TYPE":       "The request content type is not supported",
+	"UNSUPPORTED_MEDIA_TYPE.title": "Unsupported media type",
+	"REQUEST_TIMEOUT":              "The request took too long to complete",
+	"REQUEST_TIMEOUT.title":        "Request timed out",
+	"INTERNAL_ERROR":               "An internal error occurred",
+	"INTERNAL_ERROR.title":         "Internal error",
+
+	// Product errors
+	"PRODUCT_NOT_FOUND":                 "Product not found",
+	"PRODUCT_NOT_FOUND.title":           "Product not found",
+	"PRODUCT_ALREADY_EXISTS":            "Product with this SKU already exists",
+	"PRODUCT_ALREADY_EXISTS.title":      "Product already exists",
+	"PRODUCT_GTIN_ALREADY_EXISTS":       "Product with this GTIN already exists",
+	"PRODUCT_GTIN_ALREADY_EXISTS.title": "GTIN already in use",
+	"INVALID_PRODUCT_NAME":              "Invalid product name",
+	"INVALID_PRODUCT_NAME.title":        "Invalid product name",
+	"INVALID_SKU":                       "Invalid SKU format",
+	"INVALID_SKU.title":                 "Invalid SKU",
+	"INVALID_GTIN":                      "Invalid GTIN",
+	"INVALID_GTIN.title":                "Invalid GTIN",
+	"INVALID_PRICE":                     "Invalid product price",
+	"INVALID_PRICE.title":               "Invalid price",
+	"INVALID_STOCK":                     "Invalid stock quantity",
+	"INVALID_STOCK.title":               "Invalid stock",
+	"INVALID_CATEGORY":                  "Invalid product category",
+	"INVALID_CATEGORY.title":            "Invalid category",
+	"PRODUCT_INACTIVE":                  "Product is inactive",
+	"PRODUCT_INACTIVE.title":            "Product inactive",
+	"PRODUCT_DISCONTINUED":              "Product has been discontinued",
+	"PRODUCT_DISCONTINUED.title":        "Product discontinued",
+	"PRODUCT_OUT_OF_STOCK":              "Product is out of stock",
+	"PRODUCT_OUT_OF_STOCK.title":        "Product out of stock",
+	"INSUFFICIENT_STOCK":                "Insufficient stock quantity",
+	"INSUFFICIENT_STOCK.title":          "Insufficient stock",
+	"PRODUCT_NOT_AVAILABLE":             "Product is not available for purchase",
+	"PRODUCT_NOT_AVAILABLE.title":       "Product not available",
+
+	// Persistence failures
+	"FAILED_TO_CHECK_PRODUCT_EXISTENCE":       "Failed to check product existence",
+	"FAILED_TO_CHECK_PRODUCT_EXISTENCE.title": "Failed to check product existence",
+	"FAILED_TO_CREATE_PRODUCT":                "Failed to create product",
+	"FAILED_TO_CREATE_PRODUCT.title":          "Failed to create product",
+	"FAILED_TO_GENERATE_SKU":                  "Failed to generate product SKU",
+	"FAILED_TO_GENERATE_SKU.title":            "Failed to generate SKU",
+	"FAILED_TO_UPDATE_PRODUCT":                "Failed to update product",
+	"FAILED_TO_UPDATE_PRODUCT.title":          "Failed to update product",
+	"FAILED_TO_DELETE_PRODUCT":                "Failed to delete product",
+	"FAILED_TO_DELETE_PRODUCT.title":          "Failed to delete product",
+	"FAILED_TO_LIST_PRODUCTS":                 "Failed to list products",
+	"FAILED_TO_LIST_PRODUCTS.title":           "Failed to list products",
+	"FAILED_TO_SEARCH_PRODUCTS":               "Failed to search products",
+	"FAILED_TO_SEARCH_PRODUCTS.title":         "Failed to search products",
+	"FAILED_TO_UPDATE_STOCK":                  "Failed to update product stock",
+	"FAILED_TO_UPDATE_STOCK.title":            "Failed to update stock",
+	"FAILED_TO_UPDATE_PRICE":                  "Failed to update product price",
+	"FAILED_TO_UPDATE_PRICE.title":            "Failed to update price",
+
+	// Pricing errors
+	"FAILED_TO_UPDATE_PRICING":        "Failed to update price lists or exchange rates",
+	"FAILED_TO_UPDATE_PRICING.title":  "Failed to update pricing",
+	"UNSUPPORTED_CURRENCY":            "Currency is not supported",
+	"UNSUPPORTED_CURRENCY.title":      "Unsupported currency",
+	"PRICE_LIST_NOT_FOUND":            "Price list not found",
+	"PRICE_LIST_NOT_FOUND.title":      "Price list not found",
+	"PRICE_LIST_ALREADY_EXISTS":       "Price list with this code already exists",
+	"PRICE_LIST_ALREADY_EXISTS.title": "Price list already exists",
+	"INVALID_PRICE_LIST":              "Invalid price list",
+	"INVALID_PRICE_LIST.title":        "Invalid price list",
+	"PRODUCT_PRICE_NOT_FOUND":         "Product has no price in this price list and currency",
+	"PRODUCT_PRICE_NOT_FOUND.title":   "Product price not found",
+	"EXCHANGE_RATE_NOT_FOUND":         "No exchange rate is configured for this currency pair",
+	"EXCHANGE_RATE_NOT_FOUND.title":   "Exchange rate not found",
+	"INVALID_EXCHANGE_RATE":           "Invalid exchange rate",
+	"INVALID_EXCHANGE_RATE.title":     "Invalid exchange rate",
+
+	// Price history errors
+	"PRICE_CHANGE_NOT_FOUND":             "Price change not found",
+	"PRICE_CHANGE_NOT_FOUND.title":       "Price change not found",
+	"PRICE_CHANGE_NOT_CANCELLABLE":       "Only scheduled price changes can be cancelled",
+	"PRICE_CHANGE_NOT_CANCELLABLE.title": "Price change not cancellable",
+	"INVALID_PRICE_SCHEDULE":             "Invalid price schedule",
+	"INVALID_PRICE_SCHEDULE.title":       "Invalid price schedule",
+
+	// Promotion errors
+	"PROMOTION_NOT_FOUND":                 "Promotion not found",
+	"PROMOTION_NOT_FOUND.title":           "Promotion not found",
+	"INVALID_PROMOTION":                   "Invalid promotion",
+	"INVALID_PROMOTION.title":             "Invalid promotion",
+	"FAILED_TO_EVALUATE_PROMOTIONS":       "Failed to evaluate promotions",
+	"FAILED_TO_EVALUATE_PROMOTIONS.title": "Failed to evaluate promotions",
+
+	// Variant errors
+	"VARIANT_NOT_FOUND":             "Product variant not found",
+	"VARIANT_NOT_FOUND.title":       "Variant Not Found",
+	"VARIANT_ALREADY_EXISTS":        "A product or variant with this SKU or option combination already exists",
+	"VARIANT_ALREADY_EXISTS.title":  "Variant Already Exists",
+	"INVALID_VARIANT":               "Invalid product variant",
+	"INVALID_VARIANT.title":         "Invalid Variant",
+	"INVALID_PRODUCT_OPTIONS":       "Invalid product options",
+	"INVALID_PRODUCT_OPTIONS.title": "Invalid Product Options",
+
+	// Attribute errors
+	"ATTRIBUTE_DEFINITION_NOT_FOUND":       "Attribute definition not found",
+	"ATTRIBUTE_DEFINITION_NOT_FOUND.title": "Attribute Definition Not Found",
+	"INVALID_ATTRIBUTE_DEFINITION":         "Invalid attribute definition",
+	"INVALID_ATTRIBUTE_DEFINITION.title":   "Invalid Attribute Definition",
+	"INVALID_PRODUCT_ATTRIBUTES":           "Product attributes do not match the category schema",
+	"INVALID_PRODUCT_ATTRIBUTES.title":     "Invalid Product Attributes",
+
+	// Category
+	"CATEGORY_NOT_FOUND":            "Category not found",
+	"CATEGORY_NOT_FOUND.title":      "Category not found",
+	"CATEGORY_ALREADY_EXISTS":       "A category with this path already exists",
+	"CATEGORY_ALREADY_EXISTS.title": "Category already exists",
+	"INVALID_CATEGORY_CHANGE":       "Invalid category change",
+	"INVALID_CATEGORY_CHANGE.title": "Invalid category change",
+	"CATEGORY_IN_USE":               "Category still has products, subcategories or promotions",
+	"CATEGORY_IN_USE.title":         "Category in use",
+
+	// Brand errors
+	"BRAND_NOT_FOUND":             "Brand not found",
+	"BRAND_NOT_FOUND.title":       "Brand Not Found",
+	"BRAND_ALREADY_EXISTS":        "A brand with this name or alias already exists",
+	"BRAND_ALREADY_EXISTS.title":  "Brand Already Exists",
+	"INVALID_BRAND":               "Invalid brand",
+	"INVALID_BRAND.title":         "Invalid Brand",
+	"INVALID_PRODUCT_BRAND":       "Product brand is not a registered active brand",
+	"INVALID_PRODUCT_BRAND.title": "Invalid Product Brand",
+
+	// Media errors
+	"MEDIA_NOT_FOUND":                "Product media not found",
+	"MEDIA_NOT_FOUND.title":          "Media Not Found",
+	"INVALID_MEDIA":                  "Invalid product media",
+	"INVALID_MEDIA.title":            "Invalid Media",
+	"UNSUPPORTED_MEDIA_FORMAT":       "Only JPEG, PNG, GIF and WebP images and PDF documents are supported",
+	"UNSUPPORTED_MEDIA_FORMAT.title": "Unsupported Media Format",
+	"MEDIA_TOO_LARGE":                "The uploaded file exceeds the size limit",
+	"MEDIA_TOO_LARGE.title":          "Media Too Large",
+	"FAILED_TO_STORE_MEDIA":          "Failed to store product media",
+	"FAILED_TO_STORE_MEDIA.title":    "Failed to store media",
+
+	// Tags and collections
+	"TAG_NOT_FOUND":                   "Tag not found",
+	"TAG_NOT_FOUND.title":             "Tag Not Found",
+	"TAG_ALREADY_EXISTS":              "A tag with this name already exists",
+	"TAG_ALREADY_EXISTS.title":        "Tag Already Exists",
+	"INVALID_TAG":                     "Invalid tag",
+	"INVALID_TAG.title":               "Invalid Tag",
+	"COLLECTION_NOT_FOUND":            "Collection not found",
+	"COLLECTION_NOT_FOUND.title":      "Collection Not Found",
+	"COLLECTION_ALREADY_EXISTS":       "A collection with this name already exists",
+	"COLLECTION_ALREADY_EXISTS.title": "Collection Already Exists",
+	"INVALID_COLLECTION":              "Invalid collection",
+	"INVALID_COLLECTION.title":        "Invalid Collection",
+
+	// Product lifecycle
+	"INVALID_STATUS_TRANSITION":       "The product cannot move to this status from its current status",
+	"INVALID_STATUS_TRANSITION.title": "Invalid status transition",
+	"TRANSITION_GUARD_FAILED":         "The product does not meet the conditions for this status",
+	"TRANSITION_GUARD_FAILED.title":   "Status transition conditions not met",
+
+	// Product revisions
+	"REVISION_NOT_FOUND":           "Revision not found",
+	"REVISION_NOT_FOUND.title":     "Revision not found",
+	"INVALID_REVISION":             "Invalid revision",
+	"INVALID_REVISION.title":       "Invalid revision",
+	"REVISION_NOT_PENDING":         "Only pending revisions can be approved or rejected",
+	"REVISION_NOT_PENDING.title":   "Revision not pending",
+	"REVISION_NOT_APPROVED":        "Only approved revisions can be published",
+	"REVISION_NOT_APPROVED.title":  "Revision not approved",
+	"REVISION_SELF_APPROVAL":       "Revisions must be approved by someone other than their author",
+	"REVISION_SELF_APPROVAL.title": "Revision self-approval",
+	"REVISION_STALE":               "The product changed after this revision was drafted",
+	"REVISION_STALE.title":         "Revision out of date",
+
+	// Audit trail
+	"INVALID_AUDIT_QUERY":             "Invalid audit query",
+	"INVALID_AUDIT_QUERY.title":       "Invalid audit query",
+	"FAILED_TO_QUERY_AUDIT":           "Failed to query the audit trail",
+	"FAILED_TO_QUERY_AUDIT.title":     "Failed to query audit trail",
+	"PRODUCT_VERSION_NOT_FOUND":       "No recorded version of the product at that time",
+	"PRODUCT_VERSION_NOT_FOUND.title": "Product version not found",
+
+	// Product imports
+	"IMPORT_NOT_FOUND":                "Product import not found",
+	"IMPORT_NOT_FOUND.title":          "Import not found",
+	"INVALID_IMPORT":                  "Invalid product import",
+	"INVALID_IMPORT.title":            "Invalid import",
+	"IMPORT_NOT_FINISHED":             "The import is still running, its error report is not complete yet",
+	"IMPORT_NOT_FINISHED.title":       "Import not finished",
+	"FAILED_TO_IMPORT_PRODUCTS":       "Failed to import products",
+	"FAILED_TO_IMPORT_PRODUCTS.title": "Failed to import products",
+
+	// Product exports
+	"INVALID_EXPORT":                  "Invalid product export",
+	"INVALID_EXPORT.title":            "Invalid export",
+	"FAILED_TO_EXPORT_PRODUCTS":       "Failed to export products",
+	"FAILED_TO_EXPORT_PRODUCTS.title": "Failed to export products",
+
+	// Product feeds
+	"INVALID_FEED_FORMAT":           "Unsupported product feed format",
+	"INVALID_FEED_FORMAT.title":     "Unknown feed",
+	"FAILED_TO_GENERATE_FEED":       "Failed to generate the product feed",
+	"FAILED_TO_GENERATE_FEED.title": "Failed to generate feed",
+
+	// Product batches
+	"INVALID_BATCH":                 "Invalid product batch",
+	"INVALID_BATCH.title":           "Invalid batch",
+	"BATCH_TOO_LARGE":               "The batch has too many operations",
+	"BATCH_TOO_LARGE.title":         "Batch too large",
+	"INVALID_BATCH_OPERATION":       "Invalid batch operation",
+	"INVALID_BATCH_OPERATION.title": "Invalid batch operation",
+	"BATCH_ROLLED_BACK":             "Not applied because another operation of the atomic batch failed",
+	"BATCH_ROLLED_BACK.title":       "Batch rolled back",
+	"FAILED_TO_RUN_BATCH":           "Failed to run the product batch",
+	"FAILED_TO_RUN_BATCH.title":     "Failed to run batch",
+
+	// Background jobs
+	"JOB_NOT_FOUND":                  "Job not found",
+	"JOB_NOT_FOUND.title":            "Job not found",
+	"INVALID_JOB":                    "Invalid job",
+	"INVALID_JOB.title":              "Invalid job",
+	"INVALID_REPRICE":                "Invalid bulk repricing",
+	"INVALID_REPRICE.title":          "Invalid repricing",
+	"JOB_ALREADY_FINISHED":           "The job already finished and cannot be cancelled",
+	"JOB_ALREADY_FINISHED.title":     "Job already finished",
+	"JOB_RESULT_NOT_AVAILABLE":       "The job has no file to download, it has not succeeded or produces none",
+	"JOB_RESULT_NOT_AVAILABLE.title": "Job result not available",
+	"FAILED_TO_ENQUEUE_JOB":          "Failed to enqueue the job",
+	"FAILED_TO_ENQUEUE_JOB.title":    "Failed to enqueue job",
+
+	// Validator tags
+	"validation.required": "This field is required",
+	"validation.min":      "Minimum value is {param}",
+	"validation.max":      "Maximum value is {param}",
+	"validation.gte":      "Value must be greater than or equal to {param}",
+	"validation.lte":      "Value must be less than or equal to {param}",
+	"validation.default":  "Invalid value",
+}
@@ -0,0 +1,255 @@
+package i18n
+
+var spanishMessages = map[string]string{
+	// Request errors
+	"INVALID_REQUEST":              "El formato del cuerpo de la solicitud no es válido",
+	"INVALID_REQUEST.title":        "Solicitud inválida",
+	"INVALID_ID":                   "El formato del ID del producto no es válido",
+	"INVALID_ID.title":             "Identificador inválido",
+	"VALIDATION_ERROR":             "La validación de la solicitud falló",
+	"VALIDATION_ERROR.title":       "Error de validación",
+	"ROUTE_NOT_FOUND":              "La ruta solicitada no existe",
+	"ROUTE_NOT_FOUND.title":        "Ruta no encontrada",
+	"METHOD_NOT_ALLOWED":           "El método no está permitido para esta ruta",
+	"METHOD_NOT_ALLOWED.title":     "Método no permitido",
+	"PAYLOAD_TOO_LARGE":            "El cuerpo de la solicitud es demasiado grande",
+	"PAYLOAD_TOO_LARGE.title":      "Contenido demasiado grande",
+	"UNSUPPORTED_MEDIA_TYPE":       "El tipo de contenido de la solicitud no es compatible",
+	"UNSUPPORTED_MEDIA_TYPE.title": "Tipo de contenido no compatible",
+	"REQUEST_TIMEOUT":              "La solicitud tardó demasiado en completarse",
+	"REQUEST_TIMEOUT.title":        "Tiempo de espera agotado",
+	"INTERNAL_ERROR":               "Ocurrió un error interno",
+	"INTERNAL_ERROR.title":         "Error interno",
+
+	// Product errors
+	"PRODUCT_NOT_FOUND":                 "Producto no encontrado",
+	"PRODUCT_NOT_FOUND.title":           "Producto no encontrado",
+	"PRODUCT_ALREADY_EXISTS":            "Ya existe un producto con este SKU",
+	"PRODUCT_ALREADY_EXISTS.title":      "El producto ya existe",
+	"PRODUCT_GTIN_ALREADY_EXISTS":       "Ya existe un producto con este GTIN",
+	"PRODUCT_GTIN_ALREADY_EXISTS.title": "GTIN en uso",
+	"INVALID_PRODUCT_NAME":              "Nombre de producto inválido",
+	"INVALID_PRODUCT_NAME.title":        "Nombre de producto inválido",
+	"INVALID_SKU":                       "Formato de SKU inválido",
+	"INVALID_SKU.title":                 "SKU inválido",
+	"INVALID_GTIN":                      "GTIN inválido",
+	"INVALID_GTIN.title":                "GTIN inválido",
+	"INVALID_PRICE":                     "Precio de producto inválido",
+	"INVALID_PRICE.title":               "Precio inválido",
+	"INVALID_STOCK":                     "Cantidad de inventario inválida",
+	"INVALID_STOCK.title":               "Inventario inválido",
+	"INVALID_CATEGORY":                  "Categoría de producto inválida",
+	"INVALID_CATEGORY.title":            "Categoría inválida",
+	"PRODUCT_INACTIVE":                  "El producto está inactivo",
+	"PRODUCT_INACTIVE.title":            "Producto inactivo",
+	"PRODUCT_DISCONTINUED":              "El producto ha sido descontinuado",
+	"PRODUCT_DISCONTINUED.title":        "Producto descontinuado",
+	"PRODUCT_OUT_OF_STOCK":              "El producto está agotado",
+	"PRODUCT_OUT_OF_STOCK.title":        "Producto agotado",
+	"INSUFFICIENT_STOCK":                "Cantidad de inventario insuficiente",
+	"INSUFFICIENT_STOCK.title":          "Inventario insuficiente",
+	"PRODUCT_NOT_AVAILABLE":             "El producto no está disponible para la compra",
+	"PRODUCT_NOT_AVAILABLE.title":       "Producto no disponible",
+
+	// Persistence failures
+	"FAILED_TO_CHECK_PRODUCT_EXISTENCE":       "No se pudo verificar la existencia del producto",
+	"FAILED_TO_CHECK_PRODUCT_EXISTENCE.title": "Error al verificar el producto",
+	"FAILED_TO_CREATE_PRODUCT":                "No se pudo crear el producto",
+	"FAILED_TO_CREATE_PRODUCT.title":          "Error al crear el producto",
+	"FAILED_TO_GENERATE_SKU":                  "No se pudo generar el SKU del producto",
+	"FAILED_TO_GENERATE_SKU.title":            "Error al generar el SKU",
+	"FAILED_TO_UPDATE_PRODUCT":                "No se pudo actualizar el producto",
+	"FAILED_TO_UPDATE_PRODUCT.title":          "Error al actualizar el producto",
+	"FAILED_TO_DELETE_PRODUCT":                "No se pudo eliminar el producto",
+	"FAILED_TO_DELETE_PRODUCT.title":          "Error al eliminar el producto",
+	"FAILED_TO_LIST_PRODUCTS":                 "No se pudieron listar los productos",
+	"FAILED_TO_LIST_PRODUCTS.title":           "Error al listar productos",
+	"FAILED_TO_SEARCH_PRODUCTS":               "No se pudieron buscar los productos",
+	"FAILED_TO_SEARCH_PRODUCTS.title":         "Error al buscar productos",
+	"FAILED_TO_UPDATE_STOCK":                  "No se pudo actualizar el inventario del producto",
+	"FAILED_TO_UPDATE_STOCK.title":            "Error al actualizar el inventario",
+	"FAILED_TO_UPDATE_PRICE":                  "No se pudo actualizar el precio del producto",
+	"FAILED_TO_UPDATE_PRICE.title":            "Error al actualizar el precio",
+
+	// Pricing errors
+	"FAILED_TO_UPDATE_PRICING":        "No se pudieron actualizar las listas de precios o las tasas de cambio",
+	"FAILED_TO_UPDATE_PRICING.title":  "Error al actualizar precios",
+	"UNSUPPORTED_CURRENCY":            "La moneda no es compatible",
+	"UNSUPPORTED_CURRENCY.title":      "Moneda no compatible",
+	"PRICE_LIST_NOT_FOUND":            "Lista de precios no encontrada",
+	"PRICE_LIST_NOT_FOUND.title":      "Lista de precios no encontrada",
+	"PRICE_LIST_ALREADY_EXISTS":       "Ya existe una lista de precios con este código",
+	"PRICE_LIST_ALREADY_EXISTS.title": "La lista de precios ya existe",
+	"INVALID_PRICE_LIST":              "Lista de precios inválida",
+	"INVALID_PRICE_LIST.title":        "Lista de precios inválida",
+	"PRODUCT_PRICE_NOT_FOUND":         "El producto no tiene precio en esta lista de precios y moneda",
+	"PRODUCT_PRICE_NOT_FOUND.title":   "Precio de producto no encontrado",
+	"EXCHANGE_RATE_NOT_FOUND":         "No hay una tasa de cambio configurada para este par de monedas",
+	"EXCHANGE_RATE_NOT_FOUND.title":   "Tasa de cambio no encontrada",
+	"INVALID_EXCHANGE_RATE":           "Tasa de cambio inválida",
+	"INVALID_EXCHANGE_RATE.title":     "Tasa de cambio inválida",
+
+	// Price history errors
+	"PRICE_CHANGE_NOT_FOUND":             "Cambio de precio no encontrado",
+	"PRICE_CHANGE_NOT_FOUND.title":       "Cambio de precio no encontrado",
+	"PRICE_CHANGE_NOT_CANCELLABLE":       "Solo se pueden cancelar los cambios de precio programados",
+	"PRICE_CHANGE_NOT_CANCELLABLE.title": "El cambio de precio no se puede cancelar",
+	"INVALID_PRICE_SCHEDULE":             "Programación de precio inválida",
+	"INVALID_PRICE_SCHEDULE.title":       "Programación de precio inválida",
+
+	// Promotion errors
+	"PROMOTION_NOT_FOUND":                 "Promoción no encontrada",
+	"PROMOTION_NOT_FOUND.title":           "Promoción no encontrada",
+	"INVALID_PROMOTION":                   "Promoción inválida",
+	"INVALID_PROMOTION.title":             "Promoción inválida",
+	"FAILED_TO_EVALUATE_PROMOTIONS":       "No se pudieron evaluar las promociones",
+	"FAILED_TO_EVALUATE_PROMOTIONS.title": "Error al evaluar promociones",
+
+	// Variant errors
+	"VARIANT_NOT_FOUND":             "Variante de producto no encontrada",
+	"VARIANT_NOT_FOUND.title":       "Variante no encontrada",
+	"VARIANT_ALREADY_EXISTS":        "Ya existe un producto o variante con este SKU o combinación de opciones",
+	"VARIANT_ALREADY_EXISTS.title":  "La variante ya existe",
+	"INVALID_VARIANT":               "Variante de producto no válida",
+	"INVALID_VARIANT.title":         "Variante no válida",
+	"INVALID_PRODUCT_OPTIONS":       "Opciones de producto no válidas",
+	"INVALID_PRODUCT_OPTIONS.title": "Opciones no válidas",
+
+	// Attribute errors
+	"ATTRIBUTE_DEFINITION_NOT_FOUND":       "Definición de atributo no encontrada",
+	"ATTRIBUTE_DEFINITION_NOT_FOUND.title": "Definición de atributo no encontrada",
+	"INVALID_ATTRIBUTE_DEFINITION":         "Definición de atributo no válida",
+	"INVALID_ATTRIBUTE_DEFINITION.title":   "Definición de atributo no válida",
+	"INVALID_PRODUCT_ATTRIBUTES":           "Los atributos del producto no coinciden con el esquema de la categoría",
+	"INVALID_PRODUCT_ATTRIBUTES.title":     "Atributos de producto no válidos",
+
+	// Category
+	"CATEGORY_NOT_FOUND":            "Categoría no encontrada",
+	"CATEGORY_NOT_FOUND.title":      "Categoría no encontrada",
+	"CATEGORY_ALREADY_EXISTS":       "Ya existe una categoría con esta ruta",
+	"CATEGORY_ALREADY_EXISTS.title": "La categoría ya existe",
+	"INVALID_CATEGORY_CHANGE":       "Cambio de categoría no válido",
+	"INVALID_CATEGORY_CHANGE.title": "Cambio de categoría no válido",
+	"CATEGORY_IN_USE":               "La categoría todavía tiene productos, subcategorías o promociones",
+	"CATEGORY_IN_USE.title":         "Categoría en uso",
+
+	// Brand errors
+	"BRAND_NOT_FOUND":             "Marca no encontrada",
+	"BRAND_NOT_FOUND.title":       "Marca no encontrada",
+	"BRAND_ALREADY_EXISTS":        "Ya existe una marca con este nombre o alias",
+	"BRAND_ALREADY_EXISTS.title":  "La marca ya existe",
+	"INVALID_BRAND":               "Marca no válida",
+	"INVALID_BRAND.title":         "Marca no válida",
+	"INVALID_PRODUCT_BRAND":       "La marca del producto no es una marca registrada y activa",
+	"INVALID_PRODUCT_BRAND.title": "Marca de producto no válida",
+
+	// Media errors
+	"MEDIA_NOT_FOUND":                "Archivo multimedia del producto no encontrado",
+	"MEDIA_NOT_FOUND.title":          "Archivo multimedia no encontrado",
+	"INVALID_MEDIA":                  "Archivo multimedia del producto no válido",
+	"INVALID_MEDIA.title":            "Archivo multimedia no válido",
+	"UNSUPPORTED_MEDIA_FORMAT":       "Solo se admiten imágenes JPEG, PNG, GIF y WebP y documentos PDF",
+	"UNSUPPORTED_MEDIA_FORMAT.title": "Formato de archivo no admitido",
+	"MEDIA_TOO_LARGE":                "El archivo subido supera el tamaño máximo",
+	"MEDIA_TOO_LARGE.title":          "Archivo demasiado grande",
+	"FAILED_TO_STORE_MEDIA":          "Error al guardar el archivo multimedia del producto",
+	"FAILED_TO_STORE_MEDIA.title":    "Error al guardar el archivo multimedia",
+
+	// Tags and collections
+	"TAG_NOT_FOUND":                   "Etiqueta no encontrada",
+	"TAG_NOT_FOUND.title":             "Etiqueta no encontrada",
+	"TAG_ALREADY_EXISTS":              "Ya existe una etiqueta con este nombre",
+	"TAG_ALREADY_EXISTS.title":        "La etiqueta ya existe",
+	"INVALID_TAG":                     "Etiqueta no válida",
+	"INVALID_TAG.title":               "Etiqueta no válida",
+	"COLLECTION_NOT_FOUND":            "Colección no encontrada",
+	"COLLECTION_NOT_FOUND.title":      "Colección no encontrada",
+	"COLLECTION_ALREADY_EXISTS":       "Ya existe una colección con este nombre",
+	"COLLECTION_ALREADY_EXISTS.title": "La colección ya existe",
+	"INVALID_COLLECTION":              "Colección no válida",
+	"INVALID_COLLECTION.title":        "Colección no válida",
+
+	// Product lifecycle
+	"INVALID_STATUS_TRANSITION":       "El producto no puede pasar a este estado desde su estado actual",
+	"INVALID_STATUS_TRANSITION.title": "Transición de estado no válida",
+	"TRANSITION_GUARD_FAILED":         "El producto no cumple las condiciones para este estado",
+	"TRANSITION_GUARD_FAILED.title":   "Condiciones de transición no cumplidas",
+
+	// Product revisions
+	"REVISION_NOT_FOUND":           "Revisión no encontrada",
+	"REVISION_NOT_FOUND.title":     "Revisión no encontrada",
+	"INVALID_REVISION":             "Revisión no válida",
+	"INVALID_REVISION.title":       "Revisión no válida",
+	"REVISION_NOT_PENDING":         "Solo se pueden aprobar o rechazar revisiones pendientes",
+	"REVISION_NOT_PENDING.title":   "Revisión no pendiente",
+	"REVISION_NOT_APPROVED":        "Solo se pueden publicar revisiones aprobadas",
+	"REVISION_NOT_APPROVED.title":  "Revisión no aprobada",
+	"REVISION_SELF_APPROVAL":       "Las revisiones deben ser aprobadas por alguien distinto de su autor",
+	"REVISION_SELF_APPROVAL.title": "Autoaprobación de revisión",
+	"REVISION_STALE":               "El producto cambió después de redactar esta revisión",
+	"REVISION_STALE.title":         "Revisión desactualizada",
+
+	// Audit trail
+	"INVALID_AUDIT_QUERY":             "Consulta de auditoría no válida",
+	"INVALID_AUDIT_QUERY.title":       "Consulta de auditoría no válida",
+	"FAILED_TO_QUERY_AUDIT":           "No se pudo consultar el registro de auditoría",
+	"FAILED_TO_QUERY_AUDIT.title":     "Error al consultar la auditoría",
+	"PRODUCT_VERSION_NOT_FOUND":       "No hay una versión registrada del producto en ese momento",
+	"PRODUCT_VERSION_NOT_FOUND.title": "Versión del producto no encontrada",
+
+	// Product imports
+	"IMPORT_NOT_FOUND":                "Importación de productos no encontrada",
+	"IMPORT_NOT_FOUND.title":          "Importación no encontrada",
+	"INVALID_IMPORT":                  "Importación de productos no válida",
+	"INVALID_IMPORT.title":            "Importación no válida",
+	"IMPORT_NOT_FINISHED":             "La importación sigue en curso, su informe de errores aún no está completo",
+	"IMPORT_NOT_FINISHED.title":       "Importación no finalizada",
+	"FAILED_TO_IMPORT_PRODUCTS":       "No se pudieron importar los productos",
+	"FAILED_TO_IMPORT_PRODUCTS.title": "Error al importar productos",
+
+	// Product exports
+	"INVALID_EXPORT":                  "Exportación de productos no válida",
+	"INVALID_EXPORT.title":            "Exportación no válida",
+	"FAILED_TO_EXPORT_PRODUCTS":       "No se pudieron exportar los productos",
+	"FAILED_TO_EXPORT_PRODUCTS.title": "Error al exportar productos",
+
+	// Product feeds
+	"INVALID_FEED_FORMAT":           "Formato de feed de productos no admitido",
+	"INVALID_FEED_FORMAT.title":     "Feed desconocido",
+	"FAILED_TO_GENERATE_FEED":       "No se pudo generar el feed de productos",
+	"FAILED_TO_GENERATE_FEED.title": "Error al generar el feed",
+
+	// Product batches
+	"INVALID_BATCH":                 "Lote de productos no válido",
+	"INVALID_BATCH.title":           "Lote no válido",
+	"BATCH_TOO_LARGE":               "El lote tiene demasiadas operaciones",
+	"BATCH_TOO_LARGE.title":         "Lote demasiado grande",
+	"INVALID_BATCH_OPERATION":       "Operación de lote no válida",
+	"INVALID_BATCH_OPERATION.title": "Operación de lote no válida",
+	"BATCH_ROLLED_BACK":             "No se aplicó porque otra operación del lote atómico falló",
+	"BATCH_ROLLED_BACK.title":       "Lote revertido",
+	"FAILED_TO_RUN_BATCH":           "No se pudo ejecutar el lote de productos",
+	"FAILED_TO_RUN_BATCH.title":     "Error al ejecutar el lote",
+
+	// Background jobs
+	"JOB_NOT_FOUND":                  "Tarea no encontrada",
+	"JOB_NOT_FOUND.title":            "Tarea no encontrada",
+	"INVALID_JOB":                    "Tarea no válida",
+	"INVALID_JOB.title":              "Tarea no válida",
+	"INVALID_REPRICE":                "Cambio masivo de precios no válido",
+	"INVALID_REPRICE.title":          "Cambio de precios no válido",
+	"JOB_ALREADY_FINISHED":           "La tarea ya terminó y no se puede cancelar",
+	"JOB_ALREADY_FINISHED.title":     "Tarea ya terminada",
+	"JOB_RESULT_NOT_AVAILABLE":       "La tarea no tiene un archivo para descargar, no ha terminado con éxito o no genera ninguno",
+	"JOB_RESULT_NOT_AVAILABLE.title": "Resultado de la tarea no disponible",
+	"FAILED_TO_ENQUEUE_JOB":          "No se pudo encolar la tarea",
+	"FAILED_TO_ENQUEUE_JOB.title":    "Error al encolar la tarea",
+
+	// Validator tags
+	"validation.required": "Este campo es obligatorio",
+	"validation.min":      "El valor mínimo es {param}",
+	"validation.max":      "El valor máximo es {param}",
+	"validation.gte":      "El valor debe ser mayor o igual a {param}",
+	"validation.lte":      "El valor debe ser menor o igual a {param}",
+	"validation.default":  "Valor inválido",
+}
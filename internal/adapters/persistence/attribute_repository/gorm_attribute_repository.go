@@ -0,0 +1,138 @@
+package attribute_repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// AttributeDefinitionModel represents the database model for attribute definitions
+type AttributeDefinitionModel struct {
+	ID            uint      `gorm:"primarykey"`
+	CategoryID    uint      `gorm:"not null;uniqueIndex:idx_attribute_definitions_category_key"`
+	Key           string    `gorm:"not null;size:50;uniqueIndex:idx_attribute_definitions_category_key"`
+	Label         string    `gorm:"not null;size:100"`
+	Type          string    `gorm:"not null;size:20"`
+	Required      bool      `gorm:"not null;default:false"`
+	AllowedValues []string  `gorm:"type:jsonb;serializer:json"`
+	Unit          string    `gorm:"size:20"`
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+	UpdatedAt     time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName specifies the table name for GORM
+func (AttributeDefinitionModel) TableName() string {
+	return "attribute_definitions"
+}
+
+// GormAttributeRepository implements the AttributeRepository interface using GORM
+type GormAttributeRepository struct {
+	db *gorm.DB
+}
+
+// NewGormAttributeRepository creates a new GORM attribute repository
+func NewGormAttributeRepository(db *gorm.DB) ports.AttributeRepository {
+	return &GormAttributeRepository{db: db}
+}
+
+// ListDefinitions implements ports.AttributeRepository
+func (r *GormAttributeRepository) ListDefinitions(ctx context.Context, categoryIDs ...uint) ([]*entities.AttributeDefinition, error) {
+	var models []AttributeDefinitionModel
+
+	query := r.db.WithContext(ctx).Order("category_id ASC, key ASC")
+	if len(categoryIDs) > 0 {
+		query = query.Where("category_id IN ?", categoryIDs)
+	}
+	if err := query.Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	definitions := make([]*entities.AttributeDefinition, 0, len(models))
+	for i := range models {
+		definitions = append(definitions, toEntity(&models[i]))
+	}
+	return definitions, nil
+}
+
+// GetDefinition implements ports.AttributeRepository
+func (r *GormAttributeRepository) GetDefinition(ctx context.Context, categoryID uint, key string) (*entities.AttributeDefinition, error) {
+	var model AttributeDefinitionModel
+
+	err := r.db.WithContext(ctx).
+		Where("category_id = ? AND key = ?", categoryID, key).
+		First(&model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, domainErrors.ErrAttributeDefinitionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toEntity(&model), nil
+}
+
+// SaveDefinition implements ports.AttributeRepository
+func (r *GormAttributeRepository) SaveDefinition(ctx context.Context, definition *entities.AttributeDefinition) (*entities.AttributeDefinition, error) {
+	model := toModel(definition)
+
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "category_id"}, {Name: "key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"label", "type", "required", "allowed_values", "unit", "updated_at"}),
+	}).Create(model).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetDefinition(ctx, model.CategoryID, model.Key)
+}
+
+// DeleteDefinition implements ports.AttributeRepository
+func (r *GormAttributeRepository) DeleteDefinition(ctx context.Context, categoryID uint, key string) error {
+	result := r.db.WithContext(ctx).
+		Where("category_id = ? AND key = ?", categoryID, key).
+		Delete(&AttributeDefinitionModel{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domainErrors.ErrAttributeDefinitionNotFound
+	}
+	return nil
+}
+
+func toModel(definition *entities.AttributeDefinition) *AttributeDefinitionModel {
+	return &AttributeDefinitionModel{
+		ID:            definition.ID,
+		CategoryID:    definition.CategoryID,
+		Key:           definition.Key,
+		Label:         definition.Label,
+		Type:          string(definition.Type),
+		Required:      definition.Required,
+		AllowedValues: definition.AllowedValues,
+		Unit:          definition.Unit,
+		CreatedAt:     definition.CreatedAt,
+		UpdatedAt:     definition.UpdatedAt,
+	}
+}
+
+func toEntity(model *AttributeDefinitionModel) *entities.AttributeDefinition {
+	return &entities.AttributeDefinition{
+		ID:            model.ID,
+		CategoryID:    model.CategoryID,
+		Key:           model.Key,
+		Label:         model.Label,
+		Type:          entities.AttributeType(model.Type),
+		Required:      model.Required,
+		AllowedValues: model.AllowedValues,
+		Unit:          model.Unit,
+		CreatedAt:     model.CreatedAt,
+		UpdatedAt:     model.UpdatedAt,
+	}
+}
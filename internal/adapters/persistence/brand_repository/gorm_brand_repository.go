@@ -0,0 +1,286 @@
+package brand_repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"product-service/internal/adapters/persistence/product_repository"
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+
+	"gorm.io/gorm"
+)
+
+// BrandModel represents the database model for brands
+type BrandModel struct {
+	ID        uint      `gorm:"primarykey"`
+	Name      string    `gorm:"not null;size:100"`
+	Slug      string    `gorm:"uniqueIndex;not null;size:100"`
+	LogoURL   string    `gorm:"size:500"`
+	Status    string    `gorm:"not null;size:20;default:active;index"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName specifies the table name for GORM
+func (BrandModel) TableName() string {
+	return "brands"
+}
+
+// BrandNameModel represents a name a brand is known by: its canonical name
+// or one of its aliases. Normalized is unique, so no two brands share a name.
+type BrandNameModel struct {
+	ID         uint   `gorm:"primarykey"`
+	BrandID    uint   `gorm:"not null;index"`
+	Name       string `gorm:"not null;size:100"`
+	Normalized string `gorm:"uniqueIndex;not null;size:100"`
+}
+
+// TableName specifies the table name for GORM
+func (BrandNameModel) TableName() string {
+	return "brand_names"
+}
+
+// GormBrandRepository implements the BrandRepository interface using GORM
+type GormBrandRepository struct {
+	db *gorm.DB
+}
+
+// NewGormBrandRepository creates a new GORM brand repository
+func NewGormBrandRepository(db *gorm.DB) ports.BrandRepository {
+	return &GormBrandRepository{db: db}
+}
+
+// Create implements ports.BrandRepository
+func (r *GormBrandRepository) Create(ctx context.Context, brand *entities.Brand) (*entities.Brand, error) {
+	model := toModel(brand)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(model).Error; err != nil {
+			return err
+		}
+		return replaceNames(tx, model.ID, brand)
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return r.GetByID(ctx, model.ID)
+}
+
+// GetByID implements ports.BrandRepository
+func (r *GormBrandRepository) GetByID(ctx context.Context, id uint) (*entities.Brand, error) {
+	var model BrandModel
+
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error; err != nil {
+		return nil, handleError(err)
+	}
+
+	brands, err := r.withAliases(ctx, []BrandModel{model})
+	if err != nil {
+		return nil, err
+	}
+	return brands[0], nil
+}
+
+// FindByName implements ports.BrandRepository
+func (r *GormBrandRepository) FindByName(ctx context.Context, name string) (*entities.Brand, error) {
+	var brandName BrandNameModel
+
+	err := r.db.WithContext(ctx).
+		Where("normalized = ?", entities.NormalizeBrandName(name)).
+		First(&brandName).Error
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return r.GetByID(ctx, brandName.BrandID)
+}
+
+// List implements ports.BrandRepository
+func (r *GormBrandRepository) List(ctx context.Context, status entities.BrandStatus) ([]*entities.Brand, error) {
+	var models []BrandModel
+
+	query := r.db.WithContext(ctx).Order("lower(name) ASC, id ASC")
+	if status != "" {
+		query = query.Where("status = ?", string(status))
+	}
+	if err := query.Find(&models).Error; err != nil {
+		return nil, handleError(err)
+	}
+
+	return r.withAliases(ctx, models)
+}
+
+// Update implements ports.BrandRepository
+func (r *GormBrandRepository) Update(ctx context.Context, brand *entities.Brand) (*entities.Brand, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return saveBrand(ctx, tx, brand)
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return r.GetByID(ctx, brand.ID)
+}
+
+// Merge implements ports.BrandRepository
+func (r *GormBrandRepository) Merge(ctx context.Context, source, target *entities.Brand) (*entities.Brand, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec("UPDATE products SET brand_id = ?, updated_at = ? WHERE brand_id = ?",
+			target.ID, time.Now(), source.ID).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Exec(`DELETE FROM promotion_brands
+			WHERE brand_id = ? AND promotion_id IN (SELECT promotion_id FROM promotion_brands WHERE brand_id = ?)`,
+			source.ID, target.ID).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Exec("UPDATE promotion_brands SET brand_id = ? WHERE brand_id = ?", target.ID, source.ID).Error; err != nil {
+			return err
+		}
+
+		// the names of source are released before target takes them over
+		if err := tx.Where("brand_id = ?", source.ID).Delete(&BrandNameModel{}).Error; err != nil {
+			return err
+		}
+		result := tx.Delete(&BrandModel{}, source.ID)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domainErrors.ErrBrandNotFound
+		}
+
+		return saveBrand(ctx, tx, target)
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return r.GetByID(ctx, target.ID)
+}
+
+// saveBrand updates the brand row, its names and the brand name its products
+// carry, auditing the products whose brand name changes
+func saveBrand(ctx context.Context, tx *gorm.DB, brand *entities.Brand) error {
+	result := tx.Model(&BrandModel{ID: brand.ID}).
+		Select("name", "slug", "logo_url", "status", "updated_at").
+		Updates(toModel(brand))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domainErrors.ErrBrandNotFound
+	}
+
+	if err := replaceNames(tx, brand.ID, brand); err != nil {
+		return err
+	}
+
+	return product_repository.RenameProducts(ctx, tx, entities.AuditBrandChange, "brand", brand.Name, "brand_id", brand.ID)
+}
+
+// replaceNames stores the canonical name and aliases of brand as its names
+func replaceNames(tx *gorm.DB, brandID uint, brand *entities.Brand) error {
+	if err := tx.Where("brand_id = ?", brandID).Delete(&BrandNameModel{}).Error; err != nil {
+		return err
+	}
+
+	names := make([]BrandNameModel, 0, len(brand.Aliases)+1)
+	for _, name := range brand.Names() {
+		names = append(names, BrandNameModel{
+			BrandID:    brandID,
+			Name:       name,
+			Normalized: entities.NormalizeBrandName(name),
+		})
+	}
+	return tx.Create(&names).Error
+}
+
+// withAliases converts brand models to entities, loading their aliases
+func (r *GormBrandRepository) withAliases(ctx context.Context, models []BrandModel) ([]*entities.Brand, error) {
+	brands := make([]*entities.Brand, 0, len(models))
+	if len(models) == 0 {
+		return brands, nil
+	}
+
+	ids := make([]uint, 0, len(models))
+	for i := range models {
+		ids = append(ids, models[i].ID)
+	}
+
+	var names []BrandNameModel
+	if err := r.db.WithContext(ctx).Where("brand_id IN ?", ids).Order("id ASC").Find(&names).Error; err != nil {
+		return nil, handleError(err)
+	}
+	namesByBrand := make(map[uint][]BrandNameModel, len(models))
+	for _, name := range names {
+		namesByBrand[name.BrandID] = append(namesByBrand[name.BrandID], name)
+	}
+
+	for i := range models {
+		brand := toEntity(&models[i])
+		canonical := entities.NormalizeBrandName(brand.Name)
+		for _, name := range namesByBrand[brand.ID] {
+			if name.Normalized != canonical {
+				brand.Aliases = append(brand.Aliases, name.Name)
+			}
+		}
+		brands = append(brands, brand)
+	}
+	return brands, nil
+}
+
+func toModel(brand *entities.Brand) *BrandModel {
+	return &BrandModel{
+		ID:        brand.ID,
+		Name:      brand.Name,
+		Slug:      brand.Slug,
+		LogoURL:   brand.LogoURL,
+		Status:    string(brand.Status),
+		CreatedAt: brand.CreatedAt,
+		UpdatedAt: brand.UpdatedAt,
+	}
+}
+
+func toEntity(model *BrandModel) *entities.Brand {
+	return &entities.Brand{
+		ID:        model.ID,
+		Name:      model.Name,
+		Slug:      model.Slug,
+		Aliases:   []string{},
+		LogoURL:   model.LogoURL,
+		Status:    entities.BrandStatus(model.Status),
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+	}
+}
+
+// handleError converts GORM errors to domain errors
+func handleError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var domainErr *domainErrors.DomainError
+	if errors.As(err, &domainErr) {
+		return err
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domainErrors.ErrBrandNotFound
+	}
+
+	if errors.Is(err, gorm.ErrDuplicatedKey) || strings.Contains(err.Error(), "duplicate key") {
+		return domainErrors.ErrBrandAlreadyExists
+	}
+
+	return err
+}
@@ -0,0 +1,328 @@
+package category_repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"product-service/internal/adapters/persistence/product_repository"
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+
+	"gorm.io/gorm"
+)
+
+// CategoryModel represents the database model for categories
+type CategoryModel struct {
+	ID        uint      `gorm:"primarykey"`
+	ParentID  *uint     `gorm:"index"`
+	Name      string    `gorm:"not null;size:100"`
+	Slug      string    `gorm:"not null;size:100"`
+	Path      string    `gorm:"uniqueIndex;not null;size:1000"`
+	Depth     int       `gorm:"not null;default:0"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+
+	SKUPattern  string `gorm:"size:200"`
+	SKUTemplate string `gorm:"size:100"`
+	// SKUSequence is the last number the SKU template drew; only
+	// NextSKUSequence changes it
+	SKUSequence int64 `gorm:"not null;default:0"`
+}
+
+// TableName specifies the table name for GORM
+func (CategoryModel) TableName() string {
+	return "categories"
+}
+
+// GormCategoryRepository implements the CategoryRepository interface using GORM
+type GormCategoryRepository struct {
+	db *gorm.DB
+}
+
+// NewGormCategoryRepository creates a new GORM category repository
+func NewGormCategoryRepository(db *gorm.DB) ports.CategoryRepository {
+	return &GormCategoryRepository{db: db}
+}
+
+// Create implements ports.CategoryRepository
+func (r *GormCategoryRepository) Create(ctx context.Context, category *entities.Category) (*entities.Category, error) {
+	model := toModel(category)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return nil, handleError(err)
+	}
+
+	return toEntity(model), nil
+}
+
+// GetByID implements ports.CategoryRepository
+func (r *GormCategoryRepository) GetByID(ctx context.Context, id uint) (*entities.Category, error) {
+	var model CategoryModel
+
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error; err != nil {
+		return nil, handleError(err)
+	}
+
+	return toEntity(&model), nil
+}
+
+// GetByPath implements ports.CategoryRepository
+func (r *GormCategoryRepository) GetByPath(ctx context.Context, path string) (*entities.Category, error) {
+	var model CategoryModel
+
+	if err := r.db.WithContext(ctx).Where("path = ?", path).First(&model).Error; err != nil {
+		return nil, handleError(err)
+	}
+
+	return toEntity(&model), nil
+}
+
+// FindBySlug implements ports.CategoryRepository
+func (r *GormCategoryRepository) FindBySlug(ctx context.Context, slug string) ([]*entities.Category, error) {
+	var models []CategoryModel
+
+	if err := r.db.WithContext(ctx).Where("slug = ?", slug).Order("path ASC").Find(&models).Error; err != nil {
+		return nil, handleError(err)
+	}
+
+	return toEntities(models), nil
+}
+
+// List implements ports.CategoryRepository
+func (r *GormCategoryRepository) List(ctx context.Context) ([]*entities.Category, error) {
+	var models []CategoryModel
+
+	if err := r.db.WithContext(ctx).Order("path ASC").Find(&models).Error; err != nil {
+		return nil, handleError(err)
+	}
+
+	return toEntities(models), nil
+}
+
+// ListSubtree implements ports.CategoryRepository. Slugs only hold a-z, 0-9
+// and hyphens, so paths never contain LIKE wildcards.
+func (r *GormCategoryRepository) ListSubtree(ctx context.Context, path string) ([]*entities.Category, error) {
+	var models []CategoryModel
+
+	err := r.db.WithContext(ctx).
+		Where("path = ? OR path LIKE ?", path, path+"/%").
+		Order("path ASC").
+		Find(&models).Error
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return toEntities(models), nil
+}
+
+// Relocate implements ports.CategoryRepository
+func (r *GormCategoryRepository) Relocate(ctx context.Context, category *entities.Category, oldPath string) (*entities.Category, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var old CategoryModel
+		if err := tx.Where("id = ?", category.ID).First(&old).Error; err != nil {
+			return err
+		}
+
+		model := toModel(category)
+		err := tx.Model(&CategoryModel{ID: category.ID}).
+			Select("parent_id", "name", "slug", "path", "depth", "updated_at").
+			Updates(model).Error
+		if err != nil {
+			return err
+		}
+
+		if err := rewriteDescendantPaths(tx, oldPath, category.Path, category.Depth-old.Depth); err != nil {
+			return err
+		}
+
+		// products keep the category name for display
+		return product_repository.RenameProducts(ctx, tx, entities.AuditCategoryChange, "category", category.Name, "category_id", category.ID)
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return r.GetByID(ctx, category.ID)
+}
+
+// SaveSKURules implements ports.CategoryRepository
+func (r *GormCategoryRepository) SaveSKURules(ctx context.Context, category *entities.Category) (*entities.Category, error) {
+	result := r.db.WithContext(ctx).Model(&CategoryModel{ID: category.ID}).
+		Select("sku_pattern", "sku_template", "updated_at").
+		Updates(toModel(category))
+	if result.Error != nil {
+		return nil, handleError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, domainErrors.ErrCategoryNotFound
+	}
+
+	return r.GetByID(ctx, category.ID)
+}
+
+// NextSKUSequence implements ports.CategoryRepository. The increment is a
+// single statement, so concurrent creations draw distinct numbers.
+func (r *GormCategoryRepository) NextSKUSequence(ctx context.Context, id uint) (int64, error) {
+	var sequence int64
+	result := r.db.WithContext(ctx).
+		Raw("UPDATE categories SET sku_sequence = sku_sequence + 1 WHERE id = ? RETURNING sku_sequence", id).
+		Scan(&sequence)
+	if result.Error != nil {
+		return 0, handleError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return 0, domainErrors.ErrCategoryNotFound
+	}
+	return sequence, nil
+}
+
+// Merge implements ports.CategoryRepository
+func (r *GormCategoryRepository) Merge(ctx context.Context, source, target *entities.Category) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := rewriteDescendantPaths(tx, source.Path, target.Path, target.Depth-source.Depth); err != nil {
+			return err
+		}
+		if err := tx.Exec("UPDATE categories SET parent_id = ?, updated_at = ? WHERE parent_id = ?", target.ID, time.Now(), source.ID).Error; err != nil {
+			return err
+		}
+
+		moved, err := product_repository.AuditProductColumn(ctx, tx, entities.AuditCategoryChange, "category", "category", target.Name,
+			"category_id = ?", source.ID)
+		if err != nil {
+			return err
+		}
+		if err := tx.Exec("UPDATE products SET category_id = ?, category = ?, updated_at = ? WHERE category_id = ?",
+			target.ID, target.Name, time.Now(), source.ID).Error; err != nil {
+			return err
+		}
+		if err := product_repository.RecordProductVersions(tx, time.Now(), moved...); err != nil {
+			return err
+		}
+
+		err = tx.Exec(`DELETE FROM attribute_definitions
+			WHERE category_id = ? AND key IN (SELECT key FROM attribute_definitions WHERE category_id = ?)`,
+			source.ID, target.ID).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Exec("UPDATE attribute_definitions SET category_id = ?, updated_at = ? WHERE category_id = ?", target.ID, time.Now(), source.ID).Error; err != nil {
+			return err
+		}
+
+		err = tx.Exec(`DELETE FROM promotion_categories
+			WHERE category_id = ? AND promotion_id IN (SELECT promotion_id FROM promotion_categories WHERE category_id = ?)`,
+			source.ID, target.ID).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Exec("UPDATE promotion_categories SET category_id = ? WHERE category_id = ?", target.ID, source.ID).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(&CategoryModel{}, source.ID).Error
+	})
+	return handleError(err)
+}
+
+// Delete implements ports.CategoryRepository
+func (r *GormCategoryRepository) Delete(ctx context.Context, id uint) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var children, products, promotions int64
+		if err := tx.Model(&CategoryModel{}).Where("parent_id = ?", id).Count(&children).Error; err != nil {
+			return err
+		}
+		if err := tx.Table("products").Where("category_id = ? AND deleted_at IS NULL", id).Count(&products).Error; err != nil {
+			return err
+		}
+		if err := tx.Table("promotion_categories").Where("category_id = ?", id).Count(&promotions).Error; err != nil {
+			return err
+		}
+		if children > 0 || products > 0 || promotions > 0 {
+			return domainErrors.ErrCategoryInUse
+		}
+
+		result := tx.Delete(&CategoryModel{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domainErrors.ErrCategoryNotFound
+		}
+		return nil
+	})
+	return handleError(err)
+}
+
+// rewriteDescendantPaths moves every category below oldPath to the same
+// place below newPath
+func rewriteDescendantPaths(tx *gorm.DB, oldPath, newPath string, depthDelta int) error {
+	return tx.Exec(
+		"UPDATE categories SET path = ? || substr(path, ?), depth = depth + ?, updated_at = ? WHERE path LIKE ?",
+		newPath, len(oldPath)+1, depthDelta, time.Now(), oldPath+"/%",
+	).Error
+}
+
+func toModel(category *entities.Category) *CategoryModel {
+	return &CategoryModel{
+		ID:        category.ID,
+		ParentID:  category.ParentID,
+		Name:      category.Name,
+		Slug:      category.Slug,
+		Path:      category.Path,
+		Depth:     category.Depth,
+		CreatedAt: category.CreatedAt,
+		UpdatedAt: category.UpdatedAt,
+
+		SKUPattern:  category.SKUPattern,
+		SKUTemplate: category.SKUTemplate,
+	}
+}
+
+func toEntity(model *CategoryModel) *entities.Category {
+	return &entities.Category{
+		ID:        model.ID,
+		ParentID:  model.ParentID,
+		Name:      model.Name,
+		Slug:      model.Slug,
+		Path:      model.Path,
+		Depth:     model.Depth,
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+
+		SKUPattern:  model.SKUPattern,
+		SKUTemplate: model.SKUTemplate,
+	}
+}
+
+func toEntities(models []CategoryModel) []*entities.Category {
+	categories := make([]*entities.Category, 0, len(models))
+	for i := range models {
+		categories = append(categories, toEntity(&models[i]))
+	}
+	return categories
+}
+
+// handleError converts GORM errors to domain errors
+func handleError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var domainErr *domainErrors.DomainError
+	if errors.As(err, &domainErr) {
+		return err
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domainErrors.ErrCategoryNotFound
+	}
+
+	if errors.Is(err, gorm.ErrDuplicatedKey) || strings.Contains(err.Error(), "duplicate key") {
+		return domainErrors.ErrCategoryAlreadyExists
+	}
+
+	return err
+}
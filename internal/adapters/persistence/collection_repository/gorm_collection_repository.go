@@ -0,0 +1,221 @@
+package collection_repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+
+	"gorm.io/gorm"
+)
+
+// CollectionModel represents the database model for collections
+type CollectionModel struct {
+	ID          uint                      `gorm:"primarykey"`
+	Name        string                    `gorm:"not null;size:100"`
+	Slug        string                    `gorm:"uniqueIndex;not null;size:100"`
+	Description string                    `gorm:"size:1000"`
+	Type        string                    `gorm:"not null;size:20"`
+	Rules       []entities.CollectionRule `gorm:"not null;default:'[]';type:jsonb;serializer:json"`
+	CreatedAt   time.Time                 `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time                 `gorm:"autoUpdateTime"`
+}
+
+// TableName specifies the table name for GORM
+func (CollectionModel) TableName() string {
+	return "collections"
+}
+
+// CollectionProductModel places a product in a manual collection
+type CollectionProductModel struct {
+	CollectionID uint `gorm:"primaryKey"`
+	ProductID    uint `gorm:"primaryKey;index"`
+	Position     int  `gorm:"not null;default:0"`
+}
+
+// TableName specifies the table name for GORM
+func (CollectionProductModel) TableName() string {
+	return "collection_products"
+}
+
+// GormCollectionRepository implements the CollectionRepository interface using GORM
+type GormCollectionRepository struct {
+	db *gorm.DB
+}
+
+// NewGormCollectionRepository creates a new GORM collection repository
+func NewGormCollectionRepository(db *gorm.DB) ports.CollectionRepository {
+	return &GormCollectionRepository{db: db}
+}
+
+// Create implements ports.CollectionRepository
+func (r *GormCollectionRepository) Create(ctx context.Context, collection *entities.Collection) (*entities.Collection, error) {
+	model := toModel(collection)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(model).Error; err != nil {
+			return err
+		}
+		return replaceProducts(tx, model.ID, collection.ProductIDs)
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return r.GetByID(ctx, model.ID)
+}
+
+// GetByID implements ports.CollectionRepository
+func (r *GormCollectionRepository) GetByID(ctx context.Context, id uint) (*entities.Collection, error) {
+	return r.get(ctx, "id = ?", id)
+}
+
+// GetBySlug implements ports.CollectionRepository
+func (r *GormCollectionRepository) GetBySlug(ctx context.Context, slug string) (*entities.Collection, error) {
+	return r.get(ctx, "slug = ?", slug)
+}
+
+// List implements ports.CollectionRepository
+func (r *GormCollectionRepository) List(ctx context.Context) ([]*entities.Collection, error) {
+	var models []*CollectionModel
+
+	if err := r.db.WithContext(ctx).Order("name ASC").Find(&models).Error; err != nil {
+		return nil, handleError(err)
+	}
+
+	collections := make([]*entities.Collection, 0, len(models))
+	for _, model := range models {
+		collections = append(collections, toEntity(model))
+	}
+	return collections, nil
+}
+
+// Update implements ports.CollectionRepository
+func (r *GormCollectionRepository) Update(ctx context.Context, collection *entities.Collection) (*entities.Collection, error) {
+	model := toModel(collection)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&CollectionModel{ID: collection.ID}).
+			Select("name", "slug", "description", "rules", "updated_at").
+			Updates(model)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domainErrors.ErrCollectionNotFound
+		}
+		return replaceProducts(tx, collection.ID, collection.ProductIDs)
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return r.GetByID(ctx, collection.ID)
+}
+
+// Delete implements ports.CollectionRepository; product placements cascade
+func (r *GormCollectionRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&CollectionModel{}, id)
+	if result.Error != nil {
+		return handleError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domainErrors.ErrCollectionNotFound
+	}
+	return nil
+}
+
+func (r *GormCollectionRepository) get(ctx context.Context, condition string, value interface{}) (*entities.Collection, error) {
+	var model CollectionModel
+
+	if err := r.db.WithContext(ctx).Where(condition, value).First(&model).Error; err != nil {
+		return nil, handleError(err)
+	}
+
+	collection := toEntity(&model)
+	err := r.db.WithContext(ctx).Model(&CollectionProductModel{}).
+		Where("collection_id = ?", model.ID).
+		Order("position ASC").
+		Pluck("product_id", &collection.ProductIDs).Error
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return collection, nil
+}
+
+// replaceProducts rewrites the product order of a manual collection
+func replaceProducts(tx *gorm.DB, collectionID uint, productIDs []uint) error {
+	if err := tx.Where("collection_id = ?", collectionID).Delete(&CollectionProductModel{}).Error; err != nil {
+		return err
+	}
+	if len(productIDs) == 0 {
+		return nil
+	}
+
+	models := make([]*CollectionProductModel, 0, len(productIDs))
+	for position, productID := range productIDs {
+		models = append(models, &CollectionProductModel{CollectionID: collectionID, ProductID: productID, Position: position})
+	}
+	return tx.Create(&models).Error
+}
+
+func toModel(collection *entities.Collection) *CollectionModel {
+	rules := collection.Rules
+	if rules == nil {
+		rules = []entities.CollectionRule{}
+	}
+
+	return &CollectionModel{
+		ID:          collection.ID,
+		Name:        collection.Name,
+		Slug:        collection.Slug,
+		Description: collection.Description,
+		Type:        string(collection.Type),
+		Rules:       rules,
+		CreatedAt:   collection.CreatedAt,
+		UpdatedAt:   collection.UpdatedAt,
+	}
+}
+
+func toEntity(model *CollectionModel) *entities.Collection {
+	collection := &entities.Collection{
+		ID:          model.ID,
+		Name:        model.Name,
+		Slug:        model.Slug,
+		Description: model.Description,
+		Type:        entities.CollectionType(model.Type),
+		ProductIDs:  []uint{},
+		CreatedAt:   model.CreatedAt,
+		UpdatedAt:   model.UpdatedAt,
+	}
+	if len(model.Rules) > 0 {
+		collection.Rules = model.Rules
+	}
+	return collection
+}
+
+// handleError converts GORM errors to domain errors
+func handleError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var domainErr *domainErrors.DomainError
+	if errors.As(err, &domainErr) {
+		return err
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domainErrors.ErrCollectionNotFound
+	}
+
+	if errors.Is(err, gorm.ErrDuplicatedKey) || strings.Contains(err.Error(), "duplicate key") {
+		return domainErrors.ErrCollectionAlreadyExists
+	}
+
+	return err
+}
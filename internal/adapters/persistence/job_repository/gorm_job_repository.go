@@ -0,0 +1,309 @@
+package job_repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// lostWorkerError is recorded on jobs whose worker stopped renewing its
+// lease during their last attempt
+const lostWorkerError = "the worker running the job stopped responding"
+
+// JobModel represents the database model for background jobs
+type JobModel struct {
+	ID              uint            `gorm:"primarykey"`
+	Type            string          `gorm:"not null;size:50"`
+	Status          string          `gorm:"not null;size:20;index"`
+	Payload         json.RawMessage `gorm:"type:jsonb;serializer:json"`
+	Result          json.RawMessage `gorm:"type:jsonb;serializer:json"`
+	Error           string          `gorm:"type:text"`
+	Progress        int64           `gorm:"not null;default:0"`
+	Total           int64           `gorm:"not null;default:0"`
+	Attempts        int             `gorm:"not null;default:0"`
+	MaxAttempts     int             `gorm:"not null;default:1"`
+	CancelRequested bool            `gorm:"not null;default:false"`
+	Worker          string          `gorm:"size:255"`
+	LeaseExpiresAt  *time.Time      `gorm:""`
+	RunAt           time.Time       `gorm:"not null"`
+	Actor           string          `gorm:"size:255"`
+	RequestID       string          `gorm:"size:100"`
+	CreatedAt       time.Time       `gorm:"not null"`
+	StartedAt       *time.Time      `gorm:""`
+	FinishedAt      *time.Time      `gorm:""`
+	UpdatedAt       time.Time       `gorm:"not null"`
+}
+
+// TableName specifies the table name for GORM
+func (JobModel) TableName() string {
+	return "jobs"
+}
+
+// GormJobRepository implements the JobRepository interface using GORM
+type GormJobRepository struct {
+	db *gorm.DB
+}
+
+// NewGormJobRepository creates a new GORM job repository
+func NewGormJobRepository(db *gorm.DB) ports.JobRepository {
+	return &GormJobRepository{db: db}
+}
+
+// Create implements ports.JobRepository
+func (r *GormJobRepository) Create(ctx context.Context, job *entities.Job) (*entities.Job, error) {
+	model := toModel(job)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return nil, err
+	}
+
+	return toEntity(model), nil
+}
+
+// GetByID implements ports.JobRepository
+func (r *GormJobRepository) GetByID(ctx context.Context, id uint) (*entities.Job, error) {
+	var model JobModel
+
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, domainErrors.ErrJobNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toEntity(&model), nil
+}
+
+// List implements ports.JobRepository
+func (r *GormJobRepository) List(ctx context.Context, criteria ports.JobCriteria) ([]*entities.Job, int64, error) {
+	query := r.db.WithContext(ctx).Model(&JobModel{})
+
+	if criteria.Type != "" {
+		query = query.Where("type = ?", string(criteria.Type))
+	}
+	if criteria.Status != "" {
+		query = query.Where("status = ?", string(criteria.Status))
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var models []JobModel
+	err := query.
+		Limit(criteria.Limit).
+		Offset(criteria.Offset).
+		Order("created_at DESC, id DESC").
+		Find(&models).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	jobs := make([]*entities.Job, 0, len(models))
+	for i := range models {
+		jobs = append(jobs, toEntity(&models[i]))
+	}
+	return jobs, total, nil
+}
+
+// Update implements ports.JobRepository
+func (r *GormJobRepository) Update(ctx context.Context, id uint, fn ports.JobUpdateFunc) (*entities.Job, error) {
+	var updated *entities.Job
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var model JobModel
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&model).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domainErrors.ErrJobNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		job := toEntity(&model)
+		if err := fn(job); err != nil {
+			return err
+		}
+		if err := tx.Save(toModel(job)).Error; err != nil {
+			return err
+		}
+		updated = job
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// Claim implements ports.JobRepository. Jobs whose lease expired on their
+// last attempt, or whose cancellation was requested, are finished along the
+// way and the search goes on.
+func (r *GormJobRepository) Claim(ctx context.Context, types []entities.JobType, worker string, lease time.Duration, now time.Time) (*entities.Job, error) {
+	if len(types) == 0 {
+		return nil, nil
+	}
+	names := make([]string, len(types))
+	for i, jobType := range types {
+		names[i] = string(jobType)
+	}
+
+	for {
+		var claimed *entities.Job
+		found := false
+
+		err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			var model JobModel
+			result := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+				Where("type IN ?", names).
+				Where("(status = ? AND run_at <= ?) OR (status = ? AND lease_expires_at <= ?)",
+					string(entities.JobQueued), now, string(entities.JobRunning), now).
+				Order("id").
+				Limit(1).
+				Find(&model)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return nil
+			}
+			found = true
+
+			job := toEntity(&model)
+			switch {
+			case job.Status == entities.JobRunning && job.CancelRequested:
+				job.MarkCancelled(now)
+			case job.Status == entities.JobRunning && !job.CanRetry():
+				job.Fail(lostWorkerError, 0, now)
+			default:
+				job.Start(worker, now.Add(lease), now)
+				claimed = job
+			}
+			return tx.Save(toModel(job)).Error
+		})
+		if err != nil {
+			return nil, err
+		}
+		if claimed != nil || !found {
+			return claimed, nil
+		}
+	}
+}
+
+// Heartbeat implements ports.JobRepository
+func (r *GormJobRepository) Heartbeat(ctx context.Context, job *entities.Job, lease time.Duration, now time.Time) (bool, error) {
+	held := false
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var model JobModel
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", job.ID).First(&model).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if model.Status != string(entities.JobRunning) || model.Worker != job.Worker {
+			return nil
+		}
+		held = true
+
+		leaseExpiresAt := now.Add(lease)
+		err = tx.Model(&model).Updates(map[string]interface{}{
+			"progress":         job.Progress,
+			"total":            job.Total,
+			"lease_expires_at": leaseExpiresAt,
+			"updated_at":       now,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		job.LeaseExpiresAt = &leaseExpiresAt
+		job.CancelRequested = model.CancelRequested
+		job.UpdatedAt = now
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return held, nil
+}
+
+// Finish implements ports.JobRepository. A cancellation requested since the
+// last heartbeat is kept rather than overwritten.
+func (r *GormJobRepository) Finish(ctx context.Context, job *entities.Job, worker string) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&JobModel{}).
+		Where("id = ? AND status = ? AND worker = ?", job.ID, string(entities.JobRunning), worker).
+		Select("*").
+		Omit("id", "cancel_requested").
+		Updates(toModel(job))
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func toModel(job *entities.Job) *JobModel {
+	return &JobModel{
+		ID:              job.ID,
+		Type:            string(job.Type),
+		Status:          string(job.Status),
+		Payload:         job.Payload,
+		Result:          job.Result,
+		Error:           job.Error,
+		Progress:        job.Progress,
+		Total:           job.Total,
+		Attempts:        job.Attempts,
+		MaxAttempts:     job.MaxAttempts,
+		CancelRequested: job.CancelRequested,
+		Worker:          job.Worker,
+		LeaseExpiresAt:  job.LeaseExpiresAt,
+		RunAt:           job.RunAt,
+		Actor:           job.Actor,
+		RequestID:       job.RequestID,
+		CreatedAt:       job.CreatedAt,
+		StartedAt:       job.StartedAt,
+		FinishedAt:      job.FinishedAt,
+		UpdatedAt:       job.UpdatedAt,
+	}
+}
+
+func toEntity(model *JobModel) *entities.Job {
+	return &entities.Job{
+		ID:              model.ID,
+		Type:            entities.JobType(model.Type),
+		Status:          entities.JobStatus(model.Status),
+		Payload:         model.Payload,
+		Result:          model.Result,
+		Error:           model.Error,
+		Progress:        model.Progress,
+		Total:           model.Total,
+		Attempts:        model.Attempts,
+		MaxAttempts:     model.MaxAttempts,
+		CancelRequested: model.CancelRequested,
+		Worker:          model.Worker,
+		LeaseExpiresAt:  model.LeaseExpiresAt,
+		RunAt:           model.RunAt,
+		Actor:           model.Actor,
+		RequestID:       model.RequestID,
+		CreatedAt:       model.CreatedAt,
+		StartedAt:       model.StartedAt,
+		FinishedAt:      model.FinishedAt,
+		UpdatedAt:       model.UpdatedAt,
+	}
+}
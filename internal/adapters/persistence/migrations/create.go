@@ -0,0 +1,56 @@
+package migrations
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var nonIdentifier = regexp.MustCompile(`[^a-z0-9]+`)
+
+// Create writes an empty up/down pair in dir using the next free version
+// number and returns the paths of the new files
+func Create(dir, name string) (string, string, error) {
+	name = strings.Trim(nonIdentifier.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if name == "" {
+		return "", "", fmt.Errorf("migration name is required")
+	}
+
+	existing, err := Load(os.DirFS(dir))
+	if err != nil {
+		return "", "", err
+	}
+
+	next := Migration{Version: 1, Name: name}
+	if len(existing) > 0 {
+		next.Version = existing[len(existing)-1].Version + 1
+	}
+
+	upPath := filepath.Join(dir, next.ID()+".up.sql")
+	downPath := filepath.Join(dir, next.ID()+".down.sql")
+
+	header := fmt.Sprintf("-- %s\n", next.ID())
+	if err := writeNewFile(upPath, header+"\n"); err != nil {
+		return "", "", err
+	}
+	if err := writeNewFile(downPath, header+"\n"); err != nil {
+		return "", "", err
+	}
+
+	return upPath, downPath, nil
+}
+
+func writeNewFile(path, content string) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	defer file.Close()
+
+	if _, err := file.WriteString(content); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
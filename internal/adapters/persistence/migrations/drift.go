@@ -0,0 +1,196 @@
+package migrations
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// DriftKind classifies a difference between a GORM model and the database
+type DriftKind string
+
+const (
+	DriftMissingTable     DriftKind = "missing_table"
+	DriftMissingColumn    DriftKind = "missing_column"
+	DriftUnexpectedColumn DriftKind = "unexpected_column"
+	DriftTypeMismatch     DriftKind = "type_mismatch"
+	DriftNullableMismatch DriftKind = "nullable_mismatch"
+)
+
+// Drift is a single difference between a model and the live schema
+type Drift struct {
+	Table    string
+	Column   string
+	Kind     DriftKind
+	Expected string
+	Actual   string
+}
+
+func (d Drift) String() string {
+	switch d.Kind {
+	case DriftMissingTable:
+		return fmt.Sprintf("table %s is missing", d.Table)
+	case DriftMissingColumn:
+		return fmt.Sprintf("column %s.%s is missing (expected %s)", d.Table, d.Column, d.Expected)
+	case DriftUnexpectedColumn:
+		return fmt.Sprintf("column %s.%s exists in the database but not in the model", d.Table, d.Column)
+	default:
+		return fmt.Sprintf("column %s.%s: %s expected %s, found %s", d.Table, d.Column, d.Kind, d.Expected, d.Actual)
+	}
+}
+
+// CheckDrift compares the schema GORM derives from models with the tables in
+// the database. An empty result means the migrations and the models agree.
+func CheckDrift(db *gorm.DB, models ...interface{}) ([]Drift, error) {
+	var drifts []Drift
+
+	for _, model := range models {
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(model); err != nil {
+			return nil, fmt.Errorf("failed to parse model %T: %w", model, err)
+		}
+		table := stmt.Schema.Table
+
+		if !db.Migrator().HasTable(model) {
+			drifts = append(drifts, Drift{Table: table, Kind: DriftMissingTable})
+			continue
+		}
+
+		columnTypes, err := db.Migrator().ColumnTypes(model)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read columns of %s: %w", table, err)
+		}
+
+		actualColumns := make(map[string]gorm.ColumnType, len(columnTypes))
+		for _, columnType := range columnTypes {
+			actualColumns[columnType.Name()] = columnType
+		}
+
+		expectedColumns := make(map[string]bool)
+		for _, field := range stmt.Schema.Fields {
+			if field.DBName == "" || field.IgnoreMigration {
+				continue
+			}
+			expectedColumns[field.DBName] = true
+
+			expectedType := normalizeType(db.Dialector.DataTypeOf(field))
+			columnType, ok := actualColumns[field.DBName]
+			if !ok {
+				drifts = append(drifts, Drift{Table: table, Column: field.DBName, Kind: DriftMissingColumn, Expected: expectedType})
+				continue
+			}
+
+			actualType := describeColumnType(columnType)
+			if !typesMatch(expectedType, actualType) {
+				drifts = append(drifts, Drift{Table: table, Column: field.DBName, Kind: DriftTypeMismatch, Expected: expectedType, Actual: actualType})
+			}
+
+			expectNotNull := field.NotNull || field.PrimaryKey
+			if nullable, ok := columnType.Nullable(); ok && nullable == expectNotNull {
+				drifts = append(drifts, Drift{
+					Table:    table,
+					Column:   field.DBName,
+					Kind:     DriftNullableMismatch,
+					Expected: nullability(!expectNotNull),
+					Actual:   nullability(nullable),
+				})
+			}
+		}
+
+		var unexpected []string
+		for name := range actualColumns {
+			if !expectedColumns[name] {
+				unexpected = append(unexpected, name)
+			}
+		}
+		sort.Strings(unexpected)
+		for _, name := range unexpected {
+			drifts = append(drifts, Drift{Table: table, Column: name, Kind: DriftUnexpectedColumn})
+		}
+	}
+
+	return drifts, nil
+}
+
+func describeColumnType(columnType gorm.ColumnType) string {
+	name := normalizeType(columnType.DatabaseTypeName())
+	switch name {
+	case "varchar", "char":
+		if length, ok := columnType.Length(); ok && length > 0 {
+			return fmt.Sprintf("%s(%d)", name, length)
+		}
+	case "numeric":
+		if precision, scale, ok := columnType.DecimalSize(); ok && precision > 0 {
+			return fmt.Sprintf("numeric(%d,%d)", precision, scale)
+		}
+	}
+	return name
+}
+
+// typesMatch compares normalized types; a bare type on either side (e.g.
+// "varchar" without a length) matches any size of the same family
+func typesMatch(expected, actual string) bool {
+	if expected == actual {
+		return true
+	}
+	return baseType(expected) == baseType(actual) &&
+		(!strings.Contains(expected, "(") || !strings.Contains(actual, "("))
+}
+
+func baseType(t string) string {
+	if i := strings.Index(t, "("); i >= 0 {
+		return t[:i]
+	}
+	return t
+}
+
+var typeSize = regexp.MustCompile(`\s*\(\s*(\d+)\s*(?:,\s*(\d+)\s*)?\)`)
+
+// normalizeType maps the spellings used by GORM tags, the postgres dialector
+// and information_schema onto one canonical form
+func normalizeType(t string) string {
+	t = strings.ToLower(strings.TrimSpace(t))
+
+	size := ""
+	if match := typeSize.FindStringSubmatch(t); match != nil {
+		size = "(" + match[1]
+		if match[2] != "" {
+			size += "," + match[2]
+		}
+		size += ")"
+		t = strings.TrimSpace(typeSize.ReplaceAllString(t, ""))
+	}
+
+	aliases := map[string]string{
+		"bigserial":                   "int8",
+		"bigint":                      "int8",
+		"serial":                      "int4",
+		"integer":                     "int4",
+		"int":                         "int4",
+		"smallserial":                 "int2",
+		"smallint":                    "int2",
+		"character varying":           "varchar",
+		"character":                   "char",
+		"decimal":                     "numeric",
+		"boolean":                     "bool",
+		"timestamp with time zone":    "timestamptz",
+		"timestamp without time zone": "timestamp",
+		"double precision":            "float8",
+		"real":                        "float4",
+	}
+	if alias, ok := aliases[t]; ok {
+		t = alias
+	}
+
+	return t + size
+}
+
+func nullability(nullable bool) string {
+	if nullable {
+		return "NULL"
+	}
+	return "NOT NULL"
+}
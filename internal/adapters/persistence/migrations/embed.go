@@ -0,0 +1,23 @@
+package migrations
+
+import (
+	"embed"
+	"io/fs"
+)
+
+// DefaultDir is where `migration create` writes new files, relative to the
+// repository root. Files in it are embedded into the binary at build time.
+const DefaultDir = "internal/adapters/persistence/migrations/sql"
+
+//go:embed sql/*.sql
+var embedded embed.FS
+
+// Source returns the migrations bundled with the binary
+func Source() fs.FS {
+	sub, err := fs.Sub(embedded, "sql")
+	if err != nil {
+		// The embed pattern guarantees the directory exists
+		panic(err)
+	}
+	return sub
+}
@@ -0,0 +1,185 @@
+package migrations
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad_OrdersByVersion(t *testing.T) {
+	fsys := fstest.MapFS{
+		"0002_add_index.up.sql":      {Data: []byte("CREATE INDEX a ON t (a);")},
+		"0002_add_index.down.sql":    {Data: []byte("DROP INDEX a;")},
+		"0001_create_table.up.sql":   {Data: []byte("CREATE TABLE t (a INT);")},
+		"0001_create_table.down.sql": {Data: []byte("DROP TABLE t;")},
+		"0010_no_rollback.up.sql":    {Data: []byte("SELECT 1;")},
+		"README.md":                  {Data: []byte("ignored")},
+	}
+
+	migrations, err := Load(fsys)
+
+	require.NoError(t, err)
+	require.Len(t, migrations, 3)
+	assert.Equal(t, int64(1), migrations[0].Version)
+	assert.Equal(t, "create_table", migrations[0].Name)
+	assert.Equal(t, "DROP TABLE t;", migrations[0].DownSQL)
+	assert.Equal(t, int64(2), migrations[1].Version)
+	assert.Equal(t, int64(10), migrations[2].Version)
+	assert.Empty(t, migrations[2].DownSQL)
+	assert.Len(t, migrations[0].Checksum, 64)
+	assert.NotEqual(t, migrations[0].Checksum, migrations[1].Checksum)
+}
+
+func TestLoad_InvalidFiles(t *testing.T) {
+	tests := []struct {
+		name string
+		fsys fstest.MapFS
+	}{
+		{
+			name: "bad filename",
+			fsys: fstest.MapFS{"create_table.up.sql": {Data: []byte("SELECT 1;")}},
+		},
+		{
+			name: "down without up",
+			fsys: fstest.MapFS{"0001_create_table.down.sql": {Data: []byte("SELECT 1;")}},
+		},
+		{
+			name: "duplicate version",
+			fsys: fstest.MapFS{
+				"0001_a.up.sql": {Data: []byte("SELECT 1;")},
+				"0001_b.up.sql": {Data: []byte("SELECT 2;")},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(tt.fsys)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestLoad_EmbeddedSource(t *testing.T) {
+	migrations, err := Load(Source())
+
+	require.NoError(t, err)
+	require.NotEmpty(t, migrations)
+	assert.Equal(t, "create_products", migrations[0].Name)
+	for _, migration := range migrations {
+		assert.NotEmpty(t, migration.DownSQL, "migration %s has no down file", migration.ID())
+	}
+}
+
+func TestMigration_NoTransaction(t *testing.T) {
+	assert.True(t, Migration{UpSQL: "-- migrate:no-transaction\nCREATE INDEX CONCURRENTLY a ON t (a);"}.NoTransaction())
+	assert.False(t, Migration{UpSQL: "CREATE INDEX a ON t (a);"}.NoTransaction())
+}
+
+func TestPendingMigrations(t *testing.T) {
+	source := []Migration{
+		{Version: 1, Name: "one", Checksum: "c1"},
+		{Version: 2, Name: "two", Checksum: "c2"},
+		{Version: 3, Name: "three", Checksum: "c3"},
+	}
+
+	t.Run("returns unapplied migrations", func(t *testing.T) {
+		pending, err := pendingMigrations(source, []AppliedMigration{{Version: 1, Checksum: "c1"}})
+
+		require.NoError(t, err)
+		require.Len(t, pending, 2)
+		assert.Equal(t, int64(2), pending[0].Version)
+		assert.Equal(t, int64(3), pending[1].Version)
+	})
+
+	t.Run("rejects modified applied migration", func(t *testing.T) {
+		_, err := pendingMigrations(source, []AppliedMigration{{Version: 1, Checksum: "changed"}})
+
+		assert.ErrorContains(t, err, "checksum mismatch")
+	})
+
+	t.Run("rejects migration older than latest applied", func(t *testing.T) {
+		_, err := pendingMigrations(source, []AppliedMigration{
+			{Version: 1, Checksum: "c1"},
+			{Version: 3, Checksum: "c3"},
+		})
+
+		assert.ErrorContains(t, err, "older than the latest applied")
+	})
+}
+
+func TestBuildStatus(t *testing.T) {
+	appliedAt := time.Now()
+	source := []Migration{
+		{Version: 1, Name: "one", Checksum: "c1"},
+		{Version: 2, Name: "two", Checksum: "c2"},
+		{Version: 3, Name: "three", Checksum: "c3"},
+	}
+	applied := []AppliedMigration{
+		{Version: 1, Name: "one", Checksum: "c1", AppliedAt: appliedAt},
+		{Version: 2, Name: "two", Checksum: "other", AppliedAt: appliedAt},
+		{Version: 4, Name: "gone", Checksum: "c4", AppliedAt: appliedAt},
+	}
+
+	statuses := buildStatus(source, applied)
+
+	require.Len(t, statuses, 4)
+	assert.True(t, statuses[0].Applied)
+	assert.False(t, statuses[0].Modified)
+	assert.True(t, statuses[1].Modified)
+	assert.False(t, statuses[2].Applied)
+	assert.True(t, statuses[3].Missing)
+	assert.Equal(t, "gone", statuses[3].Name)
+}
+
+func TestCreate(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "0001_create_table.up.sql"), []byte("SELECT 1;"), 0o644))
+
+	upPath, downPath, err := Create(dir, "Add Product Barcodes")
+
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "0002_add_product_barcodes.up.sql"), upPath)
+	assert.Equal(t, filepath.Join(dir, "0002_add_product_barcodes.down.sql"), downPath)
+	assert.FileExists(t, upPath)
+	assert.FileExists(t, downPath)
+
+	_, _, err = Create(dir, "!!!")
+	assert.Error(t, err)
+}
+
+func TestNormalizeType(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"bigserial", "int8"},
+		{"bigint", "int8"},
+		{"int8", "int8"},
+		{"varchar(255)", "varchar(255)"},
+		{"character varying", "varchar"},
+		{"decimal(10,2)", "numeric(10,2)"},
+		{"DECIMAL(10, 2)", "numeric(10,2)"},
+		{"timestamp with time zone", "timestamptz"},
+		{"boolean", "bool"},
+		{"text", "text"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expected, normalizeType(tt.input))
+		})
+	}
+}
+
+func TestTypesMatch(t *testing.T) {
+	assert.True(t, typesMatch("varchar(255)", "varchar(255)"))
+	assert.True(t, typesMatch("varchar", "varchar(100)"))
+	assert.False(t, typesMatch("varchar(255)", "varchar(100)"))
+	assert.False(t, typesMatch("numeric(10,2)", "int8"))
+}
@@ -0,0 +1,347 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"product-service/pkg/logger"
+)
+
+// advisoryLockKey serializes migrations across every process sharing the
+// database. The value is arbitrary but must never change.
+const advisoryLockKey int64 = 7_355_608_211
+
+const createSchemaMigrationsTable = `
+CREATE TABLE IF NOT EXISTS schema_migrations (
+	version      BIGINT PRIMARY KEY,
+	name         VARCHAR(255) NOT NULL,
+	checksum     CHAR(64) NOT NULL,
+	execution_ms BIGINT NOT NULL DEFAULT 0,
+	applied_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+)`
+
+// AppliedMigration is a row of schema_migrations
+type AppliedMigration struct {
+	Version   int64
+	Name      string
+	Checksum  string
+	AppliedAt time.Time
+}
+
+// Status describes one migration known to the source, the database, or both
+type Status struct {
+	Version   int64
+	Name      string
+	Applied   bool
+	AppliedAt *time.Time
+	// Modified is set when the applied checksum differs from the source file
+	Modified bool
+	// Missing is set when the migration was applied but its file is gone
+	Missing bool
+}
+
+// Migrator applies and rolls back versioned SQL migrations
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+	logger     logger.Logger
+}
+
+// NewMigrator creates a migrator for the given source migrations
+func NewMigrator(db *sql.DB, migrations []Migration, log logger.Logger) *Migrator {
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+		logger:     log.With("component", "migrator"),
+	}
+}
+
+// Up applies every pending migration in version order and returns the ones
+// that ran. With dryRun set nothing is executed and the pending migrations are
+// returned as they would be applied.
+func (m *Migrator) Up(ctx context.Context, dryRun bool) ([]Migration, error) {
+	var applied []Migration
+
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		pending, err := m.pending(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		if dryRun {
+			applied = pending
+			return nil
+		}
+
+		for _, migration := range pending {
+			if err := m.apply(ctx, conn, migration); err != nil {
+				return err
+			}
+			applied = append(applied, migration)
+		}
+		return nil
+	})
+
+	return applied, err
+}
+
+// Down rolls back the last steps applied migrations in reverse order and
+// returns the ones that were (or, with dryRun, would be) rolled back
+func (m *Migrator) Down(ctx context.Context, steps int, dryRun bool) ([]Migration, error) {
+	if steps < 1 {
+		return nil, fmt.Errorf("steps must be at least 1")
+	}
+
+	var rolledBack []Migration
+
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		bySource := m.byVersion()
+		for i := len(applied) - 1; i >= 0 && len(rolledBack) < steps; i-- {
+			migration, ok := bySource[applied[i].Version]
+			if !ok {
+				return fmt.Errorf("cannot roll back migration %d (%s): source file is missing", applied[i].Version, applied[i].Name)
+			}
+			if migration.DownSQL == "" {
+				return fmt.Errorf("cannot roll back migration %s: no down file", migration.ID())
+			}
+
+			if !dryRun {
+				if err := m.revert(ctx, conn, migration); err != nil {
+					return err
+				}
+			}
+			rolledBack = append(rolledBack, migration)
+		}
+		return nil
+	})
+
+	return rolledBack, err
+}
+
+// Status returns every migration from the source and the database in version
+// order
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, createSchemaMigrationsTable); err != nil {
+		return nil, fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+
+	applied, err := m.applied(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildStatus(m.migrations, applied), nil
+}
+
+func buildStatus(source []Migration, applied []AppliedMigration) []Status {
+	appliedByVersion := make(map[int64]AppliedMigration, len(applied))
+	for _, row := range applied {
+		appliedByVersion[row.Version] = row
+	}
+
+	statuses := make([]Status, 0, len(source))
+	seen := make(map[int64]bool, len(source))
+	for _, migration := range source {
+		seen[migration.Version] = true
+		status := Status{Version: migration.Version, Name: migration.Name}
+		if row, ok := appliedByVersion[migration.Version]; ok {
+			appliedAt := row.AppliedAt
+			status.Applied = true
+			status.AppliedAt = &appliedAt
+			status.Modified = row.Checksum != migration.Checksum
+		}
+		statuses = append(statuses, status)
+	}
+
+	for _, row := range applied {
+		if seen[row.Version] {
+			continue
+		}
+		appliedAt := row.AppliedAt
+		statuses = append(statuses, Status{
+			Version:   row.Version,
+			Name:      row.Name,
+			Applied:   true,
+			AppliedAt: &appliedAt,
+			Missing:   true,
+		})
+	}
+
+	sortStatuses(statuses)
+	return statuses
+}
+
+// pending verifies the already applied migrations against the source and
+// returns the ones still to run
+func (m *Migrator) pending(ctx context.Context, conn *sql.Conn) ([]Migration, error) {
+	applied, err := m.applied(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return pendingMigrations(m.migrations, applied)
+}
+
+func pendingMigrations(source []Migration, applied []AppliedMigration) ([]Migration, error) {
+	appliedByVersion := make(map[int64]AppliedMigration, len(applied))
+	var latest int64
+	for _, row := range applied {
+		appliedByVersion[row.Version] = row
+		if row.Version > latest {
+			latest = row.Version
+		}
+	}
+
+	var pending []Migration
+	for _, migration := range source {
+		row, ok := appliedByVersion[migration.Version]
+		if !ok {
+			if migration.Version < latest {
+				return nil, fmt.Errorf("migration %s is older than the latest applied version %d; renumber it", migration.ID(), latest)
+			}
+			pending = append(pending, migration)
+			continue
+		}
+		if row.Checksum != migration.Checksum {
+			return nil, fmt.Errorf("checksum mismatch for applied migration %s: the file was modified after it ran", migration.ID())
+		}
+	}
+
+	return pending, nil
+}
+
+func (m *Migrator) applied(ctx context.Context, conn *sql.Conn) ([]AppliedMigration, error) {
+	rows, err := conn.QueryContext(ctx, `SELECT version, name, checksum, applied_at FROM schema_migrations ORDER BY version`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	defer rows.Close()
+
+	var applied []AppliedMigration
+	for rows.Next() {
+		var row AppliedMigration
+		if err := rows.Scan(&row.Version, &row.Name, &row.Checksum, &row.AppliedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan schema_migrations: %w", err)
+		}
+		applied = append(applied, row)
+	}
+	return applied, rows.Err()
+}
+
+func (m *Migrator) apply(ctx context.Context, conn *sql.Conn, migration Migration) error {
+	m.logger.Info("Applying migration", "version", migration.Version, "name", migration.Name)
+	start := time.Now()
+
+	record := func(exec execer) error {
+		_, err := exec.ExecContext(ctx,
+			`INSERT INTO schema_migrations (version, name, checksum, execution_ms) VALUES ($1, $2, $3, $4)`,
+			migration.Version, migration.Name, migration.Checksum, time.Since(start).Milliseconds())
+		return err
+	}
+
+	if err := m.run(ctx, conn, migration.NoTransaction(), migration.UpSQL, record); err != nil {
+		return fmt.Errorf("migration %s failed: %w", migration.ID(), err)
+	}
+
+	m.logger.Info("Migration applied",
+		"version", migration.Version,
+		"name", migration.Name,
+		"duration", time.Since(start).String())
+	return nil
+}
+
+func (m *Migrator) revert(ctx context.Context, conn *sql.Conn, migration Migration) error {
+	m.logger.Info("Rolling back migration", "version", migration.Version, "name", migration.Name)
+
+	unrecord := func(exec execer) error {
+		_, err := exec.ExecContext(ctx, `DELETE FROM schema_migrations WHERE version = $1`, migration.Version)
+		return err
+	}
+
+	if err := m.run(ctx, conn, migration.NoTransaction(), migration.DownSQL, unrecord); err != nil {
+		return fmt.Errorf("rollback of %s failed: %w", migration.ID(), err)
+	}
+
+	m.logger.Info("Migration rolled back", "version", migration.Version, "name", migration.Name)
+	return nil
+}
+
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// run executes script and then bookkeeping, atomically unless the migration
+// opted out of transactions
+func (m *Migrator) run(ctx context.Context, conn *sql.Conn, noTransaction bool, script string, bookkeeping func(execer) error) error {
+	if noTransaction {
+		if _, err := conn.ExecContext(ctx, script); err != nil {
+			return err
+		}
+		return bookkeeping(conn)
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	if err := bookkeeping(tx); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	return tx.Commit()
+}
+
+// withLock runs fn on a dedicated connection holding the migration advisory
+// lock, so concurrent pods starting at once apply each migration exactly once
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Close()
+
+	m.logger.Debug("Waiting for migration lock", "key", advisoryLockKey)
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, advisoryLockKey); err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	defer func() {
+		// Use a fresh context so the lock is released even if ctx was cancelled
+		unlockCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if _, err := conn.ExecContext(unlockCtx, `SELECT pg_advisory_unlock($1)`, advisoryLockKey); err != nil {
+			m.logger.Error("Failed to release migration lock", "error", err)
+		}
+	}()
+
+	if _, err := conn.ExecContext(ctx, createSchemaMigrationsTable); err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+
+	return fn(conn)
+}
+
+func (m *Migrator) byVersion() map[int64]Migration {
+	byVersion := make(map[int64]Migration, len(m.migrations))
+	for _, migration := range m.migrations {
+		byVersion[migration.Version] = migration
+	}
+	return byVersion
+}
@@ -0,0 +1,2 @@
+-- 0001_create_products
+DROP TABLE IF EXISTS products;
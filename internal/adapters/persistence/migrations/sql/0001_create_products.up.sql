@@ -0,0 +1,20 @@
+-- 0001_create_products
+-- IF NOT EXISTS lets databases previously managed by AutoMigrate adopt this
+-- migration without changes.
+CREATE TABLE IF NOT EXISTS products (
+    id          BIGSERIAL PRIMARY KEY,
+    name        VARCHAR(255) NOT NULL,
+    description VARCHAR(1000),
+    sku         VARCHAR(50) NOT NULL,
+    price       DECIMAL(10,2) NOT NULL,
+    category    VARCHAR(100) NOT NULL,
+    brand       VARCHAR(100),
+    stock       BIGINT NOT NULL DEFAULT 0,
+    status      VARCHAR(20) NOT NULL DEFAULT 'active',
+    created_at  TIMESTAMPTZ,
+    updated_at  TIMESTAMPTZ,
+    deleted_at  TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_products_sku ON products (sku);
+CREATE INDEX IF NOT EXISTS idx_products_deleted_at ON products (deleted_at);
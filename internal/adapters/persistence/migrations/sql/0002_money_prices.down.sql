@@ -0,0 +1,6 @@
+-- 0002_money_prices
+ALTER TABLE products
+    DROP COLUMN IF EXISTS currency;
+
+ALTER TABLE products
+    ALTER COLUMN price TYPE DECIMAL(10,2) USING ROUND(price, 2);
@@ -0,0 +1,8 @@
+-- 0002_money_prices
+-- Store prices as exact decimals with an explicit ISO 4217 currency.
+-- Existing rows keep their amount and are assigned USD.
+ALTER TABLE products
+    ALTER COLUMN price TYPE NUMERIC(18,4);
+
+ALTER TABLE products
+    ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT 'USD';
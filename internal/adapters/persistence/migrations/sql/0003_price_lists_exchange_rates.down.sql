@@ -0,0 +1,4 @@
+-- 0003_price_lists_exchange_rates
+DROP TABLE IF EXISTS exchange_rates;
+DROP TABLE IF EXISTS product_prices;
+DROP TABLE IF EXISTS price_lists;
@@ -0,0 +1,33 @@
+-- 0003_price_lists_exchange_rates
+CREATE TABLE IF NOT EXISTS price_lists (
+    id          BIGSERIAL PRIMARY KEY,
+    code        VARCHAR(50)  NOT NULL,
+    name        VARCHAR(255) NOT NULL,
+    is_default  BOOLEAN      NOT NULL DEFAULT FALSE,
+    created_at  TIMESTAMPTZ,
+    updated_at  TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_price_lists_code ON price_lists (code);
+
+-- At most one default price list
+CREATE UNIQUE INDEX IF NOT EXISTS idx_price_lists_single_default ON price_lists (is_default) WHERE is_default;
+
+CREATE TABLE IF NOT EXISTS product_prices (
+    price_list_id  BIGINT        NOT NULL REFERENCES price_lists (id) ON DELETE CASCADE,
+    product_id     BIGINT        NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    currency       VARCHAR(3)    NOT NULL,
+    amount         NUMERIC(18,4) NOT NULL,
+    updated_at     TIMESTAMPTZ,
+    PRIMARY KEY (price_list_id, product_id, currency)
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_prices_product_id ON product_prices (product_id);
+
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    base_currency   VARCHAR(3)     NOT NULL,
+    quote_currency  VARCHAR(3)     NOT NULL,
+    rate            NUMERIC(24,10) NOT NULL CHECK (rate > 0),
+    updated_at      TIMESTAMPTZ,
+    PRIMARY KEY (base_currency, quote_currency)
+);
@@ -0,0 +1,2 @@
+-- 0004_price_history
+DROP TABLE IF EXISTS price_history;
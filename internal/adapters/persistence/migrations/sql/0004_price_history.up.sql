@@ -0,0 +1,25 @@
+-- 0004_price_history
+CREATE TABLE IF NOT EXISTS price_history (
+    id              BIGSERIAL PRIMARY KEY,
+    product_id      BIGINT        NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    old_amount      NUMERIC(18,4),
+    old_currency    VARCHAR(3),
+    new_amount      NUMERIC(18,4) NOT NULL,
+    new_currency    VARCHAR(3)    NOT NULL,
+    reason          VARCHAR(500),
+    actor           VARCHAR(255),
+    effective_from  TIMESTAMPTZ   NOT NULL,
+    effective_to    TIMESTAMPTZ,
+    status          VARCHAR(20)   NOT NULL,
+    applied_at      TIMESTAMPTZ,
+    ended_at        TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ,
+    CHECK (effective_to IS NULL OR effective_to > effective_from)
+);
+
+CREATE INDEX IF NOT EXISTS idx_price_history_product_id ON price_history (product_id);
+CREATE INDEX IF NOT EXISTS idx_price_history_status ON price_history (status);
+
+-- The scheduler polls for changes that start or whose window ends
+CREATE INDEX IF NOT EXISTS idx_price_history_due_start ON price_history (effective_from) WHERE status = 'scheduled';
+CREATE INDEX IF NOT EXISTS idx_price_history_due_end ON price_history (effective_to) WHERE status = 'applied' AND effective_to IS NOT NULL;
@@ -0,0 +1,3 @@
+-- 0005_promotions
+DROP TABLE IF EXISTS promotion_targets;
+DROP TABLE IF EXISTS promotions;
@@ -0,0 +1,29 @@
+-- 0005_promotions
+CREATE TABLE IF NOT EXISTS promotions (
+    id               BIGSERIAL PRIMARY KEY,
+    name             VARCHAR(255)  NOT NULL,
+    description      TEXT,
+    discount_type    VARCHAR(20)   NOT NULL CHECK (discount_type IN ('percentage', 'fixed_amount')),
+    percentage       NUMERIC(7,4)  CHECK (percentage > 0 AND percentage <= 100),
+    amount           NUMERIC(18,4) CHECK (amount > 0),
+    amount_currency  VARCHAR(3),
+    starts_at        TIMESTAMPTZ,
+    ends_at          TIMESTAMPTZ,
+    priority         INTEGER       NOT NULL DEFAULT 0,
+    stackable        BOOLEAN       NOT NULL DEFAULT FALSE,
+    active           BOOLEAN       NOT NULL DEFAULT TRUE,
+    created_at       TIMESTAMPTZ,
+    updated_at       TIMESTAMPTZ,
+    CHECK (ends_at IS NULL OR starts_at IS NULL OR ends_at > starts_at),
+    CHECK ((discount_type = 'percentage' AND percentage IS NOT NULL)
+        OR (discount_type = 'fixed_amount' AND amount IS NOT NULL AND amount_currency IS NOT NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_promotions_active ON promotions (active);
+
+CREATE TABLE IF NOT EXISTS promotion_targets (
+    promotion_id  BIGINT       NOT NULL REFERENCES promotions (id) ON DELETE CASCADE,
+    kind          VARCHAR(20)  NOT NULL CHECK (kind IN ('category', 'brand', 'sku', 'tag')),
+    value         VARCHAR(100) NOT NULL,
+    PRIMARY KEY (promotion_id, kind, value)
+);
@@ -0,0 +1,3 @@
+-- 0006_product_variants
+DROP TABLE IF EXISTS product_variants;
+DROP TABLE IF EXISTS product_options;
@@ -0,0 +1,28 @@
+-- 0006_product_variants
+CREATE TABLE IF NOT EXISTS product_options (
+    id          BIGSERIAL PRIMARY KEY,
+    product_id  BIGINT       NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    name        VARCHAR(50)  NOT NULL,
+    position    INTEGER      NOT NULL DEFAULT 0,
+    option_values JSONB      NOT NULL,
+    CONSTRAINT idx_product_options_product_name UNIQUE (product_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS product_variants (
+    id                       BIGSERIAL PRIMARY KEY,
+    product_id               BIGINT        NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    sku                      VARCHAR(50)   NOT NULL,
+    options                  JSONB         NOT NULL,
+    option_key               VARCHAR(500)  NOT NULL,
+    price_override           NUMERIC(18,4) CHECK (price_override > 0),
+    price_override_currency  VARCHAR(3),
+    stock                    INTEGER       NOT NULL DEFAULT 0 CHECK (stock >= 0),
+    active                   BOOLEAN       NOT NULL DEFAULT TRUE,
+    created_at               TIMESTAMPTZ,
+    updated_at               TIMESTAMPTZ,
+    CONSTRAINT idx_product_variants_product_options UNIQUE (product_id, option_key),
+    CHECK ((price_override IS NULL) = (price_override_currency IS NULL))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_product_variants_sku ON product_variants (sku);
+CREATE INDEX IF NOT EXISTS idx_product_variants_product_id ON product_variants (product_id);
@@ -0,0 +1,4 @@
+-- 0007_product_attributes
+DROP TABLE IF EXISTS attribute_definitions;
+DROP INDEX IF EXISTS idx_products_attributes;
+ALTER TABLE products DROP COLUMN IF EXISTS attributes;
@@ -0,0 +1,21 @@
+-- 0007_product_attributes
+-- Custom attributes live in a JSONB column on products, validated against
+-- per-category definitions.
+ALTER TABLE products
+    ADD COLUMN IF NOT EXISTS attributes JSONB NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS idx_products_attributes ON products USING GIN (attributes);
+
+CREATE TABLE IF NOT EXISTS attribute_definitions (
+    id              BIGSERIAL PRIMARY KEY,
+    category        VARCHAR(100) NOT NULL,
+    key             VARCHAR(50)  NOT NULL,
+    label           VARCHAR(100) NOT NULL,
+    type            VARCHAR(20)  NOT NULL CHECK (type IN ('string', 'int', 'decimal', 'bool', 'enum', 'unit')),
+    required        BOOLEAN      NOT NULL DEFAULT FALSE,
+    allowed_values  JSONB,
+    unit            VARCHAR(20),
+    created_at      TIMESTAMPTZ,
+    updated_at      TIMESTAMPTZ,
+    CONSTRAINT idx_attribute_definitions_category_key UNIQUE (category, key)
+);
@@ -0,0 +1,27 @@
+-- 0008_categories
+-- Attribute schemas go back to being keyed by category name. Categories in
+-- different branches sharing a name and key cannot be told apart; the first
+-- definition is kept.
+ALTER TABLE attribute_definitions ADD COLUMN IF NOT EXISTS category VARCHAR(100);
+
+UPDATE attribute_definitions d
+SET category = c.name
+FROM categories c
+WHERE c.id = d.category_id;
+
+DELETE FROM attribute_definitions d
+USING attribute_definitions earlier
+WHERE d.category = earlier.category
+  AND d.key = earlier.key
+  AND earlier.id < d.id;
+
+ALTER TABLE attribute_definitions
+    DROP CONSTRAINT IF EXISTS idx_attribute_definitions_category_key,
+    DROP COLUMN category_id,
+    ALTER COLUMN category SET NOT NULL,
+    ADD CONSTRAINT idx_attribute_definitions_category_key UNIQUE (category, key);
+
+DROP INDEX IF EXISTS idx_products_category_id;
+ALTER TABLE products DROP COLUMN IF EXISTS category_id;
+
+DROP TABLE IF EXISTS categories;
@@ -0,0 +1,67 @@
+-- 0008_categories
+-- Categories become a tree. Each node stores the materialized path of slugs
+-- from its root (e.g. electronics/phones), so a subtree is one index range.
+CREATE TABLE IF NOT EXISTS categories (
+    id          BIGSERIAL PRIMARY KEY,
+    parent_id   BIGINT        REFERENCES categories (id),
+    name        VARCHAR(100)  NOT NULL,
+    slug        VARCHAR(100)  NOT NULL,
+    path        VARCHAR(1000) NOT NULL,
+    depth       INTEGER       NOT NULL DEFAULT 0,
+    created_at  TIMESTAMPTZ,
+    updated_at  TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_categories_path ON categories (path);
+CREATE INDEX IF NOT EXISTS idx_categories_path_prefix ON categories (path varchar_pattern_ops);
+CREATE INDEX IF NOT EXISTS idx_categories_parent_id ON categories (parent_id);
+
+-- Existing free-text categories become root categories. Spellings that only
+-- differ in case, spacing or punctuation ("Phones", " phones") share a slug
+-- and collapse into one category named after the most used spelling.
+INSERT INTO categories (name, slug, path, depth, created_at, updated_at)
+SELECT DISTINCT ON (slug) name, slug, slug, 0, NOW(), NOW()
+FROM (
+    SELECT trim(category) AS name,
+           COALESCE(NULLIF(trim(both '-' FROM regexp_replace(lower(trim(category)), '[^a-z0-9]+', '-', 'g')), ''), 'uncategorized') AS slug,
+           COUNT(*) AS uses
+    FROM (
+        SELECT category FROM products
+        UNION ALL
+        SELECT category FROM attribute_definitions
+    ) AS existing
+    GROUP BY 1, 2
+) AS spellings
+ORDER BY slug, uses DESC, name;
+
+ALTER TABLE products ADD COLUMN IF NOT EXISTS category_id BIGINT REFERENCES categories (id);
+
+UPDATE products p
+SET category_id = c.id,
+    category    = c.name
+FROM categories c
+WHERE c.path = COALESCE(NULLIF(trim(both '-' FROM regexp_replace(lower(trim(p.category)), '[^a-z0-9]+', '-', 'g')), ''), 'uncategorized');
+
+ALTER TABLE products ALTER COLUMN category_id SET NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_products_category_id ON products (category_id);
+
+-- Attribute schemas follow their category. When spellings collapse, the
+-- definition created first wins for each key.
+ALTER TABLE attribute_definitions ADD COLUMN IF NOT EXISTS category_id BIGINT REFERENCES categories (id) ON DELETE CASCADE;
+
+UPDATE attribute_definitions d
+SET category_id = c.id
+FROM categories c
+WHERE c.path = COALESCE(NULLIF(trim(both '-' FROM regexp_replace(lower(trim(d.category)), '[^a-z0-9]+', '-', 'g')), ''), 'uncategorized');
+
+DELETE FROM attribute_definitions d
+USING attribute_definitions earlier
+WHERE d.category_id = earlier.category_id
+  AND d.key = earlier.key
+  AND earlier.id < d.id;
+
+ALTER TABLE attribute_definitions
+    DROP CONSTRAINT IF EXISTS idx_attribute_definitions_category_key,
+    DROP COLUMN category,
+    ALTER COLUMN category_id SET NOT NULL,
+    ADD CONSTRAINT idx_attribute_definitions_category_key UNIQUE (category_id, key);
@@ -0,0 +1,7 @@
+-- 0009_brands
+-- Products keep the canonical brand name as free text.
+DROP INDEX IF EXISTS idx_products_brand_id;
+ALTER TABLE products DROP COLUMN IF EXISTS brand_id;
+
+DROP TABLE IF EXISTS brand_names;
+DROP TABLE IF EXISTS brands;
@@ -0,0 +1,59 @@
+-- 0009_brands
+-- Brands become a registry. Every name a brand is known by, canonical or
+-- alias, is stored once in brand_names under its lowercased, single-spaced
+-- form, so lookups ignore case and no two brands can claim the same name.
+CREATE TABLE IF NOT EXISTS brands (
+    id          BIGSERIAL PRIMARY KEY,
+    name        VARCHAR(100) NOT NULL,
+    slug        VARCHAR(100) NOT NULL,
+    logo_url    VARCHAR(500),
+    status      VARCHAR(20)  NOT NULL DEFAULT 'active',
+    created_at  TIMESTAMPTZ,
+    updated_at  TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_brands_slug ON brands (slug);
+CREATE INDEX IF NOT EXISTS idx_brands_status ON brands (status);
+
+CREATE TABLE IF NOT EXISTS brand_names (
+    id          BIGSERIAL PRIMARY KEY,
+    brand_id    BIGINT       NOT NULL REFERENCES brands (id) ON DELETE CASCADE,
+    name        VARCHAR(100) NOT NULL,
+    normalized  VARCHAR(100) NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_brand_names_normalized ON brand_names (normalized);
+CREATE INDEX IF NOT EXISTS idx_brand_names_brand_id ON brand_names (brand_id);
+
+-- Existing free-text brands are registered. Spellings sharing a slug
+-- ("Hewlett-Packard", "hewlett packard") collapse into one brand named after
+-- the most used spelling; the other spellings become its aliases.
+CREATE TEMPORARY TABLE brand_spellings ON COMMIT DROP AS
+SELECT regexp_replace(trim(brand), '\s+', ' ', 'g') AS name,
+       lower(regexp_replace(trim(brand), '\s+', ' ', 'g')) AS normalized,
+       trim(both '-' FROM regexp_replace(lower(brand), '[^a-z0-9]+', '-', 'g')) AS slug,
+       COUNT(*) AS uses
+FROM products
+WHERE trim(both '-' FROM regexp_replace(lower(COALESCE(brand, '')), '[^a-z0-9]+', '-', 'g')) <> ''
+GROUP BY 1, 2, 3;
+
+INSERT INTO brands (name, slug, status, created_at, updated_at)
+SELECT DISTINCT ON (slug) name, slug, 'active', NOW(), NOW()
+FROM brand_spellings
+ORDER BY slug, uses DESC, name;
+
+INSERT INTO brand_names (brand_id, name, normalized)
+SELECT DISTINCT ON (s.normalized) b.id, s.name, s.normalized
+FROM brand_spellings s
+JOIN brands b ON b.slug = s.slug
+ORDER BY s.normalized, s.uses DESC, s.name;
+
+ALTER TABLE products ADD COLUMN IF NOT EXISTS brand_id BIGINT REFERENCES brands (id);
+
+UPDATE products p
+SET brand_id = b.id,
+    brand    = b.name
+FROM brands b
+WHERE b.slug = trim(both '-' FROM regexp_replace(lower(p.brand), '[^a-z0-9]+', '-', 'g'));
+
+CREATE INDEX IF NOT EXISTS idx_products_brand_id ON products (brand_id);
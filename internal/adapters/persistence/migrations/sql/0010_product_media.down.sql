@@ -0,0 +1,3 @@
+-- 0010_product_media
+-- Stored files are not removed; they become unreferenced.
+DROP TABLE IF EXISTS product_media;
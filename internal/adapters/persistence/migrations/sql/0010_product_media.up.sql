@@ -0,0 +1,25 @@
+-- 0010_product_media
+-- Images and documents attached to products. The files live in the media
+-- storage backend; rows keep their keys, sniffed content type and order.
+CREATE TABLE IF NOT EXISTS product_media (
+    id             BIGSERIAL PRIMARY KEY,
+    product_id     BIGINT       NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    kind           VARCHAR(20)  NOT NULL,
+    content_type   VARCHAR(100) NOT NULL,
+    file_name      VARCHAR(255) NOT NULL,
+    size           BIGINT       NOT NULL,
+    width          INTEGER      NOT NULL DEFAULT 0,
+    height         INTEGER      NOT NULL DEFAULT 0,
+    storage_key    VARCHAR(500) NOT NULL,
+    thumbnail_key  VARCHAR(500),
+    alt_text       VARCHAR(255),
+    position       INTEGER      NOT NULL DEFAULT 0,
+    is_primary     BOOLEAN      NOT NULL DEFAULT FALSE,
+    created_at     TIMESTAMPTZ,
+    updated_at     TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_media_product_id ON product_media (product_id, position);
+
+-- At most one primary image per product
+CREATE UNIQUE INDEX IF NOT EXISTS idx_product_media_primary ON product_media (product_id) WHERE is_primary;
@@ -0,0 +1,5 @@
+-- 0011_tags_collections
+DROP TABLE IF EXISTS collection_products;
+DROP TABLE IF EXISTS collections;
+DROP TABLE IF EXISTS product_tags;
+DROP TABLE IF EXISTS tags;
@@ -0,0 +1,41 @@
+-- 0011_tags_collections
+-- Tags are slugs shared by any number of products. Collections group
+-- products either by hand, in a curated order kept in collection_products,
+-- or by rules stored as JSON and evaluated as a product search.
+CREATE TABLE IF NOT EXISTS tags (
+    id          BIGSERIAL PRIMARY KEY,
+    name        VARCHAR(50) NOT NULL,
+    created_at  TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tags_name ON tags (name);
+
+CREATE TABLE IF NOT EXISTS product_tags (
+    product_id  BIGINT NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    tag_id      BIGINT NOT NULL REFERENCES tags (id) ON DELETE CASCADE,
+    PRIMARY KEY (product_id, tag_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_tags_tag_id ON product_tags (tag_id);
+
+CREATE TABLE IF NOT EXISTS collections (
+    id           BIGSERIAL PRIMARY KEY,
+    name         VARCHAR(100)  NOT NULL,
+    slug         VARCHAR(100)  NOT NULL,
+    description  VARCHAR(1000),
+    type         VARCHAR(20)   NOT NULL,
+    rules        JSONB         NOT NULL DEFAULT '[]',
+    created_at   TIMESTAMPTZ,
+    updated_at   TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_collections_slug ON collections (slug);
+
+CREATE TABLE IF NOT EXISTS collection_products (
+    collection_id  BIGINT  NOT NULL REFERENCES collections (id) ON DELETE CASCADE,
+    product_id     BIGINT  NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    position       INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (collection_id, product_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_collection_products_product_id ON collection_products (product_id);
@@ -0,0 +1,2 @@
+-- 0012_product_lifecycle
+DROP TABLE IF EXISTS product_status_transitions;
@@ -0,0 +1,14 @@
+-- 0012_product_lifecycle
+-- Products move through draft → active ⇄ inactive → discontinued → archived.
+-- Every status change is recorded with the same transaction as the change.
+CREATE TABLE IF NOT EXISTS product_status_transitions (
+    id          BIGSERIAL PRIMARY KEY,
+    product_id  BIGINT       NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    from_status VARCHAR(20)  NOT NULL,
+    to_status   VARCHAR(20)  NOT NULL,
+    reason      VARCHAR(500),
+    actor       VARCHAR(255),
+    created_at  TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_status_transitions_product_id ON product_status_transitions (product_id, created_at);
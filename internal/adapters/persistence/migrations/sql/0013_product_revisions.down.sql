@@ -0,0 +1,2 @@
+-- 0013_product_revisions
+DROP TABLE IF EXISTS product_revisions;
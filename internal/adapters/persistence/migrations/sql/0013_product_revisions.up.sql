@@ -0,0 +1,23 @@
+-- 0013_product_revisions
+-- Staged product edits. A revision stores the requested changes as JSON and
+-- the updated_at of the product it was drafted against; it moves from
+-- pending to approved or rejected, and approved revisions are published.
+CREATE TABLE IF NOT EXISTS product_revisions (
+    id              BIGSERIAL PRIMARY KEY,
+    product_id      BIGINT       NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    changes         JSONB        NOT NULL,
+    comment         VARCHAR(500),
+    status          VARCHAR(20)  NOT NULL,
+    author          VARCHAR(255),
+    base_updated_at TIMESTAMPTZ  NOT NULL,
+    reviewer        VARCHAR(255),
+    review_comment  VARCHAR(500),
+    reviewed_at     TIMESTAMPTZ,
+    publisher       VARCHAR(255),
+    published_at    TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ,
+    updated_at      TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_revisions_product_id ON product_revisions (product_id);
+CREATE INDEX IF NOT EXISTS idx_product_revisions_status ON product_revisions (status);
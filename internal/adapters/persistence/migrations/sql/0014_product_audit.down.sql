@@ -0,0 +1,2 @@
+-- 0014_product_audit
+DROP TABLE IF EXISTS product_audit;
@@ -0,0 +1,19 @@
+-- 0014_product_audit
+-- Audit trail of product mutations, written in the same transaction as the
+-- change itself. Each entry stores the before and after values of the fields
+-- that changed as a JSON array of {field, before, after}. Entries outlive the
+-- product, so there is no foreign key.
+CREATE TABLE IF NOT EXISTS product_audit (
+    id         BIGSERIAL PRIMARY KEY,
+    product_id BIGINT       NOT NULL,
+    operation  VARCHAR(30)  NOT NULL,
+    actor      VARCHAR(255),
+    request_id VARCHAR(100),
+    changes    JSONB        NOT NULL,
+    created_at TIMESTAMPTZ  NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_audit_product_id ON product_audit (product_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_product_audit_actor ON product_audit (actor);
+CREATE INDEX IF NOT EXISTS idx_product_audit_created_at ON product_audit (created_at);
+CREATE INDEX IF NOT EXISTS idx_product_audit_changes ON product_audit USING GIN (changes jsonb_path_ops);
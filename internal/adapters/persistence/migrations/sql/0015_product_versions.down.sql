@@ -0,0 +1,2 @@
+-- 0015_product_versions
+DROP TABLE IF EXISTS product_versions;
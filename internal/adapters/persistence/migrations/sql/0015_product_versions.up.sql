@@ -0,0 +1,43 @@
+-- 0015_product_versions
+-- Temporal versions of products for point-in-time reads. Every mutation
+-- closes the open version of the product (valid_to IS NULL) and stores its
+-- new state, tags included, valid from the same instant. Like the audit
+-- trail, versions outlive the product.
+CREATE TABLE IF NOT EXISTS product_versions (
+    id          BIGSERIAL PRIMARY KEY,
+    product_id  BIGINT         NOT NULL,
+    sku         VARCHAR(50)    NOT NULL,
+    name        VARCHAR(255)   NOT NULL,
+    description VARCHAR(1000),
+    price       NUMERIC(18,4)  NOT NULL,
+    currency    VARCHAR(3)     NOT NULL,
+    category_id BIGINT         NOT NULL,
+    category    VARCHAR(100)   NOT NULL,
+    brand_id    BIGINT,
+    brand       VARCHAR(100),
+    stock       INTEGER        NOT NULL,
+    status      VARCHAR(20)    NOT NULL,
+    attributes  JSONB          NOT NULL,
+    tags        JSONB          NOT NULL,
+    created_at  TIMESTAMPTZ,
+    updated_at  TIMESTAMPTZ,
+    valid_from  TIMESTAMPTZ    NOT NULL,
+    valid_to    TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_versions_product_id ON product_versions (product_id, valid_from);
+CREATE INDEX IF NOT EXISTS idx_product_versions_sku ON product_versions (sku, valid_from);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_product_versions_open ON product_versions (product_id) WHERE valid_to IS NULL;
+
+-- Existing products start their history at their last update; earlier
+-- states were never recorded.
+INSERT INTO product_versions (product_id, sku, name, description, price, currency, category_id, category,
+                              brand_id, brand, stock, status, attributes, tags, created_at, updated_at, valid_from)
+SELECT p.id, p.sku, p.name, p.description, p.price, p.currency, p.category_id, p.category,
+       p.brand_id, p.brand, p.stock, p.status, p.attributes,
+       COALESCE((SELECT jsonb_agg(t.name ORDER BY t.name)
+                 FROM product_tags pt JOIN tags t ON t.id = pt.tag_id
+                 WHERE pt.product_id = p.id), '[]'::jsonb),
+       p.created_at, p.updated_at, COALESCE(p.updated_at, p.created_at, now())
+FROM products p
+WHERE p.deleted_at IS NULL;
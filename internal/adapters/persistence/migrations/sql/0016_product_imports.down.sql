@@ -0,0 +1,3 @@
+-- 0016_product_imports
+DROP TABLE IF EXISTS product_import_errors;
+DROP TABLE IF EXISTS product_imports;
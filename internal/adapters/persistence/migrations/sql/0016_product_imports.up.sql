@@ -0,0 +1,36 @@
+-- 0016_product_imports
+-- Bulk product imports from CSV files. An import upserts products by SKU and
+-- keeps its progress counters here; every row it could not import becomes a
+-- line of its error report in product_import_errors.
+CREATE TABLE IF NOT EXISTS product_imports (
+    id          BIGSERIAL PRIMARY KEY,
+    file_name   VARCHAR(255),
+    status      VARCHAR(20)  NOT NULL,
+    dry_run     BOOLEAN      NOT NULL DEFAULT FALSE,
+    mapping     JSONB,
+    currency    VARCHAR(3),
+    total_rows  BIGINT       NOT NULL DEFAULT 0,
+    created     BIGINT       NOT NULL DEFAULT 0,
+    updated     BIGINT       NOT NULL DEFAULT 0,
+    failed      BIGINT       NOT NULL DEFAULT 0,
+    error       TEXT,
+    actor       VARCHAR(255),
+    request_id  VARCHAR(100),
+    created_at  TIMESTAMPTZ  NOT NULL,
+    started_at  TIMESTAMPTZ,
+    finished_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_imports_status ON product_imports (status);
+
+CREATE TABLE IF NOT EXISTS product_import_errors (
+    id         BIGSERIAL PRIMARY KEY,
+    import_id  BIGINT       NOT NULL REFERENCES product_imports (id) ON DELETE CASCADE,
+    row_number BIGINT       NOT NULL,
+    sku        VARCHAR(255),
+    field      VARCHAR(100),
+    code       VARCHAR(100) NOT NULL,
+    message    TEXT         NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_import_errors_import_row ON product_import_errors (import_id, row_number);
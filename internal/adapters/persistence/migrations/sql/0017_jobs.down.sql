@@ -0,0 +1,2 @@
+-- 0017_jobs
+DROP TABLE IF EXISTS jobs;
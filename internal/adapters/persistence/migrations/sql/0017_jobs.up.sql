@@ -0,0 +1,31 @@
+-- 0017_jobs
+-- Queue of background jobs such as exports and bulk repricing. Workers claim
+-- due queued jobs with SELECT ... FOR UPDATE SKIP LOCKED and hold them under
+-- a lease they renew while the job runs; a running job whose lease expired
+-- lost its worker and is claimed again.
+CREATE TABLE IF NOT EXISTS jobs (
+    id               BIGSERIAL PRIMARY KEY,
+    type             VARCHAR(50)  NOT NULL,
+    status           VARCHAR(20)  NOT NULL,
+    payload          JSONB,
+    result           JSONB,
+    error            TEXT,
+    progress         BIGINT       NOT NULL DEFAULT 0,
+    total            BIGINT       NOT NULL DEFAULT 0,
+    attempts         INTEGER      NOT NULL DEFAULT 0,
+    max_attempts     INTEGER      NOT NULL DEFAULT 1,
+    cancel_requested BOOLEAN      NOT NULL DEFAULT FALSE,
+    worker           VARCHAR(255),
+    lease_expires_at TIMESTAMPTZ,
+    run_at           TIMESTAMPTZ  NOT NULL,
+    actor            VARCHAR(255),
+    request_id       VARCHAR(100),
+    created_at       TIMESTAMPTZ  NOT NULL,
+    started_at       TIMESTAMPTZ,
+    finished_at      TIMESTAMPTZ,
+    updated_at       TIMESTAMPTZ  NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_jobs_status ON jobs (status);
+CREATE INDEX IF NOT EXISTS idx_jobs_queued_run_at ON jobs (run_at, id) WHERE status = 'queued';
+CREATE INDEX IF NOT EXISTS idx_jobs_running_lease ON jobs (lease_expires_at) WHERE status = 'running';
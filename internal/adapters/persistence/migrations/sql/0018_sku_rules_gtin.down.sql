@@ -0,0 +1,9 @@
+-- 0018_sku_rules_gtin
+ALTER TABLE product_versions DROP COLUMN IF EXISTS gtin;
+
+DROP INDEX IF EXISTS idx_products_gtin;
+ALTER TABLE products DROP COLUMN IF EXISTS gtin;
+
+ALTER TABLE categories DROP COLUMN IF EXISTS sku_sequence;
+ALTER TABLE categories DROP COLUMN IF EXISTS sku_template;
+ALTER TABLE categories DROP COLUMN IF EXISTS sku_pattern;
@@ -0,0 +1,14 @@
+-- 0018_sku_rules_gtin
+-- Categories can restrict the SKUs of new products to a pattern and generate
+-- them from a template; sku_sequence is the last number the template drew.
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS sku_pattern VARCHAR(200);
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS sku_template VARCHAR(100);
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS sku_sequence BIGINT NOT NULL DEFAULT 0;
+
+-- Products carry an optional GTIN-8/12/13/14 barcode. GTINs are unique in
+-- their 14-digit form, so a UPC-A and the EAN-13 it reads as are the same.
+ALTER TABLE products ADD COLUMN IF NOT EXISTS gtin VARCHAR(14);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_products_gtin ON products (lpad(gtin, 14, '0'))
+    WHERE gtin IS NOT NULL AND deleted_at IS NULL;
+
+ALTER TABLE product_versions ADD COLUMN IF NOT EXISTS gtin VARCHAR(14);
@@ -0,0 +1,12 @@
+-- 0019_promotion_category_ids
+ALTER TABLE promotion_targets
+    DROP CONSTRAINT IF EXISTS promotion_targets_kind_check,
+    ADD CONSTRAINT promotion_targets_kind_check CHECK (kind IN ('category', 'brand', 'sku', 'tag'));
+
+INSERT INTO promotion_targets (promotion_id, kind, value)
+SELECT DISTINCT pc.promotion_id, 'category', c.name
+FROM promotion_categories pc
+JOIN categories c ON c.id = pc.category_id
+ON CONFLICT DO NOTHING;
+
+DROP TABLE IF EXISTS promotion_categories;
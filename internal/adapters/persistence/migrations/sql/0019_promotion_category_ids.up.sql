@@ -0,0 +1,33 @@
+-- 0019_promotion_category_ids
+-- Promotions target categories by ID and cover their descendants, instead of
+-- comparing names with the product's category name. Each stored name becomes
+-- every category carrying that name, ignoring case, which is what it matched
+-- before.
+CREATE TABLE IF NOT EXISTS promotion_categories (
+    promotion_id  BIGINT NOT NULL REFERENCES promotions (id) ON DELETE CASCADE,
+    category_id   BIGINT NOT NULL REFERENCES categories (id),
+    PRIMARY KEY (promotion_id, category_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_promotion_categories_category_id ON promotion_categories (category_id);
+
+INSERT INTO promotion_categories (promotion_id, category_id)
+SELECT DISTINCT t.promotion_id, c.id
+FROM promotion_targets t
+JOIN categories c ON lower(c.name) = lower(trim(t.value))
+WHERE t.kind = 'category';
+
+-- A promotion none of whose category names exists matched no product; it is
+-- switched off rather than left without category targets, which would make it
+-- apply to every product.
+UPDATE promotions p
+SET active     = FALSE,
+    updated_at = NOW()
+WHERE EXISTS (SELECT 1 FROM promotion_targets t WHERE t.promotion_id = p.id AND t.kind = 'category')
+  AND NOT EXISTS (SELECT 1 FROM promotion_categories pc WHERE pc.promotion_id = p.id);
+
+DELETE FROM promotion_targets WHERE kind = 'category';
+
+ALTER TABLE promotion_targets
+    DROP CONSTRAINT IF EXISTS promotion_targets_kind_check,
+    ADD CONSTRAINT promotion_targets_kind_check CHECK (kind IN ('brand', 'sku', 'tag'));
@@ -0,0 +1,12 @@
+-- 0020_promotion_brand_ids
+ALTER TABLE promotion_targets
+    DROP CONSTRAINT IF EXISTS promotion_targets_kind_check,
+    ADD CONSTRAINT promotion_targets_kind_check CHECK (kind IN ('brand', 'sku', 'tag'));
+
+INSERT INTO promotion_targets (promotion_id, kind, value)
+SELECT pb.promotion_id, 'brand', b.name
+FROM promotion_brands pb
+JOIN brands b ON b.id = pb.brand_id
+ON CONFLICT DO NOTHING;
+
+DROP TABLE IF EXISTS promotion_brands;
@@ -0,0 +1,31 @@
+-- 0020_promotion_brand_ids
+-- Promotions target registered brands by ID instead of by name, so they
+-- follow renames and merges. Stored names resolve like brand lookups do:
+-- against the canonical name or any alias, ignoring case and spacing.
+CREATE TABLE IF NOT EXISTS promotion_brands (
+    promotion_id  BIGINT NOT NULL REFERENCES promotions (id) ON DELETE CASCADE,
+    brand_id      BIGINT NOT NULL REFERENCES brands (id),
+    PRIMARY KEY (promotion_id, brand_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_promotion_brands_brand_id ON promotion_brands (brand_id);
+
+INSERT INTO promotion_brands (promotion_id, brand_id)
+SELECT DISTINCT t.promotion_id, n.brand_id
+FROM promotion_targets t
+JOIN brand_names n ON n.normalized = lower(regexp_replace(trim(t.value), '\s+', ' ', 'g'))
+WHERE t.kind = 'brand';
+
+-- As in 0019, a promotion none of whose brands is registered is switched off
+-- rather than left to apply to every brand.
+UPDATE promotions p
+SET active     = FALSE,
+    updated_at = NOW()
+WHERE EXISTS (SELECT 1 FROM promotion_targets t WHERE t.promotion_id = p.id AND t.kind = 'brand')
+  AND NOT EXISTS (SELECT 1 FROM promotion_brands pb WHERE pb.promotion_id = p.id);
+
+DELETE FROM promotion_targets WHERE kind = 'brand';
+
+ALTER TABLE promotion_targets
+    DROP CONSTRAINT IF EXISTS promotion_targets_kind_check,
+    ADD CONSTRAINT promotion_targets_kind_check CHECK (kind IN ('sku', 'tag'));
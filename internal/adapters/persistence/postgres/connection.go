@@ -42,6 +42,11 @@ func NewGormConnection(cfg *config.Config, log logger.Logger) (*GormDB, error) {
 		return nil, fmt.Errorf("failed to connect to postgres with GORM: %w", err)
 	}
 
+	// Emit a span for every statement
+	if err := db.Use(NewGormTracingPlugin(cfg.Database.Database)); err != nil {
+		return nil, fmt.Errorf("failed to register GORM tracing plugin: %w", err)
+	}
+
 	// Get underlying sql.DB to configure connection pool
 	sqlDB, err := db.DB()
 	if err != nil {
@@ -105,19 +110,6 @@ func (g *GormDB) HealthCheck(ctx context.Context) error {
 	return nil
 }
 
-// AutoMigrate runs database migrations
-func (g *GormDB) AutoMigrate(models ...interface{}) error {
-	g.logger.Info("Running database migrations")
-
-	if err := g.db.AutoMigrate(models...); err != nil {
-		g.logger.Error("Database migration failed", "error", err)
-		return fmt.Errorf("database migration failed: %w", err)
-	}
-
-	g.logger.Info("Database migrations completed successfully")
-	return nil
-}
-
 // Transaction helper for GORM
 func (g *GormDB) WithTransaction(ctx context.Context, fn func(tx *gorm.DB) error) error {
 	return g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
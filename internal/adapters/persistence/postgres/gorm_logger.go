@@ -56,21 +56,21 @@ func (l *GormZapLogger) LogMode(level gormLogger.LogLevel) gormLogger.Interface
 // Info implements gorm.io/gorm/logger.Interface
 func (l *GormZapLogger) Info(ctx context.Context, msg string, data ...interface{}) {
 	if l.logLevel >= gormLogger.Info {
-		l.logger.Info(msg, data...)
+		l.logger.Ctx(ctx).Info(msg, data...)
 	}
 }
 
 // Warn implements gorm.io/gorm/logger.Interface
 func (l *GormZapLogger) Warn(ctx context.Context, msg string, data ...interface{}) {
 	if l.logLevel >= gormLogger.Warn {
-		l.logger.Warn(msg, data...)
+		l.logger.Ctx(ctx).Warn(msg, data...)
 	}
 }
 
 // Error implements gorm.io/gorm/logger.Interface
 func (l *GormZapLogger) Error(ctx context.Context, msg string, data ...interface{}) {
 	if l.logLevel >= gormLogger.Error {
-		l.logger.Error(msg, data...)
+		l.logger.Ctx(ctx).Error(msg, data...)
 	}
 }
 
@@ -90,13 +90,16 @@ func (l *GormZapLogger) Trace(ctx context.Context, begin time.Time, fc func() (s
 		"sql", sql,
 	}
 
+	// Carry the request ID, actor and trace IDs of the calling request
+	log := l.logger.Ctx(ctx)
+
 	switch {
 	case err != nil && l.logLevel >= gormLogger.Error && (!errors.Is(err, gormLogger.ErrRecordNotFound) || !l.ignoreRecordNotFoundError):
-		l.logger.Error("database query failed", append(fields, "error", err)...)
+		log.Error("database query failed", append(fields, "error", err)...)
 	case elapsed > l.slowThreshold && l.slowThreshold != 0 && l.logLevel >= gormLogger.Warn:
-		l.logger.Warn("slow query detected", append(fields, "threshold", l.slowThreshold)...)
+		log.Warn("slow query detected", append(fields, "threshold", l.slowThreshold)...)
 	case l.logLevel == gormLogger.Info:
-		l.logger.Debug("database query executed", fields...)
+		log.Debug("database query executed", fields...)
 	}
 }
 
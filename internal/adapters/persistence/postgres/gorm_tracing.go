@@ -0,0 +1,138 @@
+// internal/adapters/persistence/gorm_tracing.go
+package persistence
+
+import (
+	"regexp"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const (
+	gormTracerName  = "product-service/gorm"
+	gormSpanKey     = "otel:span"
+	maxQueryTextLen = 2048
+)
+
+var (
+	// Single-quoted literals (with '' escapes) and bare numbers that are not
+	// part of an identifier or a $n placeholder
+	sqlStringLiteral = regexp.MustCompile(`'(?:[^']|'')*'`)
+	sqlNumberLiteral = regexp.MustCompile(`([^\w$.])-?\d+(?:\.\d+)?\b`)
+)
+
+// GormTracingPlugin emits a client span for every statement GORM executes
+type GormTracingPlugin struct {
+	tracer   trace.Tracer
+	database string
+}
+
+// NewGormTracingPlugin creates the tracing plugin for the given database name
+func NewGormTracingPlugin(database string) *GormTracingPlugin {
+	return &GormTracingPlugin{
+		tracer:   otel.Tracer(gormTracerName),
+		database: database,
+	}
+}
+
+// Name implements gorm.Plugin
+func (p *GormTracingPlugin) Name() string {
+	return "otel-tracing"
+}
+
+// Initialize implements gorm.Plugin by registering before/after callbacks on
+// every processor
+func (p *GormTracingPlugin) Initialize(db *gorm.DB) error {
+	registrations := []struct {
+		name      string
+		operation string
+		before    func(string, func(*gorm.DB)) error
+		after     func(string, func(*gorm.DB)) error
+	}{
+		{"create", "INSERT", db.Callback().Create().Before("gorm:create").Register, db.Callback().Create().After("gorm:create").Register},
+		{"query", "SELECT", db.Callback().Query().Before("gorm:query").Register, db.Callback().Query().After("gorm:query").Register},
+		{"update", "UPDATE", db.Callback().Update().Before("gorm:update").Register, db.Callback().Update().After("gorm:update").Register},
+		{"delete", "DELETE", db.Callback().Delete().Before("gorm:delete").Register, db.Callback().Delete().After("gorm:delete").Register},
+		{"row", "", db.Callback().Row().Before("gorm:row").Register, db.Callback().Row().After("gorm:row").Register},
+		{"raw", "", db.Callback().Raw().Before("gorm:raw").Register, db.Callback().Raw().After("gorm:raw").Register},
+	}
+
+	for _, r := range registrations {
+		if err := r.before("otel:before_"+r.name, p.before(r.operation)); err != nil {
+			return err
+		}
+		if err := r.after("otel:after_"+r.name, p.after); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *GormTracingPlugin) before(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		if db.Statement == nil || db.Statement.Context == nil {
+			return
+		}
+
+		spanName := "db"
+		if operation != "" {
+			spanName = "db." + strings.ToLower(operation)
+		}
+
+		ctx, span := p.tracer.Start(db.Statement.Context, spanName,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				semconv.DBSystemPostgreSQL,
+				semconv.DBNamespace(p.database),
+			),
+		)
+		if operation != "" {
+			span.SetAttributes(semconv.DBOperationName(operation))
+		}
+
+		db.Statement.Context = ctx
+		db.InstanceSet(gormSpanKey, span)
+	}
+}
+
+func (p *GormTracingPlugin) after(db *gorm.DB) {
+	value, ok := db.InstanceGet(gormSpanKey)
+	if !ok {
+		return
+	}
+	span, ok := value.(trace.Span)
+	if !ok {
+		return
+	}
+	defer span.End()
+
+	if db.Statement.Table != "" {
+		span.SetAttributes(semconv.DBCollectionName(db.Statement.Table))
+	}
+	span.SetAttributes(
+		semconv.DBQueryText(SanitizeSQL(db.Statement.SQL.String())),
+		attribute.Int64("db.rows_affected", db.Statement.RowsAffected),
+	)
+
+	if err := db.Error; err != nil && err != gorm.ErrRecordNotFound {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+// SanitizeSQL strips literal values from a statement so bound values never end
+// up in span attributes. Placeholders ($1, $2, ...) are left untouched.
+func SanitizeSQL(sql string) string {
+	sql = sqlStringLiteral.ReplaceAllString(sql, "?")
+	sql = sqlNumberLiteral.ReplaceAllString(sql, "${1}?")
+	if len(sql) > maxQueryTextLen {
+		sql = sql[:maxQueryTextLen] + "..."
+	}
+	return sql
+}
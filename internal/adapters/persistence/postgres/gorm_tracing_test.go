@@ -0,0 +1,67 @@
+package persistence
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitizeSQL(t *testing.T) {
+	tests := []struct {
+		name string
+		sql  string
+		want string
+	}{
+		{
+			name: "string literal",
+			sql:  `SELECT * FROM "products" WHERE sku = 'IPH15-128GB'`,
+			want: `SELECT * FROM "products" WHERE sku = ?`,
+		},
+		{
+			name: "escaped quotes",
+			sql:  `UPDATE "products" SET description = 'It''s the ''best'' phone' WHERE id = $1`,
+			want: `UPDATE "products" SET description = ? WHERE id = $1`,
+		},
+		{
+			name: "numbers inside strings",
+			sql:  `SELECT * FROM "products" WHERE name = 'iPhone 15 Pro 256'`,
+			want: `SELECT * FROM "products" WHERE name = ?`,
+		},
+		{
+			name: "numeric literals",
+			sql:  `SELECT * FROM "products" WHERE price_amount > 99.99 AND stock = -5 LIMIT 10`,
+			want: `SELECT * FROM "products" WHERE price_amount > ? AND stock = ? LIMIT ?`,
+		},
+		{
+			name: "placeholders",
+			sql:  `SELECT * FROM "products" WHERE id = $1 AND sku = $12`,
+			want: `SELECT * FROM "products" WHERE id = $1 AND sku = $12`,
+		},
+		{
+			name: "identifiers with digits",
+			sql:  `SELECT t1.price_v2 FROM products_2026 t1`,
+			want: `SELECT t1.price_v2 FROM products_2026 t1`,
+		},
+		{
+			name: "literals in a list",
+			sql:  `SELECT * FROM "products" WHERE id IN (1,2,3) AND status IN ('active','inactive')`,
+			want: `SELECT * FROM "products" WHERE id IN (?,?,?) AND status IN (?,?)`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, SanitizeSQL(tt.sql))
+		})
+	}
+}
+
+func TestSanitizeSQL_Truncates(t *testing.T) {
+	sql := "SELECT " + strings.Repeat("name, ", maxQueryTextLen)
+
+	sanitized := SanitizeSQL(sql)
+
+	assert.Len(t, sanitized, maxQueryTextLen+len("..."))
+	assert.True(t, strings.HasSuffix(sanitized, "..."))
+}
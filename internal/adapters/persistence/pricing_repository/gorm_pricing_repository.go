@@ -0,0 +1,345 @@
+package pricing_repository
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PriceListModel represents the database model for price lists
+type PriceListModel struct {
+	ID        uint      `gorm:"primarykey"`
+	Code      string    `gorm:"uniqueIndex;not null;size:50"`
+	Name      string    `gorm:"not null;size:255"`
+	IsDefault bool      `gorm:"not null;default:false"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName specifies the table name for GORM
+func (PriceListModel) TableName() string {
+	return "price_lists"
+}
+
+// ProductPriceModel represents the database model for explicit product prices
+type ProductPriceModel struct {
+	PriceListID uint      `gorm:"primaryKey"`
+	ProductID   uint      `gorm:"primaryKey;index"`
+	Currency    string    `gorm:"primaryKey;size:3"`
+	Amount      string    `gorm:"not null;type:numeric(18,4)"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName specifies the table name for GORM
+func (ProductPriceModel) TableName() string {
+	return "product_prices"
+}
+
+// ExchangeRateModel represents the database model for exchange rates
+type ExchangeRateModel struct {
+	BaseCurrency  string    `gorm:"primaryKey;size:3"`
+	QuoteCurrency string    `gorm:"primaryKey;size:3"`
+	Rate          string    `gorm:"not null;type:numeric(24,10)"`
+	UpdatedAt     time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName specifies the table name for GORM
+func (ExchangeRateModel) TableName() string {
+	return "exchange_rates"
+}
+
+// GormPriceListRepository implements the PriceListRepository interface using GORM
+type GormPriceListRepository struct {
+	db *gorm.DB
+}
+
+// NewGormPriceListRepository creates a new GORM price list repository
+func NewGormPriceListRepository(db *gorm.DB) ports.PriceListRepository {
+	return &GormPriceListRepository{db: db}
+}
+
+// CreatePriceList implements ports.PriceListRepository
+func (r *GormPriceListRepository) CreatePriceList(ctx context.Context, priceList *entities.PriceList) (*entities.PriceList, error) {
+	model := &PriceListModel{
+		Code:      priceList.Code,
+		Name:      priceList.Name,
+		IsDefault: priceList.IsDefault,
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if model.IsDefault {
+			if err := tx.Model(&PriceListModel{}).
+				Where("is_default = ?", true).
+				Update("is_default", false).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Create(model).Error
+	})
+	if err != nil {
+		return nil, handlePriceListError(err)
+	}
+
+	return priceListToEntity(model), nil
+}
+
+// GetPriceListByCode implements ports.PriceListRepository
+func (r *GormPriceListRepository) GetPriceListByCode(ctx context.Context, code string) (*entities.PriceList, error) {
+	var model PriceListModel
+
+	err := r.db.WithContext(ctx).Where("code = ?", strings.ToLower(code)).First(&model).Error
+	if err != nil {
+		return nil, handlePriceListError(err)
+	}
+
+	return priceListToEntity(&model), nil
+}
+
+// GetDefaultPriceList implements ports.PriceListRepository
+func (r *GormPriceListRepository) GetDefaultPriceList(ctx context.Context) (*entities.PriceList, error) {
+	var model PriceListModel
+
+	err := r.db.WithContext(ctx).Where("is_default = ?", true).First(&model).Error
+	if err != nil {
+		return nil, handlePriceListError(err)
+	}
+
+	return priceListToEntity(&model), nil
+}
+
+// ListPriceLists implements ports.PriceListRepository
+func (r *GormPriceListRepository) ListPriceLists(ctx context.Context) ([]*entities.PriceList, error) {
+	var models []PriceListModel
+
+	if err := r.db.WithContext(ctx).Order("code ASC").Find(&models).Error; err != nil {
+		return nil, handlePriceListError(err)
+	}
+
+	priceLists := make([]*entities.PriceList, 0, len(models))
+	for i := range models {
+		priceLists = append(priceLists, priceListToEntity(&models[i]))
+	}
+	return priceLists, nil
+}
+
+// SetProductPrice implements ports.PriceListRepository
+func (r *GormPriceListRepository) SetProductPrice(ctx context.Context, price *entities.ProductPrice) error {
+	model := &ProductPriceModel{
+		PriceListID: price.PriceListID,
+		ProductID:   price.ProductID,
+		Currency:    price.Price.Currency(),
+		Amount:      price.Price.Decimal(),
+	}
+
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "price_list_id"}, {Name: "product_id"}, {Name: "currency"}},
+		DoUpdates: clause.AssignmentColumns([]string{"amount", "updated_at"}),
+	}).Create(model).Error
+
+	return handlePriceListError(err)
+}
+
+// DeleteProductPrice implements ports.PriceListRepository
+func (r *GormPriceListRepository) DeleteProductPrice(ctx context.Context, priceListID, productID uint, currency string) error {
+	result := r.db.WithContext(ctx).
+		Where("price_list_id = ? AND product_id = ? AND currency = ?", priceListID, productID, strings.ToUpper(currency)).
+		Delete(&ProductPriceModel{})
+	if result.Error != nil {
+		return handlePriceListError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domainErrors.ErrProductPriceNotFound
+	}
+	return nil
+}
+
+// GetProductPrice implements ports.PriceListRepository
+func (r *GormPriceListRepository) GetProductPrice(ctx context.Context, priceListID, productID uint, currency string) (*entities.ProductPrice, error) {
+	var model ProductPriceModel
+
+	err := r.db.WithContext(ctx).
+		Where("price_list_id = ? AND product_id = ? AND currency = ?", priceListID, productID, strings.ToUpper(currency)).
+		First(&model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, domainErrors.ErrProductPriceNotFound
+	}
+	if err != nil {
+		return nil, handlePriceListError(err)
+	}
+
+	return productPriceToEntity(&model)
+}
+
+// ListProductPrices implements ports.PriceListRepository
+func (r *GormPriceListRepository) ListProductPrices(ctx context.Context, priceListID, productID uint) ([]*entities.ProductPrice, error) {
+	var models []ProductPriceModel
+
+	query := r.db.WithContext(ctx).Where("price_list_id = ?", priceListID)
+	if productID != 0 {
+		query = query.Where("product_id = ?", productID)
+	}
+	if err := query.Order("product_id ASC, currency ASC").Find(&models).Error; err != nil {
+		return nil, handlePriceListError(err)
+	}
+
+	prices := make([]*entities.ProductPrice, 0, len(models))
+	for i := range models {
+		price, err := productPriceToEntity(&models[i])
+		if err != nil {
+			return nil, err
+		}
+		prices = append(prices, price)
+	}
+	return prices, nil
+}
+
+// GormExchangeRateRepository implements the ExchangeRateRepository interface using GORM
+type GormExchangeRateRepository struct {
+	db *gorm.DB
+}
+
+// NewGormExchangeRateRepository creates a new GORM exchange rate repository
+func NewGormExchangeRateRepository(db *gorm.DB) ports.ExchangeRateRepository {
+	return &GormExchangeRateRepository{db: db}
+}
+
+// UpsertRates implements ports.ExchangeRateRepository
+func (r *GormExchangeRateRepository) UpsertRates(ctx context.Context, rates []*entities.ExchangeRate) error {
+	if len(rates) == 0 {
+		return nil
+	}
+
+	models := make([]ExchangeRateModel, 0, len(rates))
+	for _, rate := range rates {
+		models = append(models, ExchangeRateModel{
+			BaseCurrency:  rate.BaseCurrency,
+			QuoteCurrency: rate.QuoteCurrency,
+			Rate:          rate.RateString(),
+		})
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "base_currency"}, {Name: "quote_currency"}},
+			DoUpdates: clause.AssignmentColumns([]string{"rate", "updated_at"}),
+		}).Create(&models).Error
+	})
+	if err != nil {
+		return domainErrors.ErrFailedToUpdatePricing
+	}
+	return nil
+}
+
+// GetRate implements ports.ExchangeRateRepository
+func (r *GormExchangeRateRepository) GetRate(ctx context.Context, base, quote string) (*entities.ExchangeRate, error) {
+	var model ExchangeRateModel
+
+	err := r.db.WithContext(ctx).
+		Where("base_currency = ? AND quote_currency = ?", strings.ToUpper(base), strings.ToUpper(quote)).
+		First(&model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, domainErrors.ErrExchangeRateNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return exchangeRateToEntity(&model)
+}
+
+// ListRates implements ports.ExchangeRateRepository
+func (r *GormExchangeRateRepository) ListRates(ctx context.Context) ([]*entities.ExchangeRate, error) {
+	var models []ExchangeRateModel
+
+	if err := r.db.WithContext(ctx).Order("base_currency ASC, quote_currency ASC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	rates := make([]*entities.ExchangeRate, 0, len(models))
+	for i := range models {
+		rate, err := exchangeRateToEntity(&models[i])
+		if err != nil {
+			return nil, err
+		}
+		rates = append(rates, rate)
+	}
+	return rates, nil
+}
+
+// DeleteRate implements ports.ExchangeRateRepository
+func (r *GormExchangeRateRepository) DeleteRate(ctx context.Context, base, quote string) error {
+	result := r.db.WithContext(ctx).
+		Where("base_currency = ? AND quote_currency = ?", strings.ToUpper(base), strings.ToUpper(quote)).
+		Delete(&ExchangeRateModel{})
+	if result.Error != nil {
+		return domainErrors.ErrFailedToUpdatePricing
+	}
+	if result.RowsAffected == 0 {
+		return domainErrors.ErrExchangeRateNotFound
+	}
+	return nil
+}
+
+// Helper functions for conversion between domain entities and GORM models
+
+func priceListToEntity(model *PriceListModel) *entities.PriceList {
+	return &entities.PriceList{
+		ID:        model.ID,
+		Code:      model.Code,
+		Name:      model.Name,
+		IsDefault: model.IsDefault,
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+	}
+}
+
+func productPriceToEntity(model *ProductPriceModel) (*entities.ProductPrice, error) {
+	price, err := entities.ParseMoney(model.Amount, model.Currency)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.ProductPrice{
+		PriceListID: model.PriceListID,
+		ProductID:   model.ProductID,
+		Price:       price,
+		UpdatedAt:   model.UpdatedAt,
+	}, nil
+}
+
+func exchangeRateToEntity(model *ExchangeRateModel) (*entities.ExchangeRate, error) {
+	rate, ok := new(big.Rat).SetString(model.Rate)
+	if !ok {
+		return nil, domainErrors.ErrInvalidExchangeRate
+	}
+	return &entities.ExchangeRate{
+		BaseCurrency:  model.BaseCurrency,
+		QuoteCurrency: model.QuoteCurrency,
+		Rate:          rate,
+		UpdatedAt:     model.UpdatedAt,
+	}, nil
+}
+
+func handlePriceListError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domainErrors.ErrPriceListNotFound
+	}
+
+	if errors.Is(err, gorm.ErrDuplicatedKey) || strings.Contains(err.Error(), "duplicate key") {
+		return domainErrors.ErrPriceListAlreadyExists
+	}
+
+	return err
+}
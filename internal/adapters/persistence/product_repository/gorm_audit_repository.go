@@ -0,0 +1,175 @@
+package product_repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	"product-service/pkg/requestctx"
+
+	"gorm.io/gorm"
+)
+
+// AuditEntryModel represents the database model for product audit entries
+type AuditEntryModel struct {
+	ID        uint                   `gorm:"primarykey"`
+	ProductID uint                   `gorm:"not null;index"`
+	Operation string                 `gorm:"not null;size:30"`
+	Actor     string                 `gorm:"size:255;index"`
+	RequestID string                 `gorm:"size:100"`
+	Changes   []entities.AuditChange `gorm:"not null;type:jsonb;serializer:json"`
+	CreatedAt time.Time              `gorm:"not null;index"`
+}
+
+// TableName specifies the table name for GORM
+func (AuditEntryModel) TableName() string {
+	return "product_audit"
+}
+
+// GormAuditRepository implements the AuditRepository interface using GORM
+type GormAuditRepository struct {
+	db *gorm.DB
+}
+
+// NewGormAuditRepository creates a new GORM audit repository
+func NewGormAuditRepository(db *gorm.DB) ports.AuditRepository {
+	return &GormAuditRepository{db: db}
+}
+
+// List implements ports.AuditRepository
+func (r *GormAuditRepository) List(ctx context.Context, criteria ports.AuditCriteria) ([]*entities.AuditEntry, int64, error) {
+	query := r.db.WithContext(ctx).Model(&AuditEntryModel{})
+
+	if criteria.ProductID != 0 {
+		query = query.Where("product_id = ?", criteria.ProductID)
+	}
+	if criteria.Actor != "" {
+		query = query.Where("actor = ?", criteria.Actor)
+	}
+	if criteria.Operation != "" {
+		query = query.Where("operation = ?", string(criteria.Operation))
+	}
+	if criteria.RequestID != "" {
+		query = query.Where("request_id = ?", criteria.RequestID)
+	}
+	if criteria.Field != "" {
+		// containment uses the GIN index on changes
+		filter, err := json.Marshal([]map[string]string{{"field": criteria.Field}})
+		if err != nil {
+			return nil, 0, err
+		}
+		query = query.Where("changes @> ?::jsonb", string(filter))
+	}
+	if criteria.From != nil {
+		query = query.Where("created_at >= ?", *criteria.From)
+	}
+	if criteria.To != nil {
+		query = query.Where("created_at < ?", *criteria.To)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var models []AuditEntryModel
+	err := query.
+		Limit(criteria.Limit).
+		Offset(criteria.Offset).
+		Order("created_at DESC, id DESC").
+		Find(&models).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	entries := make([]*entities.AuditEntry, 0, len(models))
+	for i := range models {
+		entries = append(entries, auditEntryToEntity(&models[i]))
+	}
+	return entries, total, nil
+}
+
+// recordMutation stores the fields that differ between before and after as
+// an audit entry of operation, attributed to the actor and request of ctx,
+// and records the new version of the product. It must run in the transaction
+// that saves after; nothing is stored when no field changed.
+func recordMutation(ctx context.Context, tx *gorm.DB, operation entities.AuditOperation, before, after *entities.Product) error {
+	entry := entities.NewAuditEntry(operation, before, after, requestctx.Actor(ctx), requestctx.RequestID(ctx), time.Now())
+	if entry == nil {
+		return nil
+	}
+	if err := tx.Create(auditEntryToModel(entry)).Error; err != nil {
+		return err
+	}
+	return RecordProductVersions(tx, entry.CreatedAt, after.ID)
+}
+
+// AuditProductColumn records an audit entry of operation for every product
+// matching where, changing field from the current value of column to value,
+// and returns the IDs of those products. Repositories that rewrite
+// denormalized product columns in bulk, like brand and category names, call
+// it in the same transaction right before the UPDATE and pass the IDs to
+// RecordProductVersions after it. column must be a trusted column name.
+func AuditProductColumn(ctx context.Context, tx *gorm.DB, operation entities.AuditOperation, field, column, value, where string, args ...interface{}) ([]uint, error) {
+	statement := fmt.Sprintf(`INSERT INTO product_audit (product_id, operation, actor, request_id, changes, created_at)
+		SELECT id, ?, ?, ?, jsonb_build_array(jsonb_build_object('field', ?::text, 'before', %s, 'after', ?::text)), ?
+		FROM products WHERE %s
+		RETURNING product_id`, column, where)
+
+	values := append([]interface{}{
+		string(operation), requestctx.Actor(ctx), requestctx.RequestID(ctx), field, value, time.Now(),
+	}, args...)
+
+	var productIDs []uint
+	if err := tx.Raw(statement, values...).Scan(&productIDs).Error; err != nil {
+		return nil, err
+	}
+	return productIDs, nil
+}
+
+// RenameProducts sets column, a product's denormalized brand or category name,
+// to name on the products whose ownerColumn is ownerID. The products whose
+// name changes are audited as operation, get a new version and have their
+// updated_at moved, so readers watching it, like the feed cache, notice.
+// column and ownerColumn must be trusted column names.
+func RenameProducts(ctx context.Context, tx *gorm.DB, operation entities.AuditOperation, column, name, ownerColumn string, ownerID uint) error {
+	changed, err := AuditProductColumn(ctx, tx, operation, column, column, name,
+		ownerColumn+" = ? AND "+column+" IS DISTINCT FROM ?", ownerID, name)
+	if err != nil || len(changed) == 0 {
+		return err
+	}
+
+	now := time.Now()
+	statement := fmt.Sprintf("UPDATE products SET %s = ?, updated_at = ? WHERE id IN ?", column)
+	if err := tx.Exec(statement, name, now, changed).Error; err != nil {
+		return err
+	}
+	return RecordProductVersions(tx, now, changed...)
+}
+
+func auditEntryToModel(entry *entities.AuditEntry) *AuditEntryModel {
+	return &AuditEntryModel{
+		ID:        entry.ID,
+		ProductID: entry.ProductID,
+		Operation: string(entry.Operation),
+		Actor:     entry.Actor,
+		RequestID: entry.RequestID,
+		Changes:   entry.Changes,
+		CreatedAt: entry.CreatedAt,
+	}
+}
+
+func auditEntryToEntity(model *AuditEntryModel) *entities.AuditEntry {
+	return &entities.AuditEntry{
+		ID:        model.ID,
+		ProductID: model.ProductID,
+		Operation: entities.AuditOperation(model.Operation),
+		Actor:     model.Actor,
+		RequestID: model.RequestID,
+		Changes:   model.Changes,
+		CreatedAt: model.CreatedAt,
+	}
+}
@@ -0,0 +1,42 @@
+package product_repository
+
+import (
+	"context"
+	"testing"
+
+	"product-service/internal/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenameProducts_MovesUpdatedAt(t *testing.T) {
+	// Given
+	db, connector := setupRecordingDB(t, idAnswer("RETURNING product_id", 3, 5))
+
+	// When
+	err := RenameProducts(context.Background(), db, entities.AuditBrandChange, "brand", "Apple", "brand_id", 1)
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, connector.statements, 4)
+	assert.Contains(t, connector.statements[0], "INSERT INTO product_audit")
+	assert.Contains(t, connector.statements[0], "WHERE brand_id = $7 AND brand IS DISTINCT FROM $8")
+	assert.Equal(t, "UPDATE products SET brand = $1, updated_at = $2 WHERE id IN ($3,$4)", connector.statements[1],
+		"renamed products must move updated_at, which the feed fingerprint watches")
+	assert.Contains(t, connector.statements[2], "UPDATE product_versions SET valid_to")
+	assert.Contains(t, connector.statements[3], "INSERT INTO product_versions")
+}
+
+func TestRenameProducts_NothingToRename(t *testing.T) {
+	// Given
+	db, connector := setupRecordingDB(t)
+
+	// When
+	err := RenameProducts(context.Background(), db, entities.AuditCategoryChange, "category", "Laptops", "category_id", 3)
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, connector.statements, 1, "products already carrying the name are left alone")
+	assert.Contains(t, connector.statements[0], "INSERT INTO product_audit")
+}
@@ -0,0 +1,128 @@
+package product_repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+
+	"gorm.io/gorm"
+)
+
+// errBatchOperationFailed rolls back an atomic batch after the failing
+// operation's error was recorded in its outcome
+var errBatchOperationFailed = errors.New("batch operation failed")
+
+// GormBatchRepository implements the BatchRepository interface using GORM
+type GormBatchRepository struct {
+	db *gorm.DB
+}
+
+// NewGormBatchRepository creates a new GORM batch repository
+func NewGormBatchRepository(db *gorm.DB) ports.BatchRepository {
+	return &GormBatchRepository{db: db}
+}
+
+// Apply implements ports.BatchRepository
+func (r *GormBatchRepository) Apply(ctx context.Context, targets []ports.BatchTarget, atomic bool, fn ports.BatchOperationFunc) ([]ports.BatchOutcome, error) {
+	outcomes := make([]ports.BatchOutcome, len(targets))
+
+	if !atomic {
+		for i, target := range targets {
+			var product *entities.Product
+			err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+				var err error
+				product, err = r.apply(ctx, tx, i, target, fn)
+				return err
+			})
+			if err != nil {
+				outcomes[i].Err = r.handleError(err)
+				continue
+			}
+			outcomes[i].Product = product
+		}
+		return outcomes, nil
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i, target := range targets {
+			product, err := r.apply(ctx, tx, i, target, fn)
+			if err != nil {
+				outcomes[i].Err = r.handleError(err)
+				return errBatchOperationFailed
+			}
+			outcomes[i].Product = product
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errBatchOperationFailed) {
+		return nil, err
+	}
+	return outcomes, nil
+}
+
+// apply runs operation index on the product target addresses and saves its
+// write, returning the product as stored
+func (r *GormBatchRepository) apply(ctx context.Context, tx *gorm.DB, index int, target ports.BatchTarget, fn ports.BatchOperationFunc) (*entities.Product, error) {
+	var product *entities.Product
+	var before entities.Product
+	if !target.IsZero() {
+		var err error
+		product, err = lockBatchTarget(ctx, tx, target)
+		if err != nil {
+			return nil, err
+		}
+		before = *product
+	}
+
+	write, err := fn(index, product)
+	if err != nil {
+		return nil, err
+	}
+
+	switch write.Kind {
+	case ports.BatchWriteCreate:
+		var count int64
+		if err := tx.Model(&ProductModel{}).Where("sku = ?", write.Product.SKU).Count(&count).Error; err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			return nil, domainErrors.ErrProductAlreadyExists
+		}
+		return insertProduct(ctx, tx, write.Product)
+	case ports.BatchWriteEdit:
+		return saveProductUpdate(ctx, tx, &before, write.Product, write.PriceChanges)
+	case ports.BatchWritePrice:
+		return write.Product, savePriceChange(ctx, tx, &before, write.Product, write.PriceChanges)
+	case ports.BatchWriteStatus:
+		return write.Product, saveTransition(ctx, tx, &before, write.Product, write.Transition)
+	default:
+		return nil, fmt.Errorf("unknown batch write kind %q", write.Kind)
+	}
+}
+
+// lockBatchTarget locks the product target addresses and loads its tags
+func lockBatchTarget(ctx context.Context, tx *gorm.DB, target ports.BatchTarget) (*entities.Product, error) {
+	if target.ID != 0 {
+		return lockProductWithTags(ctx, tx, target.ID)
+	}
+
+	product, err := lockProductWhere(tx, "sku = ?", target.SKU)
+	if err != nil {
+		return nil, err
+	}
+	return (&GormProductRepository{db: tx}).withTags(ctx, product)
+}
+
+// handleError keeps domain errors and maps SKU conflicts the way product
+// creation does
+func (r *GormBatchRepository) handleError(err error) error {
+	var domainErr *domainErrors.DomainError
+	if errors.As(err, &domainErr) {
+		return err
+	}
+	return (&GormProductRepository{}).handleError(err)
+}
@@ -0,0 +1,52 @@
+package product_repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"product-service/internal/application/ports"
+
+	"gorm.io/gorm"
+)
+
+// GormFeedRepository implements the FeedRepository interface using GORM
+type GormFeedRepository struct {
+	db *gorm.DB
+}
+
+// NewGormFeedRepository creates a new GORM feed repository
+func NewGormFeedRepository(db *gorm.DB) ports.FeedRepository {
+	return &GormFeedRepository{db: db}
+}
+
+// Fingerprint implements ports.FeedRepository. Soft-deleted products count
+// through deleted_at, and brand and category renames through the updated_at
+// RenameProducts moves; media rows are deleted for good, which their count
+// catches.
+func (r *GormFeedRepository) Fingerprint(ctx context.Context) (string, error) {
+	var row struct {
+		Products        int64
+		ProductsChanged *time.Time
+		Media           int64
+		MediaChanged    *time.Time
+	}
+	err := r.db.WithContext(ctx).Raw(`SELECT
+		(SELECT COUNT(*) FROM products) AS products,
+		(SELECT MAX(GREATEST(updated_at, deleted_at)) FROM products) AS products_changed,
+		(SELECT COUNT(*) FROM product_media) AS media,
+		(SELECT MAX(updated_at) FROM product_media) AS media_changed`).
+		Scan(&row).Error
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("p%d-%d-m%d-%d", row.Products, unixNano(row.ProductsChanged), row.Media, unixNano(row.MediaChanged)), nil
+}
+
+func unixNano(t *time.Time) int64 {
+	if t == nil {
+		return 0
+	}
+	return t.UnixNano()
+}
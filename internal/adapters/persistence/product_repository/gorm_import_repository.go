@@ -0,0 +1,191 @@
+package product_repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+
+	"gorm.io/gorm"
+)
+
+// importErrorBatchSize is how many error report lines EachError reads at once
+const importErrorBatchSize = 500
+
+// ProductImportModel represents the database model for bulk product imports
+type ProductImportModel struct {
+	ID         uint                   `gorm:"primarykey"`
+	FileName   string                 `gorm:"size:255"`
+	Status     string                 `gorm:"not null;size:20;index"`
+	DryRun     bool                   `gorm:"not null;default:false"`
+	Mapping    entities.ImportMapping `gorm:"type:jsonb;serializer:json"`
+	Currency   string                 `gorm:"size:3"`
+	TotalRows  int                    `gorm:"not null;default:0"`
+	Created    int                    `gorm:"not null;default:0"`
+	Updated    int                    `gorm:"not null;default:0"`
+	Failed     int                    `gorm:"not null;default:0"`
+	Error      string                 `gorm:"type:text"`
+	Actor      string                 `gorm:"size:255"`
+	RequestID  string                 `gorm:"size:100"`
+	CreatedAt  time.Time              `gorm:"not null"`
+	StartedAt  *time.Time             `gorm:""`
+	FinishedAt *time.Time             `gorm:""`
+}
+
+// TableName specifies the table name for GORM
+func (ProductImportModel) TableName() string {
+	return "product_imports"
+}
+
+// ImportErrorModel represents the database model for the lines of an
+// import's error report
+type ImportErrorModel struct {
+	ID        uint   `gorm:"primarykey"`
+	ImportID  uint   `gorm:"not null;index:idx_product_import_errors_import_row,priority:1"`
+	RowNumber int    `gorm:"not null;index:idx_product_import_errors_import_row,priority:2"`
+	SKU       string `gorm:"size:255"`
+	Field     string `gorm:"size:100"`
+	Code      string `gorm:"not null;size:100"`
+	Message   string `gorm:"not null;type:text"`
+}
+
+// TableName specifies the table name for GORM
+func (ImportErrorModel) TableName() string {
+	return "product_import_errors"
+}
+
+// GormImportRepository implements the ImportRepository interface using GORM
+type GormImportRepository struct {
+	db *gorm.DB
+}
+
+// NewGormImportRepository creates a new GORM import repository
+func NewGormImportRepository(db *gorm.DB) ports.ImportRepository {
+	return &GormImportRepository{db: db}
+}
+
+// Create implements ports.ImportRepository
+func (r *GormImportRepository) Create(ctx context.Context, productImport *entities.ProductImport) (*entities.ProductImport, error) {
+	model := importToModel(productImport)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return nil, err
+	}
+
+	return importToEntity(model), nil
+}
+
+// GetByID implements ports.ImportRepository
+func (r *GormImportRepository) GetByID(ctx context.Context, id uint) (*entities.ProductImport, error) {
+	var model ProductImportModel
+
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, domainErrors.ErrImportNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return importToEntity(&model), nil
+}
+
+// Save implements ports.ImportRepository
+func (r *GormImportRepository) Save(ctx context.Context, productImport *entities.ProductImport, rowErrors []entities.ImportRowError) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(importToModel(productImport)).Error; err != nil {
+			return err
+		}
+		if len(rowErrors) == 0 {
+			return nil
+		}
+
+		models := make([]ImportErrorModel, 0, len(rowErrors))
+		for _, rowError := range rowErrors {
+			models = append(models, ImportErrorModel{
+				ImportID:  productImport.ID,
+				RowNumber: rowError.Row,
+				SKU:       rowError.SKU,
+				Field:     rowError.Field,
+				Code:      rowError.Code,
+				Message:   rowError.Message,
+			})
+		}
+		return tx.Create(&models).Error
+	})
+}
+
+// EachError implements ports.ImportRepository
+func (r *GormImportRepository) EachError(ctx context.Context, importID uint, fn func(entities.ImportRowError) error) error {
+	var batch []ImportErrorModel
+	var fnErr error
+
+	// Imports append their errors as they read rows, so the batches, which
+	// follow the primary key, come in row order
+	result := r.db.WithContext(ctx).
+		Where("import_id = ?", importID).
+		FindInBatches(&batch, importErrorBatchSize, func(tx *gorm.DB, _ int) error {
+			for _, model := range batch {
+				fnErr = fn(entities.ImportRowError{
+					Row:     model.RowNumber,
+					SKU:     model.SKU,
+					Field:   model.Field,
+					Code:    model.Code,
+					Message: model.Message,
+				})
+				if fnErr != nil {
+					return fnErr
+				}
+			}
+			return nil
+		})
+	if fnErr != nil {
+		return fnErr
+	}
+	return result.Error
+}
+
+func importToModel(productImport *entities.ProductImport) *ProductImportModel {
+	return &ProductImportModel{
+		ID:         productImport.ID,
+		FileName:   productImport.FileName,
+		Status:     string(productImport.Status),
+		DryRun:     productImport.DryRun,
+		Mapping:    productImport.Mapping,
+		Currency:   productImport.Currency,
+		TotalRows:  productImport.TotalRows,
+		Created:    productImport.Created,
+		Updated:    productImport.Updated,
+		Failed:     productImport.Failed,
+		Error:      productImport.Error,
+		Actor:      productImport.Actor,
+		RequestID:  productImport.RequestID,
+		CreatedAt:  productImport.CreatedAt,
+		StartedAt:  productImport.StartedAt,
+		FinishedAt: productImport.FinishedAt,
+	}
+}
+
+func importToEntity(model *ProductImportModel) *entities.ProductImport {
+	return &entities.ProductImport{
+		ID:         model.ID,
+		FileName:   model.FileName,
+		Status:     entities.ImportStatus(model.Status),
+		DryRun:     model.DryRun,
+		Mapping:    model.Mapping,
+		Currency:   model.Currency,
+		TotalRows:  model.TotalRows,
+		Created:    model.Created,
+		Updated:    model.Updated,
+		Failed:     model.Failed,
+		Error:      model.Error,
+		Actor:      model.Actor,
+		RequestID:  model.RequestID,
+		CreatedAt:  model.CreatedAt,
+		StartedAt:  model.StartedAt,
+		FinishedAt: model.FinishedAt,
+	}
+}
@@ -0,0 +1,128 @@
+package product_repository
+
+import (
+	"context"
+	"time"
+
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+
+	"gorm.io/gorm"
+)
+
+// StatusTransitionModel represents the database model for product status history
+type StatusTransitionModel struct {
+	ID         uint      `gorm:"primarykey"`
+	ProductID  uint      `gorm:"not null;index"`
+	FromStatus string    `gorm:"not null;size:20"`
+	ToStatus   string    `gorm:"not null;size:20"`
+	Reason     string    `gorm:"size:500"`
+	Actor      string    `gorm:"size:255"`
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName specifies the table name for GORM
+func (StatusTransitionModel) TableName() string {
+	return "product_status_transitions"
+}
+
+// GormLifecycleRepository implements the LifecycleRepository interface using GORM
+type GormLifecycleRepository struct {
+	db *gorm.DB
+}
+
+// NewGormLifecycleRepository creates a new GORM lifecycle repository
+func NewGormLifecycleRepository(db *gorm.DB) ports.LifecycleRepository {
+	return &GormLifecycleRepository{db: db}
+}
+
+// ApplyTransition implements ports.LifecycleRepository
+func (r *GormLifecycleRepository) ApplyTransition(ctx context.Context, productID uint, fn ports.StatusTransitionFunc) (*entities.Product, error) {
+	var product *entities.Product
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		product, err = lockProduct(tx, productID)
+		if err != nil {
+			return err
+		}
+
+		before := *product
+
+		transition, err := fn(product)
+		if err != nil {
+			return err
+		}
+
+		return saveTransition(ctx, tx, &before, product, transition)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return product, nil
+}
+
+// saveTransition saves the status of product, locked as before, together
+// with the transition and the audit entry
+func saveTransition(ctx context.Context, tx *gorm.DB, before, product *entities.Product, transition *entities.StatusTransition) error {
+	err := tx.Model(&ProductModel{}).
+		Where("id = ?", product.ID).
+		Updates(map[string]interface{}{
+			"status":     string(product.Status),
+			"updated_at": product.UpdatedAt,
+		}).Error
+	if err != nil {
+		return err
+	}
+
+	model := transitionToModel(transition)
+	if err := tx.Create(model).Error; err != nil {
+		return err
+	}
+	transition.ID = model.ID
+	return recordMutation(ctx, tx, entities.AuditStatusChange, before, product)
+}
+
+// ListTransitions implements ports.LifecycleRepository
+func (r *GormLifecycleRepository) ListTransitions(ctx context.Context, productID uint) ([]*entities.StatusTransition, error) {
+	var models []StatusTransitionModel
+
+	err := r.db.WithContext(ctx).
+		Where("product_id = ?", productID).
+		Order("created_at ASC, id ASC").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	transitions := make([]*entities.StatusTransition, 0, len(models))
+	for i := range models {
+		transitions = append(transitions, transitionToEntity(&models[i]))
+	}
+	return transitions, nil
+}
+
+func transitionToModel(transition *entities.StatusTransition) *StatusTransitionModel {
+	return &StatusTransitionModel{
+		ID:         transition.ID,
+		ProductID:  transition.ProductID,
+		FromStatus: string(transition.From),
+		ToStatus:   string(transition.To),
+		Reason:     transition.Reason,
+		Actor:      transition.Actor,
+		CreatedAt:  transition.CreatedAt,
+	}
+}
+
+func transitionToEntity(model *StatusTransitionModel) *entities.StatusTransition {
+	return &entities.StatusTransition{
+		ID:        model.ID,
+		ProductID: model.ProductID,
+		From:      entities.ProductStatus(model.FromStatus),
+		To:        entities.ProductStatus(model.ToStatus),
+		Reason:    model.Reason,
+		Actor:     model.Actor,
+		CreatedAt: model.CreatedAt,
+	}
+}
@@ -0,0 +1,224 @@
+package product_repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+
+	"gorm.io/gorm"
+)
+
+// ProductMediaModel represents the database model for product media. The
+// migration adds a partial unique index allowing one primary per product.
+type ProductMediaModel struct {
+	ID           uint      `gorm:"primarykey"`
+	ProductID    uint      `gorm:"not null;index"`
+	Kind         string    `gorm:"not null;size:20"`
+	ContentType  string    `gorm:"not null;size:100"`
+	FileName     string    `gorm:"not null;size:255"`
+	Size         int64     `gorm:"not null"`
+	Width        int       `gorm:"not null;default:0"`
+	Height       int       `gorm:"not null;default:0"`
+	StorageKey   string    `gorm:"not null;size:500"`
+	ThumbnailKey string    `gorm:"size:500"`
+	AltText      string    `gorm:"size:255"`
+	Position     int       `gorm:"not null;default:0"`
+	IsPrimary    bool      `gorm:"not null;default:false"`
+	CreatedAt    time.Time `gorm:"autoCreateTime"`
+	UpdatedAt    time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName specifies the table name for GORM
+func (ProductMediaModel) TableName() string {
+	return "product_media"
+}
+
+// GormMediaRepository implements the MediaRepository interface using GORM
+type GormMediaRepository struct {
+	db *gorm.DB
+}
+
+// NewGormMediaRepository creates a new GORM media repository
+func NewGormMediaRepository(db *gorm.DB) ports.MediaRepository {
+	return &GormMediaRepository{db: db}
+}
+
+// Create implements ports.MediaRepository
+func (r *GormMediaRepository) Create(ctx context.Context, media *entities.ProductMedia) (*entities.ProductMedia, error) {
+	model := mediaToModel(media)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if model.IsPrimary {
+			if err := clearPrimary(tx, model.ProductID); err != nil {
+				return err
+			}
+		}
+		return tx.Create(model).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mediaToEntity(model), nil
+}
+
+// GetMedia implements ports.MediaRepository
+func (r *GormMediaRepository) GetMedia(ctx context.Context, productID, mediaID uint) (*entities.ProductMedia, error) {
+	var model ProductMediaModel
+
+	err := r.db.WithContext(ctx).
+		Where("id = ? AND product_id = ?", mediaID, productID).
+		First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrMediaNotFound
+		}
+		return nil, err
+	}
+
+	return mediaToEntity(&model), nil
+}
+
+// ListMedia implements ports.MediaRepository
+func (r *GormMediaRepository) ListMedia(ctx context.Context, productID uint) ([]*entities.ProductMedia, error) {
+	media, err := r.ListMediaForProducts(ctx, []uint{productID})
+	if err != nil {
+		return nil, err
+	}
+	if media[productID] == nil {
+		return []*entities.ProductMedia{}, nil
+	}
+	return media[productID], nil
+}
+
+// ListMediaForProducts implements ports.MediaRepository
+func (r *GormMediaRepository) ListMediaForProducts(ctx context.Context, productIDs []uint) (map[uint][]*entities.ProductMedia, error) {
+	result := make(map[uint][]*entities.ProductMedia)
+	if len(productIDs) == 0 {
+		return result, nil
+	}
+
+	var models []*ProductMediaModel
+	err := r.db.WithContext(ctx).
+		Where("product_id IN ?", productIDs).
+		Order("position ASC, id ASC").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, model := range models {
+		result[model.ProductID] = append(result[model.ProductID], mediaToEntity(model))
+	}
+	return result, nil
+}
+
+// Update implements ports.MediaRepository
+func (r *GormMediaRepository) Update(ctx context.Context, media *entities.ProductMedia) (*entities.ProductMedia, error) {
+	model := mediaToModel(media)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if model.IsPrimary {
+			if err := clearPrimary(tx, model.ProductID); err != nil {
+				return err
+			}
+		}
+
+		result := tx.Model(&ProductMediaModel{ID: model.ID}).
+			Where("product_id = ?", model.ProductID).
+			Select("alt_text", "is_primary", "updated_at").
+			Updates(model)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domainErrors.ErrMediaNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetMedia(ctx, media.ProductID, media.ID)
+}
+
+// SavePositions implements ports.MediaRepository
+func (r *GormMediaRepository) SavePositions(ctx context.Context, productID uint, media []*entities.ProductMedia) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, item := range media {
+			err := tx.Model(&ProductMediaModel{}).
+				Where("id = ? AND product_id = ?", item.ID, productID).
+				Updates(map[string]interface{}{"position": item.Position, "updated_at": time.Now()}).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteMedia implements ports.MediaRepository
+func (r *GormMediaRepository) DeleteMedia(ctx context.Context, productID, mediaID uint) error {
+	result := r.db.WithContext(ctx).
+		Where("id = ? AND product_id = ?", mediaID, productID).
+		Delete(&ProductMediaModel{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domainErrors.ErrMediaNotFound
+	}
+	return nil
+}
+
+// clearPrimary makes none of a product's media primary
+func clearPrimary(tx *gorm.DB, productID uint) error {
+	return tx.Model(&ProductMediaModel{}).
+		Where("product_id = ? AND is_primary", productID).
+		Updates(map[string]interface{}{"is_primary": false, "updated_at": time.Now()}).Error
+}
+
+func mediaToModel(media *entities.ProductMedia) *ProductMediaModel {
+	return &ProductMediaModel{
+		ID:           media.ID,
+		ProductID:    media.ProductID,
+		Kind:         string(media.Kind),
+		ContentType:  media.ContentType,
+		FileName:     media.FileName,
+		Size:         media.Size,
+		Width:        media.Width,
+		Height:       media.Height,
+		StorageKey:   media.StorageKey,
+		ThumbnailKey: media.ThumbnailKey,
+		AltText:      media.AltText,
+		Position:     media.Position,
+		IsPrimary:    media.Primary,
+		CreatedAt:    media.CreatedAt,
+		UpdatedAt:    media.UpdatedAt,
+	}
+}
+
+func mediaToEntity(model *ProductMediaModel) *entities.ProductMedia {
+	return &entities.ProductMedia{
+		ID:           model.ID,
+		ProductID:    model.ProductID,
+		Kind:         entities.MediaKind(model.Kind),
+		ContentType:  model.ContentType,
+		FileName:     model.FileName,
+		Size:         model.Size,
+		Width:        model.Width,
+		Height:       model.Height,
+		StorageKey:   model.StorageKey,
+		ThumbnailKey: model.ThumbnailKey,
+		AltText:      model.AltText,
+		Position:     model.Position,
+		Primary:      model.IsPrimary,
+		CreatedAt:    model.CreatedAt,
+		UpdatedAt:    model.UpdatedAt,
+	}
+}
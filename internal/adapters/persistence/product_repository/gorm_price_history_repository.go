@@ -0,0 +1,359 @@
+package product_repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+	"product-service/pkg/requestctx"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PriceChangeModel represents the database model for price history entries
+type PriceChangeModel struct {
+	ID            uint       `gorm:"primarykey"`
+	ProductID     uint       `gorm:"not null;index"`
+	OldAmount     *string    `gorm:"type:numeric(18,4)"`
+	OldCurrency   *string    `gorm:"size:3"`
+	NewAmount     string     `gorm:"not null;type:numeric(18,4)"`
+	NewCurrency   string     `gorm:"not null;size:3"`
+	Reason        string     `gorm:"size:500"`
+	Actor         string     `gorm:"size:255"`
+	EffectiveFrom time.Time  `gorm:"not null"`
+	EffectiveTo   *time.Time `gorm:""`
+	Status        string     `gorm:"not null;size:20;index"`
+	AppliedAt     *time.Time `gorm:""`
+	EndedAt       *time.Time `gorm:""`
+	CreatedAt     time.Time  `gorm:"autoCreateTime"`
+}
+
+// TableName specifies the table name for GORM
+func (PriceChangeModel) TableName() string {
+	return "price_history"
+}
+
+// GormPriceHistoryRepository implements the PriceHistoryRepository interface using GORM
+type GormPriceHistoryRepository struct {
+	db *gorm.DB
+}
+
+// NewGormPriceHistoryRepository creates a new GORM price history repository
+func NewGormPriceHistoryRepository(db *gorm.DB) ports.PriceHistoryRepository {
+	return &GormPriceHistoryRepository{db: db}
+}
+
+// ApplyPriceChange implements ports.PriceHistoryRepository
+func (r *GormPriceHistoryRepository) ApplyPriceChange(ctx context.Context, productID uint, fn ports.PriceChangeFunc) (*entities.Product, error) {
+	var product *entities.Product
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		product, err = lockProduct(tx, productID)
+		if err != nil {
+			return err
+		}
+
+		before := *product
+
+		changes, err := fn(product)
+		if err != nil {
+			return err
+		}
+
+		return savePriceChange(ctx, tx, &before, product, changes)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return product, nil
+}
+
+// ProcessDuePriceChanges implements ports.PriceHistoryRepository
+func (r *GormPriceHistoryRepository) ProcessDuePriceChanges(ctx context.Context, now time.Time, limit int, fn ports.DuePriceChangeFunc) (int, error) {
+	processed := 0
+	var failures []error
+
+	for processed < limit {
+		found := false
+		// failed is the change the transaction gave up on, when it did
+		var failed *PriceChangeModel
+
+		err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			var model PriceChangeModel
+			result := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+				Where("(status = ? AND effective_from <= ?) OR (status = ? AND effective_to <= ?)",
+					string(entities.PriceChangeScheduled), now, string(entities.PriceChangeApplied), now).
+				Order(clause.Expr{SQL: "CASE WHEN status = ? THEN effective_to ELSE effective_from END, id", Vars: []interface{}{string(entities.PriceChangeApplied)}}).
+				Limit(1).
+				Find(&model)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return nil
+			}
+			found = true
+
+			change, err := priceChangeToEntity(&model)
+			if err != nil {
+				failed = &model
+				return err
+			}
+
+			product, err := lockProduct(tx, change.ProductID)
+			if errors.Is(err, domainErrors.ErrProductNotFound) {
+				// the product was deleted; nothing left to apply
+				if err := change.Cancel(now); err != nil {
+					change.Status = entities.PriceChangeExpired
+					change.EndedAt = &now
+				}
+				return tx.Save(priceChangeToModel(change)).Error
+			}
+			if err != nil {
+				return err
+			}
+
+			before := *product
+
+			additional, err := fn(change, product)
+			if err != nil {
+				failed = &model
+				return err
+			}
+
+			if err := tx.Save(priceChangeToModel(change)).Error; err != nil {
+				return err
+			}
+			if err := saveProductPrice(tx, product); err != nil {
+				return err
+			}
+			// the change is applied on behalf of whoever scheduled it
+			auditCtx := ctx
+			if requestctx.Actor(ctx) == "" {
+				auditCtx = requestctx.WithActor(ctx, change.Actor)
+			}
+			if err := recordMutation(auditCtx, tx, entities.AuditPriceChange, &before, product); err != nil {
+				return err
+			}
+			return createPriceChanges(tx, additional)
+		})
+		if err != nil && failed != nil {
+			// the change would come first again on every run, so it is set
+			// aside for the later ones to go ahead
+			if err := r.failPriceChange(ctx, failed, now); err != nil {
+				return processed, errors.Join(append(failures, err)...)
+			}
+			failures = append(failures, fmt.Errorf("price change %d: %w", failed.ID, err))
+			processed++
+			continue
+		}
+		if err != nil {
+			return processed, errors.Join(append(failures, err)...)
+		}
+		if !found {
+			break
+		}
+		processed++
+	}
+
+	return processed, errors.Join(failures...)
+}
+
+// failPriceChange marks a change failed unless another instance processed
+// it meanwhile
+func (r *GormPriceHistoryRepository) failPriceChange(ctx context.Context, model *PriceChangeModel, now time.Time) error {
+	return r.db.WithContext(ctx).Model(&PriceChangeModel{}).
+		Where("id = ? AND status = ?", model.ID, model.Status).
+		Updates(map[string]interface{}{
+			"status":   string(entities.PriceChangeFailed),
+			"ended_at": now,
+		}).Error
+}
+
+// SchedulePriceChange implements ports.PriceHistoryRepository
+func (r *GormPriceHistoryRepository) SchedulePriceChange(ctx context.Context, change *entities.PriceChange) (*entities.PriceChange, error) {
+	model := priceChangeToModel(change)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return nil, err
+	}
+
+	return priceChangeToEntity(model)
+}
+
+// GetPriceChange implements ports.PriceHistoryRepository
+func (r *GormPriceHistoryRepository) GetPriceChange(ctx context.Context, productID, changeID uint) (*entities.PriceChange, error) {
+	var model PriceChangeModel
+
+	err := r.db.WithContext(ctx).
+		Where("id = ? AND product_id = ?", changeID, productID).
+		First(&model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, domainErrors.ErrPriceChangeNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return priceChangeToEntity(&model)
+}
+
+// SavePriceChange implements ports.PriceHistoryRepository
+func (r *GormPriceHistoryRepository) SavePriceChange(ctx context.Context, change *entities.PriceChange) error {
+	result := r.db.WithContext(ctx).Model(&PriceChangeModel{}).
+		Where("id = ? AND status = ?", change.ID, string(entities.PriceChangeScheduled)).
+		Updates(map[string]interface{}{
+			"status":   string(change.Status),
+			"ended_at": change.EndedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domainErrors.ErrPriceChangeNotCancellable
+	}
+	return nil
+}
+
+// ListPriceChanges implements ports.PriceHistoryRepository
+func (r *GormPriceHistoryRepository) ListPriceChanges(ctx context.Context, productID uint) ([]*entities.PriceChange, error) {
+	var models []PriceChangeModel
+
+	err := r.db.WithContext(ctx).
+		Where("product_id = ?", productID).
+		Order("effective_from ASC, id ASC").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*entities.PriceChange, 0, len(models))
+	for i := range models {
+		change, err := priceChangeToEntity(&models[i])
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// lockProduct loads a product with a row lock held until the transaction ends
+func lockProduct(tx *gorm.DB, productID uint) (*entities.Product, error) {
+	return lockProductWhere(tx, "id = ?", productID)
+}
+
+func lockProductWhere(tx *gorm.DB, query string, args ...interface{}) (*entities.Product, error) {
+	var model ProductModel
+
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where(query, args...).First(&model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, domainErrors.ErrProductNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return (&GormProductRepository{}).toEntity(&model)
+}
+
+// lockProductWithTags locks a product like lockProduct and loads its tags
+func lockProductWithTags(ctx context.Context, tx *gorm.DB, productID uint) (*entities.Product, error) {
+	product, err := lockProduct(tx, productID)
+	if err != nil {
+		return nil, err
+	}
+	return (&GormProductRepository{db: tx}).withTags(ctx, product)
+}
+
+func saveProductPrice(tx *gorm.DB, product *entities.Product) error {
+	return tx.Model(&ProductModel{}).
+		Where("id = ?", product.ID).
+		Updates(map[string]interface{}{
+			"price":      product.Price.Decimal(),
+			"currency":   product.Price.Currency(),
+			"updated_at": product.UpdatedAt,
+		}).Error
+}
+
+// savePriceChange saves the price of product, locked as before, together
+// with its history entries and the audit entry
+func savePriceChange(ctx context.Context, tx *gorm.DB, before, product *entities.Product, changes []*entities.PriceChange) error {
+	if err := saveProductPrice(tx, product); err != nil {
+		return err
+	}
+	if err := recordMutation(ctx, tx, entities.AuditPriceChange, before, product); err != nil {
+		return err
+	}
+	return createPriceChanges(tx, changes)
+}
+
+func createPriceChanges(tx *gorm.DB, changes []*entities.PriceChange) error {
+	for _, change := range changes {
+		model := priceChangeToModel(change)
+		if err := tx.Create(model).Error; err != nil {
+			return err
+		}
+		change.ID = model.ID
+	}
+	return nil
+}
+
+func priceChangeToModel(change *entities.PriceChange) *PriceChangeModel {
+	model := &PriceChangeModel{
+		ID:            change.ID,
+		ProductID:     change.ProductID,
+		NewAmount:     change.NewPrice.Decimal(),
+		NewCurrency:   change.NewPrice.Currency(),
+		Reason:        change.Reason,
+		Actor:         change.Actor,
+		EffectiveFrom: change.EffectiveFrom,
+		EffectiveTo:   change.EffectiveTo,
+		Status:        string(change.Status),
+		AppliedAt:     change.AppliedAt,
+		EndedAt:       change.EndedAt,
+		CreatedAt:     change.CreatedAt,
+	}
+	if !change.OldPrice.IsZero() {
+		oldAmount := change.OldPrice.Decimal()
+		oldCurrency := change.OldPrice.Currency()
+		model.OldAmount = &oldAmount
+		model.OldCurrency = &oldCurrency
+	}
+	return model
+}
+
+func priceChangeToEntity(model *PriceChangeModel) (*entities.PriceChange, error) {
+	newPrice, err := entities.ParseMoney(model.NewAmount, model.NewCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	change := &entities.PriceChange{
+		ID:            model.ID,
+		ProductID:     model.ProductID,
+		NewPrice:      newPrice,
+		Reason:        model.Reason,
+		Actor:         model.Actor,
+		EffectiveFrom: model.EffectiveFrom,
+		EffectiveTo:   model.EffectiveTo,
+		Status:        entities.PriceChangeStatus(model.Status),
+		AppliedAt:     model.AppliedAt,
+		EndedAt:       model.EndedAt,
+		CreatedAt:     model.CreatedAt,
+	}
+	if model.OldAmount != nil && model.OldCurrency != nil {
+		change.OldPrice, err = entities.ParseMoney(*model.OldAmount, *model.OldCurrency)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return change, nil
+}
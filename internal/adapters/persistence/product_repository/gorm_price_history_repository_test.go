@@ -0,0 +1,74 @@
+package product_repository
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"product-service/internal/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// duePriceChange answers the scheduler's claim query with a scheduled change
+func duePriceChange(id, productID int64, amount string, effectiveFrom time.Time) recordingAnswer {
+	return recordingAnswer{
+		match:   `FROM "price_history"`,
+		columns: []string{"id", "product_id", "new_amount", "new_currency", "effective_from", "status"},
+		rows:    [][]driver.Value{{id, productID, amount, "USD", effectiveFrom, string(entities.PriceChangeScheduled)}},
+	}
+}
+
+// lockedProduct answers the product lock of a change
+func lockedProduct(id int64, price string) recordingAnswer {
+	return recordingAnswer{
+		match:   `FROM "products"`,
+		columns: []string{"id", "name", "sku", "price", "currency", "status"},
+		rows:    [][]driver.Value{{id, "Laptop", "LAP-001", price, "USD", string(entities.ProductStatusActive)}},
+	}
+}
+
+func TestProcessDuePriceChanges_PoisonedChangeDoesNotBlockLaterOnes(t *testing.T) {
+	// Given
+	now := time.Now()
+	db, connector := setupRecordingDB(t,
+		duePriceChange(1, 10, "899.00", now.Add(-2*time.Hour)),
+		lockedProduct(10, "999.00"),
+		duePriceChange(2, 11, "49.00", now.Add(-time.Hour)),
+		lockedProduct(11, "59.00"),
+	)
+	repo := NewGormPriceHistoryRepository(db)
+
+	var seen []uint
+	poisoned := errors.New("cannot apply")
+
+	// When
+	processed, err := repo.ProcessDuePriceChanges(context.Background(), now, 10, func(change *entities.PriceChange, product *entities.Product) ([]*entities.PriceChange, error) {
+		seen = append(seen, change.ID)
+		if change.ID == 1 {
+			return nil, poisoned
+		}
+		_, err := change.Advance(product, now)
+		return nil, err
+	})
+
+	// Then
+	assert.ErrorIs(t, err, poisoned, "the failure is still reported")
+	assert.Equal(t, 2, processed)
+	assert.Equal(t, []uint{1, 2}, seen, "the change after the poisoned one is processed in the same run")
+
+	failedAt, appliedAt := -1, -1
+	for i, statement := range connector.statements {
+		switch {
+		case statement == `UPDATE "price_history" SET "ended_at"=$1,"status"=$2 WHERE id = $3 AND status = $4`:
+			failedAt = i
+		case appliedAt < 0 && failedAt >= 0 && statement == `UPDATE "products" SET "currency"=$1,"price"=$2,"updated_at"=$3 WHERE id = $4 AND "products"."deleted_at" IS NULL`:
+			appliedAt = i
+		}
+	}
+	require.GreaterOrEqual(t, failedAt, 0, "the poisoned change is marked failed")
+	assert.Greater(t, appliedAt, failedAt, "the next change is applied after it")
+}
@@ -2,7 +2,9 @@ package product_repository
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -15,18 +17,23 @@ import (
 
 // ProductModel represents the database model for products
 type ProductModel struct {
-	ID          uint           `gorm:"primarykey"`
-	Name        string         `gorm:"not null;size:255"`
-	Description string         `gorm:"size:1000"`
-	SKU         string         `gorm:"uniqueIndex;not null;size:50"`
-	Price       float64        `gorm:"not null;type:decimal(10,2)"`
-	Category    string         `gorm:"not null;size:100"`
-	Brand       string         `gorm:"size:100"`
-	Stock       int            `gorm:"not null;default:0"`
-	Status      string         `gorm:"not null;default:'active';size:20"`
-	CreatedAt   time.Time      `gorm:"autoCreateTime"`
-	UpdatedAt   time.Time      `gorm:"autoUpdateTime"`
-	DeletedAt   gorm.DeletedAt `gorm:"index"` // For soft deletes
+	ID          uint                   `gorm:"primarykey"`
+	Name        string                 `gorm:"not null;size:255"`
+	Description string                 `gorm:"size:1000"`
+	SKU         string                 `gorm:"uniqueIndex;not null;size:50"`
+	GTIN        *string                `gorm:"size:14"` // unique in GTIN-14 form, see migration 0018
+	Price       string                 `gorm:"not null;type:numeric(18,4)"`
+	Currency    string                 `gorm:"not null;default:'USD';size:3"`
+	CategoryID  uint                   `gorm:"not null;index"`
+	Category    string                 `gorm:"not null;size:100"`
+	BrandID     *uint                  `gorm:"index"`
+	Brand       string                 `gorm:"size:100"`
+	Stock       int                    `gorm:"not null;default:0"`
+	Status      string                 `gorm:"not null;default:'active';size:20"`
+	Attributes  map[string]interface{} `gorm:"not null;default:'{}';type:jsonb;serializer:json"`
+	CreatedAt   time.Time              `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time              `gorm:"autoUpdateTime"`
+	DeletedAt   gorm.DeletedAt         `gorm:"index"` // For soft deletes
 }
 
 // TableName specifies the table name for GORM
@@ -55,14 +62,42 @@ func (r *GormProductRepository) Create(ctx context.Context, product *entities.Pr
 		return nil, domainErrors.ErrProductAlreadyExists
 	}
 
-	gormModel := r.toModel(product)
+	var created *entities.Product
 
-	// Create product in database
-	if err := r.db.WithContext(ctx).Create(gormModel).Error; err != nil {
+	// Create product, its tag links and audit entry in one transaction
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		created, err = insertProduct(ctx, tx, product)
+		return err
+	})
+	if err != nil {
 		return nil, r.handleError(err)
 	}
 
-	return r.toEntity(gormModel), nil
+	return created, nil
+}
+
+// insertProduct creates product and its tag links and records the audit
+// entry, returning the stored product
+func insertProduct(ctx context.Context, tx *gorm.DB, product *entities.Product) (*entities.Product, error) {
+	repo := &GormProductRepository{}
+	gormModel := repo.toModel(product)
+	if err := tx.Create(gormModel).Error; err != nil {
+		return nil, err
+	}
+	if err := saveProductTags(tx, gormModel.ID, product.Tags); err != nil {
+		return nil, err
+	}
+
+	created, err := repo.toEntity(gormModel)
+	if err != nil {
+		return nil, err
+	}
+	created.Tags = normalizedTags(product.Tags)
+	if err := recordMutation(ctx, tx, entities.AuditCreate, nil, created); err != nil {
+		return nil, err
+	}
+	return created, nil
 }
 
 // GetByID implements ports.ProductRepository
@@ -74,7 +109,11 @@ func (r *GormProductRepository) GetByID(ctx context.Context, id uint) (*entities
 		return nil, r.handleError(err)
 	}
 
-	return r.toEntity(&model), nil
+	product, err := r.toEntity(&model)
+	if err != nil {
+		return nil, err
+	}
+	return r.withTags(ctx, product)
 }
 
 // GetBySKU implements ports.ProductRepository
@@ -86,7 +125,40 @@ func (r *GormProductRepository) GetBySKU(ctx context.Context, sku string) (*enti
 		return nil, r.handleError(err)
 	}
 
-	return r.toEntity(&model), nil
+	product, err := r.toEntity(&model)
+	if err != nil {
+		return nil, err
+	}
+	return r.withTags(ctx, product)
+}
+
+// GetByGTIN implements ports.ProductRepository
+func (r *GormProductRepository) GetByGTIN(ctx context.Context, gtin string) (*entities.Product, error) {
+	var model ProductModel
+
+	err := r.db.WithContext(ctx).Where("lpad(gtin, 14, '0') = ?", entities.GTINKey(gtin)).First(&model).Error
+	if err != nil {
+		return nil, r.handleError(err)
+	}
+
+	product, err := r.toEntity(&model)
+	if err != nil {
+		return nil, err
+	}
+	return r.withTags(ctx, product)
+}
+
+// ExistsByGTIN implements ports.ProductRepository
+func (r *GormProductRepository) ExistsByGTIN(ctx context.Context, gtin string, excludeID uint) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&ProductModel{}).
+		Where("lpad(gtin, 14, '0') = ? AND id <> ?", entities.GTINKey(gtin), excludeID).
+		Count(&count).Error
+	if err != nil {
+		return false, domainErrors.ErrFailedToCheckProductExistance
+	}
+
+	return count > 0, nil
 }
 
 // ExistsBySKU implements ports.ProductRepository
@@ -100,17 +172,77 @@ func (r *GormProductRepository) ExistsBySKU(ctx context.Context, sku string) (bo
 	return count > 0, nil
 }
 
-// Update implements ports.ProductRepository (additional method for completeness)
-func (r *GormProductRepository) Update(ctx context.Context, product *entities.Product) (*entities.Product, error) {
-	gormModel := r.toModel(product)
-
-	err := r.db.WithContext(ctx).Model(gormModel).Where("id = ?", product.ID).Updates(gormModel).Error
+// ApplyEdit implements ports.ProductRepository
+func (r *GormProductRepository) ApplyEdit(ctx context.Context, id uint, fn ports.ProductEditFunc) (*entities.Product, error) {
+	var product *entities.Product
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		locked, err := lockProductWithTags(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		before := *locked
+		priceChanges, err := fn(locked)
+		if err != nil {
+			return err
+		}
+
+		product, err = saveProductUpdate(ctx, tx, &before, locked, priceChanges)
+		return err
+	})
 	if err != nil {
+		var domainErr *domainErrors.DomainError
+		if errors.As(err, &domainErr) {
+			return nil, err
+		}
 		return nil, r.handleError(err)
 	}
 
-	// Fetch updated record to return
-	return r.GetByID(ctx, product.ID)
+	return product, nil
+}
+
+// saveProductUpdate saves the editable fields of product, locked as before,
+// with the history entries of its price changes and records the audit entry,
+// returning the product as stored
+func saveProductUpdate(ctx context.Context, tx *gorm.DB, before, product *entities.Product, priceChanges []*entities.PriceChange) (*entities.Product, error) {
+	if err := saveProductEdits(tx, product); err != nil {
+		return nil, err
+	}
+
+	// only the editable fields were saved
+	after := *product
+	after.SKU, after.Status = before.SKU, before.Status
+	if after.Tags == nil {
+		after.Tags = before.Tags
+	}
+	if err := recordMutation(ctx, tx, entities.AuditUpdate, before, &after); err != nil {
+		return nil, err
+	}
+	if err := createPriceChanges(tx, priceChanges); err != nil {
+		return nil, err
+	}
+	return &after, nil
+}
+
+// saveProductEdits saves the editable fields of a product. Tags are only
+// rewritten when the product carries a tag list, as products loaded through
+// this repository always do. The status only changes through the
+// LifecycleRepository.
+func saveProductEdits(tx *gorm.DB, product *entities.Product) error {
+	result := tx.Model(&ProductModel{ID: product.ID}).
+		Select("name", "description", "gtin", "price", "currency", "category_id", "category", "brand_id", "brand", "stock", "attributes", "updated_at").
+		Updates((&GormProductRepository{}).toModel(product))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domainErrors.ErrProductNotFound
+	}
+	if product.Tags == nil {
+		return nil
+	}
+	return saveProductTags(tx, product.ID, product.Tags)
 }
 
 // Delete implements ports.ProductRepository (additional method for completeness)
@@ -136,26 +268,148 @@ func (r *GormProductRepository) List(ctx context.Context, limit, offset int) ([]
 		return nil, r.handleError(err)
 	}
 
-	return r.toEntities(models), nil
+	products, err := r.toEntities(models)
+	if err != nil {
+		return nil, err
+	}
+	return r.withTagsAll(ctx, products)
 }
 
-// Search implements ports.ProductRepository (additional method for completeness)
-func (r *GormProductRepository) Search(ctx context.Context, query string, limit, offset int) ([]*entities.Product, error) {
-	var models []ProductModel
+// Search implements ports.ProductRepository
+func (r *GormProductRepository) Search(ctx context.Context, criteria ports.ProductSearchCriteria) ([]*entities.Product, int64, error) {
+	query := r.searchQuery(ctx, criteria)
 
-	searchQuery := "%" + query + "%"
-	err := r.db.WithContext(ctx).Model(&ProductModel{}).
-		Where("name ILIKE ? OR description ILIKE ? OR sku ILIKE ?", searchQuery, searchQuery, searchQuery).
-		Limit(limit).
-		Offset(offset).
-		Order("created_at DESC").
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, r.handleError(err)
+	}
+
+	var models []ProductModel
+	err := query.
+		Limit(criteria.Limit).
+		Offset(criteria.Offset).
+		Order("created_at DESC, id DESC").
 		Find(&models).Error
+	if err != nil {
+		return nil, 0, r.handleError(err)
+	}
 
+	products, err := r.toEntities(models)
 	if err != nil {
-		return nil, r.handleError(err)
+		return nil, 0, err
+	}
+	products, err = r.withTagsAll(ctx, products)
+	if err != nil {
+		return nil, 0, err
+	}
+	return products, total, nil
+}
+
+// Each implements ports.ProductRepository. Tags are aggregated into each row
+// so a product is complete when it leaves the cursor.
+func (r *GormProductRepository) Each(ctx context.Context, criteria ports.ProductSearchCriteria, fn func(*entities.Product) error) error {
+	rows, err := r.searchQuery(ctx, criteria).
+		Select("products.*, (SELECT COALESCE(json_agg(t.name ORDER BY t.name), '[]')::text FROM product_tags pt JOIN tags t ON t.id = pt.tag_id WHERE pt.product_id = products.id) AS tag_names").
+		Order("id ASC").
+		Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row productWithTags
+		if err := r.db.ScanRows(rows, &row); err != nil {
+			return err
+		}
+		product, err := r.toEntity(&row.ProductModel)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal([]byte(row.TagNames), &product.Tags); err != nil {
+			return fmt.Errorf("failed to decode tags of product %d: %w", product.ID, err)
+		}
+		if err := fn(product); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// productWithTags is a product row with its tag names as a JSON array
+type productWithTags struct {
+	ProductModel `gorm:"embedded"`
+	TagNames     string
+}
+
+// searchQuery filters products by the criteria, without paging or order
+func (r *GormProductRepository) searchQuery(ctx context.Context, criteria ports.ProductSearchCriteria) *gorm.DB {
+	query := r.db.WithContext(ctx).Model(&ProductModel{})
+
+	if criteria.Query != "" {
+		searchQuery := "%" + criteria.Query + "%"
+		query = query.Where("name ILIKE ? OR description ILIKE ? OR sku ILIKE ?", searchQuery, searchQuery, searchQuery)
+	}
+	if criteria.CategoryPath != "" {
+		// slugs never contain LIKE wildcards, see category_repository
+		query = query.Where("category_id IN (SELECT id FROM categories WHERE path = ? OR path LIKE ?)",
+			criteria.CategoryPath, criteria.CategoryPath+"/%")
+	}
+	if criteria.Brand != "" {
+		query = query.Where("brand = ?", criteria.Brand)
+	}
+	if criteria.MinPrice != nil {
+		query = query.Where("currency = ? AND price >= ?", criteria.MinPrice.Currency(), criteria.MinPrice.Decimal())
+	}
+	if criteria.MaxPrice != nil {
+		query = query.Where("currency = ? AND price <= ?", criteria.MaxPrice.Currency(), criteria.MaxPrice.Decimal())
 	}
+	if criteria.InStock != nil {
+		if *criteria.InStock {
+			query = query.Where("stock > 0")
+		} else {
+			query = query.Where("stock = 0")
+		}
+	}
+	if criteria.Status != nil {
+		query = query.Where("status = ?", string(*criteria.Status))
+	}
+	for _, filter := range criteria.Attributes {
+		query = whereAttribute(query, filter)
+	}
+	if len(criteria.Tags) > 0 {
+		query = query.Where("id IN (SELECT pt.product_id FROM product_tags pt JOIN tags t ON t.id = pt.tag_id WHERE t.name IN ? GROUP BY pt.product_id HAVING COUNT(*) = ?)",
+			criteria.Tags, len(criteria.Tags))
+	}
+	if criteria.IDs != nil {
+		query = query.Where("id IN ?", criteria.IDs)
+	}
+
+	return query
+}
 
-	return r.toEntities(models), nil
+// whereAttribute narrows query to products whose JSONB attribute matches the
+// filter. Numeric comparisons first check the JSON type, so the same key
+// holding text in another category never reaches the cast.
+func whereAttribute(query *gorm.DB, filter entities.AttributeFilter) *gorm.DB {
+	switch filter.Type {
+	case entities.AttributeInt, entities.AttributeDecimal, entities.AttributeUnit:
+		operator := "="
+		switch filter.Operator {
+		case entities.AttributeMin:
+			operator = ">="
+		case entities.AttributeMax:
+			operator = "<="
+		}
+		return query.Where("jsonb_typeof(attributes -> ?) = 'number' AND (attributes ->> ?)::numeric "+operator+" ?::numeric",
+			filter.Key, filter.Key, fmt.Sprint(filter.Value))
+	case entities.AttributeBool:
+		return query.Where("attributes -> ? = ?::jsonb", filter.Key, fmt.Sprint(filter.Value))
+	case entities.AttributeString:
+		return query.Where("lower(attributes ->> ?) = lower(?)", filter.Key, fmt.Sprint(filter.Value))
+	default:
+		return query.Where("attributes ->> ? = ?", filter.Key, fmt.Sprint(filter.Value))
+	}
 }
 
 // GetByCategory implements ports.ProductRepository (additional method for completeness)
@@ -173,7 +427,11 @@ func (r *GormProductRepository) GetByCategory(ctx context.Context, category stri
 		return nil, r.handleError(err)
 	}
 
-	return r.toEntities(models), nil
+	products, err := r.toEntities(models)
+	if err != nil {
+		return nil, err
+	}
+	return r.withTagsAll(ctx, products)
 }
 
 // GetByBrand implements ports.ProductRepository (additional method for completeness)
@@ -191,7 +449,11 @@ func (r *GormProductRepository) GetByBrand(ctx context.Context, brand string, li
 		return nil, r.handleError(err)
 	}
 
-	return r.toEntities(models), nil
+	products, err := r.toEntities(models)
+	if err != nil {
+		return nil, err
+	}
+	return r.withTagsAll(ctx, products)
 }
 
 // GetByStatus implements ports.ProductRepository (additional method for completeness)
@@ -209,7 +471,11 @@ func (r *GormProductRepository) GetByStatus(ctx context.Context, status entities
 		return nil, r.handleError(err)
 	}
 
-	return r.toEntities(models), nil
+	products, err := r.toEntities(models)
+	if err != nil {
+		return nil, err
+	}
+	return r.withTagsAll(ctx, products)
 }
 
 // GetLowStockProducts implements ports.ProductRepository (additional method for completeness)
@@ -227,7 +493,11 @@ func (r *GormProductRepository) GetLowStockProducts(ctx context.Context, thresho
 		return nil, r.handleError(err)
 	}
 
-	return r.toEntities(models), nil
+	products, err := r.toEntities(models)
+	if err != nil {
+		return nil, err
+	}
+	return r.withTagsAll(ctx, products)
 }
 
 // UpdateStock implements ports.ProductRepository (additional method for completeness)
@@ -243,11 +513,12 @@ func (r *GormProductRepository) UpdateStock(ctx context.Context, id uint, stock
 }
 
 // UpdatePrice implements ports.ProductRepository (additional method for completeness)
-func (r *GormProductRepository) UpdatePrice(ctx context.Context, id uint, price float64) error {
+func (r *GormProductRepository) UpdatePrice(ctx context.Context, id uint, price entities.Money) error {
 	err := r.db.WithContext(ctx).Model(&ProductModel{}).
 		Where("id = ?", id).
 		Updates(map[string]interface{}{
-			"price":      price,
+			"price":      price.Decimal(),
+			"currency":   price.Currency(),
 			"updated_at": time.Now(),
 		}).Error
 
@@ -281,7 +552,11 @@ func (r *GormProductRepository) GetAvailableProducts(ctx context.Context, limit,
 		return nil, r.handleError(err)
 	}
 
-	return r.toEntities(models), nil
+	products, err := r.toEntities(models)
+	if err != nil {
+		return nil, err
+	}
+	return r.withTagsAll(ctx, products)
 }
 
 // Count implements ports.ProductRepository (additional method for completeness)
@@ -321,43 +596,93 @@ func (r *GormProductRepository) CountByStatus(ctx context.Context, status entiti
 // Helper functions for conversion between domain entities and GORM models
 
 func (r *GormProductRepository) toModel(product *entities.Product) *ProductModel {
+	attributes := product.Attributes
+	if attributes == nil {
+		attributes = entities.Attributes{}
+	}
+
 	return &ProductModel{
 		ID:          product.ID,
 		Name:        product.Name,
 		Description: product.Description,
 		SKU:         product.SKU,
-		Price:       product.Price,
+		GTIN:        gtinToModel(product.GTIN),
+		Price:       product.Price.Decimal(),
+		Currency:    product.Price.Currency(),
+		CategoryID:  product.CategoryID,
 		Category:    product.Category,
+		BrandID:     product.BrandID,
 		Brand:       product.Brand,
 		Stock:       product.Stock,
 		Status:      string(product.Status),
+		Attributes:  attributes,
 		CreatedAt:   product.CreatedAt,
 		UpdatedAt:   product.UpdatedAt,
 	}
 }
 
-func (r *GormProductRepository) toEntity(model *ProductModel) *entities.Product {
+func (r *GormProductRepository) toEntity(model *ProductModel) (*entities.Product, error) {
+	price, err := moneyFromModel(model.Price, model.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("product %d: %w", model.ID, err)
+	}
+
 	return &entities.Product{
 		ID:          model.ID,
 		Name:        model.Name,
 		Description: model.Description,
 		SKU:         model.SKU,
-		Price:       model.Price,
+		GTIN:        gtinFromModel(model.GTIN),
+		Price:       price,
+		CategoryID:  model.CategoryID,
 		Category:    model.Category,
+		BrandID:     model.BrandID,
 		Brand:       model.Brand,
 		Stock:       model.Stock,
 		Status:      entities.ProductStatus(model.Status),
+		Attributes:  entities.Attributes(model.Attributes),
 		CreatedAt:   model.CreatedAt,
 		UpdatedAt:   model.UpdatedAt,
+	}, nil
+}
+
+// gtinToModel stores products without a GTIN as NULL, which the unique
+// GTIN index skips
+func gtinToModel(gtin string) *string {
+	if gtin == "" {
+		return nil
+	}
+	return &gtin
+}
+
+func gtinFromModel(gtin *string) string {
+	if gtin == nil {
+		return ""
+	}
+	return *gtin
+}
+
+// moneyFromModel rebuilds a Money from the numeric column. The column keeps
+// four decimals, so "19.9900" parses exactly; rows that cannot be represented
+// in their currency are an error rather than a rounded or missing price.
+func moneyFromModel(amount, currency string) (entities.Money, error) {
+	money, err := entities.ParseMoney(amount, currency)
+	if err != nil {
+		return entities.Money{}, fmt.Errorf("invalid stored price %q %q: %w", amount, currency, err)
 	}
+	return money, nil
 }
 
-func (r *GormProductRepository) toEntities(models []ProductModel) []*entities.Product {
+func (r *GormProductRepository) toEntities(models []ProductModel) ([]*entities.Product, error) {
 	products := make([]*entities.Product, 0, len(models))
 	for _, model := range models {
-		products = append(products, r.toEntity(&model))
+		product, err := r.toEntity(&model)
+		if err != nil {
+			return nil, err
+		}
+		products = append(products, product)
 	}
-	return products
+	return products, nil
 }
 
 // Helper to convert GORM errors to domain errors
@@ -370,6 +695,11 @@ func (r *GormProductRepository) handleError(err error) error {
 		return domainErrors.ErrProductNotFound
 	}
 
+	// The GTIN index is the only unique index on products besides the SKU's
+	if strings.Contains(err.Error(), "idx_products_gtin") {
+		return domainErrors.ErrProductGTINAlreadyExists
+	}
+
 	// Handle unique constraint violation for SKU
 	if errors.Is(err, gorm.ErrDuplicatedKey) ||
 		(err.Error() != "" && (strings.Contains(err.Error(), "duplicate key") ||
@@ -0,0 +1,130 @@
+package product_repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+
+	"gorm.io/gorm"
+)
+
+// ProductVersionModel represents the database model for the temporal
+// versions of products. A version is valid from ValidFrom until ValidTo; the
+// current version has no ValidTo.
+type ProductVersionModel struct {
+	ID          uint                   `gorm:"primarykey"`
+	ProductID   uint                   `gorm:"not null;index"`
+	SKU         string                 `gorm:"not null;size:50;index"`
+	GTIN        *string                `gorm:"size:14"`
+	Name        string                 `gorm:"not null;size:255"`
+	Description string                 `gorm:"size:1000"`
+	Price       string                 `gorm:"not null;type:numeric(18,4)"`
+	Currency    string                 `gorm:"not null;size:3"`
+	CategoryID  uint                   `gorm:"not null"`
+	Category    string                 `gorm:"not null;size:100"`
+	BrandID     *uint                  `gorm:""`
+	Brand       string                 `gorm:"size:100"`
+	Stock       int                    `gorm:"not null"`
+	Status      string                 `gorm:"not null;size:20"`
+	Attributes  map[string]interface{} `gorm:"not null;type:jsonb;serializer:json"`
+	Tags        []string               `gorm:"not null;type:jsonb;serializer:json"`
+	CreatedAt   time.Time              `gorm:""`
+	UpdatedAt   time.Time              `gorm:""`
+	ValidFrom   time.Time              `gorm:"not null"`
+	ValidTo     *time.Time             `gorm:""`
+}
+
+// TableName specifies the table name for GORM
+func (ProductVersionModel) TableName() string {
+	return "product_versions"
+}
+
+// GetByIDAsOf implements ports.ProductRepository
+func (r *GormProductRepository) GetByIDAsOf(ctx context.Context, id uint, at time.Time) (*entities.Product, error) {
+	return r.versionAsOf(ctx, at, "product_id = ?", id)
+}
+
+// GetBySKUAsOf implements ports.ProductRepository
+func (r *GormProductRepository) GetBySKUAsOf(ctx context.Context, sku string, at time.Time) (*entities.Product, error) {
+	return r.versionAsOf(ctx, at, "sku = ?", sku)
+}
+
+// versionAsOf loads the version matching where that was valid at
+func (r *GormProductRepository) versionAsOf(ctx context.Context, at time.Time, where string, args ...interface{}) (*entities.Product, error) {
+	var model ProductVersionModel
+
+	err := r.db.WithContext(ctx).
+		Where(where, args...).
+		Where("valid_from <= ? AND (valid_to IS NULL OR valid_to > ?)", at, at).
+		Order("valid_from DESC, id DESC").
+		First(&model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, domainErrors.ErrProductVersionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return versionToEntity(&model)
+}
+
+// RecordProductVersions closes the open version of each product and stores
+// the state the products have in tx, tags included, as the version valid from
+// now. It must run in the transaction that changed the products, after the
+// change.
+func RecordProductVersions(tx *gorm.DB, now time.Time, productIDs ...uint) error {
+	if len(productIDs) == 0 {
+		return nil
+	}
+
+	err := tx.Exec("UPDATE product_versions SET valid_to = ? WHERE product_id IN ? AND valid_to IS NULL", now, productIDs).Error
+	if err != nil {
+		return err
+	}
+
+	return tx.Exec(`INSERT INTO product_versions (product_id, sku, gtin, name, description, price, currency, category_id, category,
+			brand_id, brand, stock, status, attributes, tags, created_at, updated_at, valid_from)
+		SELECT p.id, p.sku, p.gtin, p.name, p.description, p.price, p.currency, p.category_id, p.category,
+			p.brand_id, p.brand, p.stock, p.status, p.attributes,
+			COALESCE((SELECT jsonb_agg(t.name ORDER BY t.name)
+				FROM product_tags pt JOIN tags t ON t.id = pt.tag_id
+				WHERE pt.product_id = p.id), '[]'::jsonb),
+			p.created_at, p.updated_at, ?
+		FROM products p
+		WHERE p.id IN ?`, now, productIDs).Error
+}
+
+func versionToEntity(model *ProductVersionModel) (*entities.Product, error) {
+	price, err := moneyFromModel(model.Price, model.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("version %d of product %d: %w", model.ID, model.ProductID, err)
+	}
+
+	tags := model.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	return &entities.Product{
+		ID:          model.ProductID,
+		Name:        model.Name,
+		Description: model.Description,
+		SKU:         model.SKU,
+		GTIN:        gtinFromModel(model.GTIN),
+		Price:       price,
+		CategoryID:  model.CategoryID,
+		Category:    model.Category,
+		BrandID:     model.BrandID,
+		Brand:       model.Brand,
+		Stock:       model.Stock,
+		Status:      entities.ProductStatus(model.Status),
+		Attributes:  entities.Attributes(model.Attributes),
+		Tags:        tags,
+		CreatedAt:   model.CreatedAt,
+		UpdatedAt:   model.UpdatedAt,
+	}, nil
+}
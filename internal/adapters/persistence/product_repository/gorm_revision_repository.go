@@ -0,0 +1,206 @@
+package product_repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ProductRevisionModel represents the database model for staged product edits
+type ProductRevisionModel struct {
+	ID            uint                    `gorm:"primarykey"`
+	ProductID     uint                    `gorm:"not null;index"`
+	Changes       entities.ProductChanges `gorm:"not null;type:jsonb;serializer:json"`
+	Comment       string                  `gorm:"size:500"`
+	Status        string                  `gorm:"not null;size:20;index"`
+	Author        string                  `gorm:"size:255"`
+	BaseUpdatedAt time.Time               `gorm:"not null"`
+	Reviewer      string                  `gorm:"size:255"`
+	ReviewComment string                  `gorm:"size:500"`
+	ReviewedAt    *time.Time              `gorm:""`
+	Publisher     string                  `gorm:"size:255"`
+	PublishedAt   *time.Time              `gorm:""`
+	CreatedAt     time.Time               `gorm:"autoCreateTime"`
+	UpdatedAt     time.Time               `gorm:"autoUpdateTime"`
+}
+
+// TableName specifies the table name for GORM
+func (ProductRevisionModel) TableName() string {
+	return "product_revisions"
+}
+
+// GormRevisionRepository implements the RevisionRepository interface using GORM
+type GormRevisionRepository struct {
+	db *gorm.DB
+}
+
+// NewGormRevisionRepository creates a new GORM revision repository
+func NewGormRevisionRepository(db *gorm.DB) ports.RevisionRepository {
+	return &GormRevisionRepository{db: db}
+}
+
+// Create implements ports.RevisionRepository
+func (r *GormRevisionRepository) Create(ctx context.Context, revision *entities.ProductRevision) (*entities.ProductRevision, error) {
+	model := revisionToModel(revision)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return nil, err
+	}
+
+	return revisionToEntity(model), nil
+}
+
+// GetByID implements ports.RevisionRepository
+func (r *GormRevisionRepository) GetByID(ctx context.Context, id uint) (*entities.ProductRevision, error) {
+	var model ProductRevisionModel
+
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, domainErrors.ErrRevisionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return revisionToEntity(&model), nil
+}
+
+// List implements ports.RevisionRepository
+func (r *GormRevisionRepository) List(ctx context.Context, criteria ports.RevisionCriteria) ([]*entities.ProductRevision, error) {
+	query := r.db.WithContext(ctx).Model(&ProductRevisionModel{})
+
+	if criteria.ProductID != 0 {
+		query = query.Where("product_id = ?", criteria.ProductID)
+	}
+	if criteria.Status != "" {
+		query = query.Where("status = ?", string(criteria.Status))
+	}
+
+	var models []ProductRevisionModel
+	if err := query.Order("created_at ASC, id ASC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	revisions := make([]*entities.ProductRevision, 0, len(models))
+	for i := range models {
+		revisions = append(revisions, revisionToEntity(&models[i]))
+	}
+	return revisions, nil
+}
+
+// SaveReview implements ports.RevisionRepository
+func (r *GormRevisionRepository) SaveReview(ctx context.Context, revision *entities.ProductRevision) error {
+	result := r.db.WithContext(ctx).Model(&ProductRevisionModel{}).
+		Where("id = ? AND status = ?", revision.ID, string(entities.RevisionPending)).
+		Updates(map[string]interface{}{
+			"status":         string(revision.Status),
+			"reviewer":       revision.Reviewer,
+			"review_comment": revision.ReviewComment,
+			"reviewed_at":    revision.ReviewedAt,
+			"updated_at":     revision.UpdatedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domainErrors.ErrRevisionNotPending
+	}
+	return nil
+}
+
+// Publish implements ports.RevisionRepository
+func (r *GormRevisionRepository) Publish(ctx context.Context, revisionID uint, fn ports.RevisionPublishFunc) (*entities.ProductRevision, *entities.Product, error) {
+	var revision *entities.ProductRevision
+	var product *entities.Product
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var model ProductRevisionModel
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", revisionID).First(&model).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domainErrors.ErrRevisionNotFound
+		}
+		if err != nil {
+			return err
+		}
+		revision = revisionToEntity(&model)
+
+		product, err = lockProductWithTags(ctx, tx, revision.ProductID)
+		if err != nil {
+			return err
+		}
+		before := *product
+
+		priceChanges, err := fn(revision, product)
+		if err != nil {
+			return err
+		}
+
+		if err := saveProductEdits(tx, product); err != nil {
+			return (&GormProductRepository{}).handleError(err)
+		}
+		if err := recordMutation(ctx, tx, entities.AuditRevisionPublish, &before, product); err != nil {
+			return err
+		}
+		if err := createPriceChanges(tx, priceChanges); err != nil {
+			return err
+		}
+		return tx.Model(&ProductRevisionModel{}).
+			Where("id = ?", revision.ID).
+			Updates(map[string]interface{}{
+				"status":       string(revision.Status),
+				"publisher":    revision.Publisher,
+				"published_at": revision.PublishedAt,
+				"updated_at":   revision.UpdatedAt,
+			}).Error
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return revision, product, nil
+}
+
+func revisionToModel(revision *entities.ProductRevision) *ProductRevisionModel {
+	return &ProductRevisionModel{
+		ID:            revision.ID,
+		ProductID:     revision.ProductID,
+		Changes:       revision.Changes,
+		Comment:       revision.Comment,
+		Status:        string(revision.Status),
+		Author:        revision.Author,
+		BaseUpdatedAt: revision.BaseUpdatedAt,
+		Reviewer:      revision.Reviewer,
+		ReviewComment: revision.ReviewComment,
+		ReviewedAt:    revision.ReviewedAt,
+		Publisher:     revision.Publisher,
+		PublishedAt:   revision.PublishedAt,
+		CreatedAt:     revision.CreatedAt,
+		UpdatedAt:     revision.UpdatedAt,
+	}
+}
+
+func revisionToEntity(model *ProductRevisionModel) *entities.ProductRevision {
+	return &entities.ProductRevision{
+		ID:            model.ID,
+		ProductID:     model.ProductID,
+		Changes:       model.Changes,
+		Comment:       model.Comment,
+		Status:        entities.RevisionStatus(model.Status),
+		Author:        model.Author,
+		BaseUpdatedAt: model.BaseUpdatedAt,
+		Reviewer:      model.Reviewer,
+		ReviewComment: model.ReviewComment,
+		ReviewedAt:    model.ReviewedAt,
+		Publisher:     model.Publisher,
+		PublishedAt:   model.PublishedAt,
+		CreatedAt:     model.CreatedAt,
+		UpdatedAt:     model.UpdatedAt,
+	}
+}
@@ -0,0 +1,239 @@
+package product_repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// TagModel represents the database model for tags
+type TagModel struct {
+	ID        uint      `gorm:"primarykey"`
+	Name      string    `gorm:"not null;size:50;uniqueIndex"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName specifies the table name for GORM
+func (TagModel) TableName() string {
+	return "tags"
+}
+
+// ProductTagModel links a product to one of its tags
+type ProductTagModel struct {
+	ProductID uint `gorm:"primaryKey"`
+	TagID     uint `gorm:"primaryKey;index"`
+}
+
+// TableName specifies the table name for GORM
+func (ProductTagModel) TableName() string {
+	return "product_tags"
+}
+
+// tagCountModel is a tag row with the number of live products carrying it
+type tagCountModel struct {
+	ID           uint
+	Name         string
+	CreatedAt    time.Time
+	ProductCount int64
+}
+
+// GormTagRepository implements the TagRepository interface using GORM
+type GormTagRepository struct {
+	db *gorm.DB
+}
+
+// NewGormTagRepository creates a new GORM tag repository
+func NewGormTagRepository(db *gorm.DB) ports.TagRepository {
+	return &GormTagRepository{db: db}
+}
+
+// Create implements ports.TagRepository
+func (r *GormTagRepository) Create(ctx context.Context, tag *entities.Tag) (*entities.Tag, error) {
+	model := &TagModel{Name: tag.Name, CreatedAt: tag.CreatedAt}
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return nil, handleTagError(err)
+	}
+
+	return &entities.Tag{ID: model.ID, Name: model.Name, CreatedAt: model.CreatedAt}, nil
+}
+
+// GetByID implements ports.TagRepository
+func (r *GormTagRepository) GetByID(ctx context.Context, id uint) (*entities.Tag, error) {
+	var model tagCountModel
+
+	err := r.countedTags(ctx).Where("t.id = ?", id).Take(&model).Error
+	if err != nil {
+		return nil, handleTagError(err)
+	}
+
+	return tagToEntity(&model), nil
+}
+
+// List implements ports.TagRepository
+func (r *GormTagRepository) List(ctx context.Context) ([]*entities.Tag, error) {
+	var models []*tagCountModel
+
+	err := r.countedTags(ctx).Order("t.name ASC").Find(&models).Error
+	if err != nil {
+		return nil, handleTagError(err)
+	}
+
+	tags := make([]*entities.Tag, 0, len(models))
+	for _, model := range models {
+		tags = append(tags, tagToEntity(model))
+	}
+	return tags, nil
+}
+
+// Rename implements ports.TagRepository
+func (r *GormTagRepository) Rename(ctx context.Context, tag *entities.Tag) (*entities.Tag, error) {
+	result := r.db.WithContext(ctx).Model(&TagModel{}).
+		Where("id = ?", tag.ID).
+		Update("name", tag.Name)
+	if result.Error != nil {
+		return nil, handleTagError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, domainErrors.ErrTagNotFound
+	}
+
+	return r.GetByID(ctx, tag.ID)
+}
+
+// Delete implements ports.TagRepository; the links to products cascade
+func (r *GormTagRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&TagModel{}, id)
+	if result.Error != nil {
+		return handleTagError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domainErrors.ErrTagNotFound
+	}
+	return nil
+}
+
+// countedTags selects tags with the number of products, not soft deleted,
+// carrying each
+func (r *GormTagRepository) countedTags(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Table("tags AS t").
+		Select("t.id, t.name, t.created_at, COUNT(p.id) AS product_count").
+		Joins("LEFT JOIN product_tags pt ON pt.tag_id = t.id").
+		Joins("LEFT JOIN products p ON p.id = pt.product_id AND p.deleted_at IS NULL").
+		Group("t.id, t.name, t.created_at")
+}
+
+// saveProductTags replaces the tags of a product, registering tags seen for
+// the first time
+func saveProductTags(tx *gorm.DB, productID uint, tags []string) error {
+	if err := tx.Where("product_id = ?", productID).Delete(&ProductTagModel{}).Error; err != nil {
+		return err
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+
+	models := make([]*TagModel, 0, len(tags))
+	for _, tag := range tags {
+		models = append(models, &TagModel{Name: tag})
+	}
+	err := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoNothing: true,
+	}).Create(&models).Error
+	if err != nil {
+		return err
+	}
+
+	var tagIDs []uint
+	if err := tx.Model(&TagModel{}).Where("name IN ?", tags).Pluck("id", &tagIDs).Error; err != nil {
+		return err
+	}
+
+	links := make([]*ProductTagModel, 0, len(tagIDs))
+	for _, tagID := range tagIDs {
+		links = append(links, &ProductTagModel{ProductID: productID, TagID: tagID})
+	}
+	return tx.Create(&links).Error
+}
+
+// withTags loads the tags of a single product
+func (r *GormProductRepository) withTags(ctx context.Context, product *entities.Product) (*entities.Product, error) {
+	if _, err := r.withTagsAll(ctx, []*entities.Product{product}); err != nil {
+		return nil, err
+	}
+	return product, nil
+}
+
+// withTagsAll loads the tags of products, in name order, with one query
+func (r *GormProductRepository) withTagsAll(ctx context.Context, products []*entities.Product) ([]*entities.Product, error) {
+	if len(products) == 0 {
+		return products, nil
+	}
+
+	byID := make(map[uint]*entities.Product, len(products))
+	ids := make([]uint, 0, len(products))
+	for _, product := range products {
+		product.Tags = []string{}
+		byID[product.ID] = product
+		ids = append(ids, product.ID)
+	}
+
+	var rows []struct {
+		ProductID uint
+		Name      string
+	}
+	err := r.db.WithContext(ctx).
+		Table("product_tags AS pt").
+		Select("pt.product_id, t.name").
+		Joins("JOIN tags t ON t.id = pt.tag_id").
+		Where("pt.product_id IN ?", ids).
+		Order("t.name ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		if product := byID[row.ProductID]; product != nil {
+			product.Tags = append(product.Tags, row.Name)
+		}
+	}
+	return products, nil
+}
+
+// normalizedTags never returns nil, so created products report no tags as []
+func normalizedTags(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+	return tags
+}
+
+func tagToEntity(model *tagCountModel) *entities.Tag {
+	return &entities.Tag{
+		ID:           model.ID,
+		Name:         model.Name,
+		ProductCount: model.ProductCount,
+		CreatedAt:    model.CreatedAt,
+	}
+}
+
+// handleTagError converts GORM errors to domain errors
+func handleTagError(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domainErrors.ErrTagNotFound
+	}
+	if errors.Is(err, gorm.ErrDuplicatedKey) || strings.Contains(err.Error(), "duplicate key") {
+		return domainErrors.ErrTagAlreadyExists
+	}
+	return err
+}
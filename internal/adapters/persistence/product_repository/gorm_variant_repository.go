@@ -0,0 +1,310 @@
+package product_repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+
+	"gorm.io/gorm"
+)
+
+// ProductOptionModel represents the database model for a product's option axes
+type ProductOptionModel struct {
+	ID        uint     `gorm:"primarykey"`
+	ProductID uint     `gorm:"not null;uniqueIndex:idx_product_options_product_name"`
+	Name      string   `gorm:"not null;size:50;uniqueIndex:idx_product_options_product_name"`
+	Position  int      `gorm:"not null;default:0"`
+	Values    []string `gorm:"column:option_values;not null;type:jsonb;serializer:json"`
+}
+
+// TableName specifies the table name for GORM
+func (ProductOptionModel) TableName() string {
+	return "product_options"
+}
+
+// ProductVariantModel represents the database model for product variants
+type ProductVariantModel struct {
+	ID                    uint              `gorm:"primarykey"`
+	ProductID             uint              `gorm:"not null;index;uniqueIndex:idx_product_variants_product_options"`
+	SKU                   string            `gorm:"uniqueIndex;not null;size:50"`
+	Options               map[string]string `gorm:"not null;type:jsonb;serializer:json"`
+	OptionKey             string            `gorm:"not null;size:500;uniqueIndex:idx_product_variants_product_options"`
+	PriceOverride         *string           `gorm:"type:numeric(18,4)"`
+	PriceOverrideCurrency *string           `gorm:"size:3"`
+	Stock                 int               `gorm:"not null;default:0"`
+	Active                bool              `gorm:"not null;default:true"`
+	CreatedAt             time.Time         `gorm:"autoCreateTime"`
+	UpdatedAt             time.Time         `gorm:"autoUpdateTime"`
+}
+
+// TableName specifies the table name for GORM
+func (ProductVariantModel) TableName() string {
+	return "product_variants"
+}
+
+// GormVariantRepository implements the VariantRepository interface using GORM
+type GormVariantRepository struct {
+	db *gorm.DB
+}
+
+// NewGormVariantRepository creates a new GORM variant repository
+func NewGormVariantRepository(db *gorm.DB) ports.VariantRepository {
+	return &GormVariantRepository{db: db}
+}
+
+// ReplaceOptions implements ports.VariantRepository
+func (r *GormVariantRepository) ReplaceOptions(ctx context.Context, productID uint, options []*entities.ProductOption) ([]*entities.ProductOption, error) {
+	models := make([]ProductOptionModel, 0, len(options))
+	for _, option := range options {
+		models = append(models, ProductOptionModel{
+			ProductID: productID,
+			Name:      option.Name,
+			Position:  option.Position,
+			Values:    option.Values,
+		})
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("product_id = ?", productID).Delete(&ProductOptionModel{}).Error; err != nil {
+			return err
+		}
+		if len(models) > 0 {
+			return tx.Create(&models).Error
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*entities.ProductOption, 0, len(models))
+	for i := range models {
+		result = append(result, optionToEntity(&models[i]))
+	}
+	return result, nil
+}
+
+// ListOptions implements ports.VariantRepository
+func (r *GormVariantRepository) ListOptions(ctx context.Context, productID uint) ([]*entities.ProductOption, error) {
+	var models []ProductOptionModel
+
+	err := r.db.WithContext(ctx).
+		Where("product_id = ?", productID).
+		Order("position ASC, id ASC").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	options := make([]*entities.ProductOption, 0, len(models))
+	for i := range models {
+		options = append(options, optionToEntity(&models[i]))
+	}
+	return options, nil
+}
+
+// CreateVariants implements ports.VariantRepository
+func (r *GormVariantRepository) CreateVariants(ctx context.Context, variants []*entities.ProductVariant) ([]*entities.ProductVariant, error) {
+	if len(variants) == 0 {
+		return []*entities.ProductVariant{}, nil
+	}
+
+	models := make([]*ProductVariantModel, 0, len(variants))
+	for _, variant := range variants {
+		models = append(models, variantToModel(variant))
+	}
+
+	if err := r.db.WithContext(ctx).Create(&models).Error; err != nil {
+		return nil, handleVariantError(err)
+	}
+
+	return variantsToEntities(models)
+}
+
+// GetVariant implements ports.VariantRepository
+func (r *GormVariantRepository) GetVariant(ctx context.Context, productID, variantID uint) (*entities.ProductVariant, error) {
+	var model ProductVariantModel
+
+	err := r.db.WithContext(ctx).
+		Where("id = ? AND product_id = ?", variantID, productID).
+		First(&model).Error
+	if err != nil {
+		return nil, handleVariantError(err)
+	}
+
+	return variantToEntity(&model)
+}
+
+// UpdateVariant implements ports.VariantRepository
+func (r *GormVariantRepository) UpdateVariant(ctx context.Context, variant *entities.ProductVariant) (*entities.ProductVariant, error) {
+	model := variantToModel(variant)
+
+	result := r.db.WithContext(ctx).
+		Model(&ProductVariantModel{ID: model.ID}).
+		Where("product_id = ?", model.ProductID).
+		Select("price_override", "price_override_currency", "stock", "active", "updated_at").
+		Updates(model)
+	if result.Error != nil {
+		return nil, handleVariantError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, domainErrors.ErrVariantNotFound
+	}
+
+	return r.GetVariant(ctx, variant.ProductID, variant.ID)
+}
+
+// DeleteVariant implements ports.VariantRepository
+func (r *GormVariantRepository) DeleteVariant(ctx context.Context, productID, variantID uint) error {
+	result := r.db.WithContext(ctx).
+		Where("id = ? AND product_id = ?", variantID, productID).
+		Delete(&ProductVariantModel{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domainErrors.ErrVariantNotFound
+	}
+	return nil
+}
+
+// ListVariants implements ports.VariantRepository
+func (r *GormVariantRepository) ListVariants(ctx context.Context, productID uint) ([]*entities.ProductVariant, error) {
+	var models []*ProductVariantModel
+
+	err := r.db.WithContext(ctx).
+		Where("product_id = ?", productID).
+		Order("id ASC").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return variantsToEntities(models)
+}
+
+// ListVariantsForProducts implements ports.VariantRepository
+func (r *GormVariantRepository) ListVariantsForProducts(ctx context.Context, productIDs []uint) (map[uint][]*entities.ProductVariant, error) {
+	result := make(map[uint][]*entities.ProductVariant)
+	if len(productIDs) == 0 {
+		return result, nil
+	}
+
+	var models []*ProductVariantModel
+	err := r.db.WithContext(ctx).
+		Where("product_id IN ?", productIDs).
+		Order("id ASC").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	variants, err := variantsToEntities(models)
+	if err != nil {
+		return nil, err
+	}
+	for _, variant := range variants {
+		result[variant.ProductID] = append(result[variant.ProductID], variant)
+	}
+	return result, nil
+}
+
+// ExistsBySKU implements ports.VariantRepository
+func (r *GormVariantRepository) ExistsBySKU(ctx context.Context, sku string) (bool, error) {
+	var count int64
+
+	err := r.db.WithContext(ctx).
+		Model(&ProductVariantModel{}).
+		Where("sku = ?", sku).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func optionToEntity(model *ProductOptionModel) *entities.ProductOption {
+	return &entities.ProductOption{
+		ID:        model.ID,
+		ProductID: model.ProductID,
+		Name:      model.Name,
+		Position:  model.Position,
+		Values:    model.Values,
+	}
+}
+
+func variantToModel(variant *entities.ProductVariant) *ProductVariantModel {
+	model := &ProductVariantModel{
+		ID:        variant.ID,
+		ProductID: variant.ProductID,
+		SKU:       variant.SKU,
+		Options:   variant.Options,
+		OptionKey: variant.OptionKey(),
+		Stock:     variant.Stock,
+		Active:    variant.Active,
+		CreatedAt: variant.CreatedAt,
+		UpdatedAt: variant.UpdatedAt,
+	}
+	if !variant.PriceOverride.IsZero() {
+		amount := variant.PriceOverride.Decimal()
+		currency := variant.PriceOverride.Currency()
+		model.PriceOverride = &amount
+		model.PriceOverrideCurrency = &currency
+	}
+	return model
+}
+
+func variantToEntity(model *ProductVariantModel) (*entities.ProductVariant, error) {
+	variant := &entities.ProductVariant{
+		ID:        model.ID,
+		ProductID: model.ProductID,
+		SKU:       model.SKU,
+		Options:   model.Options,
+		Stock:     model.Stock,
+		Active:    model.Active,
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+	}
+	if model.PriceOverride != nil && model.PriceOverrideCurrency != nil {
+		price, err := entities.ParseMoney(*model.PriceOverride, *model.PriceOverrideCurrency)
+		if err != nil {
+			return nil, err
+		}
+		variant.PriceOverride = price
+	}
+	return variant, nil
+}
+
+func variantsToEntities(models []*ProductVariantModel) ([]*entities.ProductVariant, error) {
+	variants := make([]*entities.ProductVariant, 0, len(models))
+	for _, model := range models {
+		variant, err := variantToEntity(model)
+		if err != nil {
+			return nil, err
+		}
+		variants = append(variants, variant)
+	}
+	return variants, nil
+}
+
+func handleVariantError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domainErrors.ErrVariantNotFound
+	}
+
+	if errors.Is(err, gorm.ErrDuplicatedKey) || strings.Contains(err.Error(), "duplicate key") {
+		return domainErrors.ErrVariantAlreadyExists
+	}
+
+	return err
+}
@@ -0,0 +1,130 @@
+package product_repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// recordingAnswer is the result of the first query containing match
+type recordingAnswer struct {
+	match   string
+	columns []string
+	rows    [][]driver.Value
+}
+
+// idAnswer answers match with a single id column
+func idAnswer(match string, ids ...int64) recordingAnswer {
+	answer := recordingAnswer{match: match, columns: []string{"id"}}
+	for _, id := range ids {
+		answer.rows = append(answer.rows, []driver.Value{id})
+	}
+	return answer
+}
+
+// recordingConnector is a database/sql connector that records every statement
+// and answers queries from answers, each used once in order; other queries
+// return no rows
+type recordingConnector struct {
+	mu         sync.Mutex
+	statements []string
+	answers    []recordingAnswer
+}
+
+func (c *recordingConnector) Connect(context.Context) (driver.Conn, error) {
+	return &recordingConn{connector: c}, nil
+}
+
+func (c *recordingConnector) Driver() driver.Driver {
+	return nil
+}
+
+func (c *recordingConnector) record(statement string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.statements = append(c.statements, strings.Join(strings.Fields(statement), " "))
+}
+
+func (c *recordingConnector) answer(query string) *recordingRows {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, answer := range c.answers {
+		if strings.Contains(query, answer.match) {
+			c.answers = append(c.answers[:i], c.answers[i+1:]...)
+			return &recordingRows{columns: answer.columns, rows: answer.rows}
+		}
+	}
+	return &recordingRows{columns: []string{"id"}}
+}
+
+type recordingConn struct {
+	connector *recordingConnector
+}
+
+func (c *recordingConn) Prepare(string) (driver.Stmt, error) {
+	return nil, driver.ErrSkip
+}
+
+func (c *recordingConn) Close() error {
+	return nil
+}
+
+func (c *recordingConn) Begin() (driver.Tx, error) {
+	return recordingTx{}, nil
+}
+
+func (c *recordingConn) CheckNamedValue(*driver.NamedValue) error {
+	return nil
+}
+
+func (c *recordingConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.connector.record(query)
+	return driver.RowsAffected(1), nil
+}
+
+func (c *recordingConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.connector.record(query)
+	return c.connector.answer(query), nil
+}
+
+type recordingTx struct{}
+
+func (recordingTx) Commit() error   { return nil }
+func (recordingTx) Rollback() error { return nil }
+
+type recordingRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *recordingRows) Columns() []string {
+	return r.columns
+}
+
+func (r *recordingRows) Close() error {
+	return nil
+}
+
+func (r *recordingRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
+
+func setupRecordingDB(t *testing.T, answers ...recordingAnswer) (*gorm.DB, *recordingConnector) {
+	connector := &recordingConnector{answers: answers}
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sql.OpenDB(connector)}), &gorm.Config{DisableAutomaticPing: true})
+	require.NoError(t, err)
+	return db, connector
+}
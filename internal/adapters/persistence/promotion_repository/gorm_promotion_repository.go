@@ -0,0 +1,380 @@
+package promotion_repository
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+
+	"gorm.io/gorm"
+)
+
+// Target kinds stored in promotion_targets; categories and brands have their
+// own tables
+const (
+	targetSKU = "sku"
+	targetTag = "tag"
+)
+
+// PromotionModel represents the database model for promotions
+type PromotionModel struct {
+	ID             uint                     `gorm:"primarykey"`
+	Name           string                   `gorm:"not null;size:255"`
+	Description    string                   `gorm:"type:text"`
+	DiscountType   string                   `gorm:"not null;size:20"`
+	Percentage     *string                  `gorm:"type:numeric(7,4)"`
+	AmountValue    *string                  `gorm:"column:amount;type:numeric(18,4)"`
+	AmountCurrency *string                  `gorm:"column:amount_currency;size:3"`
+	StartsAt       *time.Time               `gorm:""`
+	EndsAt         *time.Time               `gorm:""`
+	Priority       int                      `gorm:"not null;default:0"`
+	Stackable      bool                     `gorm:"not null;default:false"`
+	Active         bool                     `gorm:"not null;default:true;index"`
+	Targets        []PromotionTargetModel   `gorm:"foreignKey:PromotionID;constraint:OnDelete:CASCADE"`
+	Categories     []PromotionCategoryModel `gorm:"foreignKey:PromotionID;constraint:OnDelete:CASCADE"`
+	Brands         []PromotionBrandModel    `gorm:"foreignKey:PromotionID;constraint:OnDelete:CASCADE"`
+	CreatedAt      time.Time                `gorm:"autoCreateTime"`
+	UpdatedAt      time.Time                `gorm:"autoUpdateTime"`
+}
+
+// TableName specifies the table name for GORM
+func (PromotionModel) TableName() string {
+	return "promotions"
+}
+
+// PromotionTargetModel represents one SKU or tag a promotion targets
+type PromotionTargetModel struct {
+	PromotionID uint   `gorm:"primaryKey"`
+	Kind        string `gorm:"primaryKey;size:20"`
+	Value       string `gorm:"primaryKey;size:100"`
+}
+
+// TableName specifies the table name for GORM
+func (PromotionTargetModel) TableName() string {
+	return "promotion_targets"
+}
+
+// PromotionCategoryModel represents one category a promotion targets
+type PromotionCategoryModel struct {
+	PromotionID uint `gorm:"primaryKey"`
+	CategoryID  uint `gorm:"primaryKey;index"`
+}
+
+// TableName specifies the table name for GORM
+func (PromotionCategoryModel) TableName() string {
+	return "promotion_categories"
+}
+
+// PromotionBrandModel represents one brand a promotion targets
+type PromotionBrandModel struct {
+	PromotionID uint `gorm:"primaryKey"`
+	BrandID     uint `gorm:"primaryKey;index"`
+}
+
+// TableName specifies the table name for GORM
+func (PromotionBrandModel) TableName() string {
+	return "promotion_brands"
+}
+
+// GormPromotionRepository implements the PromotionRepository interface using GORM
+type GormPromotionRepository struct {
+	db *gorm.DB
+}
+
+// NewGormPromotionRepository creates a new GORM promotion repository
+func NewGormPromotionRepository(db *gorm.DB) ports.PromotionRepository {
+	return &GormPromotionRepository{db: db}
+}
+
+// Create implements ports.PromotionRepository
+func (r *GormPromotionRepository) Create(ctx context.Context, promotion *entities.Promotion) (*entities.Promotion, error) {
+	model := toModel(promotion)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(ctx, model.ID)
+}
+
+// GetByID implements ports.PromotionRepository
+func (r *GormPromotionRepository) GetByID(ctx context.Context, id uint) (*entities.Promotion, error) {
+	var model PromotionModel
+
+	err := r.db.WithContext(ctx).Preload("Targets").Preload("Categories").Preload("Brands").First(&model, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, domainErrors.ErrPromotionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	promotions, err := r.toEntities(ctx, []PromotionModel{model})
+	if err != nil {
+		return nil, err
+	}
+	return promotions[0], nil
+}
+
+// Update implements ports.PromotionRepository. Targets, categories and brands
+// are replaced as a whole.
+func (r *GormPromotionRepository) Update(ctx context.Context, promotion *entities.Promotion) (*entities.Promotion, error) {
+	model := toModel(promotion)
+	targets, categories, brands := model.Targets, model.Categories, model.Brands
+	model.Targets, model.Categories, model.Brands = nil, nil, nil
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&PromotionModel{ID: model.ID}).
+			Select("name", "description", "discount_type", "percentage", "amount", "amount_currency",
+				"starts_at", "ends_at", "priority", "stackable", "active", "updated_at").
+			Updates(model)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domainErrors.ErrPromotionNotFound
+		}
+
+		if err := tx.Where("promotion_id = ?", model.ID).Delete(&PromotionTargetModel{}).Error; err != nil {
+			return err
+		}
+		if len(targets) > 0 {
+			if err := tx.Create(&targets).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Where("promotion_id = ?", model.ID).Delete(&PromotionCategoryModel{}).Error; err != nil {
+			return err
+		}
+		if len(categories) > 0 {
+			if err := tx.Create(&categories).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Where("promotion_id = ?", model.ID).Delete(&PromotionBrandModel{}).Error; err != nil {
+			return err
+		}
+		if len(brands) > 0 {
+			return tx.Create(&brands).Error
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(ctx, model.ID)
+}
+
+// Delete implements ports.PromotionRepository
+func (r *GormPromotionRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&PromotionModel{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domainErrors.ErrPromotionNotFound
+	}
+	return nil
+}
+
+// List implements ports.PromotionRepository
+func (r *GormPromotionRepository) List(ctx context.Context) ([]*entities.Promotion, error) {
+	var models []PromotionModel
+
+	err := r.db.WithContext(ctx).
+		Preload("Targets").
+		Preload("Categories").
+		Preload("Brands").
+		Order("priority DESC, id ASC").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return r.toEntities(ctx, models)
+}
+
+// ListLiveAt implements ports.PromotionRepository
+func (r *GormPromotionRepository) ListLiveAt(ctx context.Context, at time.Time) ([]*entities.Promotion, error) {
+	var models []PromotionModel
+
+	err := r.db.WithContext(ctx).
+		Preload("Targets").
+		Preload("Categories").
+		Preload("Brands").
+		Where("active").
+		Where("starts_at IS NULL OR starts_at <= ?", at).
+		Where("ends_at IS NULL OR ends_at > ?", at).
+		Order("priority DESC, id ASC").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return r.toEntities(ctx, models)
+}
+
+// toEntities converts promotion models to entities, looking up the current
+// paths of the categories and names of the brands they target
+func (r *GormPromotionRepository) toEntities(ctx context.Context, models []PromotionModel) ([]*entities.Promotion, error) {
+	var categoryIDs, brandIDs []uint
+	for _, model := range models {
+		for _, category := range model.Categories {
+			categoryIDs = append(categoryIDs, category.CategoryID)
+		}
+		for _, brand := range model.Brands {
+			brandIDs = append(brandIDs, brand.BrandID)
+		}
+	}
+
+	categoryPaths, err := r.columnByID(ctx, "categories", "path", categoryIDs)
+	if err != nil {
+		return nil, err
+	}
+	brandNames, err := r.columnByID(ctx, "brands", "name", brandIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	promotions := make([]*entities.Promotion, 0, len(models))
+	for i := range models {
+		promotion, err := toEntity(&models[i], categoryPaths, brandNames)
+		if err != nil {
+			return nil, err
+		}
+		promotions = append(promotions, promotion)
+	}
+	return promotions, nil
+}
+
+// columnByID reads one text column of the rows of table with the given IDs
+func (r *GormPromotionRepository) columnByID(ctx context.Context, table, column string, ids []uint) (map[uint]string, error) {
+	values := make(map[uint]string, len(ids))
+	if len(ids) == 0 {
+		return values, nil
+	}
+
+	var rows []struct {
+		ID    uint
+		Value string
+	}
+	err := r.db.WithContext(ctx).Table(table).Select("id, "+column+" AS value").Where("id IN ?", ids).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		values[row.ID] = row.Value
+	}
+	return values, nil
+}
+
+func toModel(promotion *entities.Promotion) *PromotionModel {
+	model := &PromotionModel{
+		ID:           promotion.ID,
+		Name:         promotion.Name,
+		Description:  promotion.Description,
+		DiscountType: string(promotion.DiscountType),
+		StartsAt:     promotion.StartsAt,
+		EndsAt:       promotion.EndsAt,
+		Priority:     promotion.Priority,
+		Stackable:    promotion.Stackable,
+		Active:       promotion.Active,
+		CreatedAt:    promotion.CreatedAt,
+		UpdatedAt:    promotion.UpdatedAt,
+	}
+
+	if promotion.Percentage != nil {
+		percentage := promotion.Percentage.FloatString(4)
+		model.Percentage = &percentage
+	}
+	if !promotion.Amount.IsZero() {
+		amount := promotion.Amount.Decimal()
+		currency := promotion.Amount.Currency()
+		model.AmountValue = &amount
+		model.AmountCurrency = &currency
+	}
+
+	for _, category := range promotion.Categories {
+		model.Categories = append(model.Categories, PromotionCategoryModel{PromotionID: promotion.ID, CategoryID: category.ID})
+	}
+	for _, brand := range promotion.Brands {
+		model.Brands = append(model.Brands, PromotionBrandModel{PromotionID: promotion.ID, BrandID: brand.ID})
+	}
+	for kind, values := range map[string][]string{
+		targetSKU: promotion.SKUs,
+		targetTag: promotion.Tags,
+	} {
+		for _, value := range values {
+			model.Targets = append(model.Targets, PromotionTargetModel{PromotionID: promotion.ID, Kind: kind, Value: value})
+		}
+	}
+
+	return model
+}
+
+// toEntity converts a promotion model; categoryPaths and brandNames hold the
+// paths of the categories and names of the brands it targets by ID
+func toEntity(model *PromotionModel, categoryPaths, brandNames map[uint]string) (*entities.Promotion, error) {
+	promotion := &entities.Promotion{
+		ID:           model.ID,
+		Name:         model.Name,
+		Description:  model.Description,
+		DiscountType: entities.DiscountType(model.DiscountType),
+		Categories:   make([]entities.PromotionCategory, 0, len(model.Categories)),
+		Brands:       make([]entities.PromotionBrand, 0, len(model.Brands)),
+		SKUs:         []string{},
+		Tags:         []string{},
+		StartsAt:     model.StartsAt,
+		EndsAt:       model.EndsAt,
+		Priority:     model.Priority,
+		Stackable:    model.Stackable,
+		Active:       model.Active,
+		CreatedAt:    model.CreatedAt,
+		UpdatedAt:    model.UpdatedAt,
+	}
+
+	if model.Percentage != nil {
+		percentage, ok := new(big.Rat).SetString(*model.Percentage)
+		if !ok {
+			return nil, errors.New("invalid stored promotion percentage")
+		}
+		promotion.Percentage = percentage
+	}
+	if model.AmountValue != nil && model.AmountCurrency != nil {
+		amount, err := entities.ParseMoney(*model.AmountValue, *model.AmountCurrency)
+		if err != nil {
+			return nil, err
+		}
+		promotion.Amount = amount
+	}
+
+	for _, category := range model.Categories {
+		promotion.Categories = append(promotion.Categories, entities.PromotionCategory{
+			ID:   category.CategoryID,
+			Path: categoryPaths[category.CategoryID],
+		})
+	}
+	for _, brand := range model.Brands {
+		promotion.Brands = append(promotion.Brands, entities.PromotionBrand{
+			ID:   brand.BrandID,
+			Name: brandNames[brand.BrandID],
+		})
+	}
+	for _, target := range model.Targets {
+		switch target.Kind {
+		case targetSKU:
+			promotion.SKUs = append(promotion.SKUs, target.Value)
+		case targetTag:
+			promotion.Tags = append(promotion.Tags, target.Value)
+		}
+	}
+
+	return promotion, nil
+}
@@ -0,0 +1,87 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LocalStorage keeps media files in a directory on disk. The HTTP server
+// serves the directory at the base URL.
+type LocalStorage struct {
+	directory string
+	baseURL   string
+}
+
+// NewLocalStorage creates the directory if needed
+func NewLocalStorage(directory, baseURL string) (*LocalStorage, error) {
+	if directory == "" {
+		return nil, errors.New("local media storage needs a directory")
+	}
+	if err := os.MkdirAll(directory, 0o755); err != nil {
+		return nil, fmt.Errorf("create media directory: %w", err)
+	}
+
+	return &LocalStorage{
+		directory: directory,
+		baseURL:   strings.TrimRight(baseURL, "/"),
+	}, nil
+}
+
+// Directory is the directory files are stored in
+func (s *LocalStorage) Directory() string {
+	return s.directory
+}
+
+// Put implements ports.MediaStorage. The file is written under a temporary
+// name and renamed, so readers never see a partial file.
+func (s *LocalStorage) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	if err := validKey(key); err != nil {
+		return err
+	}
+
+	target := filepath.Join(s.directory, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+
+	temp, err := os.CreateTemp(filepath.Dir(target), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temp.Name())
+
+	if _, err := temp.Write(data); err != nil {
+		temp.Close()
+		return err
+	}
+	if err := temp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(temp.Name(), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(temp.Name(), target)
+}
+
+// Delete implements ports.MediaStorage; deleting a missing file succeeds
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	if err := validKey(key); err != nil {
+		return err
+	}
+
+	err := os.Remove(filepath.Join(s.directory, filepath.FromSlash(key)))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// URL implements ports.MediaStorage
+func (s *LocalStorage) URL(key string) string {
+	return s.baseURL + "/" + key
+}
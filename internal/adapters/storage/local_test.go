@@ -0,0 +1,43 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStorage_PutAndDelete(t *testing.T) {
+	// Given
+	directory := t.TempDir()
+	storage, err := NewLocalStorage(directory, "/media/")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	// When
+	err = storage.Put(ctx, "products/1/a.png", []byte("png bytes"), "image/png")
+
+	// Then
+	require.NoError(t, err)
+	data, err := os.ReadFile(filepath.Join(directory, "products", "1", "a.png"))
+	require.NoError(t, err)
+	assert.Equal(t, "png bytes", string(data))
+	assert.Equal(t, "/media/products/1/a.png", storage.URL("products/1/a.png"))
+
+	require.NoError(t, storage.Delete(ctx, "products/1/a.png"))
+	_, err = os.Stat(filepath.Join(directory, "products", "1", "a.png"))
+	assert.True(t, os.IsNotExist(err))
+	assert.NoError(t, storage.Delete(ctx, "products/1/a.png"))
+}
+
+func TestLocalStorage_RejectsEscapingKeys(t *testing.T) {
+	storage, err := NewLocalStorage(t.TempDir(), "/media")
+	require.NoError(t, err)
+
+	for _, key := range []string{"../secret", "/etc/passwd", "products//a.png", `products\..\a.png`, ""} {
+		assert.Error(t, storage.Put(context.Background(), key, []byte("x"), "text/plain"), key)
+	}
+}
@@ -0,0 +1,231 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"product-service/internal/config"
+)
+
+const (
+	s3Algorithm  = "AWS4-HMAC-SHA256"
+	s3TimeFormat = "20060102T150405Z"
+	s3DateFormat = "20060102"
+)
+
+// S3Storage keeps media files in an S3 bucket or an S3-compatible store,
+// signing requests with AWS Signature Version 4
+type S3Storage struct {
+	client          *http.Client
+	endpoint        *url.URL
+	region          string
+	bucket          string
+	accessKeyID     string
+	secretAccessKey string
+	usePathStyle    bool
+	publicURL       string
+	now             func() time.Time
+}
+
+// NewS3Storage validates the configuration; it does not contact the store
+func NewS3Storage(cfg config.S3MediaConfig) (*S3Storage, error) {
+	if cfg.Bucket == "" || cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+		return nil, errors.New("s3 media storage needs a bucket, access key ID and secret access key")
+	}
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil || endpoint.Host == "" || (endpoint.Scheme != "http" && endpoint.Scheme != "https") {
+		return nil, fmt.Errorf("invalid s3 endpoint %q", cfg.Endpoint)
+	}
+
+	storage := &S3Storage{
+		client:          &http.Client{Timeout: 30 * time.Second},
+		endpoint:        endpoint,
+		region:          cfg.Region,
+		bucket:          cfg.Bucket,
+		accessKeyID:     cfg.AccessKeyID,
+		secretAccessKey: cfg.SecretAccessKey,
+		usePathStyle:    cfg.UsePathStyle,
+		publicURL:       strings.TrimRight(cfg.PublicURL, "/"),
+		now:             time.Now,
+	}
+	if storage.publicURL == "" {
+		storage.publicURL = strings.TrimRight(storage.objectURL("").String(), "/")
+	}
+	return storage, nil
+}
+
+// Put implements ports.MediaStorage
+func (s *S3Storage) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	if err := validKey(key); err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key).String(), bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	request.ContentLength = int64(len(data))
+	request.Header.Set("Content-Type", contentType)
+
+	return s.do(request, data, http.StatusOK)
+}
+
+// Delete implements ports.MediaStorage; S3 reports success for missing keys
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	if err := validKey(key); err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(key).String(), nil)
+	if err != nil {
+		return err
+	}
+
+	return s.do(request, nil, http.StatusNoContent, http.StatusOK, http.StatusNotFound)
+}
+
+// URL implements ports.MediaStorage
+func (s *S3Storage) URL(key string) string {
+	return s.publicURL + "/" + key
+}
+
+// objectURL addresses key in the bucket, path-style or virtual-hosted-style
+func (s *S3Storage) objectURL(key string) *url.URL {
+	objectURL := *s.endpoint
+	if s.usePathStyle {
+		objectURL.Path = strings.TrimRight(objectURL.Path, "/") + "/" + s.bucket + "/" + key
+	} else {
+		objectURL.Host = s.bucket + "." + objectURL.Host
+		objectURL.Path = strings.TrimRight(objectURL.Path, "/") + "/" + key
+	}
+	return &objectURL
+}
+
+// do signs and sends request, failing unless the response status is expected
+func (s *S3Storage) do(request *http.Request, payload []byte, expected ...int) error {
+	s.sign(request, payload)
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("s3 %s %s: %w", request.Method, request.URL.Path, err)
+	}
+	defer response.Body.Close()
+
+	for _, status := range expected {
+		if response.StatusCode == status {
+			_, _ = io.Copy(io.Discard, response.Body)
+			return nil
+		}
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+	return fmt.Errorf("s3 %s %s: %s: %s", request.Method, request.URL.Path, response.Status, strings.TrimSpace(string(body)))
+}
+
+// sign adds the Signature Version 4 Authorization header. Every header set on
+// the request at this point, plus host, is signed.
+func (s *S3Storage) sign(request *http.Request, payload []byte) {
+	now := s.now().UTC()
+	amzDate := now.Format(s3TimeFormat)
+	payloadHash := sha256Hex(payload)
+
+	request.Header.Set("X-Amz-Date", amzDate)
+	request.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": request.URL.Host}
+	for name, values := range request.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		request.Method,
+		canonicalURI(request.URL.Path),
+		canonicalQuery(request.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{now.Format(s3DateFormat), s.region, "s3", "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{s3Algorithm, amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.secretAccessKey), now.Format(s3DateFormat))
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	request.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, s.accessKeyID, scope, signedHeaders, signature))
+}
+
+// canonicalURI encodes each path segment as Signature Version 4 requires
+func canonicalURI(path string) string {
+	if path == "" {
+		return "/"
+	}
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = uriEncode(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+func canonicalQuery(query url.Values) string {
+	pairs := make([]string, 0, len(query))
+	for name, values := range query {
+		for _, value := range values {
+			pairs = append(pairs, uriEncode(name)+"="+uriEncode(value))
+		}
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "&")
+}
+
+// uriEncode percent-encodes everything except the unreserved characters
+func uriEncode(s string) string {
+	var builder strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' {
+			builder.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&builder, "%%%02X", c)
+	}
+	return builder.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
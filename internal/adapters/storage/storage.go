@@ -0,0 +1,35 @@
+// Package storage holds the backends product media files are stored in
+package storage
+
+import (
+	"fmt"
+	"strings"
+
+	"product-service/internal/application/ports"
+	"product-service/internal/config"
+)
+
+// New creates the media storage backend selected by the configuration
+func New(cfg config.MediaConfig) (ports.MediaStorage, error) {
+	switch cfg.Storage {
+	case "", "local":
+		return NewLocalStorage(cfg.Local.Directory, cfg.Local.BaseURL)
+	case "s3":
+		return NewS3Storage(cfg.S3)
+	default:
+		return nil, fmt.Errorf("unknown media storage %q, expected local or s3", cfg.Storage)
+	}
+}
+
+// validKey rejects keys that could escape the storage root
+func validKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return fmt.Errorf("invalid storage key %q", key)
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return fmt.Errorf("invalid storage key %q", key)
+		}
+	}
+	return nil
+}
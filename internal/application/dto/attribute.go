@@ -0,0 +1,38 @@
+package dto
+
+import "product-service/internal/domain/entities"
+
+// AttributeDefinitionRequestDTO defines or redefines a category attribute.
+// The category and key come from the path.
+type AttributeDefinitionRequestDTO struct {
+	Label         string                 `json:"label" validate:"omitempty,max=100"`
+	Type          entities.AttributeType `json:"type" validate:"required,oneof=string int decimal bool enum unit"`
+	Required      bool                   `json:"required"`
+	AllowedValues []string               `json:"allowed_values" validate:"omitempty,max=100,dive,required,max=100"`
+	Unit          string                 `json:"unit" validate:"omitempty,max=20"`
+}
+
+// AttributeSchemaResponseDTO lists the attribute definitions of a category
+type AttributeSchemaResponseDTO struct {
+	CategoryID uint                            `json:"category_id"`
+	Category   string                          `json:"category"`
+	Path       string                          `json:"path"`
+	Attributes []*entities.AttributeDefinition `json:"attributes"`
+}
+
+// ToEntity converts the request into a validated definition
+func (dto *AttributeDefinitionRequestDTO) ToEntity(categoryID uint, key string) (*entities.AttributeDefinition, error) {
+	definition := &entities.AttributeDefinition{
+		CategoryID:    categoryID,
+		Key:           key,
+		Label:         dto.Label,
+		Type:          dto.Type,
+		Required:      dto.Required,
+		AllowedValues: dto.AllowedValues,
+		Unit:          dto.Unit,
+	}
+	if err := definition.Validate(); err != nil {
+		return nil, err
+	}
+	return definition, nil
+}
@@ -0,0 +1,27 @@
+package dto
+
+import (
+	"product-service/internal/domain/entities"
+	"time"
+)
+
+// AuditQueryDTO filters the audit trail. Zero values do not filter.
+type AuditQueryDTO struct {
+	ProductID uint                    `json:"product_id"`
+	Actor     string                  `json:"actor" validate:"omitempty,max=255"`
+	Operation entities.AuditOperation `json:"operation"`
+	RequestID string                  `json:"request_id" validate:"omitempty,max=100"`
+	Field     string                  `json:"field" validate:"omitempty,max=100"`
+	From      *time.Time              `json:"from"`
+	To        *time.Time              `json:"to"`
+	Page      int                     `json:"page" validate:"min=0"`
+	PageSize  int                     `json:"page_size" validate:"min=1,max=100"`
+}
+
+// AuditListResponseDTO is one page of audit entries, newest first
+type AuditListResponseDTO struct {
+	Entries  []*entities.AuditEntry `json:"entries"`
+	Total    int                    `json:"total"`
+	Page     int                    `json:"page"`
+	PageSize int                    `json:"page_size"`
+}
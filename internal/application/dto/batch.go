@@ -0,0 +1,49 @@
+package dto
+
+import "product-service/internal/domain/entities"
+
+// BatchRequestDTO runs several product operations in one request, in order.
+// Atomic batches apply every operation or none; otherwise each operation
+// succeeds or fails on its own.
+type BatchRequestDTO struct {
+	Atomic     bool                 `json:"atomic"`
+	Operations []*BatchOperationDTO `json:"operations" validate:"required,min=1,dive,required"`
+}
+
+// BatchOperationDTO is one operation of a batch. Operations other than
+// create name their product by ID or, when ID is zero, by SKU. Each action
+// reads its own fields: product for create, changes for update, stock for
+// stock, price and reason for price, and status and reason for status.
+type BatchOperationDTO struct {
+	Action entities.BatchAction `json:"action" validate:"required,oneof=create update stock price status"`
+	ID     uint                 `json:"id"`
+	SKU    string               `json:"sku" validate:"omitempty,max=50"`
+
+	Product *CreateProductRequestDTO `json:"product"`
+	Changes *UpdateProductRequestDTO `json:"changes"`
+	Stock   *int                     `json:"stock" validate:"omitempty,min=0"`
+	Price   *entities.Money          `json:"price"`
+	Status  entities.ProductStatus   `json:"status" validate:"omitempty,oneof=draft active inactive discontinued archived"`
+	Reason  string                   `json:"reason" validate:"omitempty,max=500"`
+}
+
+// BatchResponseDTO reports the outcome of every operation of a batch in
+// request order
+type BatchResponseDTO struct {
+	Atomic    bool `json:"atomic"`
+	Succeeded int  `json:"succeeded"`
+	Failed    int  `json:"failed"`
+	// RolledBack is set when an operation of an atomic batch failed, so
+	// none was applied
+	RolledBack bool              `json:"rolled_back,omitempty"`
+	Results    []*BatchResultDTO `json:"results"`
+}
+
+// BatchResultDTO is the outcome of one operation: the product as saved, or
+// Err when the operation was not applied
+type BatchResultDTO struct {
+	Index   int                  `json:"index"`
+	Action  entities.BatchAction `json:"action"`
+	Product *ProductResponseDTO  `json:"product,omitempty"`
+	Err     error                `json:"-"`
+}
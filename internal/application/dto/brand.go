@@ -0,0 +1,57 @@
+package dto
+
+import (
+	"product-service/internal/domain/entities"
+	"time"
+)
+
+// CreateBrandRequestDTO for registering a brand
+type CreateBrandRequestDTO struct {
+	Name    string   `json:"name" validate:"required,max=100"`
+	Aliases []string `json:"aliases" validate:"omitempty,max=20,dive,required,max=100"`
+	LogoURL string   `json:"logo_url" validate:"omitempty,url,max=500"`
+}
+
+// UpdateBrandRequestDTO for brand updates; omitted fields are left unchanged
+// and aliases, when given, replace the current ones
+type UpdateBrandRequestDTO struct {
+	Name    *string   `json:"name" validate:"omitempty,min=1,max=100"`
+	Aliases *[]string `json:"aliases" validate:"omitempty,max=20,dive,required,max=100"`
+	LogoURL *string   `json:"logo_url" validate:"omitempty,max=500"`
+	Status  *string   `json:"status" validate:"omitempty,oneof=active inactive"`
+}
+
+// MergeBrandRequestDTO merges the brand in the path into target_id
+type MergeBrandRequestDTO struct {
+	TargetID uint `json:"target_id" validate:"required"`
+}
+
+// BrandResponseDTO for brand responses
+type BrandResponseDTO struct {
+	ID        uint      `json:"id"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+	Aliases   []string  `json:"aliases"`
+	LogoURL   string    `json:"logo_url,omitempty"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func BrandToResponseDTO(brand *entities.Brand) *BrandResponseDTO {
+	aliases := brand.Aliases
+	if aliases == nil {
+		aliases = []string{}
+	}
+
+	return &BrandResponseDTO{
+		ID:        brand.ID,
+		Name:      brand.Name,
+		Slug:      brand.Slug,
+		Aliases:   aliases,
+		LogoURL:   brand.LogoURL,
+		Status:    string(brand.Status),
+		CreatedAt: brand.CreatedAt,
+		UpdatedAt: brand.UpdatedAt,
+	}
+}
@@ -0,0 +1,90 @@
+package dto
+
+import (
+	"product-service/internal/domain/entities"
+	"time"
+)
+
+// CreateCategoryRequestDTO for category creation; without parent_id the
+// category becomes a root
+type CreateCategoryRequestDTO struct {
+	Name     string `json:"name" validate:"required,min=2,max=100"`
+	ParentID *uint  `json:"parent_id"`
+}
+
+// RenameCategoryRequestDTO for renaming a category, which changes its slug
+// and the paths below it
+type RenameCategoryRequestDTO struct {
+	Name string `json:"name" validate:"required,min=2,max=100"`
+}
+
+// MoveCategoryRequestDTO moves a category under parent_id, or to the root
+// when parent_id is null
+type MoveCategoryRequestDTO struct {
+	ParentID *uint `json:"parent_id"`
+}
+
+// MergeCategoryRequestDTO merges the category in the path into target_id
+type MergeCategoryRequestDTO struct {
+	TargetID uint `json:"target_id" validate:"required"`
+}
+
+// SKURulesRequestDTO sets the SKU rules of a category: sku_pattern is a
+// regular expression new SKUs must match and sku_template, such as
+// "{BRAND}-{CATEGORY}-{SEQ}", generates SKUs for products created without
+// one. Empty values remove a rule.
+type SKURulesRequestDTO struct {
+	SKUPattern  string `json:"sku_pattern" validate:"max=200"`
+	SKUTemplate string `json:"sku_template" validate:"max=100"`
+}
+
+// CategoryResponseDTO is a category with, in tree responses, its subcategories
+type CategoryResponseDTO struct {
+	ID          uint                   `json:"id"`
+	ParentID    *uint                  `json:"parent_id,omitempty"`
+	Name        string                 `json:"name"`
+	Slug        string                 `json:"slug"`
+	Path        string                 `json:"path"`
+	Depth       int                    `json:"depth"`
+	SKUPattern  string                 `json:"sku_pattern,omitempty"`
+	SKUTemplate string                 `json:"sku_template,omitempty"`
+	CreatedAt   time.Time              `json:"created_at"`
+	UpdatedAt   time.Time              `json:"updated_at"`
+	Children    []*CategoryResponseDTO `json:"children,omitempty"`
+}
+
+func CategoryToResponseDTO(category *entities.Category) *CategoryResponseDTO {
+	return &CategoryResponseDTO{
+		ID:          category.ID,
+		ParentID:    category.ParentID,
+		Name:        category.Name,
+		Slug:        category.Slug,
+		Path:        category.Path,
+		Depth:       category.Depth,
+		SKUPattern:  category.SKUPattern,
+		SKUTemplate: category.SKUTemplate,
+		CreatedAt:   category.CreatedAt,
+		UpdatedAt:   category.UpdatedAt,
+	}
+}
+
+// CategoryTree nests categories under their parents. Categories must be
+// ordered by path, as the repository returns them; those whose parent is not
+// in the list become roots of the result.
+func CategoryTree(categories []*entities.Category) []*CategoryResponseDTO {
+	roots := make([]*CategoryResponseDTO, 0)
+	byID := make(map[uint]*CategoryResponseDTO, len(categories))
+	for _, category := range categories {
+		node := CategoryToResponseDTO(category)
+		byID[category.ID] = node
+
+		if category.ParentID != nil {
+			if parent, ok := byID[*category.ParentID]; ok {
+				parent.Children = append(parent.Children, node)
+				continue
+			}
+		}
+		roots = append(roots, node)
+	}
+	return roots
+}
@@ -0,0 +1,80 @@
+package dto
+
+import (
+	"product-service/internal/domain/entities"
+	"time"
+)
+
+// CreateTagRequestDTO for registering a tag before any product carries it
+type CreateTagRequestDTO struct {
+	Name string `json:"name" validate:"required,max=50"`
+}
+
+// RenameTagRequestDTO renames a tag on every product carrying it
+type RenameTagRequestDTO struct {
+	Name string `json:"name" validate:"required,max=50"`
+}
+
+// TagResponseDTO for tag responses
+type TagResponseDTO struct {
+	ID           uint      `json:"id"`
+	Name         string    `json:"name"`
+	ProductCount int64     `json:"product_count"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// CreateCollectionRequestDTO for creating a collection. Manual collections
+// take product_ids in display order; rule collections take rules, all of
+// which a product must match.
+type CreateCollectionRequestDTO struct {
+	Name        string                    `json:"name" validate:"required,min=2,max=100"`
+	Description string                    `json:"description" validate:"omitempty,max=1000"`
+	Type        entities.CollectionType   `json:"type" validate:"required,oneof=manual rule"`
+	Rules       []entities.CollectionRule `json:"rules" validate:"omitempty,max=10"`
+	ProductIDs  []uint                    `json:"product_ids" validate:"omitempty,max=1000"`
+}
+
+// UpdateCollectionRequestDTO for collection updates; omitted fields are left
+// unchanged and rules or product_ids, when given, replace the current ones
+type UpdateCollectionRequestDTO struct {
+	Name        *string                    `json:"name" validate:"omitempty,min=2,max=100"`
+	Description *string                    `json:"description" validate:"omitempty,max=1000"`
+	Rules       *[]entities.CollectionRule `json:"rules" validate:"omitempty,max=10"`
+	ProductIDs  *[]uint                    `json:"product_ids" validate:"omitempty,max=1000"`
+}
+
+// CollectionResponseDTO for collection responses
+type CollectionResponseDTO struct {
+	ID          uint                      `json:"id"`
+	Name        string                    `json:"name"`
+	Slug        string                    `json:"slug"`
+	Description string                    `json:"description"`
+	Type        entities.CollectionType   `json:"type"`
+	Rules       []entities.CollectionRule `json:"rules,omitempty"`
+	ProductIDs  []uint                    `json:"product_ids,omitempty"`
+	CreatedAt   time.Time                 `json:"created_at"`
+	UpdatedAt   time.Time                 `json:"updated_at"`
+}
+
+func TagToResponseDTO(tag *entities.Tag) *TagResponseDTO {
+	return &TagResponseDTO{
+		ID:           tag.ID,
+		Name:         tag.Name,
+		ProductCount: tag.ProductCount,
+		CreatedAt:    tag.CreatedAt,
+	}
+}
+
+func CollectionToResponseDTO(collection *entities.Collection) *CollectionResponseDTO {
+	return &CollectionResponseDTO{
+		ID:          collection.ID,
+		Name:        collection.Name,
+		Slug:        collection.Slug,
+		Description: collection.Description,
+		Type:        collection.Type,
+		Rules:       collection.Rules,
+		ProductIDs:  collection.ProductIDs,
+		CreatedAt:   collection.CreatedAt,
+		UpdatedAt:   collection.UpdatedAt,
+	}
+}
@@ -0,0 +1,10 @@
+package dto
+
+import "product-service/internal/domain/entities"
+
+// FeedResponseDTO describes a cached feed file. Regenerated reports whether
+// this call wrote it again.
+type FeedResponseDTO struct {
+	*entities.Feed
+	Regenerated bool `json:"regenerated"`
+}
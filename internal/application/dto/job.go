@@ -0,0 +1,80 @@
+package dto
+
+import "product-service/internal/domain/entities"
+
+// JobResponseDTO is the status and progress of a background job. ResultURL
+// is set by the HTTP layer once a job produced a file to download.
+type JobResponseDTO struct {
+	*entities.Job
+	ResultURL string `json:"result_url,omitempty"`
+}
+
+// JobQueryDTO filters the job list. Zero values do not filter.
+type JobQueryDTO struct {
+	Type     entities.JobType   `json:"type"`
+	Status   entities.JobStatus `json:"status"`
+	Page     int                `json:"page" validate:"min=0"`
+	PageSize int                `json:"page_size" validate:"min=1,max=100"`
+}
+
+// JobListResponseDTO is one page of jobs, newest first
+type JobListResponseDTO struct {
+	Jobs     []*JobResponseDTO `json:"jobs"`
+	Total    int               `json:"total"`
+	Page     int               `json:"page"`
+	PageSize int               `json:"page_size"`
+}
+
+// RepriceRequestDTO changes the price of every product matching Filters,
+// either by Percent, e.g. "-15", or by adding Amount, which may be negative
+// and only applies to prices in its currency. Paging of Filters is ignored.
+type RepriceRequestDTO struct {
+	Filters ProductSearchRequestDTO `json:"filters"`
+	Percent string                  `json:"percent" validate:"omitempty,max=20"`
+	Amount  *entities.Money         `json:"amount"`
+	Reason  string                  `json:"reason" validate:"omitempty,max=500"`
+}
+
+// FeedJobRequestDTO regenerates feeds in the background; every format when
+// Formats is empty
+type FeedJobRequestDTO struct {
+	Formats []entities.FeedFormat `json:"formats" validate:"omitempty,max=10"`
+	Force   bool                  `json:"force"`
+}
+
+// ImportJobRequestDTO runs a queued import from File, the name of its
+// upload in the import spool directory
+type ImportJobRequestDTO struct {
+	ImportID uint   `json:"import_id"`
+	File     string `json:"file"`
+}
+
+// ExportJobResultDTO is the result of an export job, whose file is kept
+// with the job
+type ExportJobResultDTO struct {
+	FileName string                `json:"file_name"`
+	Format   entities.ExportFormat `json:"format"`
+	Rows     int64                 `json:"rows"`
+}
+
+// RepriceJobResultDTO counts what a repricing job did to the matched
+// products. Errors lists the first failures.
+type RepriceJobResultDTO struct {
+	Matched   int               `json:"matched"`
+	Changed   int               `json:"changed"`
+	Unchanged int               `json:"unchanged"`
+	Failed    int               `json:"failed"`
+	Errors    []RepriceErrorDTO `json:"errors,omitempty"`
+}
+
+// RepriceErrorDTO says why one product could not be repriced
+type RepriceErrorDTO struct {
+	ProductID uint   `json:"product_id"`
+	Code      string `json:"code"`
+	Message   string `json:"message"`
+}
+
+// FeedJobResultDTO is the result of a feed job
+type FeedJobResultDTO struct {
+	Feeds []*FeedResponseDTO `json:"feeds"`
+}
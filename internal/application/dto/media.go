@@ -0,0 +1,86 @@
+package dto
+
+import (
+	"product-service/internal/domain/entities"
+	"time"
+)
+
+// MediaUploadDTO is an uploaded file with the form fields sent alongside it
+type MediaUploadDTO struct {
+	FileName string
+	Data     []byte
+	AltText  string
+	Primary  bool
+}
+
+// UpdateMediaRequestDTO for media updates; omitted fields are left unchanged
+type UpdateMediaRequestDTO struct {
+	AltText *string `json:"alt_text" validate:"omitempty,max=255"`
+	// Primary true makes the image the product's primary image; false leaves
+	// the product without one
+	Primary *bool `json:"primary"`
+}
+
+// ReorderMediaRequestDTO lists every media ID of the product in display order
+type ReorderMediaRequestDTO struct {
+	MediaIDs []uint `json:"media_ids" validate:"required,min=1"`
+}
+
+// MediaResponseDTO for media responses
+type MediaResponseDTO struct {
+	ID           uint               `json:"id"`
+	Kind         entities.MediaKind `json:"kind"`
+	ContentType  string             `json:"content_type"`
+	FileName     string             `json:"file_name"`
+	Size         int64              `json:"size"`
+	Width        int                `json:"width,omitempty"`
+	Height       int                `json:"height,omitempty"`
+	URL          string             `json:"url"`
+	ThumbnailURL string             `json:"thumbnail_url,omitempty"`
+	AltText      string             `json:"alt_text"`
+	Position     int                `json:"position"`
+	Primary      bool               `json:"primary"`
+	CreatedAt    time.Time          `json:"created_at"`
+}
+
+// MediaToResponseDTO converts media; url resolves storage keys to addresses
+func MediaToResponseDTO(media *entities.ProductMedia, url func(key string) string) *MediaResponseDTO {
+	response := &MediaResponseDTO{
+		ID:          media.ID,
+		Kind:        media.Kind,
+		ContentType: media.ContentType,
+		FileName:    media.FileName,
+		Size:        media.Size,
+		Width:       media.Width,
+		Height:      media.Height,
+		URL:         url(media.StorageKey),
+		AltText:     media.AltText,
+		Position:    media.Position,
+		Primary:     media.Primary,
+		CreatedAt:   media.CreatedAt,
+	}
+	if media.ThumbnailKey != "" {
+		response.ThumbnailURL = url(media.ThumbnailKey)
+	}
+	return response
+}
+
+// MediaToResponseDTOs converts a product's media
+func MediaToResponseDTOs(media []*entities.ProductMedia, url func(key string) string) []*MediaResponseDTO {
+	responses := make([]*MediaResponseDTO, 0, len(media))
+	for _, item := range media {
+		responses = append(responses, MediaToResponseDTO(item, url))
+	}
+	return responses
+}
+
+// ApplyMedia attaches a product's media and the URLs of its primary image
+func (dto *ProductResponseDTO) ApplyMedia(media []*MediaResponseDTO) {
+	dto.Media = media
+	for _, item := range media {
+		if item.Primary {
+			dto.ImageURL = item.URL
+			dto.ThumbnailURL = item.ThumbnailURL
+		}
+	}
+}
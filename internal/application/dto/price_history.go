@@ -0,0 +1,83 @@
+package dto
+
+import (
+	"product-service/internal/domain/entities"
+	"time"
+)
+
+// SchedulePriceRequestDTO for scheduling a future price. When EffectiveTo is
+// set the previous price is restored at that time.
+type SchedulePriceRequestDTO struct {
+	Price         entities.Money `json:"price"`
+	Reason        string         `json:"reason" validate:"omitempty,max=500"`
+	EffectiveFrom time.Time      `json:"effective_from" validate:"required"`
+	EffectiveTo   *time.Time     `json:"effective_to,omitempty"`
+}
+
+// PriceChangeDTO for price history entries
+type PriceChangeDTO struct {
+	ID            uint                       `json:"id"`
+	OldPrice      entities.Money             `json:"old_price"`
+	NewPrice      entities.Money             `json:"new_price"`
+	Reason        string                     `json:"reason,omitempty"`
+	Actor         string                     `json:"actor,omitempty"`
+	EffectiveFrom time.Time                  `json:"effective_from"`
+	EffectiveTo   *time.Time                 `json:"effective_to,omitempty"`
+	Status        entities.PriceChangeStatus `json:"status"`
+	AppliedAt     *time.Time                 `json:"applied_at,omitempty"`
+	EndedAt       *time.Time                 `json:"ended_at,omitempty"`
+	CreatedAt     time.Time                  `json:"created_at"`
+}
+
+// PriceTimelineDTO lists a product's past price changes, most recent first,
+// and the changes still waiting to take effect, soonest first
+type PriceTimelineDTO struct {
+	ProductID    uint              `json:"product_id"`
+	CurrentPrice entities.Money    `json:"current_price"`
+	History      []*PriceChangeDTO `json:"history"`
+	Upcoming     []*PriceChangeDTO `json:"upcoming"`
+}
+
+// PriceChangeToDTO converts a price change entity to its DTO
+func PriceChangeToDTO(change *entities.PriceChange) *PriceChangeDTO {
+	if change == nil {
+		return nil
+	}
+
+	return &PriceChangeDTO{
+		ID:            change.ID,
+		OldPrice:      change.OldPrice,
+		NewPrice:      change.NewPrice,
+		Reason:        change.Reason,
+		Actor:         change.Actor,
+		EffectiveFrom: change.EffectiveFrom,
+		EffectiveTo:   change.EffectiveTo,
+		Status:        change.Status,
+		AppliedAt:     change.AppliedAt,
+		EndedAt:       change.EndedAt,
+		CreatedAt:     change.CreatedAt,
+	}
+}
+
+// NewPriceTimelineDTO splits a product's changes into history and upcoming
+func NewPriceTimelineDTO(product *entities.Product, changes []*entities.PriceChange) *PriceTimelineDTO {
+	timeline := &PriceTimelineDTO{
+		ProductID:    product.ID,
+		CurrentPrice: product.Price,
+		History:      []*PriceChangeDTO{},
+		Upcoming:     []*PriceChangeDTO{},
+	}
+
+	// changes arrive ordered by effective time
+	for _, change := range changes {
+		if change.IsUpcoming() {
+			timeline.Upcoming = append(timeline.Upcoming, PriceChangeToDTO(change))
+		}
+	}
+	for i := len(changes) - 1; i >= 0; i-- {
+		if !changes[i].IsUpcoming() {
+			timeline.History = append(timeline.History, PriceChangeToDTO(changes[i]))
+		}
+	}
+	return timeline
+}
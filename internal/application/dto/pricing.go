@@ -0,0 +1,110 @@
+package dto
+
+import (
+	"product-service/internal/domain/entities"
+	"time"
+)
+
+// CreatePriceListRequestDTO for price list creation
+type CreatePriceListRequestDTO struct {
+	Code      string `json:"code" validate:"required,min=2,max=50"`
+	Name      string `json:"name" validate:"required,min=2,max=255"`
+	IsDefault bool   `json:"is_default"`
+}
+
+// PriceListResponseDTO for price list responses
+type PriceListResponseDTO struct {
+	ID        uint      `json:"id"`
+	Code      string    `json:"code"`
+	Name      string    `json:"name"`
+	IsDefault bool      `json:"is_default"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// SetProductPriceRequestDTO for setting an explicit product price in a price list
+type SetProductPriceRequestDTO struct {
+	Price entities.Money `json:"price"`
+}
+
+// ProductPriceResponseDTO for explicit product price responses
+type ProductPriceResponseDTO struct {
+	PriceList string         `json:"price_list"`
+	ProductID uint           `json:"product_id"`
+	Price     entities.Money `json:"price"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+// ExchangeRateDTO for exchange rate requests and responses. Rate is a decimal
+// string so it round-trips exactly.
+type ExchangeRateDTO struct {
+	BaseCurrency  string    `json:"base_currency" validate:"required,len=3"`
+	QuoteCurrency string    `json:"quote_currency" validate:"required,len=3"`
+	Rate          string    `json:"rate" validate:"required"`
+	UpdatedAt     time.Time `json:"updated_at,omitempty"`
+}
+
+// ExchangeRateImportRequestDTO for bulk exchange rate imports
+type ExchangeRateImportRequestDTO struct {
+	Rates []ExchangeRateDTO `json:"rates" validate:"required,min=1,dive"`
+}
+
+// ResolvedPriceDTO is a product price expressed in a requested currency
+type ResolvedPriceDTO struct {
+	Price        entities.Money       `json:"price"`
+	Source       entities.PriceSource `json:"source"`
+	Converted    bool                 `json:"converted"`
+	PriceList    string               `json:"price_list,omitempty"`
+	ExchangeRate string               `json:"exchange_rate,omitempty"`
+}
+
+// Conversion methods
+func (dto *CreatePriceListRequestDTO) ToEntity() (*entities.PriceList, error) {
+	return entities.NewPriceList(dto.Code, dto.Name, dto.IsDefault)
+}
+
+func (dto *ExchangeRateDTO) ToEntity() (*entities.ExchangeRate, error) {
+	return entities.NewExchangeRate(dto.BaseCurrency, dto.QuoteCurrency, dto.Rate)
+}
+
+func PriceListToResponseDTO(priceList *entities.PriceList) *PriceListResponseDTO {
+	return &PriceListResponseDTO{
+		ID:        priceList.ID,
+		Code:      priceList.Code,
+		Name:      priceList.Name,
+		IsDefault: priceList.IsDefault,
+		CreatedAt: priceList.CreatedAt,
+		UpdatedAt: priceList.UpdatedAt,
+	}
+}
+
+func ProductPriceToResponseDTO(priceList *entities.PriceList, price *entities.ProductPrice) *ProductPriceResponseDTO {
+	return &ProductPriceResponseDTO{
+		PriceList: priceList.Code,
+		ProductID: price.ProductID,
+		Price:     price.Price,
+		UpdatedAt: price.UpdatedAt,
+	}
+}
+
+func ExchangeRateToDTO(rate *entities.ExchangeRate) *ExchangeRateDTO {
+	return &ExchangeRateDTO{
+		BaseCurrency:  rate.BaseCurrency,
+		QuoteCurrency: rate.QuoteCurrency,
+		Rate:          rate.RateString(),
+		UpdatedAt:     rate.UpdatedAt,
+	}
+}
+
+func ResolvedPriceToDTO(resolved *entities.ResolvedPrice) *ResolvedPriceDTO {
+	response := &ResolvedPriceDTO{
+		Price:     resolved.Price,
+		Source:    resolved.Source,
+		Converted: resolved.Source == entities.PriceSourceConverted,
+		PriceList: resolved.PriceList,
+	}
+	if resolved.Rate != nil {
+		response.ExchangeRate = resolved.Rate.RateString()
+	}
+	return response
+}
@@ -5,25 +5,49 @@ import (
 	"time"
 )
 
-// CreateProductRequestDTO for product creation
+// CreateProductRequestDTO for product creation. Category names an existing
+// category by path, e.g. "Electronics/Phones", or by name when only one
+// category has that name. Brand, when set, is the name or an alias of an
+// active registered brand and is stored under the brand's canonical name.
+// SKU may be left out in categories with a SKU template, which generates it.
 type CreateProductRequestDTO struct {
-	Name        string  `json:"name" validate:"required,min=2,max=255"`
-	Description string  `json:"description" validate:"omitempty,max=1000"`
-	SKU         string  `json:"sku" validate:"required,min=3,max=50"`
-	Price       float64 `json:"price" validate:"required,min=0,max=999999.99"`
-	Category    string  `json:"category" validate:"required,min=2,max=100"`
-	Brand       string  `json:"brand" validate:"omitempty,max=100"`
-	Stock       int     `json:"stock" validate:"min=0"`
+	Name        string         `json:"name" validate:"required,min=2,max=255"`
+	Description string         `json:"description" validate:"omitempty,max=1000"`
+	SKU         string         `json:"sku" validate:"omitempty,min=3,max=50"`
+	GTIN        string         `json:"gtin" validate:"omitempty,max=20"`
+	Price       entities.Money `json:"price"`
+	Category    string         `json:"category" validate:"required,min=2,max=1000"`
+	Brand       string         `json:"brand" validate:"omitempty,max=100"`
+	Stock       int            `json:"stock" validate:"min=0"`
+
+	// Attributes are checked against the category's attribute schema
+	Attributes entities.Attributes `json:"attributes"`
+
+	// Tags are stored in slug form, so "Summer Sale" becomes "summer-sale"
+	Tags []string `json:"tags" validate:"omitempty,max=20,dive,max=50"`
+
+	// Status is draft or active; new products are active when omitted
+	Status entities.ProductStatus `json:"status" validate:"omitempty,oneof=draft active"`
 }
 
 // UpdateProductRequestDTO for product updates
 type UpdateProductRequestDTO struct {
-	Name        string   `json:"name" validate:"omitempty,min=2,max=255"`
-	Description string   `json:"description" validate:"omitempty,max=1000"`
-	Category    string   `json:"category" validate:"omitempty,min=2,max=100"`
-	Brand       string   `json:"brand" validate:"omitempty,max=100"`
-	Price       *float64 `json:"price" validate:"omitempty,min=0,max=999999.99"`
-	Stock       *int     `json:"stock" validate:"omitempty,min=0"`
+	Name        string          `json:"name" validate:"omitempty,min=2,max=255"`
+	Description string          `json:"description" validate:"omitempty,max=1000"`
+	Category    string          `json:"category" validate:"omitempty,min=2,max=1000"`
+	Brand       string          `json:"brand" validate:"omitempty,max=100"`
+	Price       *entities.Money `json:"price"`
+	Stock       *int            `json:"stock" validate:"omitempty,min=0"`
+
+	// GTIN, when present, replaces the product's barcode; "" removes it
+	GTIN *string `json:"gtin" validate:"omitempty,max=20"`
+
+	// Attributes are merged into the product's attributes; a null value
+	// removes that attribute
+	Attributes entities.Attributes `json:"attributes"`
+
+	// Tags, when present, replace the product's tags; [] removes them all
+	Tags []string `json:"tags" validate:"omitempty,max=20,dive,max=50"`
 }
 
 // ProductResponseDTO for product responses
@@ -32,16 +56,44 @@ type ProductResponseDTO struct {
 	Name        string                 `json:"name"`
 	Description string                 `json:"description"`
 	SKU         string                 `json:"sku"`
-	Price       float64                `json:"price"`
+	GTIN        string                 `json:"gtin,omitempty"`
+	Price       entities.Money         `json:"price"`
+	CategoryID  uint                   `json:"category_id"`
 	Category    string                 `json:"category"`
+	BrandID     *uint                  `json:"brand_id,omitempty"`
 	Brand       string                 `json:"brand"`
 	Stock       int                    `json:"stock"`
 	Status      entities.ProductStatus `json:"status"`
+	Attributes  entities.Attributes    `json:"attributes,omitempty"`
+	Tags        []string               `json:"tags"`
 	IsActive    bool                   `json:"is_active"`
 	IsInStock   bool                   `json:"is_in_stock"`
 	IsAvailable bool                   `json:"is_available"`
 	CreatedAt   time.Time              `json:"created_at"`
 	UpdatedAt   time.Time              `json:"updated_at"`
+
+	// AsOf is set when this is the product's recorded state at that time
+	// rather than its current state
+	AsOf *time.Time `json:"as_of,omitempty"`
+
+	// HasVariants is set when the product is a parent of variants; Stock and
+	// availability are then derived from the variants
+	HasVariants  bool `json:"has_variants"`
+	VariantCount int  `json:"variant_count,omitempty"`
+
+	// ResolvedPrice is set when the caller asks for a specific currency
+	ResolvedPrice *ResolvedPriceDTO `json:"resolved_price,omitempty"`
+
+	// EffectivePrice is Price after promotions; AppliedPromotions explains
+	// which promotions applied, in the order they were taken off
+	EffectivePrice    *entities.Money       `json:"effective_price,omitempty"`
+	AppliedPromotions []AppliedPromotionDTO `json:"applied_promotions,omitempty"`
+
+	// Media lists the product's images and documents in display order;
+	// ImageURL and ThumbnailURL are those of the primary image
+	Media        []*MediaResponseDTO `json:"media,omitempty"`
+	ImageURL     string              `json:"image_url,omitempty"`
+	ThumbnailURL string              `json:"thumbnail_url,omitempty"`
 }
 
 // ProductListResponseDTO for paginated product lists
@@ -55,14 +107,31 @@ type ProductListResponseDTO struct {
 // ProductSearchRequestDTO for product search
 type ProductSearchRequestDTO struct {
 	Query    string                  `json:"query" validate:"omitempty,min=1,max=255"`
-	Category string                  `json:"category" validate:"omitempty,min=2,max=100"`
 	Brand    string                  `json:"brand" validate:"omitempty,max=100"`
-	MinPrice *float64                `json:"min_price" validate:"omitempty,min=0"`
-	MaxPrice *float64                `json:"max_price" validate:"omitempty,min=0"`
+	MinPrice *entities.Money         `json:"min_price"`
+	MaxPrice *entities.Money         `json:"max_price"`
 	InStock  *bool                   `json:"in_stock"`
 	Status   *entities.ProductStatus `json:"status"`
 	Page     int                     `json:"page" validate:"min=0"`
 	PageSize int                     `json:"page_size" validate:"min=1,max=100"`
+
+	// Category or CategoryID restricts the search to a category and all its
+	// descendants; Category takes a path or a name like CreateProductRequestDTO
+	Category   string `json:"category" validate:"omitempty,min=2,max=1000"`
+	CategoryID *uint  `json:"category_id"`
+
+	// Attributes filter on custom attributes, e.g. storage_gb >= 128
+	Attributes []AttributeFilterDTO `json:"attributes"`
+
+	// Tags matches products carrying every one of the tags
+	Tags []string `json:"tags" validate:"omitempty,max=10,dive,max=50"`
+}
+
+// AttributeFilterDTO is one custom attribute filter; Operator is eq, min or max
+type AttributeFilterDTO struct {
+	Key      string                           `json:"key" validate:"required,max=50"`
+	Operator entities.AttributeFilterOperator `json:"operator" validate:"omitempty,oneof=eq min max"`
+	Value    string                           `json:"value" validate:"required,max=500"`
 }
 
 // StockUpdateRequestDTO for stock updates
@@ -70,14 +139,31 @@ type StockUpdateRequestDTO struct {
 	Stock int `json:"stock" validate:"min=0"`
 }
 
-// PriceUpdateRequestDTO for price updates
+// PriceUpdateRequestDTO for price updates. Price accepts either
+// {"amount":"19.99","currency":"USD"} or a legacy bare number in USD.
 type PriceUpdateRequestDTO struct {
-	Price float64 `json:"price" validate:"min=0,max=999999.99"`
+	Price  entities.Money `json:"price"`
+	Reason string         `json:"reason" validate:"omitempty,max=500"`
+}
+
+// TransitionRequestDTO moves a product to another lifecycle status
+type TransitionRequestDTO struct {
+	Status entities.ProductStatus `json:"status" validate:"required,oneof=draft active inactive discontinued archived"`
+	Reason string                 `json:"reason" validate:"omitempty,max=500"`
+}
+
+// TransitionListResponseDTO is a product's status history with the
+// statuses it can move to next
+type TransitionListResponseDTO struct {
+	ProductID   uint                         `json:"product_id"`
+	Status      entities.ProductStatus       `json:"status"`
+	Allowed     []entities.ProductStatus     `json:"allowed"`
+	Transitions []*entities.StatusTransition `json:"transitions"`
 }
 
 // Conversion methods
 func (dto *CreateProductRequestDTO) ToEntity() (*entities.Product, error) {
-	return entities.NewProduct(
+	product, err := entities.NewProduct(
 		dto.Name,
 		dto.Description,
 		dto.SKU,
@@ -86,6 +172,38 @@ func (dto *CreateProductRequestDTO) ToEntity() (*entities.Product, error) {
 		dto.Price,
 		dto.Stock,
 	)
+	if err != nil {
+		return nil, err
+	}
+	if dto.Status == entities.ProductStatusDraft {
+		product.Status = entities.ProductStatusDraft
+	}
+	return product, nil
+}
+
+// ToChanges converts the update to the product edits it requests; empty
+// strings leave a field unchanged
+func (dto *UpdateProductRequestDTO) ToChanges() entities.ProductChanges {
+	changes := entities.ProductChanges{
+		GTIN:       dto.GTIN,
+		Price:      dto.Price,
+		Stock:      dto.Stock,
+		Attributes: dto.Attributes,
+		Tags:       dto.Tags,
+	}
+	if dto.Name != "" {
+		changes.Name = &dto.Name
+	}
+	if dto.Description != "" {
+		changes.Description = &dto.Description
+	}
+	if dto.Category != "" {
+		changes.Category = &dto.Category
+	}
+	if dto.Brand != "" {
+		changes.Brand = &dto.Brand
+	}
+	return changes
 }
 
 func ProductToResponseDTO(product *entities.Product) *ProductResponseDTO {
@@ -94,11 +212,16 @@ func ProductToResponseDTO(product *entities.Product) *ProductResponseDTO {
 		Name:        product.Name,
 		Description: product.Description,
 		SKU:         product.SKU,
+		GTIN:        product.GTIN,
 		Price:       product.Price,
+		CategoryID:  product.CategoryID,
 		Category:    product.Category,
+		BrandID:     product.BrandID,
 		Brand:       product.Brand,
 		Stock:       product.Stock,
 		Status:      product.Status,
+		Attributes:  product.Attributes,
+		Tags:        product.Tags,
 		IsActive:    product.IsActive(),
 		IsInStock:   product.IsInStock(),
 		IsAvailable: product.IsAvailable(),
@@ -0,0 +1,16 @@
+package dto
+
+import "product-service/internal/domain/entities"
+
+// ExportRequestDTO describes a catalog export. Filters narrow it like a
+// search, but every match is exported; their paging is ignored.
+type ExportRequestDTO struct {
+	// Format is csv (the default), jsonl or xlsx
+	Format entities.ExportFormat `json:"format" validate:"omitempty,oneof=csv jsonl xlsx"`
+
+	// Columns selects and orders the exported fields, and attributes as
+	// "attributes.<key>"; entities.DefaultExportColumns when empty
+	Columns []string `json:"columns" validate:"omitempty,max=100,dive,max=100"`
+
+	Filters ProductSearchRequestDTO `json:"filters"`
+}
@@ -0,0 +1,27 @@
+package dto
+
+import "product-service/internal/domain/entities"
+
+// ImportRequestDTO describes a bulk product import of a CSV file
+type ImportRequestDTO struct {
+	FileName string `json:"file_name" validate:"omitempty,max=255"`
+
+	// Mapping maps product fields, and attributes as "attributes.<key>", to
+	// column headers; unmapped fields are read from the column named after
+	// them
+	Mapping entities.ImportMapping `json:"mapping"`
+
+	// Currency prices rows of files without a currency column
+	Currency string `json:"currency" validate:"omitempty,len=3"`
+
+	// DryRun validates every row and reports what would change without
+	// writing anything
+	DryRun bool `json:"dry_run"`
+}
+
+// ImportResponseDTO is the progress of an import. ErrorReportURL is set by
+// the HTTP layer once the import finished with failed rows.
+type ImportResponseDTO struct {
+	*entities.ProductImport
+	ErrorReportURL string `json:"error_report_url,omitempty"`
+}
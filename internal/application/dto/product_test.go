@@ -24,7 +24,7 @@ func TestCreateProductRequestDTO_ToEntity(t *testing.T) {
 				Name:        "iPhone 15",
 				Description: "Latest Apple smartphone",
 				SKU:         "IPH15-128GB",
-				Price:       999.99,
+				Price:       entities.MustParseMoney("999.99", "USD"),
 				Category:    "Electronics",
 				Brand:       "Apple",
 				Stock:       100,
@@ -37,7 +37,7 @@ func TestCreateProductRequestDTO_ToEntity(t *testing.T) {
 				Name:        "",
 				Description: "Description",
 				SKU:         "SKU123",
-				Price:       100.0,
+				Price:       entities.MustParseMoney("100.0", "USD"),
 				Category:    "Electronics",
 				Brand:       "Brand",
 				Stock:       10,
@@ -51,7 +51,7 @@ func TestCreateProductRequestDTO_ToEntity(t *testing.T) {
 				Name:        "Product Name",
 				Description: "Description",
 				SKU:         "AB", // Too short
-				Price:       100.0,
+				Price:       entities.MustParseMoney("100.0", "USD"),
 				Category:    "Electronics",
 				Brand:       "Brand",
 				Stock:       10,
@@ -65,7 +65,7 @@ func TestCreateProductRequestDTO_ToEntity(t *testing.T) {
 				Name:        "Product Name",
 				Description: "Description",
 				SKU:         "SKU123",
-				Price:       -10.0,
+				Price:       entities.MustParseMoney("-10.0", "USD"),
 				Category:    "Electronics",
 				Brand:       "Brand",
 				Stock:       10,
@@ -79,7 +79,7 @@ func TestCreateProductRequestDTO_ToEntity(t *testing.T) {
 				Name:        "Product Name",
 				Description: "Description",
 				SKU:         "SKU123",
-				Price:       100.0,
+				Price:       entities.MustParseMoney("100.0", "USD"),
 				Category:    "Electronics",
 				Brand:       "Brand",
 				Stock:       -5,
@@ -93,7 +93,7 @@ func TestCreateProductRequestDTO_ToEntity(t *testing.T) {
 				Name:        "Product Name",
 				Description: "Description",
 				SKU:         "SKU123",
-				Price:       100.0,
+				Price:       entities.MustParseMoney("100.0", "USD"),
 				Category:    "",
 				Brand:       "Brand",
 				Stock:       10,
@@ -107,7 +107,7 @@ func TestCreateProductRequestDTO_ToEntity(t *testing.T) {
 				Name:        "Product Name",
 				Description: "Description",
 				SKU:         "SKU123",
-				Price:       100.0,
+				Price:       entities.MustParseMoney("100.0", "USD"),
 				Category:    "Electronics",
 				Brand:       "",
 				Stock:       10,
@@ -148,7 +148,7 @@ func TestProductToResponseDTO(t *testing.T) {
 		Name:        "iPhone 15",
 		Description: "Latest Apple smartphone",
 		SKU:         "IPH15-128GB",
-		Price:       999.99,
+		Price:       entities.MustParseMoney("999.99", "USD"),
 		Category:    "Electronics",
 		Brand:       "Apple",
 		Stock:       100,
@@ -184,7 +184,7 @@ func TestProductToResponseDTO_InactiveProduct(t *testing.T) {
 		ID:     1,
 		Name:   "Discontinued Product",
 		SKU:    "DISC-001",
-		Price:  100.0,
+		Price:  entities.MustParseMoney("100.0", "USD"),
 		Stock:  10,
 		Status: entities.ProductStatusDiscontinued,
 	}
@@ -205,7 +205,7 @@ func TestProductToResponseDTO_OutOfStock(t *testing.T) {
 		ID:     1,
 		Name:   "Out of Stock Product",
 		SKU:    "OOS-001",
-		Price:  100.0,
+		Price:  entities.MustParseMoney("100.0", "USD"),
 		Stock:  0, // No stock
 		Status: entities.ProductStatusActive,
 	}
@@ -229,7 +229,7 @@ func TestProductsToResponseDTOs(t *testing.T) {
 			Name:        "iPhone 15",
 			Description: "Latest Apple smartphone",
 			SKU:         "IPH15-128GB",
-			Price:       999.99,
+			Price:       entities.MustParseMoney("999.99", "USD"),
 			Category:    "Electronics",
 			Brand:       "Apple",
 			Stock:       100,
@@ -242,7 +242,7 @@ func TestProductsToResponseDTOs(t *testing.T) {
 			Name:        "Samsung Galaxy S24",
 			Description: "Latest Samsung smartphone",
 			SKU:         "SGS24-128GB",
-			Price:       899.99,
+			Price:       entities.MustParseMoney("899.99", "USD"),
 			Category:    "Electronics",
 			Brand:       "Samsung",
 			Stock:       50,
@@ -275,7 +275,7 @@ func TestCreateProductRequestDTO_JSONSerialization(t *testing.T) {
 		Name:        "iPhone 15",
 		Description: "Latest Apple smartphone",
 		SKU:         "IPH15-128GB",
-		Price:       999.99,
+		Price:       entities.MustParseMoney("999.99", "USD"),
 		Category:    "Electronics",
 		Brand:       "Apple",
 		Stock:       100,
@@ -307,7 +307,7 @@ func TestProductResponseDTO_JSONSerialization(t *testing.T) {
 		Name:        "iPhone 15",
 		Description: "Latest Apple smartphone",
 		SKU:         "IPH15-128GB",
-		Price:       999.99,
+		Price:       entities.MustParseMoney("999.99", "USD"),
 		Category:    "Electronics",
 		Brand:       "Apple",
 		Stock:       100,
@@ -344,7 +344,7 @@ func TestProductResponseDTO_JSONSerialization(t *testing.T) {
 
 func TestUpdateProductRequestDTO_PartialUpdate(t *testing.T) {
 	// Given - Only some fields provided
-	newPrice := 899.99
+	newPrice := entities.MustParseMoney("899.99", "USD")
 	dto := UpdateProductRequestDTO{
 		Name:  "iPhone 15 Pro",
 		Price: &newPrice,
@@ -361,7 +361,7 @@ func TestUpdateProductRequestDTO_PartialUpdate(t *testing.T) {
 	require.NoError(t, err)
 
 	assert.Equal(t, "iPhone 15 Pro", decoded["name"])
-	assert.Equal(t, 899.99, decoded["price"])
+	assert.Equal(t, map[string]interface{}{"amount": "899.99", "currency": "USD"}, decoded["price"])
 	assert.Equal(t, "", decoded["description"]) // Empty string, not nil
 	assert.Equal(t, "", decoded["category"])    // Empty string, not nil
 	assert.Equal(t, "", decoded["brand"])       // Empty string, not nil
@@ -370,7 +370,7 @@ func TestUpdateProductRequestDTO_PartialUpdate(t *testing.T) {
 
 func TestUpdateProductRequestDTO_WithPointers(t *testing.T) {
 	// Given
-	newPrice := 799.99
+	newPrice := entities.MustParseMoney("799.99", "USD")
 	newStock := 200
 	dto := UpdateProductRequestDTO{
 		Name:  "Updated Product",
@@ -451,39 +451,40 @@ func TestStockUpdateRequestDTO_Validation(t *testing.T) {
 	}
 }
 
-func TestPriceUpdateRequestDTO_Validation(t *testing.T) {
+func TestPriceUpdateRequestDTO_Decoding(t *testing.T) {
 	tests := []struct {
-		name  string
-		price float64
-		valid bool
+		name     string
+		body     string
+		expected entities.Money
+		valid    bool
 	}{
-		{"valid positive price", 99.99, true},
-		{"valid zero price", 0.0, true},
-		{"invalid negative price", -10.0, false},
-		{"valid maximum price", 999999.99, true},
+		{"money object", `{"price":{"amount":"99.99","currency":"EUR"}}`, entities.MustParseMoney("99.99", "EUR"), true},
+		{"legacy numeric price", `{"price":99.99}`, entities.MustParseMoney("99.99", "USD"), true},
+		{"valid zero price", `{"price":0}`, entities.MustParseMoney("0", "USD"), true},
+		{"valid maximum price", `{"price":999999.99}`, entities.MustParseMoney("999999.99", "USD"), true},
+		{"excess precision", `{"price":99.999}`, entities.Money{}, false},
+		{"unsupported currency", `{"price":{"amount":"1","currency":"XYZ"}}`, entities.Money{}, false},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			dto := PriceUpdateRequestDTO{Price: tt.price}
-
-			// Serialize to JSON to test structure
-			jsonData, err := json.Marshal(dto)
-			require.NoError(t, err)
-
 			var decoded PriceUpdateRequestDTO
-			err = json.Unmarshal(jsonData, &decoded)
-			require.NoError(t, err)
+			err := json.Unmarshal([]byte(tt.body), &decoded)
 
-			assert.Equal(t, tt.price, decoded.Price)
+			if !tt.valid {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, decoded.Price)
 		})
 	}
 }
 
 func TestProductSearchRequestDTO_Structure(t *testing.T) {
 	// Given
-	minPrice := 100.0
-	maxPrice := 1000.0
+	minPrice := entities.MustParseMoney("100", "USD")
+	maxPrice := entities.MustParseMoney("1000", "USD")
 	inStock := true
 	status := entities.ProductStatusActive
 
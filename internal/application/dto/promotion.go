@@ -0,0 +1,168 @@
+package dto
+
+import (
+	"errors"
+	"math/big"
+	"product-service/internal/domain/entities"
+	"time"
+)
+
+// PromotionRequestDTO for promotion creation and replacement. Percentage is
+// a decimal string such as "15" or "12.5" for percentage discounts; Amount is
+// used for fixed-amount discounts. Categories name existing categories by path
+// or, when unambiguous, by name; Brands name registered brands by their name
+// or an alias.
+type PromotionRequestDTO struct {
+	Name         string                `json:"name" validate:"required,min=2,max=255"`
+	Description  string                `json:"description" validate:"max=1000"`
+	DiscountType entities.DiscountType `json:"discount_type" validate:"required,oneof=percentage fixed_amount"`
+	Percentage   string                `json:"percentage,omitempty"`
+	Amount       *entities.Money       `json:"amount,omitempty"`
+	Categories   []string              `json:"categories" validate:"omitempty,dive,max=1000"`
+	Brands       []string              `json:"brands" validate:"omitempty,dive,max=100"`
+	SKUs         []string              `json:"skus" validate:"omitempty,dive,max=100"`
+	Tags         []string              `json:"tags" validate:"omitempty,dive,max=100"`
+	StartsAt     *time.Time            `json:"starts_at,omitempty"`
+	EndsAt       *time.Time            `json:"ends_at,omitempty"`
+	Priority     int                   `json:"priority" validate:"min=0,max=1000"`
+	Stackable    bool                  `json:"stackable"`
+	Active       *bool                 `json:"active,omitempty"`
+}
+
+// PromotionResponseDTO for promotion responses. Categories holds the current
+// paths of the categories listed in CategoryIDs, Brands the current names of
+// the brands listed in BrandIDs.
+type PromotionResponseDTO struct {
+	ID           uint                  `json:"id"`
+	Name         string                `json:"name"`
+	Description  string                `json:"description"`
+	DiscountType entities.DiscountType `json:"discount_type"`
+	Percentage   string                `json:"percentage,omitempty"`
+	Amount       *entities.Money       `json:"amount,omitempty"`
+	CategoryIDs  []uint                `json:"category_ids"`
+	Categories   []string              `json:"categories"`
+	BrandIDs     []uint                `json:"brand_ids"`
+	Brands       []string              `json:"brands"`
+	SKUs         []string              `json:"skus"`
+	Tags         []string              `json:"tags"`
+	StartsAt     *time.Time            `json:"starts_at,omitempty"`
+	EndsAt       *time.Time            `json:"ends_at,omitempty"`
+	Priority     int                   `json:"priority"`
+	Stackable    bool                  `json:"stackable"`
+	Active       bool                  `json:"active"`
+	CreatedAt    time.Time             `json:"created_at"`
+	UpdatedAt    time.Time             `json:"updated_at"`
+}
+
+// AppliedPromotionDTO explains one promotion that contributed to an effective price
+type AppliedPromotionDTO struct {
+	PromotionID  uint                  `json:"promotion_id"`
+	Name         string                `json:"name"`
+	DiscountType entities.DiscountType `json:"discount_type"`
+	Percentage   string                `json:"percentage,omitempty"`
+	Discount     entities.Money        `json:"discount"`
+	PriceAfter   entities.Money        `json:"price_after"`
+}
+
+// ToEntity converts the request to a validated promotion entity targeting
+// categories and brands, which the caller resolved from the request's
+// Categories and Brands. Active defaults to true.
+func (dto *PromotionRequestDTO) ToEntity(categories []entities.PromotionCategory, brands []entities.PromotionBrand) (*entities.Promotion, error) {
+	promotion := &entities.Promotion{
+		Name:         dto.Name,
+		Description:  dto.Description,
+		DiscountType: dto.DiscountType,
+		Categories:   categories,
+		Brands:       brands,
+		SKUs:         dto.SKUs,
+		Tags:         dto.Tags,
+		StartsAt:     dto.StartsAt,
+		EndsAt:       dto.EndsAt,
+		Priority:     dto.Priority,
+		Stackable:    dto.Stackable,
+		Active:       dto.Active == nil || *dto.Active,
+	}
+
+	if dto.Percentage != "" {
+		percentage, ok := new(big.Rat).SetString(dto.Percentage)
+		if !ok {
+			return nil, errors.New("percentage must be a decimal number")
+		}
+		promotion.Percentage = percentage
+	}
+	if dto.Amount != nil {
+		promotion.Amount = *dto.Amount
+	}
+
+	if err := promotion.Validate(); err != nil {
+		return nil, err
+	}
+	return promotion, nil
+}
+
+// PromotionToResponseDTO converts a promotion entity to its response DTO
+func PromotionToResponseDTO(promotion *entities.Promotion) *PromotionResponseDTO {
+	response := &PromotionResponseDTO{
+		ID:           promotion.ID,
+		Name:         promotion.Name,
+		Description:  promotion.Description,
+		DiscountType: promotion.DiscountType,
+		Percentage:   promotion.PercentageString(),
+		CategoryIDs:  make([]uint, 0, len(promotion.Categories)),
+		Categories:   make([]string, 0, len(promotion.Categories)),
+		BrandIDs:     make([]uint, 0, len(promotion.Brands)),
+		Brands:       make([]string, 0, len(promotion.Brands)),
+		SKUs:         promotion.SKUs,
+		Tags:         promotion.Tags,
+		StartsAt:     promotion.StartsAt,
+		EndsAt:       promotion.EndsAt,
+		Priority:     promotion.Priority,
+		Stackable:    promotion.Stackable,
+		Active:       promotion.Active,
+		CreatedAt:    promotion.CreatedAt,
+		UpdatedAt:    promotion.UpdatedAt,
+	}
+	for _, category := range promotion.Categories {
+		response.CategoryIDs = append(response.CategoryIDs, category.ID)
+		response.Categories = append(response.Categories, category.Path)
+	}
+	for _, brand := range promotion.Brands {
+		response.BrandIDs = append(response.BrandIDs, brand.ID)
+		response.Brands = append(response.Brands, brand.Name)
+	}
+	if !promotion.Amount.IsZero() {
+		amount := promotion.Amount
+		response.Amount = &amount
+	}
+	return response
+}
+
+// ApplyPriceEvaluation sets the effective price of a product response and
+// the promotions that produced it
+func (dto *ProductResponseDTO) ApplyPriceEvaluation(evaluation *entities.PriceEvaluation) {
+	effectivePrice := evaluation.EffectivePrice
+	dto.EffectivePrice = &effectivePrice
+
+	dto.AppliedPromotions = make([]AppliedPromotionDTO, 0, len(evaluation.Applied))
+	for _, applied := range evaluation.Applied {
+		dto.AppliedPromotions = append(dto.AppliedPromotions, AppliedPromotionDTO{
+			PromotionID:  applied.PromotionID,
+			Name:         applied.Name,
+			DiscountType: applied.DiscountType,
+			Percentage:   applied.Percentage,
+			Discount:     applied.Discount,
+			PriceAfter:   applied.PriceAfter,
+		})
+	}
+}
+
+// PromotionTarget describes the product for promotion targeting; categoryPath
+// is the path of the product's category
+func (dto *ProductResponseDTO) PromotionTarget(categoryPath string) entities.PromotionTarget {
+	return entities.PromotionTarget{
+		SKU:          dto.SKU,
+		CategoryPath: categoryPath,
+		BrandID:      dto.BrandID,
+		Tags:         dto.Tags,
+	}
+}
@@ -0,0 +1,79 @@
+package dto
+
+import (
+	"product-service/internal/domain/entities"
+	"time"
+)
+
+// SubmitRevisionRequestDTO drafts a product edit for review. Changes take
+// the same fields as a product update.
+type SubmitRevisionRequestDTO struct {
+	Changes UpdateProductRequestDTO `json:"changes"`
+	Comment string                  `json:"comment" validate:"omitempty,max=500"`
+}
+
+// ReviewRevisionRequestDTO approves or rejects a revision
+type ReviewRevisionRequestDTO struct {
+	Comment string `json:"comment" validate:"omitempty,max=500"`
+}
+
+// RevisionResponseDTO for revision responses
+type RevisionResponseDTO struct {
+	ID            uint                    `json:"id"`
+	ProductID     uint                    `json:"product_id"`
+	Status        entities.RevisionStatus `json:"status"`
+	Changes       entities.ProductChanges `json:"changes"`
+	Comment       string                  `json:"comment,omitempty"`
+	Author        string                  `json:"author,omitempty"`
+	Reviewer      string                  `json:"reviewer,omitempty"`
+	ReviewComment string                  `json:"review_comment,omitempty"`
+	ReviewedAt    *time.Time              `json:"reviewed_at,omitempty"`
+	Publisher     string                  `json:"publisher,omitempty"`
+	PublishedAt   *time.Time              `json:"published_at,omitempty"`
+	BaseUpdatedAt time.Time               `json:"base_updated_at"`
+	CreatedAt     time.Time               `json:"created_at"`
+	UpdatedAt     time.Time               `json:"updated_at"`
+
+	// Diff compares an open revision with the live product; Stale is set
+	// when the product changed after the revision was drafted, in which case
+	// it cannot be published
+	Diff  []entities.FieldChange `json:"diff,omitempty"`
+	Stale bool                   `json:"stale,omitempty"`
+
+	// Product is the live product after the revision was published
+	Product *ProductResponseDTO `json:"product,omitempty"`
+}
+
+// RevisionListResponseDTO wraps a revision list
+type RevisionListResponseDTO struct {
+	Revisions []*RevisionResponseDTO `json:"revisions"`
+}
+
+// RevisionToResponseDTO converts a revision entity to its DTO
+func RevisionToResponseDTO(revision *entities.ProductRevision) *RevisionResponseDTO {
+	return &RevisionResponseDTO{
+		ID:            revision.ID,
+		ProductID:     revision.ProductID,
+		Status:        revision.Status,
+		Changes:       revision.Changes,
+		Comment:       revision.Comment,
+		Author:        revision.Author,
+		Reviewer:      revision.Reviewer,
+		ReviewComment: revision.ReviewComment,
+		ReviewedAt:    revision.ReviewedAt,
+		Publisher:     revision.Publisher,
+		PublishedAt:   revision.PublishedAt,
+		BaseUpdatedAt: revision.BaseUpdatedAt,
+		CreatedAt:     revision.CreatedAt,
+		UpdatedAt:     revision.UpdatedAt,
+	}
+}
+
+// RevisionsToResponseDTOs converts revision entities to DTOs
+func RevisionsToResponseDTOs(revisions []*entities.ProductRevision) []*RevisionResponseDTO {
+	dtos := make([]*RevisionResponseDTO, 0, len(revisions))
+	for _, revision := range revisions {
+		dtos = append(dtos, RevisionToResponseDTO(revision))
+	}
+	return dtos
+}
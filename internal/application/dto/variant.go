@@ -0,0 +1,115 @@
+package dto
+
+import (
+	"product-service/internal/domain/entities"
+	"time"
+)
+
+// ProductOptionDTO defines one option axis, e.g. {"name":"Size","values":["S","M","L"]}
+type ProductOptionDTO struct {
+	Name   string   `json:"name" validate:"required,max=50"`
+	Values []string `json:"values" validate:"required,min=1,max=50,dive,required,max=50"`
+}
+
+// SetProductOptionsRequestDTO replaces a product's option axes in the given order
+type SetProductOptionsRequestDTO struct {
+	Options []ProductOptionDTO `json:"options" validate:"max=3,dive"`
+}
+
+// CreateVariantRequestDTO for adding a single variant
+type CreateVariantRequestDTO struct {
+	SKU           string            `json:"sku" validate:"required,min=3,max=50"`
+	Options       map[string]string `json:"options" validate:"required"`
+	PriceOverride *entities.Money   `json:"price_override"`
+	Stock         int               `json:"stock" validate:"min=0"`
+}
+
+// UpdateVariantRequestDTO for variant updates. clear_price_override drops the
+// override so the variant sells at the parent's price again.
+type UpdateVariantRequestDTO struct {
+	PriceOverride *entities.Money `json:"price_override"`
+	ClearPrice    bool            `json:"clear_price_override"`
+	Stock         *int            `json:"stock" validate:"omitempty,min=0"`
+	Active        *bool           `json:"active"`
+}
+
+// GenerateVariantsRequestDTO for generating the variant matrix
+type GenerateVariantsRequestDTO struct {
+	Stock int `json:"stock" validate:"min=0"`
+}
+
+// VariantResponseDTO for variant responses
+type VariantResponseDTO struct {
+	ID            uint              `json:"id"`
+	ProductID     uint              `json:"product_id"`
+	SKU           string            `json:"sku"`
+	Options       map[string]string `json:"options"`
+	Price         entities.Money    `json:"price"`
+	PriceOverride *entities.Money   `json:"price_override,omitempty"`
+	Stock         int               `json:"stock"`
+	Active        bool              `json:"active"`
+	IsAvailable   bool              `json:"is_available"`
+	CreatedAt     time.Time         `json:"created_at"`
+	UpdatedAt     time.Time         `json:"updated_at"`
+}
+
+// ProductVariantsResponseDTO lists a product's options and variants
+type ProductVariantsResponseDTO struct {
+	ProductID      uint                      `json:"product_id"`
+	Options        []*entities.ProductOption `json:"options"`
+	Variants       []*VariantResponseDTO     `json:"variants"`
+	TotalStock     int                       `json:"total_stock"`
+	AvailableCount int                       `json:"available_count"`
+}
+
+// ProductOptionsToEntities converts option definitions for validation
+func ProductOptionsToEntities(options []ProductOptionDTO) []entities.ProductOption {
+	definitions := make([]entities.ProductOption, 0, len(options))
+	for _, option := range options {
+		definitions = append(definitions, entities.ProductOption{Name: option.Name, Values: option.Values})
+	}
+	return definitions
+}
+
+// VariantToResponseDTO converts a variant, resolving its price against the parent's
+func VariantToResponseDTO(variant *entities.ProductVariant, parentPrice entities.Money) *VariantResponseDTO {
+	response := &VariantResponseDTO{
+		ID:          variant.ID,
+		ProductID:   variant.ProductID,
+		SKU:         variant.SKU,
+		Options:     variant.Options,
+		Price:       variant.Price(parentPrice),
+		Stock:       variant.Stock,
+		Active:      variant.Active,
+		IsAvailable: variant.IsAvailable(),
+		CreatedAt:   variant.CreatedAt,
+		UpdatedAt:   variant.UpdatedAt,
+	}
+	if !variant.PriceOverride.IsZero() {
+		override := variant.PriceOverride
+		response.PriceOverride = &override
+	}
+	return response
+}
+
+// VariantsToResponseDTOs converts a product's variants
+func VariantsToResponseDTOs(variants []*entities.ProductVariant, parentPrice entities.Money) []*VariantResponseDTO {
+	responses := make([]*VariantResponseDTO, 0, len(variants))
+	for _, variant := range variants {
+		responses = append(responses, VariantToResponseDTO(variant, parentPrice))
+	}
+	return responses
+}
+
+// ApplyVariantSummary derives the product's stock and availability from its
+// variants. Products without variants keep their own stock.
+func (dto *ProductResponseDTO) ApplyVariantSummary(summary entities.VariantSummary) {
+	if summary.VariantCount == 0 {
+		return
+	}
+	dto.HasVariants = true
+	dto.VariantCount = summary.VariantCount
+	dto.Stock = summary.TotalStock
+	dto.IsInStock = summary.AvailableCount > 0
+	dto.IsAvailable = dto.IsActive && dto.IsInStock
+}
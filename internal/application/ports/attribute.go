@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+	"product-service/internal/domain/entities"
+)
+
+// AttributeRepository defines the contract for per-category attribute schemas
+type AttributeRepository interface {
+	// ListDefinitions returns the attribute schemas of the given categories,
+	// or of every category when none are given, ordered by category and key
+	ListDefinitions(ctx context.Context, categoryIDs ...uint) ([]*entities.AttributeDefinition, error)
+
+	GetDefinition(ctx context.Context, categoryID uint, key string) (*entities.AttributeDefinition, error)
+
+	// SaveDefinition creates the definition or replaces the one with the same category and key
+	SaveDefinition(ctx context.Context, definition *entities.AttributeDefinition) (*entities.AttributeDefinition, error)
+
+	DeleteDefinition(ctx context.Context, categoryID uint, key string) error
+}
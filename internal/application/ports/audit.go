@@ -0,0 +1,35 @@
+package ports
+
+import (
+	"context"
+	"product-service/internal/domain/entities"
+	"time"
+)
+
+// AuditRepository defines the contract for reading the product audit trail.
+// Entries are written by the product repositories in the same transaction as
+// the mutation they record, so there is no write method here.
+type AuditRepository interface {
+	// List returns one page of entries matching the criteria, newest first,
+	// and the total number of matches
+	List(ctx context.Context, criteria AuditCriteria) ([]*entities.AuditEntry, int64, error)
+}
+
+// AuditCriteria narrows an audit query. Zero values do not filter.
+type AuditCriteria struct {
+	ProductID uint
+	Actor     string
+	Operation entities.AuditOperation
+	RequestID string
+
+	// Field matches entries that changed this field, e.g. price or
+	// attributes.color
+	Field string
+
+	// From and To bound the entry time; From is inclusive, To exclusive
+	From *time.Time
+	To   *time.Time
+
+	Limit  int
+	Offset int
+}
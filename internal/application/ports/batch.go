@@ -0,0 +1,64 @@
+package ports
+
+import (
+	"context"
+	"product-service/internal/domain/entities"
+)
+
+// BatchWriteKind says which parts of a product a batch operation saves
+type BatchWriteKind string
+
+const (
+	// BatchWriteCreate inserts a new product with its tags
+	BatchWriteCreate BatchWriteKind = "create"
+	// BatchWriteEdit saves the editable fields together with PriceChanges, as
+	// ProductRepository.ApplyEdit
+	BatchWriteEdit BatchWriteKind = "edit"
+	// BatchWritePrice saves the price together with PriceChanges
+	BatchWritePrice BatchWriteKind = "price"
+	// BatchWriteStatus saves the status together with Transition
+	BatchWriteStatus BatchWriteKind = "status"
+)
+
+// BatchWrite is what a batch operation saves
+type BatchWrite struct {
+	Kind         BatchWriteKind
+	Product      *entities.Product
+	PriceChanges []*entities.PriceChange
+	Transition   *entities.StatusTransition
+}
+
+// BatchTarget addresses the product of a batch operation by ID or, when ID
+// is zero, by SKU. Operations that create a product have a zero target.
+type BatchTarget struct {
+	ID  uint
+	SKU string
+}
+
+// IsZero reports whether the target addresses no product
+func (t BatchTarget) IsZero() bool {
+	return t.ID == 0 && t.SKU == ""
+}
+
+// BatchOperationFunc runs operation index of a batch with the locked product
+// it addresses, nil for a zero target, and returns what to save
+type BatchOperationFunc func(index int, product *entities.Product) (*BatchWrite, error)
+
+// BatchOutcome is the saved product or the error of one batch operation
+type BatchOutcome struct {
+	Product *entities.Product
+	Err     error
+}
+
+// BatchRepository defines the contract for applying many product operations
+// in one request
+type BatchRepository interface {
+	// Apply locks the product of each target in order, runs fn and saves
+	// what it returns, recording audit entries and history as the single
+	// product repositories do. An atomic batch runs in one transaction that
+	// stops at the first failing operation and rolls back every one before
+	// it; otherwise each operation commits on its own. There is one outcome
+	// per target, zero for operations an atomic batch never reached; the
+	// error is for failing to run the batch itself.
+	Apply(ctx context.Context, targets []BatchTarget, atomic bool, fn BatchOperationFunc) ([]BatchOutcome, error)
+}
@@ -0,0 +1,29 @@
+package ports
+
+import (
+	"context"
+	"product-service/internal/domain/entities"
+)
+
+// BrandRepository defines the contract for the brand registry
+type BrandRepository interface {
+	// Create stores a brand; its name and aliases must not belong to another brand
+	Create(ctx context.Context, brand *entities.Brand) (*entities.Brand, error)
+
+	GetByID(ctx context.Context, id uint) (*entities.Brand, error)
+
+	// FindByName retrieves the brand whose canonical name or one of whose
+	// aliases matches name, ignoring case and spacing
+	FindByName(ctx context.Context, name string) (*entities.Brand, error)
+
+	// List returns the brands ordered by name, optionally only those with status
+	List(ctx context.Context, status entities.BrandStatus) ([]*entities.Brand, error)
+
+	// Update saves the brand and renames its products to the canonical name
+	Update(ctx context.Context, brand *entities.Brand) (*entities.Brand, error)
+
+	// Merge reassigns the products and promotion targets of source to target,
+	// saves target with the names of source as aliases and deletes source, in
+	// one transaction
+	Merge(ctx context.Context, source, target *entities.Brand) (*entities.Brand, error)
+}
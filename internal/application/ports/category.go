@@ -0,0 +1,47 @@
+package ports
+
+import (
+	"context"
+	"product-service/internal/domain/entities"
+)
+
+// CategoryRepository defines the contract for the category tree
+type CategoryRepository interface {
+	Create(ctx context.Context, category *entities.Category) (*entities.Category, error)
+
+	GetByID(ctx context.Context, id uint) (*entities.Category, error)
+
+	// GetByPath retrieves a category by its materialized path, e.g. "electronics/phones"
+	GetByPath(ctx context.Context, path string) (*entities.Category, error)
+
+	// FindBySlug returns every category with the given slug, wherever it sits in the tree
+	FindBySlug(ctx context.Context, slug string) ([]*entities.Category, error)
+
+	// List returns every category ordered by path, so parents come before their children
+	List(ctx context.Context) ([]*entities.Category, error)
+
+	// ListSubtree returns the category at path and all its descendants, ordered by path
+	ListSubtree(ctx context.Context, path string) ([]*entities.Category, error)
+
+	// Relocate saves a renamed or moved category and rewrites the paths of
+	// its descendants, which were below oldPath, in one transaction
+	Relocate(ctx context.Context, category *entities.Category, oldPath string) (*entities.Category, error)
+
+	// SaveSKURules saves the category's SKU pattern and template
+	SaveSKURules(ctx context.Context, category *entities.Category) (*entities.Category, error)
+
+	// NextSKUSequence draws the next number of the category's SKU template,
+	// starting at 1. Numbers are never handed out twice, even to creations
+	// that fail afterwards.
+	NextSKUSequence(ctx context.Context, id uint) (int64, error)
+
+	// Merge moves the products, attribute definitions, subcategories and
+	// promotion targets of source to target and deletes source, in one
+	// transaction. Attribute definitions target already has take precedence
+	// over those of source.
+	Merge(ctx context.Context, source, target *entities.Category) error
+
+	// Delete removes a category without products, subcategories or promotions
+	// targeting it
+	Delete(ctx context.Context, id uint) error
+}
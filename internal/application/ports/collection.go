@@ -0,0 +1,42 @@
+package ports
+
+import (
+	"context"
+	"product-service/internal/domain/entities"
+)
+
+// TagRepository defines the contract for tag persistence. Products carry
+// their tags themselves; see ProductRepository.
+type TagRepository interface {
+	// Create registers a tag that no product carries yet
+	Create(ctx context.Context, tag *entities.Tag) (*entities.Tag, error)
+
+	// GetByID returns the tag with its product count
+	GetByID(ctx context.Context, id uint) (*entities.Tag, error)
+
+	// List returns all tags by name, with their product counts
+	List(ctx context.Context) ([]*entities.Tag, error)
+
+	// Rename saves the tag's new name; products keep carrying the tag
+	Rename(ctx context.Context, tag *entities.Tag) (*entities.Tag, error)
+
+	// Delete removes the tag from all products
+	Delete(ctx context.Context, id uint) error
+}
+
+// CollectionRepository defines the contract for collection persistence
+type CollectionRepository interface {
+	Create(ctx context.Context, collection *entities.Collection) (*entities.Collection, error)
+
+	// GetByID and GetBySlug return the collection with its manual product order
+	GetByID(ctx context.Context, id uint) (*entities.Collection, error)
+	GetBySlug(ctx context.Context, slug string) (*entities.Collection, error)
+
+	// List returns all collections by name, without their product IDs
+	List(ctx context.Context) ([]*entities.Collection, error)
+
+	// Update saves the name, description, rules and manual product order
+	Update(ctx context.Context, collection *entities.Collection) (*entities.Collection, error)
+
+	Delete(ctx context.Context, id uint) error
+}
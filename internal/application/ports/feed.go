@@ -0,0 +1,11 @@
+package ports
+
+import "context"
+
+// FeedRepository defines what product feeds need from the catalog besides
+// the products themselves
+type FeedRepository interface {
+	// Fingerprint identifies the current state of the products and their
+	// media; it changes whenever either is created, updated or deleted
+	Fingerprint(ctx context.Context) (string, error)
+}
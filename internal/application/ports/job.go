@@ -0,0 +1,52 @@
+package ports
+
+import (
+	"context"
+	"product-service/internal/domain/entities"
+	"time"
+)
+
+// JobUpdateFunc changes a locked job before it is saved
+type JobUpdateFunc func(job *entities.Job) error
+
+// JobRepository defines the contract for the background job queue. Any
+// number of workers in any number of processes share the queue; a running
+// job belongs to the worker whose lease on it has not expired.
+type JobRepository interface {
+	// Create queues a new job
+	Create(ctx context.Context, job *entities.Job) (*entities.Job, error)
+
+	// GetByID retrieves a job, or ErrJobNotFound
+	GetByID(ctx context.Context, id uint) (*entities.Job, error)
+
+	// List returns one page of jobs matching the criteria, newest first, and
+	// the total number of matches
+	List(ctx context.Context, criteria JobCriteria) ([]*entities.Job, int64, error)
+
+	// Update locks a job, runs fn and saves the job unless fn fails
+	Update(ctx context.Context, id uint, fn JobUpdateFunc) (*entities.Job, error)
+
+	// Claim starts the oldest job of one of types that is due at now, or
+	// whose worker's lease expired, for worker until now+lease. Jobs locked
+	// by other workers are skipped. A job whose lease expired on its last
+	// attempt fails instead. It returns nil when no job is due.
+	Claim(ctx context.Context, types []entities.JobType, worker string, lease time.Duration, now time.Time) (*entities.Job, error)
+
+	// Heartbeat saves the progress of a running job and extends its lease
+	// to now+lease, copying CancelRequested from the stored job. It reports
+	// false when worker no longer holds the job.
+	Heartbeat(ctx context.Context, job *entities.Job, lease time.Duration, now time.Time) (bool, error)
+
+	// Finish saves the job after its worker recorded how the attempt ended.
+	// It reports false when the worker had already lost the job.
+	Finish(ctx context.Context, job *entities.Job, worker string) (bool, error)
+}
+
+// JobCriteria narrows a job query. Zero values do not filter.
+type JobCriteria struct {
+	Type   entities.JobType
+	Status entities.JobStatus
+
+	Limit  int
+	Offset int
+}
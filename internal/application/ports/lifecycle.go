@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"context"
+	"product-service/internal/domain/entities"
+)
+
+// StatusTransitionFunc moves a locked product to a new status and returns
+// the history entry to store with it
+type StatusTransitionFunc func(product *entities.Product) (*entities.StatusTransition, error)
+
+// LifecycleRepository defines the contract for product status persistence.
+// Statuses only change through it, so every transition is recorded in the
+// same transaction as the new status.
+type LifecycleRepository interface {
+	// ApplyTransition locks the product, runs fn and saves the product's
+	// status together with the returned transition
+	ApplyTransition(ctx context.Context, productID uint, fn StatusTransitionFunc) (*entities.Product, error)
+
+	// ListTransitions returns the status history of a product, oldest first
+	ListTransitions(ctx context.Context, productID uint) ([]*entities.StatusTransition, error)
+}
@@ -0,0 +1,41 @@
+package ports
+
+import (
+	"context"
+	"product-service/internal/domain/entities"
+)
+
+// MediaRepository defines the contract for product media metadata
+type MediaRepository interface {
+	// Create stores the media; when it is primary, any other primary media
+	// of the product stops being primary in the same transaction
+	Create(ctx context.Context, media *entities.ProductMedia) (*entities.ProductMedia, error)
+
+	GetMedia(ctx context.Context, productID, mediaID uint) (*entities.ProductMedia, error)
+
+	// ListMedia returns a product's media ordered by position
+	ListMedia(ctx context.Context, productID uint) ([]*entities.ProductMedia, error)
+
+	// ListMediaForProducts returns the media of each product, ordered by position
+	ListMediaForProducts(ctx context.Context, productIDs []uint) (map[uint][]*entities.ProductMedia, error)
+
+	// Update saves alt text and the primary flag, with the same primary
+	// handling as Create
+	Update(ctx context.Context, media *entities.ProductMedia) (*entities.ProductMedia, error)
+
+	// SavePositions stores the positions of a product's media in one transaction
+	SavePositions(ctx context.Context, productID uint, media []*entities.ProductMedia) error
+
+	DeleteMedia(ctx context.Context, productID, mediaID uint) error
+}
+
+// MediaStorage stores the bytes of uploaded media. Keys are slash-separated
+// relative paths such as "products/42/3f9c.jpg".
+type MediaStorage interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+
+	Delete(ctx context.Context, key string) error
+
+	// URL returns the address clients download the object from
+	URL(key string) string
+}
@@ -0,0 +1,46 @@
+package ports
+
+import (
+	"context"
+	"product-service/internal/domain/entities"
+	"time"
+)
+
+// PriceChangeFunc mutates a locked product and returns the price history
+// entries to store with it
+type PriceChangeFunc func(product *entities.Product) ([]*entities.PriceChange, error)
+
+// DuePriceChangeFunc advances a due price change on its locked product and
+// returns any additional history entries to store
+type DuePriceChangeFunc func(change *entities.PriceChange, product *entities.Product) ([]*entities.PriceChange, error)
+
+// PriceHistoryRepository defines the contract for price history persistence.
+// Product prices only change through it, so every change is recorded in the
+// same transaction as the new price.
+type PriceHistoryRepository interface {
+	// ApplyPriceChange locks the product, runs fn and saves the product's price
+	// together with the returned history entries
+	ApplyPriceChange(ctx context.Context, productID uint, fn PriceChangeFunc) (*entities.Product, error)
+
+	// ProcessDuePriceChanges claims up to limit changes that are due at now,
+	// one transaction each, skipping rows locked by other instances. fn runs
+	// with the change and its locked product; the change, the product's price
+	// and any returned entries are saved. A change fn fails on, or that cannot
+	// be read, is marked failed instead of blocking the ones after it, and its
+	// error is returned together with the others once the batch is done. It
+	// returns how many were processed, failed ones included.
+	ProcessDuePriceChanges(ctx context.Context, now time.Time, limit int, fn DuePriceChangeFunc) (int, error)
+
+	// SchedulePriceChange stores a scheduled change
+	SchedulePriceChange(ctx context.Context, change *entities.PriceChange) (*entities.PriceChange, error)
+
+	// GetPriceChange retrieves a change of a product, or ErrPriceChangeNotFound
+	GetPriceChange(ctx context.Context, productID, changeID uint) (*entities.PriceChange, error)
+
+	// SavePriceChange updates the status of a change that is still scheduled,
+	// returning ErrPriceChangeNotCancellable when it was processed meanwhile
+	SavePriceChange(ctx context.Context, change *entities.PriceChange) error
+
+	// ListPriceChanges returns every change of a product ordered by effective time
+	ListPriceChanges(ctx context.Context, productID uint) ([]*entities.PriceChange, error)
+}
@@ -0,0 +1,52 @@
+package ports
+
+import (
+	"context"
+	"product-service/internal/domain/entities"
+)
+
+// PriceListRepository defines the contract for price list persistence
+type PriceListRepository interface {
+	// CreatePriceList stores a new price list. Creating a default list clears
+	// the flag on any previous default.
+	CreatePriceList(ctx context.Context, priceList *entities.PriceList) (*entities.PriceList, error)
+
+	// GetPriceListByCode retrieves a price list by its code
+	GetPriceListByCode(ctx context.Context, code string) (*entities.PriceList, error)
+
+	// GetDefaultPriceList retrieves the default price list, or
+	// ErrPriceListNotFound when none is marked as default
+	GetDefaultPriceList(ctx context.Context) (*entities.PriceList, error)
+
+	// ListPriceLists returns every price list ordered by code
+	ListPriceLists(ctx context.Context) ([]*entities.PriceList, error)
+
+	// SetProductPrice creates or replaces the price of a product in the price's currency
+	SetProductPrice(ctx context.Context, price *entities.ProductPrice) error
+
+	// DeleteProductPrice removes the price of a product in one currency
+	DeleteProductPrice(ctx context.Context, priceListID, productID uint, currency string) error
+
+	// GetProductPrice retrieves the price of a product in one currency, or
+	// ErrProductPriceNotFound
+	GetProductPrice(ctx context.Context, priceListID, productID uint, currency string) (*entities.ProductPrice, error)
+
+	// ListProductPrices returns the prices stored in a price list, optionally
+	// restricted to one product when productID is not zero
+	ListProductPrices(ctx context.Context, priceListID, productID uint) ([]*entities.ProductPrice, error)
+}
+
+// ExchangeRateRepository defines the contract for exchange rate persistence
+type ExchangeRateRepository interface {
+	// UpsertRates creates or replaces the given rates in a single transaction
+	UpsertRates(ctx context.Context, rates []*entities.ExchangeRate) error
+
+	// GetRate retrieves the rate from base to quote, or ErrExchangeRateNotFound
+	GetRate(ctx context.Context, base, quote string) (*entities.ExchangeRate, error)
+
+	// ListRates returns every configured rate
+	ListRates(ctx context.Context) ([]*entities.ExchangeRate, error)
+
+	// DeleteRate removes the rate from base to quote
+	DeleteRate(ctx context.Context, base, quote string) error
+}
@@ -3,8 +3,13 @@ package ports
 import (
 	"context"
 	"product-service/internal/domain/entities"
+	"time"
 )
 
+// ProductEditFunc changes the editable fields of a locked product and returns
+// the price history entries of any price change it made
+type ProductEditFunc func(product *entities.Product) ([]*entities.PriceChange, error)
+
 // ProductRepository defines the contract for product persistence
 type ProductRepository interface {
 	// Create a new product
@@ -16,6 +21,59 @@ type ProductRepository interface {
 	// GetBySKU retrieves a product by its SKU (unique identifier)
 	GetBySKU(ctx context.Context, sku string) (*entities.Product, error)
 
+	// GetByGTIN retrieves a product by its barcode, comparing GTINs in their
+	// 14-digit form so a UPC-A also finds the product stored under its EAN-13
+	GetByGTIN(ctx context.Context, gtin string) (*entities.Product, error)
+
+	// GetByIDAsOf retrieves the recorded state a product had at the given
+	// time, even if it was deleted since
+	GetByIDAsOf(ctx context.Context, id uint, at time.Time) (*entities.Product, error)
+
+	// GetBySKUAsOf retrieves the recorded state of the product that had the
+	// SKU at the given time
+	GetBySKUAsOf(ctx context.Context, sku string, at time.Time) (*entities.Product, error)
+
 	// ExistsBySKU checks if a product with the given SKU exists
 	ExistsBySKU(ctx context.Context, sku string) (bool, error)
+
+	// ExistsByGTIN checks if a product other than excludeID has the GTIN
+	ExistsByGTIN(ctx context.Context, gtin string, excludeID uint) (bool, error)
+
+	// ApplyEdit locks the product, runs fn and saves the editable fields it
+	// changed together with the audit entry and the returned price history
+	// entries. Product prices change through it or the PriceHistoryRepository
+	// only, so every price change is recorded.
+	ApplyEdit(ctx context.Context, id uint, fn ProductEditFunc) (*entities.Product, error)
+
+	// Search returns one page of products matching the criteria and the
+	// total number of matches
+	Search(ctx context.Context, criteria ProductSearchCriteria) ([]*entities.Product, int64, error)
+
+	// Each calls fn with every product matching the criteria in ID order,
+	// reading them from a database cursor rather than all at once. Limit and
+	// Offset are ignored; an error from fn stops the iteration and is
+	// returned as is.
+	Each(ctx context.Context, criteria ProductSearchCriteria, fn func(*entities.Product) error) error
+}
+
+// ProductSearchCriteria narrows a product search. Zero values do not filter.
+type ProductSearchCriteria struct {
+	Query      string
+	Brand      string
+	MinPrice   *entities.Money
+	MaxPrice   *entities.Money
+	InStock    *bool
+	Status     *entities.ProductStatus
+	Attributes []entities.AttributeFilter
+	Limit      int
+	Offset     int
+
+	// CategoryPath matches the category at this path and all its descendants
+	CategoryPath string
+
+	// Tags matches products carrying every one of the tags
+	Tags []string
+
+	// IDs restricts the search to these products
+	IDs []uint
 }
@@ -0,0 +1,24 @@
+package ports
+
+import (
+	"context"
+	"product-service/internal/domain/entities"
+)
+
+// ImportRepository defines the contract for product imports and their error
+// reports
+type ImportRepository interface {
+	// Create stores a new import
+	Create(ctx context.Context, productImport *entities.ProductImport) (*entities.ProductImport, error)
+
+	// GetByID retrieves an import, or ErrImportNotFound
+	GetByID(ctx context.Context, id uint) (*entities.ProductImport, error)
+
+	// Save stores the status and counters of an import together with new
+	// lines of its error report, in one transaction
+	Save(ctx context.Context, productImport *entities.ProductImport, rowErrors []entities.ImportRowError) error
+
+	// EachError calls fn with every line of an import's error report in row
+	// order, reading them in batches rather than all at once
+	EachError(ctx context.Context, importID uint, fn func(entities.ImportRowError) error) error
+}
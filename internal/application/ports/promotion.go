@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+	"product-service/internal/domain/entities"
+	"time"
+)
+
+// PromotionRepository defines the contract for promotion persistence
+type PromotionRepository interface {
+	Create(ctx context.Context, promotion *entities.Promotion) (*entities.Promotion, error)
+	GetByID(ctx context.Context, id uint) (*entities.Promotion, error)
+	Update(ctx context.Context, promotion *entities.Promotion) (*entities.Promotion, error)
+	Delete(ctx context.Context, id uint) error
+	List(ctx context.Context) ([]*entities.Promotion, error)
+
+	// ListLiveAt returns the active promotions whose window contains at
+	ListLiveAt(ctx context.Context, at time.Time) ([]*entities.Promotion, error)
+}
@@ -0,0 +1,37 @@
+package ports
+
+import (
+	"context"
+	"product-service/internal/domain/entities"
+)
+
+// RevisionPublishFunc applies a locked revision to its locked product and
+// returns the price history entries of any price change it made
+type RevisionPublishFunc func(revision *entities.ProductRevision, product *entities.Product) ([]*entities.PriceChange, error)
+
+// RevisionRepository defines the contract for staged product edits
+type RevisionRepository interface {
+	// Create stores a new revision
+	Create(ctx context.Context, revision *entities.ProductRevision) (*entities.ProductRevision, error)
+
+	// GetByID retrieves a revision, or ErrRevisionNotFound
+	GetByID(ctx context.Context, id uint) (*entities.ProductRevision, error)
+
+	// List returns the revisions matching the criteria, oldest first
+	List(ctx context.Context, criteria RevisionCriteria) ([]*entities.ProductRevision, error)
+
+	// SaveReview stores the review of a revision that was still pending,
+	// returning ErrRevisionNotPending when it was reviewed meanwhile
+	SaveReview(ctx context.Context, revision *entities.ProductRevision) error
+
+	// Publish locks the revision and its product, runs fn and saves the
+	// product's editable fields and price history entries together with the
+	// revision, so a revision is published at most once and never half-applied
+	Publish(ctx context.Context, revisionID uint, fn RevisionPublishFunc) (*entities.ProductRevision, *entities.Product, error)
+}
+
+// RevisionCriteria narrows a revision listing. Zero values do not filter.
+type RevisionCriteria struct {
+	ProductID uint
+	Status    entities.RevisionStatus
+}
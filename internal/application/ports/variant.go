@@ -0,0 +1,31 @@
+package ports
+
+import (
+	"context"
+	"product-service/internal/domain/entities"
+)
+
+// VariantRepository defines the contract for product option and variant persistence
+type VariantRepository interface {
+	// ReplaceOptions swaps a product's option axes for the given ones
+	ReplaceOptions(ctx context.Context, productID uint, options []*entities.ProductOption) ([]*entities.ProductOption, error)
+
+	// ListOptions returns a product's option axes in position order
+	ListOptions(ctx context.Context, productID uint) ([]*entities.ProductOption, error)
+
+	// CreateVariants inserts variants in one transaction
+	CreateVariants(ctx context.Context, variants []*entities.ProductVariant) ([]*entities.ProductVariant, error)
+
+	GetVariant(ctx context.Context, productID, variantID uint) (*entities.ProductVariant, error)
+	UpdateVariant(ctx context.Context, variant *entities.ProductVariant) (*entities.ProductVariant, error)
+	DeleteVariant(ctx context.Context, productID, variantID uint) error
+
+	// ListVariants returns a product's variants ordered by ID
+	ListVariants(ctx context.Context, productID uint) ([]*entities.ProductVariant, error)
+
+	// ListVariantsForProducts returns the variants of several products keyed by product ID
+	ListVariantsForProducts(ctx context.Context, productIDs []uint) (map[uint][]*entities.ProductVariant, error)
+
+	// ExistsBySKU checks if a variant with the given SKU exists
+	ExistsBySKU(ctx context.Context, sku string) (bool, error)
+}
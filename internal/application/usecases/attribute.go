@@ -0,0 +1,90 @@
+package usecases
+
+import (
+	"context"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	productErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+)
+
+// AttributeUseCases defines the interface for per-category attribute schemas
+type AttributeUseCases interface {
+	GetSchema(ctx context.Context, categoryID uint) (*dto.AttributeSchemaResponseDTO, error)
+	SaveDefinition(ctx context.Context, categoryID uint, key string, request *dto.AttributeDefinitionRequestDTO) (*entities.AttributeDefinition, error)
+	DeleteDefinition(ctx context.Context, categoryID uint, key string) error
+}
+
+// attributeUseCasesImpl implements AttributeUseCases interface
+type attributeUseCasesImpl struct {
+	attributeRepo ports.AttributeRepository
+	categoryRepo  ports.CategoryRepository
+	logger        logger.Logger
+}
+
+// NewAttributeUseCases creates a new instance of attribute use cases
+func NewAttributeUseCases(attributeRepo ports.AttributeRepository, categoryRepo ports.CategoryRepository, log logger.Logger) AttributeUseCases {
+	return &attributeUseCasesImpl{
+		attributeRepo: attributeRepo,
+		categoryRepo:  categoryRepo,
+		logger:        log.With("component", "attribute_usecases"),
+	}
+}
+
+// GetSchema returns the attribute definitions of a category
+func (uc *attributeUseCasesImpl) GetSchema(ctx context.Context, categoryID uint) (*dto.AttributeSchemaResponseDTO, error) {
+	category, err := uc.categoryRepo.GetByID(ctx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	definitions, err := uc.attributeRepo.ListDefinitions(ctx, categoryID)
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("Failed to list attribute definitions", "error", err, "category_id", categoryID)
+		return nil, err
+	}
+
+	return &dto.AttributeSchemaResponseDTO{
+		CategoryID: category.ID,
+		Category:   category.Name,
+		Path:       category.Path,
+		Attributes: definitions,
+	}, nil
+}
+
+// SaveDefinition creates or replaces a category attribute. Products already
+// stored are not revalidated until they are next updated.
+func (uc *attributeUseCasesImpl) SaveDefinition(ctx context.Context, categoryID uint, key string, request *dto.AttributeDefinitionRequestDTO) (*entities.AttributeDefinition, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("SaveDefinition use case called", "category_id", categoryID, "key", key, "type", request.Type)
+
+	if _, err := uc.categoryRepo.GetByID(ctx, categoryID); err != nil {
+		return nil, err
+	}
+
+	definition, err := request.ToEntity(categoryID, key)
+	if err != nil {
+		return nil, &productErrors.DomainError{
+			Code:    productErrors.ErrInvalidAttributeDefinition.Code,
+			Message: err.Error(),
+		}
+	}
+
+	saved, err := uc.attributeRepo.SaveDefinition(ctx, definition)
+	if err != nil {
+		log.Error("Failed to save attribute definition", "error", err, "category_id", categoryID, "key", key)
+		return nil, err
+	}
+
+	log.Info("SaveDefinition success", "category_id", categoryID, "key", key)
+	return saved, nil
+}
+
+// DeleteDefinition removes a category attribute
+func (uc *attributeUseCasesImpl) DeleteDefinition(ctx context.Context, categoryID uint, key string) error {
+	uc.logger.Ctx(ctx).Info("DeleteDefinition use case called", "category_id", categoryID, "key", key)
+
+	return uc.attributeRepo.DeleteDefinition(ctx, categoryID, key)
+}
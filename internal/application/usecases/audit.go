@@ -0,0 +1,97 @@
+package usecases
+
+import (
+	"context"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	productErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+)
+
+// AuditUseCases defines the interface for querying the product audit trail.
+// Entries are written by the repositories together with each mutation.
+type AuditUseCases interface {
+	ListProductAudit(ctx context.Context, productID uint, query *dto.AuditQueryDTO) (*dto.AuditListResponseDTO, error)
+	ListAudit(ctx context.Context, query *dto.AuditQueryDTO) (*dto.AuditListResponseDTO, error)
+}
+
+// auditUseCasesImpl implements AuditUseCases interface
+type auditUseCasesImpl struct {
+	auditRepo   ports.AuditRepository
+	productRepo ports.ProductRepository
+	logger      logger.Logger
+}
+
+// NewAuditUseCases creates a new instance of audit use cases
+func NewAuditUseCases(auditRepo ports.AuditRepository, productRepo ports.ProductRepository, log logger.Logger) AuditUseCases {
+	return &auditUseCasesImpl{
+		auditRepo:   auditRepo,
+		productRepo: productRepo,
+		logger:      log.With("component", "audit_usecases"),
+	}
+}
+
+// ListProductAudit returns one page of the audit trail of a product, newest
+// first; the product filter of query is ignored
+func (uc *auditUseCasesImpl) ListProductAudit(ctx context.Context, productID uint, query *dto.AuditQueryDTO) (*dto.AuditListResponseDTO, error) {
+	if _, err := uc.productRepo.GetByID(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	scoped := *query
+	scoped.ProductID = productID
+	return uc.ListAudit(ctx, &scoped)
+}
+
+// ListAudit returns one page of audit entries matching query, newest first
+func (uc *auditUseCasesImpl) ListAudit(ctx context.Context, query *dto.AuditQueryDTO) (*dto.AuditListResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	if query.Operation != "" && !entities.IsValidAuditOperation(query.Operation) {
+		return nil, &productErrors.DomainError{
+			Code:    productErrors.ErrInvalidAuditQuery.Code,
+			Message: "unknown audit operation " + string(query.Operation),
+			Field:   "operation",
+		}
+	}
+	if query.From != nil && query.To != nil && !query.From.Before(*query.To) {
+		return nil, &productErrors.DomainError{
+			Code:    productErrors.ErrInvalidAuditQuery.Code,
+			Message: "from must be before to",
+			Field:   "from",
+		}
+	}
+
+	page := query.Page
+	if page < 0 {
+		page = 0
+	}
+	pageSize := query.PageSize
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	entries, total, err := uc.auditRepo.List(ctx, ports.AuditCriteria{
+		ProductID: query.ProductID,
+		Actor:     query.Actor,
+		Operation: query.Operation,
+		RequestID: query.RequestID,
+		Field:     query.Field,
+		From:      query.From,
+		To:        query.To,
+		Limit:     pageSize,
+		Offset:    page * pageSize,
+	})
+	if err != nil {
+		log.Error("Failed to query audit trail", "error", err, "product_id", query.ProductID)
+		return nil, productErrors.ErrFailedToQueryAudit
+	}
+
+	return &dto.AuditListResponseDTO{
+		Entries:  entries,
+		Total:    int(total),
+		Page:     page,
+		PageSize: pageSize,
+	}, nil
+}
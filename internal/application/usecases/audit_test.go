@@ -0,0 +1,113 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockAuditRepository implements the AuditRepository interface for testing
+type MockAuditRepository struct {
+	mock.Mock
+}
+
+func (m *MockAuditRepository) List(ctx context.Context, criteria ports.AuditCriteria) ([]*entities.AuditEntry, int64, error) {
+	args := m.Called(ctx, criteria)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*entities.AuditEntry), args.Get(1).(int64), args.Error(2)
+}
+
+func setupTestAuditUseCases() (AuditUseCases, *MockAuditRepository, *MockProductRepository) {
+	mockAudit := new(MockAuditRepository)
+	mockProducts := new(MockProductRepository)
+	return NewAuditUseCases(mockAudit, mockProducts, logger.New("test")), mockAudit, mockProducts
+}
+
+func TestAuditUseCases_ListProductAudit(t *testing.T) {
+	// Given
+	useCases, mockAudit, mockProducts := setupTestAuditUseCases()
+	ctx := context.Background()
+
+	entries := []*entities.AuditEntry{{ID: 2, ProductID: 5, Operation: entities.AuditPriceChange, Actor: "alice"}}
+	mockProducts.On("GetByID", ctx, uint(5)).Return(&entities.Product{ID: 5}, nil)
+	mockAudit.On("List", ctx, ports.AuditCriteria{ProductID: 5, Field: "price", Limit: 20, Offset: 40}).Return(entries, int64(41), nil)
+
+	// When
+	result, err := useCases.ListProductAudit(ctx, 5, &dto.AuditQueryDTO{ProductID: 9, Field: "price", Page: 2, PageSize: 20})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, entries, result.Entries)
+	assert.Equal(t, 41, result.Total)
+	assert.Equal(t, 2, result.Page)
+	mockAudit.AssertExpectations(t)
+}
+
+func TestAuditUseCases_ListProductAudit_ProductNotFound(t *testing.T) {
+	// Given
+	useCases, mockAudit, mockProducts := setupTestAuditUseCases()
+	ctx := context.Background()
+
+	mockProducts.On("GetByID", ctx, uint(5)).Return(nil, domainErrors.ErrProductNotFound)
+
+	// When
+	_, err := useCases.ListProductAudit(ctx, 5, &dto.AuditQueryDTO{PageSize: 10})
+
+	// Then
+	assert.Equal(t, domainErrors.ErrProductNotFound, err)
+	mockAudit.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+}
+
+func TestAuditUseCases_ListAudit_InvalidQuery(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-time.Hour)
+
+	tests := []struct {
+		name  string
+		query *dto.AuditQueryDTO
+		field string
+	}{
+		{"unknown operation", &dto.AuditQueryDTO{Operation: "delete"}, "operation"},
+		{"empty time range", &dto.AuditQueryDTO{From: &now, To: &earlier}, "from"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCases, mockAudit, _ := setupTestAuditUseCases()
+
+			_, err := useCases.ListAudit(context.Background(), tt.query)
+
+			var domainErr *domainErrors.DomainError
+			require.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, domainErrors.ErrInvalidAuditQuery.Code, domainErr.Code)
+			assert.Equal(t, tt.field, domainErr.Field)
+			mockAudit.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestAuditUseCases_ListAudit_RepositoryFailure(t *testing.T) {
+	// Given
+	useCases, mockAudit, _ := setupTestAuditUseCases()
+	ctx := context.Background()
+
+	mockAudit.On("List", ctx, ports.AuditCriteria{Actor: "bob", Limit: 10}).Return(nil, int64(0), errors.New("connection reset"))
+
+	// When
+	_, err := useCases.ListAudit(ctx, &dto.AuditQueryDTO{Actor: "bob", PageSize: 500})
+
+	// Then
+	assert.Equal(t, domainErrors.ErrFailedToQueryAudit, err)
+}
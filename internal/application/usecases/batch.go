@@ -0,0 +1,245 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	productErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+	"product-service/pkg/requestctx"
+	"time"
+)
+
+// BatchOptions limits batch requests
+type BatchOptions struct {
+	// MaxOperations caps the operations of one batch; no cap when zero
+	MaxOperations int
+}
+
+// BatchUseCases defines the interface for applying many product operations
+// in one request. Operations are validated like their single-product
+// counterparts and addressed by product ID or SKU.
+type BatchUseCases interface {
+	// RunBatch applies the operations of request in order and reports the
+	// outcome of each. The error is for batches rejected as a whole.
+	RunBatch(ctx context.Context, request *dto.BatchRequestDTO) (*dto.BatchResponseDTO, error)
+}
+
+// batchUseCasesImpl implements BatchUseCases interface
+type batchUseCasesImpl struct {
+	*productEditor
+	batchRepo ports.BatchRepository
+	options   BatchOptions
+	logger    logger.Logger
+}
+
+// NewBatchUseCases creates a new instance of batch use cases
+func NewBatchUseCases(batchRepo ports.BatchRepository, attributeRepo ports.AttributeRepository, categoryRepo ports.CategoryRepository, brandRepo ports.BrandRepository, options BatchOptions, log logger.Logger) BatchUseCases {
+	log = log.With("component", "batch_usecases")
+	return &batchUseCasesImpl{
+		productEditor: newProductEditor(attributeRepo, categoryRepo, brandRepo, log),
+		batchRepo:     batchRepo,
+		options:       options,
+		logger:        log,
+	}
+}
+
+// RunBatch checks every operation before any runs. Operations that are
+// malformed, such as a stock operation without a stock, fail without
+// touching the catalog; in an atomic batch they stop the whole batch.
+func (uc *batchUseCasesImpl) RunBatch(ctx context.Context, request *dto.BatchRequestDTO) (*dto.BatchResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("RunBatch use case called", "operations", len(request.Operations), "atomic", request.Atomic)
+
+	if len(request.Operations) == 0 {
+		return nil, &productErrors.DomainError{
+			Code:    productErrors.ErrInvalidBatch.Code,
+			Message: "a batch needs at least one operation",
+			Field:   "operations",
+		}
+	}
+	if uc.options.MaxOperations > 0 && len(request.Operations) > uc.options.MaxOperations {
+		return nil, &productErrors.DomainError{
+			Code:    productErrors.ErrBatchTooLarge.Code,
+			Message: fmt.Sprintf("a batch can have at most %d operations", uc.options.MaxOperations),
+			Field:   "operations",
+		}
+	}
+
+	response := &dto.BatchResponseDTO{
+		Atomic:  request.Atomic,
+		Results: make([]*dto.BatchResultDTO, len(request.Operations)),
+	}
+
+	// pending maps the operations handed to the repository to their index
+	// in the request
+	var pending []int
+	var targets []ports.BatchTarget
+	for i, operation := range request.Operations {
+		result := &dto.BatchResultDTO{Index: i, Action: operation.Action}
+		response.Results[i] = result
+
+		target, err := batchTarget(operation)
+		if err != nil {
+			result.Err = err
+			continue
+		}
+		pending = append(pending, i)
+		targets = append(targets, target)
+	}
+
+	if len(pending) > 0 && (!request.Atomic || len(pending) == len(request.Operations)) {
+		outcomes, err := uc.batchRepo.Apply(ctx, targets, request.Atomic, func(index int, product *entities.Product) (*ports.BatchWrite, error) {
+			return uc.operationWrite(ctx, request.Operations[pending[index]], product)
+		})
+		if err != nil {
+			log.Error("Failed to run batch", "error", err)
+			return nil, productErrors.ErrFailedToRunBatch
+		}
+
+		for j, outcome := range outcomes {
+			result := response.Results[pending[j]]
+			switch {
+			case outcome.Err != nil:
+				result.Err = uc.operationError(ctx, result, outcome.Err)
+			case outcome.Product != nil:
+				result.Product = dto.ProductToResponseDTO(outcome.Product)
+			}
+		}
+	}
+
+	for _, result := range response.Results {
+		if result.Err != nil {
+			response.Failed++
+		}
+	}
+	if request.Atomic && response.Failed > 0 {
+		response.RolledBack = true
+		for _, result := range response.Results {
+			if result.Err == nil {
+				result.Product = nil
+				result.Err = productErrors.ErrBatchRolledBack
+				response.Failed++
+			}
+		}
+	}
+	response.Succeeded = len(response.Results) - response.Failed
+
+	log.Info("RunBatch finished",
+		"succeeded", response.Succeeded,
+		"failed", response.Failed,
+		"rolled_back", response.RolledBack)
+	return response, nil
+}
+
+// operationWrite runs operation on product, nil for creates, and returns
+// what to save
+func (uc *batchUseCasesImpl) operationWrite(ctx context.Context, operation *dto.BatchOperationDTO, product *entities.Product) (*ports.BatchWrite, error) {
+	switch operation.Action {
+	case entities.BatchCreate:
+		if err := entities.ValidateSKU(operation.Product.SKU); err != nil {
+			return nil, productErrors.ErrInvalidProductSKU
+		}
+		created, err := uc.newProduct(ctx, operation.Product)
+		if err != nil {
+			return nil, err
+		}
+		return &ports.BatchWrite{Kind: ports.BatchWriteCreate, Product: created}, nil
+
+	case entities.BatchUpdate:
+		priceChanges, err := uc.applyChanges(ctx, product, operation.Changes.ToChanges())
+		if err != nil {
+			return nil, err
+		}
+		return &ports.BatchWrite{Kind: ports.BatchWriteEdit, Product: product, PriceChanges: priceChanges}, nil
+
+	case entities.BatchStock:
+		if err := product.UpdateStock(*operation.Stock); err != nil {
+			return nil, productErrors.NewProductValidationError("stock", err.Error())
+		}
+		return &ports.BatchWrite{Kind: ports.BatchWriteEdit, Product: product}, nil
+
+	case entities.BatchPrice:
+		change, err := entities.ChangePrice(product, *operation.Price, operation.Reason, requestctx.Actor(ctx), time.Now())
+		if err != nil {
+			return nil, productErrors.NewProductValidationError("price", err.Error())
+		}
+		return &ports.BatchWrite{Kind: ports.BatchWritePrice, Product: product, PriceChanges: []*entities.PriceChange{change}}, nil
+
+	case entities.BatchStatus:
+		transition, err := transitionProduct(ctx, product, operation.Status, operation.Reason)
+		if err != nil {
+			return nil, err
+		}
+		return &ports.BatchWrite{Kind: ports.BatchWriteStatus, Product: product, Transition: transition}, nil
+	}
+
+	return nil, invalidBatchOperation("action", fmt.Sprintf("unknown action %q", operation.Action))
+}
+
+// operationError keeps domain errors and hides anything else behind
+// ErrFailedToRunBatch
+func (uc *batchUseCasesImpl) operationError(ctx context.Context, result *dto.BatchResultDTO, err error) error {
+	var domainErr *productErrors.DomainError
+	if errors.As(err, &domainErr) {
+		return err
+	}
+	uc.logger.Ctx(ctx).Error("Failed to run batch operation", "error", err, "index", result.Index, "action", result.Action)
+	return productErrors.ErrFailedToRunBatch
+}
+
+// batchTarget returns the product operation addresses after checking that
+// it carries what its action needs
+func batchTarget(operation *dto.BatchOperationDTO) (ports.BatchTarget, error) {
+	target := ports.BatchTarget{ID: operation.ID, SKU: operation.SKU}
+
+	if !operation.Action.IsValid() {
+		return target, invalidBatchOperation("action", fmt.Sprintf("unknown action %q", operation.Action))
+	}
+	if operation.Action.AddressesProduct() && target.IsZero() {
+		return target, invalidBatchOperation("id", fmt.Sprintf("%s operations need the id or sku of a product", operation.Action))
+	}
+	if !operation.Action.AddressesProduct() && !target.IsZero() {
+		return target, invalidBatchOperation("id", "create operations take the sku in product, not an id or sku")
+	}
+
+	var missing string
+	switch operation.Action {
+	case entities.BatchCreate:
+		if operation.Product == nil {
+			missing = "product"
+		}
+	case entities.BatchUpdate:
+		if operation.Changes == nil {
+			missing = "changes"
+		}
+	case entities.BatchStock:
+		if operation.Stock == nil {
+			missing = "stock"
+		}
+	case entities.BatchPrice:
+		if operation.Price == nil {
+			missing = "price"
+		}
+	case entities.BatchStatus:
+		if operation.Status == "" {
+			missing = "status"
+		}
+	}
+	if missing != "" {
+		return target, invalidBatchOperation(missing, fmt.Sprintf("%s operations need %s", operation.Action, missing))
+	}
+	return target, nil
+}
+
+func invalidBatchOperation(field, message string) error {
+	return &productErrors.DomainError{
+		Code:    productErrors.ErrInvalidBatchOperation.Code,
+		Message: message,
+		Field:   field,
+	}
+}
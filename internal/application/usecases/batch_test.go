@@ -0,0 +1,224 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockBatchRepository implements the BatchRepository interface for testing.
+// Apply runs fn on copies of products and collects the writes it returns.
+type MockBatchRepository struct {
+	mock.Mock
+	products []*entities.Product
+	writes   []*ports.BatchWrite
+}
+
+func (m *MockBatchRepository) Apply(ctx context.Context, targets []ports.BatchTarget, atomic bool, fn ports.BatchOperationFunc) ([]ports.BatchOutcome, error) {
+	args := m.Called(ctx, targets, atomic)
+	if err := args.Error(0); err != nil {
+		return nil, err
+	}
+
+	outcomes := make([]ports.BatchOutcome, len(targets))
+	for i, target := range targets {
+		var product *entities.Product
+		if !target.IsZero() {
+			product = m.find(target)
+			if product == nil {
+				outcomes[i].Err = domainErrors.ErrProductNotFound
+				if atomic {
+					return outcomes, nil
+				}
+				continue
+			}
+		}
+
+		write, err := fn(i, product)
+		if err != nil {
+			outcomes[i].Err = err
+			if atomic {
+				return outcomes, nil
+			}
+			continue
+		}
+		m.writes = append(m.writes, write)
+		outcomes[i].Product = write.Product
+	}
+	return outcomes, nil
+}
+
+func (m *MockBatchRepository) find(target ports.BatchTarget) *entities.Product {
+	for _, product := range m.products {
+		if (target.ID != 0 && product.ID == target.ID) || (target.ID == 0 && product.SKU == target.SKU) {
+			found := *product
+			return &found
+		}
+	}
+	return nil
+}
+
+func setupTestBatchUseCases(maxOperations int) (BatchUseCases, *MockBatchRepository) {
+	mockBatch := &MockBatchRepository{
+		products: []*entities.Product{
+			{ID: 1, SKU: "IPH15-128GB", Name: "iPhone 15", Price: entities.MustParseMoney("999.99", "USD"),
+				CategoryID: 1, Category: "Electronics", Stock: 10, Status: entities.ProductStatusActive},
+			{ID: 2, SKU: "MBP-14-M3", Name: "MacBook Pro", Price: entities.MustParseMoney("1999.00", "USD"),
+				CategoryID: 1, Category: "Electronics", Stock: 2, Status: entities.ProductStatusDraft},
+		},
+	}
+	mockAttributes := new(MockAttributeRepository)
+	mockAttributes.On("ListDefinitions", mock.Anything, mock.Anything).Return([]*entities.AttributeDefinition{}, nil).Maybe()
+	mockCategories := new(MockCategoryRepository)
+	mockCategories.On("GetByPath", mock.Anything, "electronics").Return(testCategory(1, "Electronics", nil), nil).Maybe()
+
+	useCases := NewBatchUseCases(mockBatch, mockAttributes, mockCategories, new(MockBrandRepository), BatchOptions{MaxOperations: maxOperations}, logger.New("test"))
+	return useCases, mockBatch
+}
+
+func intPtr(value int) *int {
+	return &value
+}
+
+func TestBatchUseCases_RunBatch_PerItem(t *testing.T) {
+	// Given
+	useCases, mockBatch := setupTestBatchUseCases(10)
+	ctx := context.Background()
+	price := entities.MustParseMoney("899.99", "USD")
+
+	request := &dto.BatchRequestDTO{Operations: []*dto.BatchOperationDTO{
+		{Action: entities.BatchCreate, Product: &dto.CreateProductRequestDTO{
+			Name: "iPad Air", SKU: "IPAD-AIR-11", Price: entities.MustParseMoney("599.00", "USD"), Category: "Electronics", Stock: 5}},
+		{Action: entities.BatchStock, SKU: "IPH15-128GB", Stock: intPtr(42)},
+		{Action: entities.BatchPrice, ID: 1, Price: &price, Reason: "spring sale"},
+		{Action: entities.BatchStatus, SKU: "MBP-14-M3", Status: entities.ProductStatusArchived},
+		{Action: entities.BatchStock, SKU: "UNKNOWN-SKU", Stock: intPtr(1)},
+		{Action: entities.BatchStock, SKU: "IPH15-128GB"},
+	}}
+	mockBatch.On("Apply", ctx, []ports.BatchTarget{
+		{}, {SKU: "IPH15-128GB"}, {ID: 1}, {SKU: "MBP-14-M3"}, {SKU: "UNKNOWN-SKU"},
+	}, false).Return(nil)
+
+	// When
+	response, err := useCases.RunBatch(ctx, request)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 3, response.Succeeded)
+	assert.Equal(t, 3, response.Failed)
+	assert.False(t, response.RolledBack)
+	require.Len(t, response.Results, 6)
+
+	assert.NoError(t, response.Results[0].Err)
+	assert.Equal(t, "IPAD-AIR-11", response.Results[0].Product.SKU)
+	assert.Equal(t, uint(1), response.Results[0].Product.CategoryID)
+	assert.Equal(t, 42, response.Results[1].Product.Stock)
+	assert.True(t, response.Results[2].Product.Price.Equal(price))
+
+	var domainErr *domainErrors.DomainError
+	require.ErrorAs(t, response.Results[3].Err, &domainErr)
+	assert.Equal(t, domainErrors.ErrInvalidStatusTransition.Code, domainErr.Code)
+	assert.Equal(t, domainErrors.ErrProductNotFound, response.Results[4].Err)
+	require.ErrorAs(t, response.Results[5].Err, &domainErr)
+	assert.Equal(t, domainErrors.ErrInvalidBatchOperation.Code, domainErr.Code)
+	assert.Equal(t, "stock", domainErr.Field)
+
+	require.Len(t, mockBatch.writes, 3)
+	assert.Equal(t, ports.BatchWriteCreate, mockBatch.writes[0].Kind)
+	assert.Equal(t, ports.BatchWriteEdit, mockBatch.writes[1].Kind)
+	assert.Equal(t, ports.BatchWritePrice, mockBatch.writes[2].Kind)
+	require.Len(t, mockBatch.writes[2].PriceChanges, 1)
+	assert.Equal(t, "spring sale", mockBatch.writes[2].PriceChanges[0].Reason)
+}
+
+func TestBatchUseCases_RunBatch_AtomicRollsBack(t *testing.T) {
+	// Given
+	useCases, mockBatch := setupTestBatchUseCases(10)
+	ctx := context.Background()
+
+	request := &dto.BatchRequestDTO{Atomic: true, Operations: []*dto.BatchOperationDTO{
+		{Action: entities.BatchStock, ID: 1, Stock: intPtr(7)},
+		{Action: entities.BatchUpdate, ID: 3, Changes: &dto.UpdateProductRequestDTO{Name: "Gone"}},
+		{Action: entities.BatchStatus, ID: 2, Status: entities.ProductStatusActive},
+	}}
+	mockBatch.On("Apply", ctx, []ports.BatchTarget{{ID: 1}, {ID: 3}, {ID: 2}}, true).Return(nil)
+
+	// When
+	response, err := useCases.RunBatch(ctx, request)
+
+	// Then
+	require.NoError(t, err)
+	assert.True(t, response.RolledBack)
+	assert.Equal(t, 0, response.Succeeded)
+	assert.Equal(t, 3, response.Failed)
+	assert.Equal(t, domainErrors.ErrBatchRolledBack, response.Results[0].Err)
+	assert.Nil(t, response.Results[0].Product)
+	assert.Equal(t, domainErrors.ErrProductNotFound, response.Results[1].Err)
+	assert.Equal(t, domainErrors.ErrBatchRolledBack, response.Results[2].Err)
+}
+
+func TestBatchUseCases_RunBatch_AtomicInvalidOperationRunsNothing(t *testing.T) {
+	// Given
+	useCases, mockBatch := setupTestBatchUseCases(10)
+
+	request := &dto.BatchRequestDTO{Atomic: true, Operations: []*dto.BatchOperationDTO{
+		{Action: entities.BatchStock, ID: 1, Stock: intPtr(7)},
+		{Action: entities.BatchCreate, SKU: "IPAD-AIR-11"},
+	}}
+
+	// When
+	response, err := useCases.RunBatch(context.Background(), request)
+
+	// Then
+	require.NoError(t, err)
+	assert.True(t, response.RolledBack)
+	assert.Equal(t, domainErrors.ErrBatchRolledBack, response.Results[0].Err)
+	var domainErr *domainErrors.DomainError
+	require.ErrorAs(t, response.Results[1].Err, &domainErr)
+	assert.Equal(t, domainErrors.ErrInvalidBatchOperation.Code, domainErr.Code)
+	mockBatch.AssertNotCalled(t, "Apply", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestBatchUseCases_RunBatch_Errors(t *testing.T) {
+	stock := &dto.BatchOperationDTO{Action: entities.BatchStock, ID: 1, Stock: intPtr(1)}
+
+	t.Run("empty batch", func(t *testing.T) {
+		useCases, _ := setupTestBatchUseCases(2)
+
+		_, err := useCases.RunBatch(context.Background(), &dto.BatchRequestDTO{})
+
+		var domainErr *domainErrors.DomainError
+		require.ErrorAs(t, err, &domainErr)
+		assert.Equal(t, domainErrors.ErrInvalidBatch.Code, domainErr.Code)
+	})
+
+	t.Run("too many operations", func(t *testing.T) {
+		useCases, _ := setupTestBatchUseCases(2)
+
+		_, err := useCases.RunBatch(context.Background(), &dto.BatchRequestDTO{Operations: []*dto.BatchOperationDTO{stock, stock, stock}})
+
+		var domainErr *domainErrors.DomainError
+		require.ErrorAs(t, err, &domainErr)
+		assert.Equal(t, domainErrors.ErrBatchTooLarge.Code, domainErr.Code)
+		assert.Equal(t, "a batch can have at most 2 operations", domainErr.Message)
+	})
+
+	t.Run("repository failure", func(t *testing.T) {
+		useCases, mockBatch := setupTestBatchUseCases(2)
+		ctx := context.Background()
+		mockBatch.On("Apply", ctx, mock.Anything, true).Return(errors.New("connection reset"))
+
+		_, err := useCases.RunBatch(ctx, &dto.BatchRequestDTO{Atomic: true, Operations: []*dto.BatchOperationDTO{stock}})
+
+		assert.Equal(t, domainErrors.ErrFailedToRunBatch, err)
+	})
+}
@@ -0,0 +1,191 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	productErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+)
+
+// BrandUseCases defines the interface for the brand registry
+type BrandUseCases interface {
+	ListBrands(ctx context.Context, status string) ([]*dto.BrandResponseDTO, error)
+	GetBrand(ctx context.Context, id uint) (*dto.BrandResponseDTO, error)
+	CreateBrand(ctx context.Context, request *dto.CreateBrandRequestDTO) (*dto.BrandResponseDTO, error)
+	UpdateBrand(ctx context.Context, id uint, request *dto.UpdateBrandRequestDTO) (*dto.BrandResponseDTO, error)
+	MergeBrand(ctx context.Context, id uint, request *dto.MergeBrandRequestDTO) (*dto.BrandResponseDTO, error)
+}
+
+// brandUseCasesImpl implements BrandUseCases interface
+type brandUseCasesImpl struct {
+	brandRepo ports.BrandRepository
+	logger    logger.Logger
+}
+
+// NewBrandUseCases creates a new instance of brand use cases
+func NewBrandUseCases(brandRepo ports.BrandRepository, log logger.Logger) BrandUseCases {
+	return &brandUseCasesImpl{
+		brandRepo: brandRepo,
+		logger:    log.With("component", "brand_usecases"),
+	}
+}
+
+// ListBrands returns the registered brands, optionally only those with status
+func (uc *brandUseCasesImpl) ListBrands(ctx context.Context, status string) ([]*dto.BrandResponseDTO, error) {
+	brands, err := uc.brandRepo.List(ctx, entities.BrandStatus(status))
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("Failed to list brands", "error", err)
+		return nil, err
+	}
+
+	response := make([]*dto.BrandResponseDTO, 0, len(brands))
+	for _, brand := range brands {
+		response = append(response, dto.BrandToResponseDTO(brand))
+	}
+	return response, nil
+}
+
+// GetBrand returns a brand with its aliases
+func (uc *brandUseCasesImpl) GetBrand(ctx context.Context, id uint) (*dto.BrandResponseDTO, error) {
+	brand, err := uc.brandRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.BrandToResponseDTO(brand), nil
+}
+
+// CreateBrand registers a brand whose name and aliases no other brand uses
+func (uc *brandUseCasesImpl) CreateBrand(ctx context.Context, request *dto.CreateBrandRequestDTO) (*dto.BrandResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("CreateBrand use case called", "name", request.Name)
+
+	brand, err := entities.NewBrand(request.Name, request.Aliases, request.LogoURL)
+	if err != nil {
+		return nil, invalidBrand(err)
+	}
+	if err := uc.ensureNamesFree(ctx, brand); err != nil {
+		return nil, err
+	}
+
+	created, err := uc.brandRepo.Create(ctx, brand)
+	if err != nil {
+		log.Error("Failed to create brand", "error", err, "name", brand.Name)
+		return nil, err
+	}
+
+	log.Info("CreateBrand success", "brand_id", created.ID, "name", created.Name)
+	return dto.BrandToResponseDTO(created), nil
+}
+
+// UpdateBrand changes a brand's name, aliases, logo or status. Products of a
+// renamed brand carry the new name.
+func (uc *brandUseCasesImpl) UpdateBrand(ctx context.Context, id uint, request *dto.UpdateBrandRequestDTO) (*dto.BrandResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("UpdateBrand use case called", "brand_id", id)
+
+	brand, err := uc.brandRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Name != nil {
+		if err := brand.Rename(*request.Name); err != nil {
+			return nil, invalidBrand(err)
+		}
+	}
+	if request.Aliases != nil {
+		if err := brand.SetAliases(*request.Aliases); err != nil {
+			return nil, invalidBrand(err)
+		}
+	}
+	if request.LogoURL != nil {
+		if err := brand.SetLogoURL(*request.LogoURL); err != nil {
+			return nil, invalidBrand(err)
+		}
+	}
+	if request.Status != nil {
+		if err := brand.SetStatus(entities.BrandStatus(*request.Status)); err != nil {
+			return nil, invalidBrand(err)
+		}
+	}
+	if err := uc.ensureNamesFree(ctx, brand); err != nil {
+		return nil, err
+	}
+
+	updated, err := uc.brandRepo.Update(ctx, brand)
+	if err != nil {
+		log.Error("Failed to update brand", "error", err, "brand_id", id)
+		return nil, err
+	}
+
+	log.Info("UpdateBrand success", "brand_id", id, "name", updated.Name)
+	return dto.BrandToResponseDTO(updated), nil
+}
+
+// MergeBrand folds a duplicate brand into a target: its products and the
+// promotions targeting it move to the target, its names become aliases of the
+// target and it is deleted
+func (uc *brandUseCasesImpl) MergeBrand(ctx context.Context, id uint, request *dto.MergeBrandRequestDTO) (*dto.BrandResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("MergeBrand use case called", "brand_id", id, "target_id", request.TargetID)
+
+	if request.TargetID == id {
+		return nil, invalidBrand(errors.New("a brand cannot be merged into itself"))
+	}
+
+	source, err := uc.brandRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	target, err := uc.brandRepo.GetByID(ctx, request.TargetID)
+	if err != nil {
+		return nil, err
+	}
+
+	target.Absorb(source)
+
+	merged, err := uc.brandRepo.Merge(ctx, source, target)
+	if err != nil {
+		log.Error("Failed to merge brand", "error", err, "brand_id", id, "target_id", target.ID)
+		return nil, err
+	}
+
+	log.Info("MergeBrand success", "from", source.Name, "into", merged.Name)
+	return dto.BrandToResponseDTO(merged), nil
+}
+
+// ensureNamesFree fails when another brand is already known by the name or
+// one of the aliases of brand
+func (uc *brandUseCasesImpl) ensureNamesFree(ctx context.Context, brand *entities.Brand) error {
+	for _, name := range brand.Names() {
+		existing, err := uc.brandRepo.FindByName(ctx, name)
+		if errors.Is(err, productErrors.ErrBrandNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if existing.ID != brand.ID {
+			return &productErrors.DomainError{
+				Code:    productErrors.ErrBrandAlreadyExists.Code,
+				Message: fmt.Sprintf("%q already names brand %s", name, existing.Name),
+			}
+		}
+	}
+	return nil
+}
+
+func invalidBrand(err error) error {
+	return &productErrors.DomainError{
+		Code:    productErrors.ErrInvalidBrand.Code,
+		Message: err.Error(),
+	}
+}
@@ -0,0 +1,189 @@
+package usecases
+
+import (
+	"context"
+	"product-service/internal/application/dto"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockBrandRepository implements the BrandRepository interface for testing
+type MockBrandRepository struct {
+	mock.Mock
+}
+
+func (m *MockBrandRepository) Create(ctx context.Context, brand *entities.Brand) (*entities.Brand, error) {
+	args := m.Called(ctx, brand)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Brand), args.Error(1)
+}
+
+func (m *MockBrandRepository) GetByID(ctx context.Context, id uint) (*entities.Brand, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Brand), args.Error(1)
+}
+
+func (m *MockBrandRepository) FindByName(ctx context.Context, name string) (*entities.Brand, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Brand), args.Error(1)
+}
+
+func (m *MockBrandRepository) List(ctx context.Context, status entities.BrandStatus) ([]*entities.Brand, error) {
+	args := m.Called(ctx, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Brand), args.Error(1)
+}
+
+func (m *MockBrandRepository) Update(ctx context.Context, brand *entities.Brand) (*entities.Brand, error) {
+	args := m.Called(ctx, brand)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Brand), args.Error(1)
+}
+
+func (m *MockBrandRepository) Merge(ctx context.Context, source, target *entities.Brand) (*entities.Brand, error) {
+	args := m.Called(ctx, source, target)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Brand), args.Error(1)
+}
+
+func setupTestBrandUseCases() (BrandUseCases, *MockBrandRepository) {
+	mockRepo := new(MockBrandRepository)
+	useCases := NewBrandUseCases(mockRepo, logger.New("test"))
+	return useCases, mockRepo
+}
+
+// testBrand builds a stored active brand
+func testBrand(id uint, name string, aliases ...string) *entities.Brand {
+	brand, err := entities.NewBrand(name, aliases, "")
+	if err != nil {
+		panic(err)
+	}
+	brand.ID = id
+	return brand
+}
+
+func TestBrandUseCases_CreateBrand_Success(t *testing.T) {
+	// Given
+	useCases, mockRepo := setupTestBrandUseCases()
+	ctx := context.Background()
+
+	mockRepo.On("FindByName", ctx, mock.Anything).Return(nil, domainErrors.ErrBrandNotFound)
+	mockRepo.On("Create", ctx, mock.MatchedBy(func(brand *entities.Brand) bool {
+		return brand.Name == "Hewlett-Packard" && brand.Slug == "hewlett-packard" &&
+			assert.ObjectsAreEqual([]string{"HP"}, brand.Aliases)
+	})).Return(testBrand(4, "Hewlett-Packard", "HP"), nil)
+
+	// When
+	result, err := useCases.CreateBrand(ctx, &dto.CreateBrandRequestDTO{
+		Name:    " Hewlett-Packard ",
+		Aliases: []string{"HP", "hewlett-packard", "hp"},
+	})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, uint(4), result.ID)
+	assert.Equal(t, []string{"HP"}, result.Aliases)
+	assert.Equal(t, "active", result.Status)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestBrandUseCases_CreateBrand_AliasOfAnotherBrand(t *testing.T) {
+	// Given
+	useCases, mockRepo := setupTestBrandUseCases()
+	ctx := context.Background()
+
+	mockRepo.On("FindByName", ctx, "HP Inc").Return(nil, domainErrors.ErrBrandNotFound)
+	mockRepo.On("FindByName", ctx, "HP").Return(testBrand(4, "Hewlett-Packard", "HP"), nil)
+
+	// When
+	result, err := useCases.CreateBrand(ctx, &dto.CreateBrandRequestDTO{Name: "HP Inc", Aliases: []string{"HP"}})
+
+	// Then
+	assert.Nil(t, result)
+	var domainErr *domainErrors.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, domainErrors.ErrBrandAlreadyExists.Code, domainErr.Code)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestBrandUseCases_UpdateBrand_Deactivate(t *testing.T) {
+	// Given
+	useCases, mockRepo := setupTestBrandUseCases()
+	ctx := context.Background()
+
+	brand := testBrand(4, "Hewlett-Packard", "HP")
+	status := "inactive"
+
+	mockRepo.On("GetByID", ctx, uint(4)).Return(brand, nil)
+	mockRepo.On("FindByName", ctx, mock.Anything).Return(brand, nil)
+	mockRepo.On("Update", ctx, mock.MatchedBy(func(brand *entities.Brand) bool {
+		return brand.Status == entities.BrandStatusInactive
+	})).Return(brand, nil)
+
+	// When
+	result, err := useCases.UpdateBrand(ctx, 4, &dto.UpdateBrandRequestDTO{Status: &status})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "inactive", result.Status)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestBrandUseCases_MergeBrand_Success(t *testing.T) {
+	// Given
+	useCases, mockRepo := setupTestBrandUseCases()
+	ctx := context.Background()
+
+	source := testBrand(5, "HP Inc.", "HPI")
+	target := testBrand(4, "Hewlett-Packard", "HP")
+
+	mockRepo.On("GetByID", ctx, uint(5)).Return(source, nil)
+	mockRepo.On("GetByID", ctx, uint(4)).Return(target, nil)
+	mockRepo.On("Merge", ctx, source, mock.MatchedBy(func(brand *entities.Brand) bool {
+		return brand.ID == 4 && assert.ObjectsAreEqual([]string{"HP", "HP Inc.", "HPI"}, brand.Aliases)
+	})).Return(testBrand(4, "Hewlett-Packard", "HP", "HP Inc.", "HPI"), nil)
+
+	// When
+	result, err := useCases.MergeBrand(ctx, 5, &dto.MergeBrandRequestDTO{TargetID: 4})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "Hewlett-Packard", result.Name)
+	assert.Equal(t, []string{"HP", "HP Inc.", "HPI"}, result.Aliases)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestBrandUseCases_MergeBrand_IntoItself(t *testing.T) {
+	// Given
+	useCases, mockRepo := setupTestBrandUseCases()
+
+	// When
+	result, err := useCases.MergeBrand(context.Background(), 4, &dto.MergeBrandRequestDTO{TargetID: 4})
+
+	// Then
+	assert.Nil(t, result)
+	var domainErr *domainErrors.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, domainErrors.ErrInvalidBrand.Code, domainErr.Code)
+	mockRepo.AssertNotCalled(t, "Merge", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,345 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	productErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+	"strings"
+)
+
+// CategoryUseCases defines the interface for the category tree
+type CategoryUseCases interface {
+	ListCategories(ctx context.Context) ([]*dto.CategoryResponseDTO, error)
+	GetCategory(ctx context.Context, id uint) (*dto.CategoryResponseDTO, error)
+	CreateCategory(ctx context.Context, request *dto.CreateCategoryRequestDTO) (*dto.CategoryResponseDTO, error)
+	RenameCategory(ctx context.Context, id uint, request *dto.RenameCategoryRequestDTO) (*dto.CategoryResponseDTO, error)
+	MoveCategory(ctx context.Context, id uint, request *dto.MoveCategoryRequestDTO) (*dto.CategoryResponseDTO, error)
+	MergeCategory(ctx context.Context, id uint, request *dto.MergeCategoryRequestDTO) (*dto.CategoryResponseDTO, error)
+	SetSKURules(ctx context.Context, id uint, request *dto.SKURulesRequestDTO) (*dto.CategoryResponseDTO, error)
+	DeleteCategory(ctx context.Context, id uint) error
+}
+
+// categoryUseCasesImpl implements CategoryUseCases interface
+type categoryUseCasesImpl struct {
+	categoryRepo ports.CategoryRepository
+	logger       logger.Logger
+}
+
+// NewCategoryUseCases creates a new instance of category use cases
+func NewCategoryUseCases(categoryRepo ports.CategoryRepository, log logger.Logger) CategoryUseCases {
+	return &categoryUseCasesImpl{
+		categoryRepo: categoryRepo,
+		logger:       log.With("component", "category_usecases"),
+	}
+}
+
+// ListCategories returns the whole category tree
+func (uc *categoryUseCasesImpl) ListCategories(ctx context.Context) ([]*dto.CategoryResponseDTO, error) {
+	categories, err := uc.categoryRepo.List(ctx)
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("Failed to list categories", "error", err)
+		return nil, err
+	}
+
+	return dto.CategoryTree(categories), nil
+}
+
+// GetCategory returns a category with its subtree
+func (uc *categoryUseCasesImpl) GetCategory(ctx context.Context, id uint) (*dto.CategoryResponseDTO, error) {
+	category, err := uc.categoryRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	subtree, err := uc.categoryRepo.ListSubtree(ctx, category.Path)
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("Failed to list category subtree", "error", err, "category_id", id)
+		return nil, err
+	}
+
+	tree := dto.CategoryTree(subtree)
+	if len(tree) == 0 {
+		return dto.CategoryToResponseDTO(category), nil
+	}
+	return tree[0], nil
+}
+
+// CreateCategory adds a category under an existing parent, or as a root
+func (uc *categoryUseCasesImpl) CreateCategory(ctx context.Context, request *dto.CreateCategoryRequestDTO) (*dto.CategoryResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("CreateCategory use case called", "name", request.Name, "parent_id", request.ParentID)
+
+	parent, err := uc.parent(ctx, request.ParentID)
+	if err != nil {
+		return nil, err
+	}
+
+	category, err := entities.NewCategory(request.Name, parent)
+	if err != nil {
+		return nil, invalidCategoryChange(err)
+	}
+
+	created, err := uc.categoryRepo.Create(ctx, category)
+	if err != nil {
+		log.Error("Failed to create category", "error", err, "path", category.Path)
+		return nil, err
+	}
+
+	log.Info("CreateCategory success", "category_id", created.ID, "path", created.Path)
+	return dto.CategoryToResponseDTO(created), nil
+}
+
+// RenameCategory renames a category; its slug and the paths of its subtree
+// follow the new name
+func (uc *categoryUseCasesImpl) RenameCategory(ctx context.Context, id uint, request *dto.RenameCategoryRequestDTO) (*dto.CategoryResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("RenameCategory use case called", "category_id", id, "name", request.Name)
+
+	category, err := uc.categoryRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	oldPath := category.Path
+	if err := category.Rename(request.Name); err != nil {
+		return nil, invalidCategoryChange(err)
+	}
+	if err := uc.ensurePathFree(ctx, category.Path, id); err != nil {
+		return nil, err
+	}
+
+	renamed, err := uc.categoryRepo.Relocate(ctx, category, oldPath)
+	if err != nil {
+		log.Error("Failed to rename category", "error", err, "category_id", id)
+		return nil, err
+	}
+
+	log.Info("RenameCategory success", "category_id", id, "path", renamed.Path)
+	return dto.CategoryToResponseDTO(renamed), nil
+}
+
+// MoveCategory moves a category, with its subtree, under another parent
+func (uc *categoryUseCasesImpl) MoveCategory(ctx context.Context, id uint, request *dto.MoveCategoryRequestDTO) (*dto.CategoryResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("MoveCategory use case called", "category_id", id, "parent_id", request.ParentID)
+
+	category, err := uc.categoryRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	parent, err := uc.parent(ctx, request.ParentID)
+	if err != nil {
+		return nil, err
+	}
+	subtree, err := uc.categoryRepo.ListSubtree(ctx, category.Path)
+	if err != nil {
+		log.Error("Failed to list category subtree", "error", err, "category_id", id)
+		return nil, err
+	}
+
+	oldPath := category.Path
+	if err := category.MoveTo(parent, entities.SubtreeHeight(category, subtree)); err != nil {
+		return nil, invalidCategoryChange(err)
+	}
+	if category.Path == oldPath {
+		return dto.CategoryToResponseDTO(category), nil
+	}
+	if err := uc.ensurePathFree(ctx, category.Path, id); err != nil {
+		return nil, err
+	}
+
+	moved, err := uc.categoryRepo.Relocate(ctx, category, oldPath)
+	if err != nil {
+		log.Error("Failed to move category", "error", err, "category_id", id)
+		return nil, err
+	}
+
+	log.Info("MoveCategory success", "category_id", id, "from", oldPath, "to", moved.Path)
+	return dto.CategoryToResponseDTO(moved), nil
+}
+
+// MergeCategory folds a category into a target: its products, attribute
+// definitions, subcategories and promotion targets move to the target and it
+// is deleted
+func (uc *categoryUseCasesImpl) MergeCategory(ctx context.Context, id uint, request *dto.MergeCategoryRequestDTO) (*dto.CategoryResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("MergeCategory use case called", "category_id", id, "target_id", request.TargetID)
+
+	if request.TargetID == id {
+		return nil, invalidCategoryChange(errors.New("a category cannot be merged into itself"))
+	}
+
+	source, err := uc.categoryRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	target, err := uc.categoryRepo.GetByID(ctx, request.TargetID)
+	if err != nil {
+		return nil, err
+	}
+	if target.IsDescendantOf(source) {
+		return nil, invalidCategoryChange(errors.New("a category cannot be merged into one of its descendants"))
+	}
+
+	sourceTree, err := uc.categoryRepo.ListSubtree(ctx, source.Path)
+	if err != nil {
+		log.Error("Failed to list category subtree", "error", err, "category_id", id)
+		return nil, err
+	}
+	if err := target.CheckRoomBelow(entities.SubtreeHeight(source, sourceTree)); err != nil {
+		return nil, invalidCategoryChange(err)
+	}
+
+	// subcategories keep their slugs, so none may clash with a child of target
+	for _, child := range sourceTree {
+		if child.ParentID == nil || *child.ParentID != source.ID {
+			continue
+		}
+		if err := uc.ensurePathFree(ctx, target.ChildPath(child.Slug), child.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.categoryRepo.Merge(ctx, source, target); err != nil {
+		log.Error("Failed to merge category", "error", err, "category_id", id, "target_id", target.ID)
+		return nil, err
+	}
+
+	log.Info("MergeCategory success", "from", source.Path, "into", target.Path)
+	return uc.GetCategory(ctx, target.ID)
+}
+
+// SetSKURules replaces the SKU pattern and template of a category. They apply
+// to products created from then on; existing SKUs are left as they are.
+func (uc *categoryUseCasesImpl) SetSKURules(ctx context.Context, id uint, request *dto.SKURulesRequestDTO) (*dto.CategoryResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("SetSKURules use case called", "category_id", id, "pattern", request.SKUPattern, "template", request.SKUTemplate)
+
+	category, err := uc.categoryRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Checked one by one first so that errors name the offending field
+	if pattern := strings.TrimSpace(request.SKUPattern); pattern != "" {
+		if _, err := entities.CompileSKUPattern(pattern); err != nil {
+			return nil, invalidSKURule("sku_pattern", err)
+		}
+	}
+	if template := strings.TrimSpace(request.SKUTemplate); template != "" {
+		if _, err := entities.ParseSKUTemplate(template); err != nil {
+			return nil, invalidSKURule("sku_template", err)
+		}
+	}
+	if err := category.SetSKURules(request.SKUPattern, request.SKUTemplate); err != nil {
+		return nil, invalidCategoryChange(err)
+	}
+
+	saved, err := uc.categoryRepo.SaveSKURules(ctx, category)
+	if err != nil {
+		log.Error("Failed to save SKU rules", "error", err, "category_id", id)
+		return nil, err
+	}
+
+	log.Info("SetSKURules success", "category_id", id, "path", saved.Path)
+	return dto.CategoryToResponseDTO(saved), nil
+}
+
+// DeleteCategory removes a category that has no products or subcategories and
+// that no promotion targets
+func (uc *categoryUseCasesImpl) DeleteCategory(ctx context.Context, id uint) error {
+	uc.logger.Ctx(ctx).Info("DeleteCategory use case called", "category_id", id)
+
+	return uc.categoryRepo.Delete(ctx, id)
+}
+
+// parent loads the category identified by parentID, or nil for roots
+func (uc *categoryUseCasesImpl) parent(ctx context.Context, parentID *uint) (*entities.Category, error) {
+	if parentID == nil {
+		return nil, nil
+	}
+
+	parent, err := uc.categoryRepo.GetByID(ctx, *parentID)
+	if errors.Is(err, productErrors.ErrCategoryNotFound) {
+		return nil, &productErrors.DomainError{
+			Code:    productErrors.ErrInvalidCategoryChange.Code,
+			Message: fmt.Sprintf("parent category %d does not exist", *parentID),
+			Field:   "parent_id",
+		}
+	}
+	return parent, err
+}
+
+// ensurePathFree fails when a category other than id already has path
+func (uc *categoryUseCasesImpl) ensurePathFree(ctx context.Context, path string, id uint) error {
+	existing, err := uc.categoryRepo.GetByPath(ctx, path)
+	if errors.Is(err, productErrors.ErrCategoryNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if existing.ID != id {
+		return &productErrors.DomainError{
+			Code:    productErrors.ErrCategoryAlreadyExists.Code,
+			Message: fmt.Sprintf("category %s already exists", path),
+		}
+	}
+	return nil
+}
+
+func invalidCategoryChange(err error) error {
+	return &productErrors.DomainError{
+		Code:    productErrors.ErrInvalidCategoryChange.Code,
+		Message: err.Error(),
+	}
+}
+
+func invalidSKURule(field string, err error) error {
+	return &productErrors.DomainError{
+		Code:    productErrors.ErrInvalidCategoryChange.Code,
+		Message: err.Error(),
+		Field:   field,
+	}
+}
+
+// resolveCategory finds the category a reference names: a path such as
+// "Electronics/Phones", or a bare name when exactly one category has it
+func resolveCategory(ctx context.Context, categoryRepo ports.CategoryRepository, reference string) (*entities.Category, error) {
+	path := entities.CategoryPath(reference)
+	if path == "" {
+		return nil, productErrors.ErrCategoryNotFound
+	}
+
+	category, err := categoryRepo.GetByPath(ctx, path)
+	if !errors.Is(err, productErrors.ErrCategoryNotFound) || strings.Contains(path, "/") {
+		return category, err
+	}
+
+	candidates, err := categoryRepo.FindBySlug(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	switch len(candidates) {
+	case 0:
+		return nil, productErrors.ErrCategoryNotFound
+	case 1:
+		return candidates[0], nil
+	default:
+		return nil, &productErrors.DomainError{
+			Code:    productErrors.ErrInvalidProductCategory.Code,
+			Message: fmt.Sprintf("category %q is ambiguous, use its path, e.g. %s", reference, candidates[0].Path),
+			Field:   "category",
+		}
+	}
+}
@@ -0,0 +1,332 @@
+package usecases
+
+import (
+	"context"
+	"product-service/internal/application/dto"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockCategoryRepository implements the CategoryRepository interface for testing
+type MockCategoryRepository struct {
+	mock.Mock
+}
+
+func (m *MockCategoryRepository) Create(ctx context.Context, category *entities.Category) (*entities.Category, error) {
+	args := m.Called(ctx, category)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) GetByID(ctx context.Context, id uint) (*entities.Category, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) GetByPath(ctx context.Context, path string) (*entities.Category, error) {
+	args := m.Called(ctx, path)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) FindBySlug(ctx context.Context, slug string) ([]*entities.Category, error) {
+	args := m.Called(ctx, slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) List(ctx context.Context) ([]*entities.Category, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) ListSubtree(ctx context.Context, path string) ([]*entities.Category, error) {
+	args := m.Called(ctx, path)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) Relocate(ctx context.Context, category *entities.Category, oldPath string) (*entities.Category, error) {
+	args := m.Called(ctx, category, oldPath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) Merge(ctx context.Context, source, target *entities.Category) error {
+	args := m.Called(ctx, source, target)
+	return args.Error(0)
+}
+
+func (m *MockCategoryRepository) SaveSKURules(ctx context.Context, category *entities.Category) (*entities.Category, error) {
+	args := m.Called(ctx, category)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) NextSKUSequence(ctx context.Context, id uint) (int64, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockCategoryRepository) Delete(ctx context.Context, id uint) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func setupTestCategoryUseCases() (CategoryUseCases, *MockCategoryRepository) {
+	mockRepo := new(MockCategoryRepository)
+	useCases := NewCategoryUseCases(mockRepo, logger.New("test"))
+	return useCases, mockRepo
+}
+
+// testCategory builds a stored category named name under parent
+func testCategory(id uint, name string, parent *entities.Category) *entities.Category {
+	category, err := entities.NewCategory(name, parent)
+	if err != nil {
+		panic(err)
+	}
+	category.ID = id
+	return category
+}
+
+func TestCategoryUseCases_CreateCategory_UnderParent(t *testing.T) {
+	// Given
+	useCases, mockRepo := setupTestCategoryUseCases()
+	ctx := context.Background()
+
+	electronics := testCategory(1, "Electronics", nil)
+	parentID := uint(1)
+
+	mockRepo.On("GetByID", ctx, uint(1)).Return(electronics, nil)
+	mockRepo.On("Create", ctx, mock.MatchedBy(func(category *entities.Category) bool {
+		return category.Path == "electronics/mobile-phones" && category.Depth == 1 && *category.ParentID == 1
+	})).Return(testCategory(2, "Mobile Phones", electronics), nil)
+
+	// When
+	result, err := useCases.CreateCategory(ctx, &dto.CreateCategoryRequestDTO{Name: "Mobile Phones", ParentID: &parentID})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, uint(2), result.ID)
+	assert.Equal(t, "mobile-phones", result.Slug)
+	assert.Equal(t, "electronics/mobile-phones", result.Path)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCategoryUseCases_MoveCategory_UnderOwnDescendant(t *testing.T) {
+	// Given
+	useCases, mockRepo := setupTestCategoryUseCases()
+	ctx := context.Background()
+
+	electronics := testCategory(1, "Electronics", nil)
+	phones := testCategory(2, "Phones", electronics)
+	parentID := uint(2)
+
+	mockRepo.On("GetByID", ctx, uint(1)).Return(electronics, nil)
+	mockRepo.On("GetByID", ctx, uint(2)).Return(phones, nil)
+	mockRepo.On("ListSubtree", ctx, "electronics").Return([]*entities.Category{electronics, phones}, nil)
+
+	// When
+	result, err := useCases.MoveCategory(ctx, 1, &dto.MoveCategoryRequestDTO{ParentID: &parentID})
+
+	// Then
+	assert.Nil(t, result)
+	var domainErr *domainErrors.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, domainErrors.ErrInvalidCategoryChange.Code, domainErr.Code)
+	mockRepo.AssertNotCalled(t, "Relocate", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCategoryUseCases_MoveCategory_RewritesPath(t *testing.T) {
+	// Given
+	useCases, mockRepo := setupTestCategoryUseCases()
+	ctx := context.Background()
+
+	electronics := testCategory(1, "Electronics", nil)
+	phones := testCategory(5, "Phones", nil)
+
+	mockRepo.On("GetByID", ctx, uint(5)).Return(phones, nil)
+	mockRepo.On("GetByID", ctx, uint(1)).Return(electronics, nil)
+	mockRepo.On("ListSubtree", ctx, "phones").Return([]*entities.Category{phones}, nil)
+	mockRepo.On("GetByPath", ctx, "electronics/phones").Return(nil, domainErrors.ErrCategoryNotFound)
+	mockRepo.On("Relocate", ctx, mock.MatchedBy(func(category *entities.Category) bool {
+		return category.Path == "electronics/phones" && category.Depth == 1
+	}), "phones").Return(testCategory(5, "Phones", electronics), nil)
+
+	parentID := uint(1)
+
+	// When
+	result, err := useCases.MoveCategory(ctx, 5, &dto.MoveCategoryRequestDTO{ParentID: &parentID})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "electronics/phones", result.Path)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCategoryUseCases_MergeCategory_SubcategoryClash(t *testing.T) {
+	// Given
+	useCases, mockRepo := setupTestCategoryUseCases()
+	ctx := context.Background()
+
+	phones := testCategory(1, "Phones", nil)
+	phoneCases := testCategory(2, "Cases", phones)
+	mobile := testCategory(3, "Mobile Phones", nil)
+
+	mockRepo.On("GetByID", ctx, uint(1)).Return(phones, nil)
+	mockRepo.On("GetByID", ctx, uint(3)).Return(mobile, nil)
+	mockRepo.On("ListSubtree", ctx, "phones").Return([]*entities.Category{phones, phoneCases}, nil)
+	mockRepo.On("GetByPath", ctx, "mobile-phones/cases").Return(testCategory(4, "Cases", mobile), nil)
+
+	// When
+	result, err := useCases.MergeCategory(ctx, 1, &dto.MergeCategoryRequestDTO{TargetID: 3})
+
+	// Then
+	assert.Nil(t, result)
+	var domainErr *domainErrors.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, domainErrors.ErrCategoryAlreadyExists.Code, domainErr.Code)
+	mockRepo.AssertNotCalled(t, "Merge", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCategoryUseCases_MergeCategory_Success(t *testing.T) {
+	// Given
+	useCases, mockRepo := setupTestCategoryUseCases()
+	ctx := context.Background()
+
+	phones := testCategory(1, "phones", nil)
+	mobile := testCategory(3, "Mobile Phones", nil)
+
+	mockRepo.On("GetByID", ctx, uint(1)).Return(phones, nil)
+	mockRepo.On("GetByID", ctx, uint(3)).Return(mobile, nil)
+	mockRepo.On("ListSubtree", ctx, "phones").Return([]*entities.Category{phones}, nil)
+	mockRepo.On("Merge", ctx, phones, mobile).Return(nil)
+	mockRepo.On("ListSubtree", ctx, "mobile-phones").Return([]*entities.Category{mobile}, nil)
+
+	// When
+	result, err := useCases.MergeCategory(ctx, 1, &dto.MergeCategoryRequestDTO{TargetID: 3})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, uint(3), result.ID)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCategoryUseCases_SetSKURules(t *testing.T) {
+	// Given
+	useCases, mockRepo := setupTestCategoryUseCases()
+	ctx := context.Background()
+
+	mockRepo.On("GetByID", ctx, uint(4)).Return(testCategory(4, "Phones", nil), nil)
+	mockRepo.On("SaveSKURules", ctx, mock.MatchedBy(func(category *entities.Category) bool {
+		return category.SKUPattern == `PHN-\d{4}` && category.SKUTemplate == "PHN-{SEQ}"
+	})).Return(func() *entities.Category {
+		saved := testCategory(4, "Phones", nil)
+		saved.SKUPattern, saved.SKUTemplate = `PHN-\d{4}`, "PHN-{SEQ}"
+		return saved
+	}(), nil)
+
+	// When
+	result, err := useCases.SetSKURules(ctx, 4, &dto.SKURulesRequestDTO{SKUPattern: ` PHN-\d{4} `, SKUTemplate: "PHN-{SEQ}"})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, `PHN-\d{4}`, result.SKUPattern)
+	assert.Equal(t, "PHN-{SEQ}", result.SKUTemplate)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCategoryUseCases_SetSKURules_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		request dto.SKURulesRequestDTO
+		field   string
+	}{
+		{name: "pattern", request: dto.SKURulesRequestDTO{SKUPattern: "PHN-("}, field: "sku_pattern"},
+		{name: "template", request: dto.SKURulesRequestDTO{SKUTemplate: "{BRAND}-{COLOR}"}, field: "sku_template"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			useCases, mockRepo := setupTestCategoryUseCases()
+			ctx := context.Background()
+
+			mockRepo.On("GetByID", ctx, uint(4)).Return(testCategory(4, "Phones", nil), nil)
+
+			// When
+			result, err := useCases.SetSKURules(ctx, 4, &tt.request)
+
+			// Then
+			assert.Nil(t, result)
+			var domainErr *domainErrors.DomainError
+			require.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, domainErrors.ErrInvalidCategoryChange.Code, domainErr.Code)
+			assert.Equal(t, tt.field, domainErr.Field)
+			mockRepo.AssertNotCalled(t, "SaveSKURules", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestResolveCategory(t *testing.T) {
+	ctx := context.Background()
+	electronics := testCategory(1, "Electronics", nil)
+	phones := testCategory(2, "Phones", electronics)
+
+	t.Run("by path", func(t *testing.T) {
+		mockRepo := new(MockCategoryRepository)
+		mockRepo.On("GetByPath", ctx, "electronics/phones").Return(phones, nil)
+
+		category, err := resolveCategory(ctx, mockRepo, "Electronics / Phones")
+
+		require.NoError(t, err)
+		assert.Equal(t, uint(2), category.ID)
+	})
+
+	t.Run("by unique name", func(t *testing.T) {
+		mockRepo := new(MockCategoryRepository)
+		mockRepo.On("GetByPath", ctx, "phones").Return(nil, domainErrors.ErrCategoryNotFound)
+		mockRepo.On("FindBySlug", ctx, "phones").Return([]*entities.Category{phones}, nil)
+
+		category, err := resolveCategory(ctx, mockRepo, "phones")
+
+		require.NoError(t, err)
+		assert.Equal(t, uint(2), category.ID)
+	})
+
+	t.Run("ambiguous name", func(t *testing.T) {
+		mockRepo := new(MockCategoryRepository)
+		mockRepo.On("GetByPath", ctx, "phones").Return(nil, domainErrors.ErrCategoryNotFound)
+		mockRepo.On("FindBySlug", ctx, "phones").Return([]*entities.Category{phones, testCategory(9, "Phones", testCategory(8, "Toys", nil))}, nil)
+
+		_, err := resolveCategory(ctx, mockRepo, "Phones")
+
+		var domainErr *domainErrors.DomainError
+		require.ErrorAs(t, err, &domainErr)
+		assert.Equal(t, domainErrors.ErrInvalidProductCategory.Code, domainErr.Code)
+	})
+}
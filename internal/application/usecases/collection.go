@@ -0,0 +1,329 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	productErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+	"strconv"
+)
+
+// CollectionUseCases defines the interface for curated and rule-based
+// product collections
+type CollectionUseCases interface {
+	ListCollections(ctx context.Context) ([]*dto.CollectionResponseDTO, error)
+	GetCollection(ctx context.Context, reference string) (*dto.CollectionResponseDTO, error)
+	CreateCollection(ctx context.Context, request *dto.CreateCollectionRequestDTO) (*dto.CollectionResponseDTO, error)
+	UpdateCollection(ctx context.Context, id uint, request *dto.UpdateCollectionRequestDTO) (*dto.CollectionResponseDTO, error)
+	DeleteCollection(ctx context.Context, id uint) error
+	ListCollectionProducts(ctx context.Context, reference string, page, pageSize int) (*dto.ProductListResponseDTO, error)
+}
+
+// collectionUseCasesImpl implements CollectionUseCases interface
+type collectionUseCasesImpl struct {
+	collectionRepo ports.CollectionRepository
+	productRepo    ports.ProductRepository
+	categoryRepo   ports.CategoryRepository
+	brandRepo      ports.BrandRepository
+	logger         logger.Logger
+}
+
+// NewCollectionUseCases creates a new instance of collection use cases
+func NewCollectionUseCases(collectionRepo ports.CollectionRepository, productRepo ports.ProductRepository, categoryRepo ports.CategoryRepository, brandRepo ports.BrandRepository, log logger.Logger) CollectionUseCases {
+	return &collectionUseCasesImpl{
+		collectionRepo: collectionRepo,
+		productRepo:    productRepo,
+		categoryRepo:   categoryRepo,
+		brandRepo:      brandRepo,
+		logger:         log.With("component", "collection_usecases"),
+	}
+}
+
+// ListCollections returns all collections by name
+func (uc *collectionUseCasesImpl) ListCollections(ctx context.Context) ([]*dto.CollectionResponseDTO, error) {
+	collections, err := uc.collectionRepo.List(ctx)
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("Failed to list collections", "error", err)
+		return nil, err
+	}
+
+	response := make([]*dto.CollectionResponseDTO, 0, len(collections))
+	for _, collection := range collections {
+		response = append(response, dto.CollectionToResponseDTO(collection))
+	}
+	return response, nil
+}
+
+// GetCollection returns a collection by ID or slug
+func (uc *collectionUseCasesImpl) GetCollection(ctx context.Context, reference string) (*dto.CollectionResponseDTO, error) {
+	collection, err := uc.findCollection(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.CollectionToResponseDTO(collection), nil
+}
+
+// CreateCollection creates a manual or rule collection. Rules must name an
+// existing category and brand; manual products must exist.
+func (uc *collectionUseCasesImpl) CreateCollection(ctx context.Context, request *dto.CreateCollectionRequestDTO) (*dto.CollectionResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("CreateCollection use case called", "name", request.Name, "type", request.Type)
+
+	collection, err := entities.NewCollection(request.Name, request.Description, request.Type, request.Rules)
+	if err != nil {
+		return nil, invalidCollection(err)
+	}
+	if len(request.ProductIDs) > 0 {
+		if err := uc.setProducts(ctx, collection, request.ProductIDs); err != nil {
+			return nil, err
+		}
+	}
+	if collection.Type == entities.CollectionTypeRule {
+		if _, err := uc.ruleCriteria(ctx, collection); err != nil {
+			return nil, err
+		}
+	}
+
+	created, err := uc.collectionRepo.Create(ctx, collection)
+	if err != nil {
+		log.Error("Failed to create collection", "error", err, "name", collection.Name)
+		return nil, err
+	}
+
+	log.Info("CreateCollection success", "collection_id", created.ID, "slug", created.Slug)
+	return dto.CollectionToResponseDTO(created), nil
+}
+
+// UpdateCollection renames a collection or replaces its rules or products
+func (uc *collectionUseCasesImpl) UpdateCollection(ctx context.Context, id uint, request *dto.UpdateCollectionRequestDTO) (*dto.CollectionResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("UpdateCollection use case called", "collection_id", id)
+
+	collection, err := uc.collectionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Name != nil {
+		if err := collection.Rename(*request.Name); err != nil {
+			return nil, invalidCollection(err)
+		}
+	}
+	if request.Description != nil {
+		if err := collection.SetDescription(*request.Description); err != nil {
+			return nil, invalidCollection(err)
+		}
+	}
+	if request.Rules != nil {
+		if err := collection.SetRules(*request.Rules); err != nil {
+			return nil, invalidCollection(err)
+		}
+		if _, err := uc.ruleCriteria(ctx, collection); err != nil {
+			return nil, err
+		}
+	}
+	if request.ProductIDs != nil {
+		if err := uc.setProducts(ctx, collection, *request.ProductIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := uc.collectionRepo.Update(ctx, collection)
+	if err != nil {
+		log.Error("Failed to update collection", "error", err, "collection_id", id)
+		return nil, err
+	}
+
+	log.Info("UpdateCollection success", "collection_id", id)
+	return dto.CollectionToResponseDTO(updated), nil
+}
+
+// DeleteCollection deletes a collection; its products are untouched
+func (uc *collectionUseCasesImpl) DeleteCollection(ctx context.Context, id uint) error {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("DeleteCollection use case called", "collection_id", id)
+
+	if err := uc.collectionRepo.Delete(ctx, id); err != nil {
+		log.Error("Failed to delete collection", "error", err, "collection_id", id)
+		return err
+	}
+	return nil
+}
+
+// ListCollectionProducts returns one page of a collection's products: manual
+// collections in their curated order, rule collections newest first
+func (uc *collectionUseCasesImpl) ListCollectionProducts(ctx context.Context, reference string, page, pageSize int) (*dto.ProductListResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("ListCollectionProducts use case called", "collection", reference, "page", page, "page_size", pageSize)
+
+	if page < 0 {
+		page = 0
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	collection, err := uc.findCollection(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	var products []*entities.Product
+	var total int
+	if collection.Type == entities.CollectionTypeManual {
+		products, total, err = uc.manualProducts(ctx, collection, page*pageSize, pageSize)
+	} else {
+		products, total, err = uc.ruleProducts(ctx, collection, page*pageSize, pageSize)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("ListCollectionProducts success", "collection_id", collection.ID, "count", len(products), "total", total)
+	return &dto.ProductListResponseDTO{
+		Products: dto.ProductsToResponseDTOs(products),
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}, nil
+}
+
+// manualProducts loads one page of the curated order. Products deleted
+// since they were added drop out of the page.
+func (uc *collectionUseCasesImpl) manualProducts(ctx context.Context, collection *entities.Collection, offset, limit int) ([]*entities.Product, int, error) {
+	total := len(collection.ProductIDs)
+	if offset >= total {
+		return []*entities.Product{}, total, nil
+	}
+	pageIDs := collection.ProductIDs[offset:min(offset+limit, total)]
+
+	found, _, err := uc.productRepo.Search(ctx, ports.ProductSearchCriteria{IDs: pageIDs, Limit: len(pageIDs)})
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("Failed to load collection products", "error", err, "collection_id", collection.ID)
+		return nil, 0, productErrors.ErrFailedToSearchProducts
+	}
+
+	byID := make(map[uint]*entities.Product, len(found))
+	for _, product := range found {
+		byID[product.ID] = product
+	}
+	products := make([]*entities.Product, 0, len(pageIDs))
+	for _, id := range pageIDs {
+		if product, ok := byID[id]; ok {
+			products = append(products, product)
+		}
+	}
+	return products, total, nil
+}
+
+func (uc *collectionUseCasesImpl) ruleProducts(ctx context.Context, collection *entities.Collection, offset, limit int) ([]*entities.Product, int, error) {
+	criteria, err := uc.ruleCriteria(ctx, collection)
+	if err != nil {
+		return nil, 0, err
+	}
+	criteria.Offset = offset
+	criteria.Limit = limit
+
+	products, total, err := uc.productRepo.Search(ctx, *criteria)
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("Failed to search collection products", "error", err, "collection_id", collection.ID)
+		return nil, 0, productErrors.ErrFailedToSearchProducts
+	}
+	return products, int(total), nil
+}
+
+// ruleCriteria turns a rule collection's filter into a product search,
+// resolving its category and brand references
+func (uc *collectionUseCasesImpl) ruleCriteria(ctx context.Context, collection *entities.Collection) (*ports.ProductSearchCriteria, error) {
+	filter, err := collection.Filter()
+	if err != nil {
+		return nil, invalidCollection(err)
+	}
+
+	criteria := &ports.ProductSearchCriteria{
+		Tags:     filter.Tags,
+		MinPrice: filter.MinPrice,
+		MaxPrice: filter.MaxPrice,
+		InStock:  filter.InStock,
+		Status:   filter.Status,
+	}
+
+	if filter.Category != "" {
+		category, err := resolveCategory(ctx, uc.categoryRepo, filter.Category)
+		if errors.Is(err, productErrors.ErrCategoryNotFound) {
+			return nil, invalidCollection(fmt.Errorf("category %q does not exist", filter.Category))
+		}
+		if err != nil {
+			return nil, err
+		}
+		criteria.CategoryPath = category.Path
+	}
+
+	if filter.Brand != "" {
+		brand, err := uc.brandRepo.FindByName(ctx, filter.Brand)
+		if errors.Is(err, productErrors.ErrBrandNotFound) {
+			return nil, invalidCollection(fmt.Errorf("brand %q is not registered", filter.Brand))
+		}
+		if err != nil {
+			return nil, err
+		}
+		criteria.Brand = brand.Name
+	}
+
+	return criteria, nil
+}
+
+// setProducts replaces the products of a manual collection after checking
+// they all exist
+func (uc *collectionUseCasesImpl) setProducts(ctx context.Context, collection *entities.Collection, productIDs []uint) error {
+	if err := collection.SetProducts(productIDs); err != nil {
+		return invalidCollection(err)
+	}
+	if len(collection.ProductIDs) == 0 {
+		return nil
+	}
+
+	found, _, err := uc.productRepo.Search(ctx, ports.ProductSearchCriteria{IDs: collection.ProductIDs, Limit: len(collection.ProductIDs)})
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("Failed to check collection products", "error", err)
+		return productErrors.ErrFailedToSearchProducts
+	}
+	existing := make(map[uint]bool, len(found))
+	for _, product := range found {
+		existing[product.ID] = true
+	}
+	for _, id := range collection.ProductIDs {
+		if !existing[id] {
+			return &productErrors.DomainError{
+				Code:    productErrors.ErrInvalidCollection.Code,
+				Message: fmt.Sprintf("product %d does not exist", id),
+				Field:   "product_ids",
+			}
+		}
+	}
+	return nil
+}
+
+// findCollection looks a collection up by numeric ID or by slug
+func (uc *collectionUseCasesImpl) findCollection(ctx context.Context, reference string) (*entities.Collection, error) {
+	if id, err := strconv.ParseUint(reference, 10, 32); err == nil {
+		return uc.collectionRepo.GetByID(ctx, uint(id))
+	}
+	return uc.collectionRepo.GetBySlug(ctx, entities.Slugify(reference))
+}
+
+func invalidCollection(err error) error {
+	return &productErrors.DomainError{
+		Code:    productErrors.ErrInvalidCollection.Code,
+		Message: err.Error(),
+	}
+}
@@ -0,0 +1,173 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockCollectionRepository implements the CollectionRepository interface for testing
+type MockCollectionRepository struct {
+	mock.Mock
+}
+
+func (m *MockCollectionRepository) Create(ctx context.Context, collection *entities.Collection) (*entities.Collection, error) {
+	args := m.Called(ctx, collection)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Collection), args.Error(1)
+}
+
+func (m *MockCollectionRepository) GetByID(ctx context.Context, id uint) (*entities.Collection, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Collection), args.Error(1)
+}
+
+func (m *MockCollectionRepository) GetBySlug(ctx context.Context, slug string) (*entities.Collection, error) {
+	args := m.Called(ctx, slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Collection), args.Error(1)
+}
+
+func (m *MockCollectionRepository) List(ctx context.Context) ([]*entities.Collection, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Collection), args.Error(1)
+}
+
+func (m *MockCollectionRepository) Update(ctx context.Context, collection *entities.Collection) (*entities.Collection, error) {
+	args := m.Called(ctx, collection)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Collection), args.Error(1)
+}
+
+func (m *MockCollectionRepository) Delete(ctx context.Context, id uint) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func setupTestCollectionUseCases() (CollectionUseCases, *MockCollectionRepository, *MockProductRepository, *MockCategoryRepository, *MockBrandRepository) {
+	mockCollectionRepo := new(MockCollectionRepository)
+	mockProductRepo := new(MockProductRepository)
+	mockCategoryRepo := new(MockCategoryRepository)
+	mockBrandRepo := new(MockBrandRepository)
+	useCases := NewCollectionUseCases(mockCollectionRepo, mockProductRepo, mockCategoryRepo, mockBrandRepo, logger.New("test"))
+	return useCases, mockCollectionRepo, mockProductRepo, mockCategoryRepo, mockBrandRepo
+}
+
+func TestCollectionUseCases_ListCollectionProducts_RuleCollection(t *testing.T) {
+	// Given
+	useCases, mockCollectionRepo, mockProductRepo, mockCategoryRepo, mockBrandRepo := setupTestCollectionUseCases()
+	ctx := context.Background()
+
+	collection := &entities.Collection{
+		ID: 4, Name: "Cheap Acme Shoes", Slug: "cheap-acme-shoes", Type: entities.CollectionTypeRule,
+		Rules: []entities.CollectionRule{
+			{Field: entities.RuleFieldCategory, Operator: entities.RuleEquals, Value: "clothing/shoes"},
+			{Field: entities.RuleFieldBrand, Operator: entities.RuleEquals, Value: "acme inc"},
+			{Field: entities.RuleFieldPrice, Operator: entities.RuleLess, Value: "50.00"},
+		},
+	}
+	maxPrice := entities.MustParseMoney("49.99", "USD")
+
+	mockCollectionRepo.On("GetBySlug", ctx, "cheap-acme-shoes").Return(collection, nil)
+	mockCategoryRepo.On("GetByPath", ctx, "clothing/shoes").Return(&entities.Category{ID: 2, Path: "clothing/shoes"}, nil)
+	mockBrandRepo.On("FindByName", ctx, "acme inc").Return(&entities.Brand{ID: 1, Name: "Acme"}, nil)
+	mockProductRepo.On("Search", ctx, ports.ProductSearchCriteria{
+		CategoryPath: "clothing/shoes",
+		Brand:        "Acme",
+		MaxPrice:     &maxPrice,
+		Offset:       20,
+		Limit:        10,
+	}).Return([]*entities.Product{variantParent()}, int64(21), nil)
+
+	// When
+	result, err := useCases.ListCollectionProducts(ctx, "Cheap Acme Shoes", 2, 10)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 21, result.Total)
+	assert.Len(t, result.Products, 1)
+	mockProductRepo.AssertExpectations(t)
+}
+
+func TestCollectionUseCases_ListCollectionProducts_ManualOrder(t *testing.T) {
+	// Given
+	useCases, mockCollectionRepo, mockProductRepo, _, _ := setupTestCollectionUseCases()
+	ctx := context.Background()
+
+	collection := &entities.Collection{ID: 1, Type: entities.CollectionTypeManual, ProductIDs: []uint{9, 3, 5}}
+	mockCollectionRepo.On("GetByID", ctx, uint(1)).Return(collection, nil)
+	mockProductRepo.On("Search", ctx, ports.ProductSearchCriteria{IDs: []uint{9, 3}, Limit: 2}).
+		Return([]*entities.Product{{ID: 3, Name: "Three"}, {ID: 9, Name: "Nine"}}, int64(2), nil)
+
+	// When
+	result, err := useCases.ListCollectionProducts(ctx, "1", 0, 2)
+
+	// Then: products follow the curated order, not the search order
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.Total)
+	require.Len(t, result.Products, 2)
+	assert.Equal(t, uint(9), result.Products[0].ID)
+	assert.Equal(t, uint(3), result.Products[1].ID)
+}
+
+func TestCollectionUseCases_CreateCollection_UnknownProduct(t *testing.T) {
+	// Given
+	useCases, mockCollectionRepo, mockProductRepo, _, _ := setupTestCollectionUseCases()
+	ctx := context.Background()
+
+	mockProductRepo.On("Search", ctx, ports.ProductSearchCriteria{IDs: []uint{1, 2}, Limit: 2}).
+		Return([]*entities.Product{{ID: 1}}, int64(1), nil)
+
+	// When
+	_, err := useCases.CreateCollection(ctx, &dto.CreateCollectionRequestDTO{
+		Name: "Staff Picks", Type: entities.CollectionTypeManual, ProductIDs: []uint{1, 2, 1},
+	})
+
+	// Then
+	var domainErr *domainErrors.DomainError
+	require.True(t, errors.As(err, &domainErr))
+	assert.Equal(t, domainErrors.ErrInvalidCollection.Code, domainErr.Code)
+	assert.Equal(t, "product_ids", domainErr.Field)
+	mockCollectionRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestCollectionUseCases_CreateCollection_UnknownBrand(t *testing.T) {
+	// Given
+	useCases, mockCollectionRepo, _, _, mockBrandRepo := setupTestCollectionUseCases()
+	ctx := context.Background()
+
+	mockBrandRepo.On("FindByName", ctx, "Nobody").Return(nil, domainErrors.ErrBrandNotFound)
+
+	// When
+	_, err := useCases.CreateCollection(ctx, &dto.CreateCollectionRequestDTO{
+		Name: "Nobody", Type: entities.CollectionTypeRule,
+		Rules: []entities.CollectionRule{{Field: entities.RuleFieldBrand, Value: "Nobody"}},
+	})
+
+	// Then
+	var domainErr *domainErrors.DomainError
+	require.True(t, errors.As(err, &domainErr))
+	assert.Equal(t, domainErrors.ErrInvalidCollection.Code, domainErr.Code)
+	mockCollectionRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,500 @@
+package usecases
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	productErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// feedBatchSize is how many products a feed collects before loading their
+// media in one query
+const feedBatchSize = 200
+
+// googleNamespace is the XML namespace of Google Merchant Center attributes
+const googleNamespace = "http://base.google.com/ns/1.0"
+
+// FeedOptions says where feed files are cached and how items link to the
+// storefront
+type FeedOptions struct {
+	Directory string
+	Title     string
+	// StoreURL is the storefront home page; relative media URLs are
+	// resolved against it
+	StoreURL string
+	// ProductURL is the product page link with {sku} and {id} placeholders;
+	// items have no link when it is empty
+	ProductURL string
+}
+
+// FeedUseCases defines the interface for product feeds for Google Merchant
+// Center and other marketplaces. Feeds list the available products, those
+// active and in stock, and are cached on disk: a feed is only written again
+// once products or their media change.
+type FeedUseCases interface {
+	// GenerateFeed writes the feed again if products changed since it was
+	// last written, or regardless when force is set
+	GenerateFeed(ctx context.Context, format entities.FeedFormat, force bool) (*dto.FeedResponseDTO, error)
+	// OpenFeed opens the cached feed file, generating it first when it is
+	// missing or products changed
+	OpenFeed(ctx context.Context, format entities.FeedFormat) (*dto.FeedResponseDTO, io.ReadSeekCloser, error)
+}
+
+// feedUseCasesImpl implements FeedUseCases interface
+type feedUseCasesImpl struct {
+	feedRepo    ports.FeedRepository
+	productRepo ports.ProductRepository
+	mediaRepo   ports.MediaRepository
+	storage     ports.MediaStorage
+	options     FeedOptions
+	logger      logger.Logger
+
+	// generating serializes generation, so the scheduler and requests never
+	// write the same feed twice at once
+	generating sync.Mutex
+}
+
+// NewFeedUseCases creates a new instance of feed use cases
+func NewFeedUseCases(feedRepo ports.FeedRepository, productRepo ports.ProductRepository, mediaRepo ports.MediaRepository, storage ports.MediaStorage, options FeedOptions, log logger.Logger) FeedUseCases {
+	return &feedUseCasesImpl{
+		feedRepo:    feedRepo,
+		productRepo: productRepo,
+		mediaRepo:   mediaRepo,
+		storage:     storage,
+		options:     options,
+		logger:      log.With("component", "feed_usecases"),
+	}
+}
+
+func (uc *feedUseCasesImpl) GenerateFeed(ctx context.Context, format entities.FeedFormat, force bool) (*dto.FeedResponseDTO, error) {
+	uc.logger.Ctx(ctx).Info("GenerateFeed use case called", "format", format, "force", force)
+
+	return uc.refresh(ctx, format, force)
+}
+
+func (uc *feedUseCasesImpl) OpenFeed(ctx context.Context, format entities.FeedFormat) (*dto.FeedResponseDTO, io.ReadSeekCloser, error) {
+	feed, err := uc.refresh(ctx, format, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	file, err := os.Open(uc.feedPath(format))
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("Failed to open feed file", "error", err, "format", format)
+		return nil, nil, productErrors.ErrFailedToGenerateFeed
+	}
+	return feed, file, nil
+}
+
+// refresh compares the fingerprint of the catalog with the one the cached
+// feed was generated from and generates it again when they differ
+func (uc *feedUseCasesImpl) refresh(ctx context.Context, format entities.FeedFormat, force bool) (*dto.FeedResponseDTO, error) {
+	log := uc.logger.Ctx(ctx).With("format", format)
+
+	if !format.IsValid() {
+		return nil, &productErrors.DomainError{
+			Code:    productErrors.ErrInvalidFeedFormat.Code,
+			Message: fmt.Sprintf("unknown feed format %q", format),
+			Field:   "format",
+		}
+	}
+
+	uc.generating.Lock()
+	defer uc.generating.Unlock()
+
+	fingerprint, err := uc.fingerprint(ctx)
+	if err != nil {
+		log.Error("Failed to fingerprint the catalog", "error", err)
+		return nil, productErrors.ErrFailedToGenerateFeed
+	}
+
+	if !force {
+		cached, err := uc.readFeed(format)
+		if err != nil {
+			log.Warn("Ignoring unreadable feed metadata", "error", err)
+		}
+		if cached != nil && cached.Fingerprint == fingerprint {
+			return &dto.FeedResponseDTO{Feed: cached}, nil
+		}
+	}
+
+	feed, err := uc.generate(ctx, format, fingerprint)
+	if err != nil {
+		log.Error("Failed to generate feed", "error", err)
+		return nil, productErrors.ErrFailedToGenerateFeed
+	}
+
+	log.Info("Feed generated", "items", feed.Items)
+	return &dto.FeedResponseDTO{Feed: feed, Regenerated: true}, nil
+}
+
+// fingerprint identifies the catalog together with the options that shape
+// the items, so changing the store links regenerates the feeds too
+func (uc *feedUseCasesImpl) fingerprint(ctx context.Context) (string, error) {
+	catalog, err := uc.feedRepo.Fingerprint(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	options := fnv.New64a()
+	for _, option := range []string{uc.options.Title, uc.options.StoreURL, uc.options.ProductURL} {
+		options.Write([]byte(option))
+		options.Write([]byte{0})
+	}
+	return catalog + "-" + strconv.FormatUint(options.Sum64(), 36), nil
+}
+
+// generate writes the feed to a temporary file that replaces the cached one
+// once complete, so readers never see a partial feed
+func (uc *feedUseCasesImpl) generate(ctx context.Context, format entities.FeedFormat, fingerprint string) (*entities.Feed, error) {
+	if err := os.MkdirAll(uc.options.Directory, 0o755); err != nil {
+		return nil, err
+	}
+	file, err := os.CreateTemp(uc.options.Directory, "."+format.FileName()+"-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	feed := &entities.Feed{Format: format, Fingerprint: fingerprint, GeneratedAt: time.Now().UTC()}
+	encoder, err := newFeedEncoder(format, file, uc.options, feed.GeneratedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := make([]*entities.Product, 0, feedBatchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		images, err := uc.imageLinks(ctx, batch)
+		if err != nil {
+			return err
+		}
+		for _, product := range batch {
+			if err := encoder.Write(entities.NewFeedItem(product, uc.productLink(product), images[product.ID])); err != nil {
+				return err
+			}
+			feed.Items++
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	active, inStock := entities.ProductStatusActive, true
+	criteria := ports.ProductSearchCriteria{Status: &active, InStock: &inStock}
+	err = uc.productRepo.Each(ctx, criteria, func(product *entities.Product) error {
+		if !product.IsAvailable() {
+			return nil
+		}
+		batch = append(batch, product)
+		if len(batch) < feedBatchSize {
+			return nil
+		}
+		return flush()
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	if err := file.Close(); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(file.Name(), uc.feedPath(format)); err != nil {
+		return nil, err
+	}
+
+	return feed, uc.writeFeed(feed)
+}
+
+// imageLinks returns the absolute URL of the primary image of each product,
+// or of its first image when none is primary
+func (uc *feedUseCasesImpl) imageLinks(ctx context.Context, products []*entities.Product) (map[uint]string, error) {
+	ids := make([]uint, len(products))
+	for i, product := range products {
+		ids[i] = product.ID
+	}
+	media, err := uc.mediaRepo.ListMediaForProducts(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	links := make(map[uint]string, len(media))
+	for productID, items := range media {
+		var image *entities.ProductMedia
+		for _, item := range items {
+			if item.Kind != entities.MediaKindImage {
+				continue
+			}
+			if image == nil || item.Primary {
+				image = item
+			}
+			if item.Primary {
+				break
+			}
+		}
+		if image != nil {
+			links[productID] = uc.absoluteURL(uc.storage.URL(image.StorageKey))
+		}
+	}
+	return links, nil
+}
+
+// productLink fills the product URL template
+func (uc *feedUseCasesImpl) productLink(product *entities.Product) string {
+	if uc.options.ProductURL == "" {
+		return ""
+	}
+	return strings.NewReplacer(
+		"{sku}", url.PathEscape(product.SKU),
+		"{id}", strconv.FormatUint(uint64(product.ID), 10),
+	).Replace(uc.options.ProductURL)
+}
+
+// absoluteURL resolves locally served media against the store URL
+func (uc *feedUseCasesImpl) absoluteURL(link string) string {
+	base, err := url.Parse(uc.options.StoreURL)
+	if err != nil || uc.options.StoreURL == "" {
+		return link
+	}
+	reference, err := url.Parse(link)
+	if err != nil {
+		return link
+	}
+	return base.ResolveReference(reference).String()
+}
+
+func (uc *feedUseCasesImpl) feedPath(format entities.FeedFormat) string {
+	return filepath.Join(uc.options.Directory, format.FileName())
+}
+
+// metadataPath keeps the description of a feed file next to it
+func (uc *feedUseCasesImpl) metadataPath(format entities.FeedFormat) string {
+	return uc.feedPath(format) + ".json"
+}
+
+// readFeed returns the description of the cached feed, or nil when the feed
+// was never generated
+func (uc *feedUseCasesImpl) readFeed(format entities.FeedFormat) (*entities.Feed, error) {
+	data, err := os.ReadFile(uc.metadataPath(format))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(uc.feedPath(format)); err != nil {
+		return nil, nil
+	}
+
+	var feed entities.Feed
+	if err := json.Unmarshal(data, &feed); err != nil {
+		return nil, err
+	}
+	return &feed, nil
+}
+
+func (uc *feedUseCasesImpl) writeFeed(feed *entities.Feed) error {
+	data, err := json.Marshal(feed)
+	if err != nil {
+		return err
+	}
+	temporary := uc.metadataPath(feed.Format) + ".tmp"
+	if err := os.WriteFile(temporary, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(temporary, uc.metadataPath(feed.Format))
+}
+
+// feedEncoder writes the items of a feed in its format
+type feedEncoder interface {
+	Write(item entities.FeedItem) error
+	Close() error
+}
+
+// newFeedEncoder starts a feed on w, writing what comes before the items
+func newFeedEncoder(format entities.FeedFormat, w io.Writer, options FeedOptions, generatedAt time.Time) (feedEncoder, error) {
+	buffer := bufio.NewWriter(w)
+
+	switch format {
+	case entities.FeedGoogleRSS:
+		fmt.Fprintf(buffer, "%s<rss version=\"2.0\" xmlns:g=\"%s\">\n<channel>\n", xml.Header, googleNamespace)
+		encoder := &googleRSSEncoder{buffer: buffer, xml: xml.NewEncoder(buffer)}
+		channel := []struct{ name, value string }{
+			{"title", options.Title}, {"link", options.StoreURL}, {"description", options.Title},
+		}
+		for _, element := range channel {
+			if err := encoder.xml.EncodeElement(element.value, xml.StartElement{Name: xml.Name{Local: element.name}}); err != nil {
+				return nil, err
+			}
+		}
+		return encoder, nil
+	case entities.FeedGoogleTSV:
+		encoder := &googleTSVEncoder{buffer: buffer}
+		_, err := buffer.WriteString(strings.Join(googleTSVColumns, "\t") + "\n")
+		return encoder, err
+	default:
+		fmt.Fprintf(buffer, "%s<products generated_at=\"%s\">\n", xml.Header, generatedAt.Format(time.RFC3339))
+		return &xmlFeedEncoder{buffer: buffer, xml: xml.NewEncoder(buffer)}, nil
+	}
+}
+
+// googleRSSItem is an item of a Google Merchant Center RSS feed. Element
+// names carry the g: prefix declared on the rss element.
+type googleRSSItem struct {
+	XMLName          xml.Name `xml:"item"`
+	ID               string   `xml:"g:id"`
+	Title            string   `xml:"g:title"`
+	Description      string   `xml:"g:description"`
+	Link             string   `xml:"g:link,omitempty"`
+	ImageLink        string   `xml:"g:image_link,omitempty"`
+	Availability     string   `xml:"g:availability"`
+	Price            string   `xml:"g:price"`
+	Brand            string   `xml:"g:brand,omitempty"`
+	GTIN             string   `xml:"g:gtin,omitempty"`
+	MPN              string   `xml:"g:mpn,omitempty"`
+	Condition        string   `xml:"g:condition"`
+	IdentifierExists string   `xml:"g:identifier_exists,omitempty"`
+	ProductType      string   `xml:"g:product_type,omitempty"`
+}
+
+type googleRSSEncoder struct {
+	buffer *bufio.Writer
+	xml    *xml.Encoder
+}
+
+func (e *googleRSSEncoder) Write(item entities.FeedItem) error {
+	return e.xml.Encode(googleRSSItem{
+		ID:               item.ID,
+		Title:            item.Title,
+		Description:      item.Description,
+		Link:             item.Link,
+		ImageLink:        item.ImageLink,
+		Availability:     item.Availability,
+		Price:            item.FeedPrice(),
+		Brand:            item.Brand,
+		GTIN:             item.GTIN,
+		MPN:              item.MPN,
+		Condition:        item.Condition,
+		IdentifierExists: identifierExists(item),
+		ProductType:      item.ProductType,
+	})
+}
+
+func (e *googleRSSEncoder) Close() error {
+	if _, err := e.buffer.WriteString("\n</channel>\n</rss>\n"); err != nil {
+		return err
+	}
+	return e.buffer.Flush()
+}
+
+// googleTSVColumns is the header of Google Merchant Center TSV feeds
+var googleTSVColumns = []string{
+	"id", "title", "description", "link", "image_link", "availability", "price",
+	"brand", "gtin", "mpn", "condition", "identifier_exists", "product_type",
+}
+
+type googleTSVEncoder struct {
+	buffer *bufio.Writer
+}
+
+func (e *googleTSVEncoder) Write(item entities.FeedItem) error {
+	values := []string{
+		item.ID, item.Title, item.Description, item.Link, item.ImageLink, item.Availability, item.FeedPrice(),
+		item.Brand, item.GTIN, item.MPN, item.Condition, identifierExists(item), item.ProductType,
+	}
+	for i, value := range values {
+		// TSV feeds have no quoting, so separators inside values become spaces
+		values[i] = strings.Join(strings.FieldsFunc(value, func(r rune) bool {
+			return r == '\t' || r == '\n' || r == '\r'
+		}), " ")
+	}
+	_, err := e.buffer.WriteString(strings.Join(values, "\t") + "\n")
+	return err
+}
+
+func (e *googleTSVEncoder) Close() error {
+	return e.buffer.Flush()
+}
+
+// xmlFeedItem is a product of the plain XML feed
+type xmlFeedItem struct {
+	XMLName      xml.Name `xml:"product"`
+	ID           string   `xml:"id"`
+	Title        string   `xml:"title"`
+	Description  string   `xml:"description"`
+	Link         string   `xml:"link,omitempty"`
+	ImageLink    string   `xml:"image_link,omitempty"`
+	Availability string   `xml:"availability"`
+	Price        struct {
+		Currency string `xml:"currency,attr"`
+		Amount   string `xml:",chardata"`
+	} `xml:"price"`
+	Brand     string `xml:"brand,omitempty"`
+	GTIN      string `xml:"gtin,omitempty"`
+	MPN       string `xml:"mpn,omitempty"`
+	Condition string `xml:"condition"`
+	Category  string `xml:"category,omitempty"`
+}
+
+type xmlFeedEncoder struct {
+	buffer *bufio.Writer
+	xml    *xml.Encoder
+}
+
+func (e *xmlFeedEncoder) Write(item entities.FeedItem) error {
+	product := xmlFeedItem{
+		ID:           item.ID,
+		Title:        item.Title,
+		Description:  item.Description,
+		Link:         item.Link,
+		ImageLink:    item.ImageLink,
+		Availability: item.Availability,
+		Brand:        item.Brand,
+		GTIN:         item.GTIN,
+		MPN:          item.MPN,
+		Condition:    item.Condition,
+		Category:     item.ProductType,
+	}
+	product.Price.Currency = item.Price.Currency()
+	product.Price.Amount = item.Price.Decimal()
+	return e.xml.Encode(product)
+}
+
+func (e *xmlFeedEncoder) Close() error {
+	if _, err := e.buffer.WriteString("\n</products>\n"); err != nil {
+		return err
+	}
+	return e.buffer.Flush()
+}
+
+// identifierExists is "no" for items without unique product identifiers,
+// which Google Merchant Center otherwise rejects
+func identifierExists(item entities.FeedItem) string {
+	if item.IdentifierExists() {
+		return ""
+	}
+	return "no"
+}
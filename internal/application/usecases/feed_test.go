@@ -0,0 +1,205 @@
+package usecases
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockFeedRepository implements the FeedRepository interface for testing
+type MockFeedRepository struct {
+	mock.Mock
+}
+
+func (m *MockFeedRepository) Fingerprint(ctx context.Context) (string, error) {
+	args := m.Called(ctx)
+	return args.String(0), args.Error(1)
+}
+
+func setupTestFeedUseCases(t *testing.T) (FeedUseCases, *MockFeedRepository, *MockProductRepository, *MockMediaRepository, string) {
+	mockFeeds := new(MockFeedRepository)
+	mockProducts := new(MockProductRepository)
+	mockMedia := new(MockMediaRepository)
+	directory := t.TempDir()
+
+	useCases := NewFeedUseCases(mockFeeds, mockProducts, mockMedia, new(MockMediaStorage), FeedOptions{
+		Directory:  directory,
+		Title:      "Acme Store",
+		StoreURL:   "https://shop.example.com",
+		ProductURL: "https://shop.example.com/products/{sku}",
+	}, logger.New("test"))
+	return useCases, mockFeeds, mockProducts, mockMedia, directory
+}
+
+func testFeedProducts(t *testing.T) []*entities.Product {
+	t.Helper()
+	price, err := entities.ParseMoney("19.99", "EUR")
+	require.NoError(t, err)
+	return []*entities.Product{
+		{ID: 1, SKU: "CAM 001", Name: "Camera", Description: "Mirrorless\tcamera", Price: price, Brand: "Acme",
+			Category: "Electronics", Stock: 3, Status: entities.ProductStatusActive,
+			Attributes: entities.Attributes{"gtin": "4006381333931"}},
+		{ID: 2, SKU: "LENS-001", Name: "Lens & hood", Price: price, Category: "Electronics", Stock: 1,
+			Status: entities.ProductStatusActive},
+	}
+}
+
+var feedCriteria = mock.MatchedBy(func(criteria ports.ProductSearchCriteria) bool {
+	return *criteria.Status == entities.ProductStatusActive && *criteria.InStock
+})
+
+func TestFeedUseCases_GenerateFeed_GoogleRSS(t *testing.T) {
+	// Given
+	useCases, mockFeeds, mockProducts, mockMedia, directory := setupTestFeedUseCases(t)
+	ctx := context.Background()
+
+	mockFeeds.On("Fingerprint", ctx).Return("p2-100-m1-100", nil)
+	mockProducts.On("Each", ctx, feedCriteria).Return(testFeedProducts(t), nil)
+	mockMedia.On("ListMediaForProducts", ctx, []uint{1, 2}).Return(map[uint][]*entities.ProductMedia{
+		1: {
+			{ProductID: 1, Kind: entities.MediaKindDocument, StorageKey: "products/1/manual.pdf"},
+			{ProductID: 1, Kind: entities.MediaKindImage, StorageKey: "products/1/side.jpg"},
+			{ProductID: 1, Kind: entities.MediaKindImage, StorageKey: "products/1/front.jpg", Primary: true},
+		},
+	}, nil)
+
+	// When
+	feed, err := useCases.GenerateFeed(ctx, entities.FeedGoogleRSS, false)
+
+	// Then
+	require.NoError(t, err)
+	assert.True(t, feed.Regenerated)
+	assert.Equal(t, 2, feed.Items)
+
+	content, err := os.ReadFile(filepath.Join(directory, "google.xml"))
+	require.NoError(t, err)
+	assert.NoError(t, xml.Unmarshal(content, new(struct{})))
+	assert.Contains(t, string(content), `<rss version="2.0" xmlns:g="http://base.google.com/ns/1.0">`)
+	assert.Contains(t, string(content), `<title>Acme Store</title>`)
+	assert.Contains(t, string(content), `<g:id>CAM 001</g:id>`)
+	assert.Contains(t, string(content), `<g:link>https://shop.example.com/products/CAM%20001</g:link>`)
+	assert.Contains(t, string(content), `<g:image_link>https://cdn.example.com/products/1/front.jpg</g:image_link>`)
+	assert.Contains(t, string(content), `<g:availability>in_stock</g:availability><g:price>19.99 EUR</g:price><g:brand>Acme</g:brand><g:gtin>4006381333931</g:gtin><g:condition>new</g:condition>`)
+	assert.Contains(t, string(content), `<g:title>Lens &amp; hood</g:title>`)
+	assert.Contains(t, string(content), `<g:identifier_exists>no</g:identifier_exists>`)
+}
+
+func TestFeedUseCases_GenerateFeed_Formats(t *testing.T) {
+	tests := []struct {
+		format   entities.FeedFormat
+		fileName string
+		contains []string
+	}{
+		{
+			format:   entities.FeedGoogleTSV,
+			fileName: "google.tsv",
+			contains: []string{
+				"id\ttitle\tdescription\tlink\timage_link\tavailability\tprice\tbrand\tgtin\tmpn\tcondition\tidentifier_exists\tproduct_type\n",
+				"CAM 001\tCamera\tMirrorless camera\thttps://shop.example.com/products/CAM%20001\t\tin_stock\t19.99 EUR\tAcme\t4006381333931\t\tnew\t\tElectronics\n",
+			},
+		},
+		{
+			format:   entities.FeedXML,
+			fileName: "products.xml",
+			contains: []string{
+				`<product><id>LENS-001</id><title>Lens &amp; hood</title>`,
+				`<price currency="EUR">19.99</price>`,
+				"</products>\n",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			// Given
+			useCases, mockFeeds, mockProducts, mockMedia, directory := setupTestFeedUseCases(t)
+			ctx := context.Background()
+
+			mockFeeds.On("Fingerprint", ctx).Return("p2-100-m0-0", nil)
+			mockProducts.On("Each", ctx, feedCriteria).Return(testFeedProducts(t), nil)
+			mockMedia.On("ListMediaForProducts", ctx, mock.Anything).Return(map[uint][]*entities.ProductMedia{}, nil)
+
+			// When
+			feed, err := useCases.GenerateFeed(ctx, tt.format, false)
+
+			// Then
+			require.NoError(t, err)
+			assert.Equal(t, 2, feed.Items)
+			content, err := os.ReadFile(filepath.Join(directory, tt.fileName))
+			require.NoError(t, err)
+			for _, expected := range tt.contains {
+				assert.Contains(t, string(content), expected)
+			}
+		})
+	}
+}
+
+func TestFeedUseCases_OpenFeed_RegeneratesOnlyWhenProductsChange(t *testing.T) {
+	// Given
+	useCases, mockFeeds, mockProducts, mockMedia, _ := setupTestFeedUseCases(t)
+	ctx := context.Background()
+
+	mockFeeds.On("Fingerprint", ctx).Return("p2-100-m0-0", nil).Twice()
+	mockFeeds.On("Fingerprint", ctx).Return("p2-200-m0-0", nil).Once()
+	mockProducts.On("Each", ctx, feedCriteria).Return(testFeedProducts(t), nil)
+	mockMedia.On("ListMediaForProducts", ctx, mock.Anything).Return(map[uint][]*entities.ProductMedia{}, nil)
+
+	// When
+	first, file, err := useCases.OpenFeed(ctx, entities.FeedXML)
+	require.NoError(t, err)
+	content, err := io.ReadAll(file)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	cached, err := useCases.GenerateFeed(ctx, entities.FeedXML, false)
+	require.NoError(t, err)
+	changed, err := useCases.GenerateFeed(ctx, entities.FeedXML, false)
+	require.NoError(t, err)
+
+	// Then
+	assert.True(t, first.Regenerated)
+	assert.True(t, strings.HasPrefix(string(content), "<?xml"))
+	assert.False(t, cached.Regenerated)
+	assert.Equal(t, first.GeneratedAt, cached.GeneratedAt)
+	assert.True(t, changed.Regenerated)
+	mockProducts.AssertNumberOfCalls(t, "Each", 2)
+}
+
+func TestFeedUseCases_GenerateFeed_Errors(t *testing.T) {
+	t.Run("unknown format", func(t *testing.T) {
+		useCases, _, _, _, _ := setupTestFeedUseCases(t)
+
+		_, err := useCases.GenerateFeed(context.Background(), "csv", false)
+
+		var domainErr *domainErrors.DomainError
+		require.ErrorAs(t, err, &domainErr)
+		assert.Equal(t, domainErrors.ErrInvalidFeedFormat.Code, domainErr.Code)
+	})
+
+	t.Run("repository failure keeps the cached feed", func(t *testing.T) {
+		useCases, mockFeeds, mockProducts, _, directory := setupTestFeedUseCases(t)
+		ctx := context.Background()
+		mockFeeds.On("Fingerprint", ctx).Return("p1-1-m0-0", nil)
+		mockProducts.On("Each", ctx, feedCriteria).Return(nil, errors.New("connection reset"))
+
+		_, err := useCases.GenerateFeed(ctx, entities.FeedGoogleRSS, false)
+
+		assert.Equal(t, domainErrors.ErrFailedToGenerateFeed, err)
+		files, err := os.ReadDir(directory)
+		require.NoError(t, err)
+		assert.Empty(t, files)
+	})
+}
@@ -0,0 +1,440 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	productErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+	"product-service/pkg/requestctx"
+	"strconv"
+	"time"
+)
+
+// repriceErrorLimit is how many failed products a repricing job lists in
+// its result
+const repriceErrorLimit = 20
+
+// errPriceUnchanged skips products a repricing rule leaves at their price
+var errPriceUnchanged = errors.New("price unchanged")
+
+// JobOptions says how often jobs are attempted and where the files they
+// produce are kept
+type JobOptions struct {
+	MaxAttempts int
+	Directory   string
+}
+
+// JobUseCases defines the interface for long-running catalog operations run
+// by background workers: exports to a file, bulk repricing, feed
+// generation and product imports. Requests queue a job and follow its progress until it
+// finishes, instead of holding the connection open.
+type JobUseCases interface {
+	// EnqueueExport validates the export and queues it
+	EnqueueExport(ctx context.Context, request *dto.ExportRequestDTO) (*dto.JobResponseDTO, error)
+	// EnqueueReprice validates the repricing rule and filters and queues it
+	EnqueueReprice(ctx context.Context, request *dto.RepriceRequestDTO) (*dto.JobResponseDTO, error)
+	// EnqueueFeeds queues the generation of the requested feeds
+	EnqueueFeeds(ctx context.Context, request *dto.FeedJobRequestDTO) (*dto.JobResponseDTO, error)
+	GetJob(ctx context.Context, id uint) (*dto.JobResponseDTO, error)
+	ListJobs(ctx context.Context, query *dto.JobQueryDTO) (*dto.JobListResponseDTO, error)
+	// CancelJob cancels a queued job, or asks the worker of a running one to
+	// stop it
+	CancelJob(ctx context.Context, id uint) (*dto.JobResponseDTO, error)
+	// OpenJobResult opens the file a succeeded export job wrote
+	OpenJobResult(ctx context.Context, id uint) (*dto.ExportJobResultDTO, io.ReadSeekCloser, error)
+
+	// JobTypes lists the job types RunJob runs
+	JobTypes() []entities.JobType
+	// RunJob does the work of a claimed job and returns its result. progress
+	// is told how much of the work is done; ctx is cancelled when the job
+	// should stop.
+	RunJob(ctx context.Context, job *entities.Job, progress func(done, total int64)) (any, error)
+}
+
+// jobUseCasesImpl implements JobUseCases interface
+type jobUseCasesImpl struct {
+	*productEditor
+	jobRepo          ports.JobRepository
+	productRepo      ports.ProductRepository
+	priceHistoryRepo ports.PriceHistoryRepository
+	exportUseCases   ExportUseCases
+	feedUseCases     FeedUseCases
+	importUseCases   ImportUseCases
+	options          JobOptions
+	logger           logger.Logger
+}
+
+// NewJobUseCases creates a new instance of job use cases
+func NewJobUseCases(jobRepo ports.JobRepository, productRepo ports.ProductRepository, priceHistoryRepo ports.PriceHistoryRepository, attributeRepo ports.AttributeRepository, categoryRepo ports.CategoryRepository, brandRepo ports.BrandRepository, exportUseCases ExportUseCases, feedUseCases FeedUseCases, importUseCases ImportUseCases, options JobOptions, log logger.Logger) JobUseCases {
+	log = log.With("component", "job_usecases")
+	return &jobUseCasesImpl{
+		productEditor:    newProductEditor(attributeRepo, categoryRepo, brandRepo, log),
+		jobRepo:          jobRepo,
+		productRepo:      productRepo,
+		priceHistoryRepo: priceHistoryRepo,
+		exportUseCases:   exportUseCases,
+		feedUseCases:     feedUseCases,
+		importUseCases:   importUseCases,
+		options:          options,
+		logger:           log,
+	}
+}
+
+func (uc *jobUseCasesImpl) EnqueueExport(ctx context.Context, request *dto.ExportRequestDTO) (*dto.JobResponseDTO, error) {
+	uc.logger.Ctx(ctx).Info("EnqueueExport use case called", "format", request.Format)
+
+	if _, err := uc.exportUseCases.PrepareExport(ctx, request); err != nil {
+		return nil, err
+	}
+	return uc.enqueue(ctx, entities.JobProductExport, request, uc.options.MaxAttempts)
+}
+
+// EnqueueReprice queues a job with a single attempt: a retry after some
+// products were repriced would reprice them twice
+func (uc *jobUseCasesImpl) EnqueueReprice(ctx context.Context, request *dto.RepriceRequestDTO) (*dto.JobResponseDTO, error) {
+	uc.logger.Ctx(ctx).Info("EnqueueReprice use case called", "percent", request.Percent, "amount", request.Amount)
+
+	if _, err := newRepriceRule(request); err != nil {
+		return nil, err
+	}
+	if _, err := uc.searchCriteria(ctx, &request.Filters); err != nil {
+		return nil, err
+	}
+	return uc.enqueue(ctx, entities.JobProductReprice, request, 1)
+}
+
+func (uc *jobUseCasesImpl) EnqueueFeeds(ctx context.Context, request *dto.FeedJobRequestDTO) (*dto.JobResponseDTO, error) {
+	uc.logger.Ctx(ctx).Info("EnqueueFeeds use case called", "formats", request.Formats, "force", request.Force)
+
+	for _, format := range request.Formats {
+		if !format.IsValid() {
+			return nil, &productErrors.DomainError{
+				Code:    productErrors.ErrInvalidFeedFormat.Code,
+				Message: fmt.Sprintf("unknown feed format %q", format),
+				Field:   "formats",
+			}
+		}
+	}
+	return uc.enqueue(ctx, entities.JobFeedGenerate, request, uc.options.MaxAttempts)
+}
+
+// enqueue queues a job of jobType for the actor and request of ctx
+func (uc *jobUseCasesImpl) enqueue(ctx context.Context, jobType entities.JobType, payload any, maxAttempts int) (*dto.JobResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	job, err := entities.NewJob(jobType, payload, maxAttempts, requestctx.Actor(ctx), requestctx.RequestID(ctx), time.Now())
+	if err != nil {
+		log.Error("Failed to encode job payload", "error", err, "type", jobType)
+		return nil, productErrors.ErrFailedToEnqueueJob
+	}
+
+	created, err := uc.jobRepo.Create(ctx, job)
+	if err != nil {
+		log.Error("Failed to create job", "error", err, "type", jobType)
+		return nil, productErrors.ErrFailedToEnqueueJob
+	}
+
+	log.Info("Job enqueued", "job_id", created.ID, "type", jobType)
+	return &dto.JobResponseDTO{Job: created}, nil
+}
+
+// GetJob returns the status and progress of a job
+func (uc *jobUseCasesImpl) GetJob(ctx context.Context, id uint) (*dto.JobResponseDTO, error) {
+	job, err := uc.jobRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.JobResponseDTO{Job: job}, nil
+}
+
+// ListJobs returns one page of jobs matching query, newest first
+func (uc *jobUseCasesImpl) ListJobs(ctx context.Context, query *dto.JobQueryDTO) (*dto.JobListResponseDTO, error) {
+	if query.Type != "" && !query.Type.IsValid() {
+		return nil, &productErrors.DomainError{
+			Code:    productErrors.ErrInvalidJob.Code,
+			Message: fmt.Sprintf("unknown job type %q", query.Type),
+			Field:   "type",
+		}
+	}
+	if query.Status != "" && !query.Status.IsValid() {
+		return nil, &productErrors.DomainError{
+			Code:    productErrors.ErrInvalidJob.Code,
+			Message: fmt.Sprintf("unknown job status %q", query.Status),
+			Field:   "status",
+		}
+	}
+
+	page := query.Page
+	if page < 0 {
+		page = 0
+	}
+	pageSize := query.PageSize
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	jobs, total, err := uc.jobRepo.List(ctx, ports.JobCriteria{
+		Type:   query.Type,
+		Status: query.Status,
+		Limit:  pageSize,
+		Offset: page * pageSize,
+	})
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("Failed to list jobs", "error", err)
+		return nil, err
+	}
+
+	responses := make([]*dto.JobResponseDTO, 0, len(jobs))
+	for _, job := range jobs {
+		responses = append(responses, &dto.JobResponseDTO{Job: job})
+	}
+	return &dto.JobListResponseDTO{
+		Jobs:     responses,
+		Total:    int(total),
+		Page:     page,
+		PageSize: pageSize,
+	}, nil
+}
+
+// CancelJob reports ErrJobAlreadyFinished for jobs that finished
+func (uc *jobUseCasesImpl) CancelJob(ctx context.Context, id uint) (*dto.JobResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("CancelJob use case called", "job_id", id)
+
+	job, err := uc.jobRepo.Update(ctx, id, func(job *entities.Job) error {
+		if !job.Cancel(time.Now()) {
+			return productErrors.ErrJobAlreadyFinished
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("CancelJob success", "job_id", id, "status", job.Status)
+	return &dto.JobResponseDTO{Job: job}, nil
+}
+
+// OpenJobResult reports ErrJobResultNotAvailable unless the job is an export
+// that succeeded
+func (uc *jobUseCasesImpl) OpenJobResult(ctx context.Context, id uint) (*dto.ExportJobResultDTO, io.ReadSeekCloser, error) {
+	job, err := uc.jobRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if job.Type != entities.JobProductExport || job.Status != entities.JobSucceeded {
+		return nil, nil, productErrors.ErrJobResultNotAvailable
+	}
+
+	var result dto.ExportJobResultDTO
+	if err := json.Unmarshal(job.Result, &result); err != nil {
+		uc.logger.Ctx(ctx).Error("Failed to decode job result", "error", err, "job_id", id)
+		return nil, nil, productErrors.ErrJobResultNotAvailable
+	}
+
+	file, err := os.Open(filepath.Join(uc.jobDirectory(job), result.FileName))
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("Failed to open job result", "error", err, "job_id", id)
+		return nil, nil, productErrors.ErrJobResultNotAvailable
+	}
+	return &result, file, nil
+}
+
+func (uc *jobUseCasesImpl) JobTypes() []entities.JobType {
+	return []entities.JobType{entities.JobProductExport, entities.JobProductReprice, entities.JobFeedGenerate, entities.JobProductImport}
+}
+
+func (uc *jobUseCasesImpl) RunJob(ctx context.Context, job *entities.Job, progress func(done, total int64)) (any, error) {
+	switch job.Type {
+	case entities.JobProductExport:
+		var request dto.ExportRequestDTO
+		if err := json.Unmarshal(job.Payload, &request); err != nil {
+			return nil, err
+		}
+		return uc.runExport(ctx, job, &request, progress)
+	case entities.JobProductReprice:
+		var request dto.RepriceRequestDTO
+		if err := json.Unmarshal(job.Payload, &request); err != nil {
+			return nil, err
+		}
+		return uc.runReprice(ctx, &request, progress)
+	case entities.JobFeedGenerate:
+		var request dto.FeedJobRequestDTO
+		if err := json.Unmarshal(job.Payload, &request); err != nil {
+			return nil, err
+		}
+		return uc.runFeeds(ctx, &request, progress)
+	case entities.JobProductImport:
+		var request dto.ImportJobRequestDTO
+		if err := json.Unmarshal(job.Payload, &request); err != nil {
+			return nil, err
+		}
+		return uc.importUseCases.ProcessImport(ctx, &request)
+	default:
+		return nil, fmt.Errorf("unknown job type %q", job.Type)
+	}
+}
+
+// runExport writes the export to a temporary file in the job's directory
+// that is renamed once complete, so a retried job never serves a partial
+// file
+func (uc *jobUseCasesImpl) runExport(ctx context.Context, job *entities.Job, request *dto.ExportRequestDTO, progress func(done, total int64)) (*dto.ExportJobResultDTO, error) {
+	export, err := uc.exportUseCases.PrepareExport(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	criteria := export.criteria
+	criteria.Limit = 1
+	_, total, err := uc.productRepo.Search(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+	var rows int64
+	export.progress = func(written int64) {
+		rows = written
+		progress(written, total)
+	}
+
+	directory := uc.jobDirectory(job)
+	if err := os.MkdirAll(directory, 0o755); err != nil {
+		return nil, err
+	}
+	file, err := os.CreateTemp(directory, "."+export.FileName()+"-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	if err := uc.exportUseCases.WriteExport(ctx, export, file); err != nil {
+		return nil, err
+	}
+	if err := file.Close(); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(file.Name(), filepath.Join(directory, export.FileName())); err != nil {
+		return nil, err
+	}
+
+	return &dto.ExportJobResultDTO{FileName: export.FileName(), Format: export.Format, Rows: rows}, nil
+}
+
+// runReprice collects the matching products before changing any price, so
+// the changes cannot move products in or out of a price filter while it
+// reads them. Every product is repriced in its own transaction with a price
+// history entry; products the rule cannot apply to are counted as failed.
+// A cancelled job keeps the prices it already changed.
+func (uc *jobUseCasesImpl) runReprice(ctx context.Context, request *dto.RepriceRequestDTO, progress func(done, total int64)) (*dto.RepriceJobResultDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	rule, err := newRepriceRule(request)
+	if err != nil {
+		return nil, err
+	}
+	criteria, err := uc.searchCriteria(ctx, &request.Filters)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []uint
+	err = uc.productRepo.Each(ctx, criteria, func(product *entities.Product) error {
+		ids = append(ids, product.ID)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dto.RepriceJobResultDTO{Matched: len(ids)}
+	actor := requestctx.Actor(ctx)
+	for i, id := range ids {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		_, err := uc.priceHistoryRepo.ApplyPriceChange(ctx, id, func(product *entities.Product) ([]*entities.PriceChange, error) {
+			price, err := rule.Apply(product.Price)
+			if err != nil {
+				return nil, productErrors.NewProductValidationError("price", err.Error())
+			}
+			if price.Equal(product.Price) {
+				return nil, errPriceUnchanged
+			}
+			change, err := entities.ChangePrice(product, price, request.Reason, actor, time.Now())
+			if err != nil {
+				return nil, productErrors.NewProductValidationError("price", err.Error())
+			}
+			return []*entities.PriceChange{change}, nil
+		})
+
+		var domainErr *productErrors.DomainError
+		switch {
+		case err == nil:
+			result.Changed++
+		case errors.Is(err, errPriceUnchanged):
+			result.Unchanged++
+		case errors.As(err, &domainErr):
+			result.Failed++
+			if len(result.Errors) < repriceErrorLimit {
+				result.Errors = append(result.Errors, dto.RepriceErrorDTO{ProductID: id, Code: domainErr.Code, Message: domainErr.Message})
+			}
+		default:
+			log.Error("Failed to reprice product", "error", err, "product_id", id)
+			return nil, err
+		}
+		progress(int64(i+1), int64(len(ids)))
+	}
+
+	log.Info("Repricing finished", "matched", result.Matched, "changed", result.Changed, "failed", result.Failed)
+	return result, nil
+}
+
+// runFeeds generates the requested feeds, or every feed, one at a time
+func (uc *jobUseCasesImpl) runFeeds(ctx context.Context, request *dto.FeedJobRequestDTO, progress func(done, total int64)) (*dto.FeedJobResultDTO, error) {
+	formats := request.Formats
+	if len(formats) == 0 {
+		formats = entities.FeedFormats
+	}
+
+	result := &dto.FeedJobResultDTO{Feeds: make([]*dto.FeedResponseDTO, 0, len(formats))}
+	for i, format := range formats {
+		feed, err := uc.feedUseCases.GenerateFeed(ctx, format, request.Force)
+		if err != nil {
+			return nil, err
+		}
+		result.Feeds = append(result.Feeds, feed)
+		progress(int64(i+1), int64(len(formats)))
+	}
+	return result, nil
+}
+
+// jobDirectory holds the files a job writes
+func (uc *jobUseCasesImpl) jobDirectory(job *entities.Job) string {
+	return filepath.Join(uc.options.Directory, strconv.FormatUint(uint64(job.ID), 10))
+}
+
+func newRepriceRule(request *dto.RepriceRequestDTO) (*entities.RepriceRule, error) {
+	rule, err := entities.NewRepriceRule(request.Percent, request.Amount)
+	if err != nil {
+		field := "percent"
+		if request.Amount != nil {
+			field = "amount"
+		}
+		return nil, &productErrors.DomainError{
+			Code:    productErrors.ErrInvalidReprice.Code,
+			Message: err.Error(),
+			Field:   field,
+		}
+	}
+	return rule, nil
+}
@@ -0,0 +1,253 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockJobRepository implements the JobRepository interface for testing
+type MockJobRepository struct {
+	mock.Mock
+}
+
+func (m *MockJobRepository) Create(ctx context.Context, job *entities.Job) (*entities.Job, error) {
+	args := m.Called(ctx, job)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Job), args.Error(1)
+}
+
+func (m *MockJobRepository) GetByID(ctx context.Context, id uint) (*entities.Job, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Job), args.Error(1)
+}
+
+func (m *MockJobRepository) List(ctx context.Context, criteria ports.JobCriteria) ([]*entities.Job, int64, error) {
+	args := m.Called(ctx, criteria)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*entities.Job), args.Get(1).(int64), args.Error(2)
+}
+
+// Update runs fn on a copy of the job the expectation returns
+func (m *MockJobRepository) Update(ctx context.Context, id uint, fn ports.JobUpdateFunc) (*entities.Job, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	job := *args.Get(0).(*entities.Job)
+	if err := fn(&job); err != nil {
+		return nil, err
+	}
+	return &job, args.Error(1)
+}
+
+func (m *MockJobRepository) Claim(ctx context.Context, types []entities.JobType, worker string, lease time.Duration, now time.Time) (*entities.Job, error) {
+	args := m.Called(ctx, types, worker)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Job), args.Error(1)
+}
+
+func (m *MockJobRepository) Heartbeat(ctx context.Context, job *entities.Job, lease time.Duration, now time.Time) (bool, error) {
+	args := m.Called(ctx, job)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockJobRepository) Finish(ctx context.Context, job *entities.Job, worker string) (bool, error) {
+	args := m.Called(ctx, job, worker)
+	return args.Bool(0), args.Error(1)
+}
+
+func setupTestJobUseCases(t *testing.T) (JobUseCases, *MockJobRepository, *MockProductRepository, *MockPriceHistoryRepository) {
+	mockJobs := new(MockJobRepository)
+	mockProducts := new(MockProductRepository)
+	mockPriceHistory := new(MockPriceHistoryRepository)
+	mockAttributes := new(MockAttributeRepository)
+	mockCategories := new(MockCategoryRepository)
+	mockBrands := new(MockBrandRepository)
+	log := logger.New("test")
+
+	exports := NewExportUseCases(mockProducts, mockAttributes, mockCategories, mockBrands, log)
+	useCases := NewJobUseCases(mockJobs, mockProducts, mockPriceHistory, mockAttributes, mockCategories, mockBrands, exports, nil, nil, JobOptions{
+		MaxAttempts: 3,
+		Directory:   t.TempDir(),
+	}, log)
+	return useCases, mockJobs, mockProducts, mockPriceHistory
+}
+
+func TestJobUseCases_EnqueueReprice(t *testing.T) {
+	t.Run("queues a single attempt", func(t *testing.T) {
+		// Given
+		useCases, mockJobs, _, _ := setupTestJobUseCases(t)
+		ctx := context.Background()
+		request := &dto.RepriceRequestDTO{Percent: "-10", Reason: "spring sale", Filters: dto.ProductSearchRequestDTO{Query: "laptop"}}
+
+		mockJobs.On("Create", ctx, mock.MatchedBy(func(job *entities.Job) bool {
+			return job.Type == entities.JobProductReprice && job.Status == entities.JobQueued && job.MaxAttempts == 1
+		})).Return(&entities.Job{ID: 7, Type: entities.JobProductReprice, Status: entities.JobQueued}, nil)
+
+		// When
+		response, err := useCases.EnqueueReprice(ctx, request)
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, uint(7), response.ID)
+		mockJobs.AssertExpectations(t)
+	})
+
+	t.Run("invalid rule", func(t *testing.T) {
+		// Given
+		useCases, mockJobs, _, _ := setupTestJobUseCases(t)
+
+		// When
+		_, err := useCases.EnqueueReprice(context.Background(), &dto.RepriceRequestDTO{Percent: "-100"})
+
+		// Then
+		var domainErr *domainErrors.DomainError
+		require.ErrorAs(t, err, &domainErr)
+		assert.Equal(t, domainErrors.ErrInvalidReprice.Code, domainErr.Code)
+		assert.Equal(t, "percent", domainErr.Field)
+		mockJobs.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestJobUseCases_RunJob_Reprice(t *testing.T) {
+	// Given
+	useCases, _, mockProducts, mockPriceHistory := setupTestJobUseCases(t)
+	ctx := context.Background()
+
+	job, err := entities.NewJob(entities.JobProductReprice, &dto.RepriceRequestDTO{Percent: "-10", Reason: "spring sale"}, 1, "ops@example.com", "", time.Now())
+	require.NoError(t, err)
+
+	mockProducts.On("Each", ctx, ports.ProductSearchCriteria{}).Return([]*entities.Product{{ID: 1}, {ID: 2}, {ID: 3}}, nil)
+	mockPriceHistory.On("ApplyPriceChange", ctx, uint(1)).Return(&entities.Product{ID: 1, Price: entities.MustParseMoney("100.00", "USD")}, nil)
+	// 0.045 rounds back to 0.05
+	mockPriceHistory.On("ApplyPriceChange", ctx, uint(2)).Return(&entities.Product{ID: 2, Price: entities.MustParseMoney("0.05", "USD")}, nil)
+	mockPriceHistory.On("ApplyPriceChange", ctx, uint(3)).Return(nil, domainErrors.ErrProductNotFound)
+	mockPriceHistory.On("savedPriceChanges", mock.MatchedBy(func(changes []*entities.PriceChange) bool {
+		return len(changes) == 1 && changes[0].NewPrice.Equal(entities.MustParseMoney("90.00", "USD")) &&
+			changes[0].Reason == "spring sale"
+	})).Once()
+
+	var reported [][2]int64
+	progress := func(done, total int64) {
+		reported = append(reported, [2]int64{done, total})
+	}
+
+	// When
+	result, err := useCases.RunJob(ctx, job, progress)
+
+	// Then
+	require.NoError(t, err)
+	reprice := result.(*dto.RepriceJobResultDTO)
+	assert.Equal(t, 3, reprice.Matched)
+	assert.Equal(t, 1, reprice.Changed)
+	assert.Equal(t, 1, reprice.Unchanged)
+	assert.Equal(t, 1, reprice.Failed)
+	require.Len(t, reprice.Errors, 1)
+	assert.Equal(t, dto.RepriceErrorDTO{ProductID: 3, Code: domainErrors.ErrProductNotFound.Code, Message: domainErrors.ErrProductNotFound.Message}, reprice.Errors[0])
+	assert.Equal(t, [][2]int64{{1, 3}, {2, 3}, {3, 3}}, reported)
+	mockPriceHistory.AssertExpectations(t)
+}
+
+func TestJobUseCases_RunJob_Export(t *testing.T) {
+	// Given
+	useCases, mockJobs, mockProducts, _ := setupTestJobUseCases(t)
+	ctx := context.Background()
+
+	job, err := entities.NewJob(entities.JobProductExport, &dto.ExportRequestDTO{Columns: []string{"sku", "name"}}, 3, "", "", time.Now())
+	require.NoError(t, err)
+	job.ID = 12
+
+	mockProducts.On("Search", ctx, ports.ProductSearchCriteria{Limit: 1}).Return([]*entities.Product{}, int64(2), nil)
+	mockProducts.On("Each", ctx, ports.ProductSearchCriteria{}).Return(testExportProducts(t), nil)
+
+	var done, total int64
+	result, err := useCases.RunJob(ctx, job, func(d, t int64) { done, total = d, t })
+	require.NoError(t, err)
+	require.NoError(t, job.Succeed(result, time.Now()))
+	mockJobs.On("GetByID", ctx, uint(12)).Return(job, nil)
+
+	// When
+	file, content, err := useCases.OpenJobResult(ctx, 12)
+
+	// Then
+	require.NoError(t, err)
+	defer content.Close()
+	assert.Equal(t, &dto.ExportJobResultDTO{FileName: "products.csv", Format: entities.ExportCSV, Rows: 2}, file)
+	assert.Equal(t, int64(2), done)
+	assert.Equal(t, int64(2), total)
+
+	written, err := io.ReadAll(content)
+	require.NoError(t, err)
+	assert.Equal(t, "sku,name\nLAP-001,\"Laptop, 13\"\"\"\nLAP-002,Laptop Pro\n", string(written))
+}
+
+func TestJobUseCases_OpenJobResult_NotAvailable(t *testing.T) {
+	// Given
+	useCases, mockJobs, _, _ := setupTestJobUseCases(t)
+	ctx := context.Background()
+	mockJobs.On("GetByID", ctx, uint(3)).Return(&entities.Job{ID: 3, Type: entities.JobProductExport, Status: entities.JobRunning}, nil)
+	mockJobs.On("GetByID", ctx, uint(4)).Return(&entities.Job{ID: 4, Type: entities.JobFeedGenerate, Status: entities.JobSucceeded,
+		Result: json.RawMessage(`{"feeds":[]}`)}, nil)
+
+	for _, id := range []uint{3, 4} {
+		// When
+		_, _, err := useCases.OpenJobResult(ctx, id)
+
+		// Then
+		assert.Equal(t, domainErrors.ErrJobResultNotAvailable, err)
+	}
+}
+
+func TestJobUseCases_CancelJob(t *testing.T) {
+	// Given
+	useCases, mockJobs, _, _ := setupTestJobUseCases(t)
+	ctx := context.Background()
+	mockJobs.On("Update", ctx, uint(1)).Return(&entities.Job{ID: 1, Status: entities.JobRunning}, nil)
+	mockJobs.On("Update", ctx, uint(2)).Return(&entities.Job{ID: 2, Status: entities.JobSucceeded}, nil)
+
+	// When
+	running, err := useCases.CancelJob(ctx, 1)
+	_, finishedErr := useCases.CancelJob(ctx, 2)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, entities.JobRunning, running.Status)
+	assert.True(t, running.CancelRequested)
+	assert.Equal(t, domainErrors.ErrJobAlreadyFinished, finishedErr)
+}
+
+func TestJobUseCases_ListJobs_InvalidStatus(t *testing.T) {
+	// Given
+	useCases, mockJobs, _, _ := setupTestJobUseCases(t)
+
+	// When
+	_, err := useCases.ListJobs(context.Background(), &dto.JobQueryDTO{Status: "paused", PageSize: 10})
+
+	// Then
+	var domainErr *domainErrors.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, domainErrors.ErrInvalidJob.Code, domainErr.Code)
+	assert.Equal(t, "status", domainErr.Field)
+	mockJobs.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,363 @@
+package usecases
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	productErrors "product-service/internal/domain/errors"
+	"product-service/pkg/imaging"
+	"product-service/pkg/logger"
+	"sort"
+)
+
+// MediaOptions limits uploads and sizes their thumbnails
+type MediaOptions struct {
+	MaxUploadSize int64
+	// ThumbnailSize is the longest side of generated thumbnails, in pixels
+	ThumbnailSize int
+}
+
+// MediaUseCases defines the interface for product images and documents
+type MediaUseCases interface {
+	UploadMedia(ctx context.Context, productID uint, upload *dto.MediaUploadDTO) (*dto.MediaResponseDTO, error)
+	ListMedia(ctx context.Context, productID uint) ([]*dto.MediaResponseDTO, error)
+	UpdateMedia(ctx context.Context, productID, mediaID uint, request *dto.UpdateMediaRequestDTO) (*dto.MediaResponseDTO, error)
+	ReorderMedia(ctx context.Context, productID uint, request *dto.ReorderMediaRequestDTO) ([]*dto.MediaResponseDTO, error)
+	DeleteMedia(ctx context.Context, productID, mediaID uint) error
+	ApplyMedia(ctx context.Context, products []*dto.ProductResponseDTO) error
+	MaxUploadSize() int64
+}
+
+// mediaUseCasesImpl implements MediaUseCases interface
+type mediaUseCasesImpl struct {
+	mediaRepo   ports.MediaRepository
+	productRepo ports.ProductRepository
+	storage     ports.MediaStorage
+	options     MediaOptions
+	logger      logger.Logger
+}
+
+// NewMediaUseCases creates a new instance of media use cases
+func NewMediaUseCases(mediaRepo ports.MediaRepository, productRepo ports.ProductRepository, storage ports.MediaStorage, options MediaOptions, log logger.Logger) MediaUseCases {
+	return &mediaUseCasesImpl{
+		mediaRepo:   mediaRepo,
+		productRepo: productRepo,
+		storage:     storage,
+		options:     options,
+		logger:      log.With("component", "media_usecases"),
+	}
+}
+
+// MaxUploadSize is the largest accepted file, in bytes
+func (uc *mediaUseCasesImpl) MaxUploadSize() int64 {
+	return uc.options.MaxUploadSize
+}
+
+// UploadMedia stores a file and attaches it to a product. The content type
+// is sniffed from the bytes; images get their dimensions recorded and a
+// thumbnail. A product's first image becomes its primary image.
+func (uc *mediaUseCasesImpl) UploadMedia(ctx context.Context, productID uint, upload *dto.MediaUploadDTO) (*dto.MediaResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("UploadMedia use case called", "product_id", productID, "file_name", upload.FileName, "size", len(upload.Data))
+
+	if _, err := uc.productRepo.GetByID(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	size := int64(len(upload.Data))
+	if size == 0 {
+		return nil, productErrors.NewProductValidationError("file", "file is empty")
+	}
+	if size > uc.options.MaxUploadSize {
+		return nil, &productErrors.DomainError{
+			Code:    productErrors.ErrMediaTooLarge.Code,
+			Message: fmt.Sprintf("file is %d bytes, the limit is %d bytes", size, uc.options.MaxUploadSize),
+			Field:   "file",
+		}
+	}
+
+	contentType, _, _ := mime.ParseMediaType(http.DetectContentType(upload.Data))
+	if !entities.SupportedMediaType(contentType) {
+		return nil, &productErrors.DomainError{
+			Code:    productErrors.ErrUnsupportedMediaFormat.Code,
+			Message: fmt.Sprintf("%s files are not supported; upload JPEG, PNG, GIF or WebP images or PDF documents", contentType),
+			Field:   "file",
+		}
+	}
+
+	media, err := entities.NewProductMedia(productID, upload.FileName, contentType, size, upload.AltText)
+	if err != nil {
+		return nil, invalidMedia(err)
+	}
+
+	existing, err := uc.mediaRepo.ListMedia(ctx, productID)
+	if err != nil {
+		log.Error("Failed to list product media", "error", err, "product_id", productID)
+		return nil, err
+	}
+	media.Position = len(existing)
+	if upload.Primary || (media.IsImage() && entities.PrimaryMedia(existing) == nil) {
+		if err := media.MakePrimary(); err != nil {
+			return nil, invalidMedia(err)
+		}
+	}
+
+	var thumbnail *imaging.Thumbnail
+	if media.IsImage() {
+		thumbnail, err = uc.describeImage(media, upload.Data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	stored, err := uc.store(ctx, media, upload.Data, thumbnail)
+	if err != nil {
+		log.Error("Failed to store media", "error", err, "product_id", productID)
+		return nil, productErrors.ErrFailedToStoreMedia
+	}
+
+	created, err := uc.mediaRepo.Create(ctx, media)
+	if err != nil {
+		log.Error("Failed to save media", "error", err, "product_id", productID)
+		uc.discard(ctx, stored...)
+		return nil, err
+	}
+
+	log.Info("UploadMedia success", "product_id", productID, "media_id", created.ID, "content_type", contentType)
+	return dto.MediaToResponseDTO(created, uc.storage.URL), nil
+}
+
+// ListMedia returns a product's media in display order
+func (uc *mediaUseCasesImpl) ListMedia(ctx context.Context, productID uint) ([]*dto.MediaResponseDTO, error) {
+	if _, err := uc.productRepo.GetByID(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	media, err := uc.mediaRepo.ListMedia(ctx, productID)
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("Failed to list product media", "error", err, "product_id", productID)
+		return nil, err
+	}
+
+	return dto.MediaToResponseDTOs(media, uc.storage.URL), nil
+}
+
+// UpdateMedia changes the alt text or the primary flag of a product's media
+func (uc *mediaUseCasesImpl) UpdateMedia(ctx context.Context, productID, mediaID uint, request *dto.UpdateMediaRequestDTO) (*dto.MediaResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("UpdateMedia use case called", "product_id", productID, "media_id", mediaID)
+
+	media, err := uc.mediaRepo.GetMedia(ctx, productID, mediaID)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.AltText != nil {
+		if err := media.SetAltText(*request.AltText); err != nil {
+			return nil, invalidMedia(err)
+		}
+	}
+	if request.Primary != nil {
+		if *request.Primary {
+			if err := media.MakePrimary(); err != nil {
+				return nil, invalidMedia(err)
+			}
+		} else {
+			media.Primary = false
+		}
+	}
+
+	updated, err := uc.mediaRepo.Update(ctx, media)
+	if err != nil {
+		log.Error("Failed to update media", "error", err, "media_id", mediaID)
+		return nil, err
+	}
+
+	log.Info("UpdateMedia success", "product_id", productID, "media_id", mediaID)
+	return dto.MediaToResponseDTO(updated, uc.storage.URL), nil
+}
+
+// ReorderMedia sets the display order of all of a product's media
+func (uc *mediaUseCasesImpl) ReorderMedia(ctx context.Context, productID uint, request *dto.ReorderMediaRequestDTO) ([]*dto.MediaResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("ReorderMedia use case called", "product_id", productID, "media_ids", request.MediaIDs)
+
+	media, err := uc.mediaRepo.ListMedia(ctx, productID)
+	if err != nil {
+		log.Error("Failed to list product media", "error", err, "product_id", productID)
+		return nil, err
+	}
+
+	if err := entities.ReorderMedia(media, request.MediaIDs); err != nil {
+		return nil, &productErrors.DomainError{
+			Code:    productErrors.ErrInvalidMedia.Code,
+			Message: err.Error(),
+			Field:   "media_ids",
+		}
+	}
+
+	if err := uc.mediaRepo.SavePositions(ctx, productID, media); err != nil {
+		log.Error("Failed to save media order", "error", err, "product_id", productID)
+		return nil, err
+	}
+
+	sort.Slice(media, func(i, j int) bool { return media[i].Position < media[j].Position })
+	return dto.MediaToResponseDTOs(media, uc.storage.URL), nil
+}
+
+// DeleteMedia detaches media from a product and removes its files. When the
+// primary image goes, the next image in display order takes its place.
+func (uc *mediaUseCasesImpl) DeleteMedia(ctx context.Context, productID, mediaID uint) error {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("DeleteMedia use case called", "product_id", productID, "media_id", mediaID)
+
+	media, err := uc.mediaRepo.GetMedia(ctx, productID, mediaID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.mediaRepo.DeleteMedia(ctx, productID, mediaID); err != nil {
+		log.Error("Failed to delete media", "error", err, "media_id", mediaID)
+		return err
+	}
+	uc.discard(ctx, media.StorageKey, media.ThumbnailKey)
+
+	if !media.Primary {
+		return nil
+	}
+
+	remaining, err := uc.mediaRepo.ListMedia(ctx, productID)
+	if err != nil {
+		log.Error("Failed to list product media", "error", err, "product_id", productID)
+		return err
+	}
+	for _, next := range remaining {
+		if next.IsImage() {
+			_ = next.MakePrimary()
+			_, err := uc.mediaRepo.Update(ctx, next)
+			return err
+		}
+	}
+	return nil
+}
+
+// ApplyMedia attaches media and primary image URLs to product responses
+func (uc *mediaUseCasesImpl) ApplyMedia(ctx context.Context, products []*dto.ProductResponseDTO) error {
+	if len(products) == 0 {
+		return nil
+	}
+
+	ids := make([]uint, 0, len(products))
+	for _, product := range products {
+		ids = append(ids, product.ID)
+	}
+
+	media, err := uc.mediaRepo.ListMediaForProducts(ctx, ids)
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("Failed to load product media", "error", err)
+		return err
+	}
+
+	for _, product := range products {
+		if items := media[product.ID]; len(items) > 0 {
+			product.ApplyMedia(dto.MediaToResponseDTOs(items, uc.storage.URL))
+		}
+	}
+	return nil
+}
+
+// describeImage records the image's dimensions and renders its thumbnail.
+// Formats the standard library cannot decode, such as WebP, are kept
+// without either.
+func (uc *mediaUseCasesImpl) describeImage(media *entities.ProductMedia, data []byte) (*imaging.Thumbnail, error) {
+	width, height, err := imaging.Size(data)
+	if errors.Is(err, imaging.ErrUnsupportedFormat) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, &productErrors.DomainError{
+			Code:    productErrors.ErrInvalidMedia.Code,
+			Message: "the image could not be read",
+			Field:   "file",
+		}
+	}
+	media.Width, media.Height = width, height
+
+	thumbnail, err := imaging.MakeThumbnail(data, uc.options.ThumbnailSize)
+	if err != nil {
+		return nil, &productErrors.DomainError{
+			Code:    productErrors.ErrInvalidMedia.Code,
+			Message: err.Error(),
+			Field:   "file",
+		}
+	}
+	return thumbnail, nil
+}
+
+// store writes the file and its thumbnail under fresh keys, which it sets on
+// media, and returns the keys written
+func (uc *mediaUseCasesImpl) store(ctx context.Context, media *entities.ProductMedia, data []byte, thumbnail *imaging.Thumbnail) ([]string, error) {
+	name, err := randomName()
+	if err != nil {
+		return nil, err
+	}
+	base := fmt.Sprintf("products/%d/%s", media.ProductID, name)
+
+	media.StorageKey = base + media.Extension()
+	if err := uc.storage.Put(ctx, media.StorageKey, data, media.ContentType); err != nil {
+		return nil, err
+	}
+	if thumbnail == nil {
+		return []string{media.StorageKey}, nil
+	}
+
+	extension := ".png"
+	if thumbnail.ContentType == "image/jpeg" {
+		extension = ".jpg"
+	}
+	media.ThumbnailKey = base + "_thumb" + extension
+	if err := uc.storage.Put(ctx, media.ThumbnailKey, thumbnail.Data, thumbnail.ContentType); err != nil {
+		uc.discard(ctx, media.StorageKey)
+		return nil, err
+	}
+	return []string{media.StorageKey, media.ThumbnailKey}, nil
+}
+
+// discard deletes stored files; failures only leave orphaned files, so they
+// are logged rather than returned
+func (uc *mediaUseCasesImpl) discard(ctx context.Context, keys ...string) {
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+		if err := uc.storage.Delete(ctx, key); err != nil {
+			uc.logger.Ctx(ctx).Warn("Failed to delete stored media file", "error", err, "key", key)
+		}
+	}
+}
+
+func randomName() (string, error) {
+	buffer := make([]byte, 16)
+	if _, err := rand.Read(buffer); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buffer), nil
+}
+
+func invalidMedia(err error) error {
+	return &productErrors.DomainError{
+		Code:    productErrors.ErrInvalidMedia.Code,
+		Message: err.Error(),
+	}
+}
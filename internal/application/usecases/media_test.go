@@ -0,0 +1,244 @@
+package usecases
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/png"
+	"product-service/internal/application/dto"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockMediaRepository implements the MediaRepository interface for testing
+type MockMediaRepository struct {
+	mock.Mock
+}
+
+func (m *MockMediaRepository) Create(ctx context.Context, media *entities.ProductMedia) (*entities.ProductMedia, error) {
+	args := m.Called(ctx, media)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ProductMedia), args.Error(1)
+}
+
+func (m *MockMediaRepository) GetMedia(ctx context.Context, productID, mediaID uint) (*entities.ProductMedia, error) {
+	args := m.Called(ctx, productID, mediaID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ProductMedia), args.Error(1)
+}
+
+func (m *MockMediaRepository) ListMedia(ctx context.Context, productID uint) ([]*entities.ProductMedia, error) {
+	args := m.Called(ctx, productID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.ProductMedia), args.Error(1)
+}
+
+func (m *MockMediaRepository) ListMediaForProducts(ctx context.Context, productIDs []uint) (map[uint][]*entities.ProductMedia, error) {
+	args := m.Called(ctx, productIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[uint][]*entities.ProductMedia), args.Error(1)
+}
+
+func (m *MockMediaRepository) Update(ctx context.Context, media *entities.ProductMedia) (*entities.ProductMedia, error) {
+	args := m.Called(ctx, media)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ProductMedia), args.Error(1)
+}
+
+func (m *MockMediaRepository) SavePositions(ctx context.Context, productID uint, media []*entities.ProductMedia) error {
+	args := m.Called(ctx, productID, media)
+	return args.Error(0)
+}
+
+func (m *MockMediaRepository) DeleteMedia(ctx context.Context, productID, mediaID uint) error {
+	args := m.Called(ctx, productID, mediaID)
+	return args.Error(0)
+}
+
+// MockMediaStorage implements the MediaStorage interface for testing
+type MockMediaStorage struct {
+	mock.Mock
+}
+
+func (m *MockMediaStorage) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	args := m.Called(ctx, key, data, contentType)
+	return args.Error(0)
+}
+
+func (m *MockMediaStorage) Delete(ctx context.Context, key string) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+func (m *MockMediaStorage) URL(key string) string {
+	return "https://cdn.example.com/" + key
+}
+
+func setupTestMediaUseCases() (MediaUseCases, *MockMediaRepository, *MockMediaStorage, *MockProductRepository) {
+	mockMediaRepo := new(MockMediaRepository)
+	mockStorage := new(MockMediaStorage)
+	mockProductRepo := new(MockProductRepository)
+	useCases := NewMediaUseCases(mockMediaRepo, mockProductRepo, mockStorage, MediaOptions{MaxUploadSize: 1 << 20, ThumbnailSize: 16}, logger.New("test"))
+	return useCases, mockMediaRepo, mockStorage, mockProductRepo
+}
+
+func testPNG(t *testing.T, width, height int) []byte {
+	var buffer bytes.Buffer
+	require.NoError(t, png.Encode(&buffer, image.NewRGBA(image.Rect(0, 0, width, height))))
+	return buffer.Bytes()
+}
+
+func TestMediaUseCases_UploadMedia_FirstImageBecomesPrimary(t *testing.T) {
+	// Given
+	useCases, mockMediaRepo, mockStorage, mockProductRepo := setupTestMediaUseCases()
+	ctx := context.Background()
+	data := testPNG(t, 64, 32)
+
+	mockProductRepo.On("GetByID", ctx, uint(1)).Return(variantParent(), nil)
+	mockMediaRepo.On("ListMedia", ctx, uint(1)).Return([]*entities.ProductMedia{}, nil)
+	mockStorage.On("Put", ctx, mock.MatchedBy(func(key string) bool {
+		return strings.HasPrefix(key, "products/1/") && strings.HasSuffix(key, "_thumb.png")
+	}), mock.Anything, "image/png").Return(nil).Once()
+	mockStorage.On("Put", ctx, mock.MatchedBy(func(key string) bool {
+		return strings.HasPrefix(key, "products/1/") && strings.HasSuffix(key, ".png")
+	}), data, "image/png").Return(nil).Once()
+	mockMediaRepo.On("Create", ctx, mock.MatchedBy(func(media *entities.ProductMedia) bool {
+		return media.Primary && media.Width == 64 && media.Height == 32 && media.ThumbnailKey != "" && media.FileName == "front.png"
+	})).Return(&entities.ProductMedia{
+		ID: 7, ProductID: 1, Kind: entities.MediaKindImage, ContentType: "image/png", FileName: "front.png",
+		StorageKey: "products/1/f.png", ThumbnailKey: "products/1/f_thumb.png", Primary: true,
+	}, nil)
+
+	// When
+	result, err := useCases.UploadMedia(ctx, 1, &dto.MediaUploadDTO{FileName: "../front.png", Data: data, AltText: "Front view"})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, uint(7), result.ID)
+	assert.Equal(t, "image/png", result.ContentType)
+	assert.True(t, result.Primary)
+	assert.True(t, strings.HasPrefix(result.ThumbnailURL, "https://cdn.example.com/products/1/"))
+
+	mockMediaRepo.AssertExpectations(t)
+	mockStorage.AssertExpectations(t)
+}
+
+func TestMediaUseCases_UploadMedia_TooLarge(t *testing.T) {
+	// Given
+	useCases, mockMediaRepo, mockStorage, mockProductRepo := setupTestMediaUseCases()
+	ctx := context.Background()
+
+	mockProductRepo.On("GetByID", ctx, uint(1)).Return(variantParent(), nil)
+
+	// When
+	_, err := useCases.UploadMedia(ctx, 1, &dto.MediaUploadDTO{FileName: "huge.bin", Data: make([]byte, 2<<20)})
+
+	// Then
+	var domainErr *domainErrors.DomainError
+	require.True(t, errors.As(err, &domainErr))
+	assert.Equal(t, domainErrors.ErrMediaTooLarge.Code, domainErr.Code)
+	mockStorage.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockMediaRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestMediaUseCases_UploadMedia_UnsupportedFormat(t *testing.T) {
+	// Given
+	useCases, _, mockStorage, mockProductRepo := setupTestMediaUseCases()
+	ctx := context.Background()
+
+	mockProductRepo.On("GetByID", ctx, uint(1)).Return(variantParent(), nil)
+
+	// When: an HTML page named like an image is sniffed as HTML
+	_, err := useCases.UploadMedia(ctx, 1, &dto.MediaUploadDTO{FileName: "photo.jpg", Data: []byte("<html><body>hi</body></html>")})
+
+	// Then
+	var domainErr *domainErrors.DomainError
+	require.True(t, errors.As(err, &domainErr))
+	assert.Equal(t, domainErrors.ErrUnsupportedMediaFormat.Code, domainErr.Code)
+	mockStorage.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestMediaUseCases_UploadMedia_RemovesFilesWhenSaveFails(t *testing.T) {
+	// Given
+	useCases, mockMediaRepo, mockStorage, mockProductRepo := setupTestMediaUseCases()
+	ctx := context.Background()
+	data := []byte("%PDF-1.4 manual")
+
+	mockProductRepo.On("GetByID", ctx, uint(1)).Return(variantParent(), nil)
+	mockMediaRepo.On("ListMedia", ctx, uint(1)).Return([]*entities.ProductMedia{}, nil)
+	mockStorage.On("Put", ctx, mock.Anything, data, "application/pdf").Return(nil)
+	mockMediaRepo.On("Create", ctx, mock.Anything).Return(nil, errors.New("database down"))
+	mockStorage.On("Delete", ctx, mock.MatchedBy(func(key string) bool { return strings.HasSuffix(key, ".pdf") })).Return(nil)
+
+	// When
+	_, err := useCases.UploadMedia(ctx, 1, &dto.MediaUploadDTO{FileName: "manual.pdf", Data: data})
+
+	// Then
+	require.Error(t, err)
+	mockStorage.AssertExpectations(t)
+}
+
+func TestMediaUseCases_DeleteMedia_PromotesNextImage(t *testing.T) {
+	// Given
+	useCases, mockMediaRepo, mockStorage, _ := setupTestMediaUseCases()
+	ctx := context.Background()
+
+	primary := &entities.ProductMedia{ID: 1, ProductID: 1, Kind: entities.MediaKindImage, StorageKey: "products/1/a.png", ThumbnailKey: "products/1/a_thumb.png", Primary: true}
+	manual := &entities.ProductMedia{ID: 2, ProductID: 1, Kind: entities.MediaKindDocument, StorageKey: "products/1/b.pdf", Position: 1}
+	back := &entities.ProductMedia{ID: 3, ProductID: 1, Kind: entities.MediaKindImage, StorageKey: "products/1/c.png", Position: 2}
+
+	mockMediaRepo.On("GetMedia", ctx, uint(1), uint(1)).Return(primary, nil)
+	mockMediaRepo.On("DeleteMedia", ctx, uint(1), uint(1)).Return(nil)
+	mockStorage.On("Delete", ctx, "products/1/a.png").Return(nil)
+	mockStorage.On("Delete", ctx, "products/1/a_thumb.png").Return(nil)
+	mockMediaRepo.On("ListMedia", ctx, uint(1)).Return([]*entities.ProductMedia{manual, back}, nil)
+	mockMediaRepo.On("Update", ctx, mock.MatchedBy(func(media *entities.ProductMedia) bool {
+		return media.ID == 3 && media.Primary
+	})).Return(back, nil)
+
+	// When
+	err := useCases.DeleteMedia(ctx, 1, 1)
+
+	// Then
+	require.NoError(t, err)
+	mockMediaRepo.AssertExpectations(t)
+	mockStorage.AssertExpectations(t)
+}
+
+func TestMediaUseCases_ApplyMedia(t *testing.T) {
+	// Given
+	useCases, mockMediaRepo, _, _ := setupTestMediaUseCases()
+	ctx := context.Background()
+
+	products := []*dto.ProductResponseDTO{{ID: 1}, {ID: 2}}
+	mockMediaRepo.On("ListMediaForProducts", ctx, []uint{1, 2}).Return(map[uint][]*entities.ProductMedia{
+		1: {{ID: 5, ProductID: 1, Kind: entities.MediaKindImage, StorageKey: "products/1/a.png", ThumbnailKey: "products/1/a_thumb.png", Primary: true}},
+	}, nil)
+
+	// When
+	err := useCases.ApplyMedia(ctx, products)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "https://cdn.example.com/products/1/a.png", products[0].ImageURL)
+	assert.Equal(t, "https://cdn.example.com/products/1/a_thumb.png", products[0].ThumbnailURL)
+	assert.Empty(t, products[1].Media)
+}
@@ -0,0 +1,154 @@
+package usecases
+
+import (
+	"context"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	productErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+	"product-service/pkg/requestctx"
+	"time"
+)
+
+// PriceHistoryUseCases defines the interface for price history and scheduling operations
+type PriceHistoryUseCases interface {
+	GetPriceTimeline(ctx context.Context, productID uint) (*dto.PriceTimelineDTO, error)
+	SchedulePriceChange(ctx context.Context, productID uint, request *dto.SchedulePriceRequestDTO) (*dto.PriceChangeDTO, error)
+	CancelPriceChange(ctx context.Context, productID, changeID uint) (*dto.PriceChangeDTO, error)
+	ApplyDuePriceChanges(ctx context.Context, now time.Time) (int, error)
+}
+
+// duePriceChangesBatchSize bounds how many changes one scheduler run applies
+const duePriceChangesBatchSize = 100
+
+// priceHistoryUseCasesImpl implements PriceHistoryUseCases interface
+type priceHistoryUseCasesImpl struct {
+	priceHistoryRepo ports.PriceHistoryRepository
+	productRepo      ports.ProductRepository
+	logger           logger.Logger
+}
+
+// NewPriceHistoryUseCases creates a new instance of price history use cases
+func NewPriceHistoryUseCases(priceHistoryRepo ports.PriceHistoryRepository, productRepo ports.ProductRepository, log logger.Logger) PriceHistoryUseCases {
+	return &priceHistoryUseCasesImpl{
+		priceHistoryRepo: priceHistoryRepo,
+		productRepo:      productRepo,
+		logger:           log.With("component", "price_history_usecases"),
+	}
+}
+
+// GetPriceTimeline returns the price history and upcoming changes of a product
+func (uc *priceHistoryUseCasesImpl) GetPriceTimeline(ctx context.Context, productID uint) (*dto.PriceTimelineDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	product, err := uc.productRepo.GetByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := uc.priceHistoryRepo.ListPriceChanges(ctx, productID)
+	if err != nil {
+		log.Error("Failed to list price changes", "error", err, "product_id", productID)
+		return nil, err
+	}
+
+	return dto.NewPriceTimelineDTO(product, changes), nil
+}
+
+// SchedulePriceChange schedules a price for a future window
+func (uc *priceHistoryUseCasesImpl) SchedulePriceChange(ctx context.Context, productID uint, request *dto.SchedulePriceRequestDTO) (*dto.PriceChangeDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("SchedulePriceChange use case called", "product_id", productID, "price", request.Price.String(), "effective_from", request.EffectiveFrom)
+
+	product, err := uc.productRepo.GetByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the price on a copy so price errors are reported against the price field
+	candidate := *product
+	if err := candidate.UpdatePrice(request.Price); err != nil {
+		return nil, productErrors.NewProductValidationError("price", err.Error())
+	}
+
+	change, err := entities.NewScheduledPriceChange(productID, request.Price, request.Reason, requestctx.Actor(ctx), request.EffectiveFrom, request.EffectiveTo, time.Now())
+	if err != nil {
+		return nil, &productErrors.DomainError{
+			Code:    productErrors.ErrInvalidPriceSchedule.Code,
+			Message: err.Error(),
+			Field:   productErrors.ErrInvalidPriceSchedule.Field,
+		}
+	}
+
+	scheduled, err := uc.priceHistoryRepo.SchedulePriceChange(ctx, change)
+	if err != nil {
+		log.Error("Failed to schedule price change", "error", err, "product_id", productID)
+		return nil, productErrors.ErrFailedToUpdatePrice
+	}
+
+	log.Info("SchedulePriceChange success", "product_id", productID, "change_id", scheduled.ID)
+	return dto.PriceChangeToDTO(scheduled), nil
+}
+
+// CancelPriceChange withdraws a scheduled change before it takes effect
+func (uc *priceHistoryUseCasesImpl) CancelPriceChange(ctx context.Context, productID, changeID uint) (*dto.PriceChangeDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("CancelPriceChange use case called", "product_id", productID, "change_id", changeID)
+
+	change, err := uc.priceHistoryRepo.GetPriceChange(ctx, productID, changeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := change.Cancel(time.Now()); err != nil {
+		return nil, productErrors.ErrPriceChangeNotCancellable
+	}
+
+	if err := uc.priceHistoryRepo.SavePriceChange(ctx, change); err != nil {
+		log.Warn("Failed to cancel price change", "error", err, "change_id", changeID)
+		return nil, err
+	}
+
+	log.Info("CancelPriceChange success", "product_id", productID, "change_id", changeID)
+	return dto.PriceChangeToDTO(change), nil
+}
+
+// ApplyDuePriceChanges applies scheduled changes whose window has started and
+// restores prices whose window has ended, returning how many were processed
+func (uc *priceHistoryUseCasesImpl) ApplyDuePriceChanges(ctx context.Context, now time.Time) (int, error) {
+	log := uc.logger.Ctx(ctx)
+
+	processed, err := uc.priceHistoryRepo.ProcessDuePriceChanges(ctx, now, duePriceChangesBatchSize, func(change *entities.PriceChange, product *entities.Product) ([]*entities.PriceChange, error) {
+		previous := change.Status
+
+		restored, err := change.Advance(product, now)
+		if err != nil {
+			// a change that can never apply would otherwise be retried forever
+			log.Warn("Cancelling price change that cannot be applied", "error", err, "change_id", change.ID)
+			if cancelErr := change.Cancel(now); cancelErr != nil {
+				return nil, err
+			}
+			return nil, nil
+		}
+
+		log.Info("Price change processed",
+			"change_id", change.ID,
+			"product_id", product.ID,
+			"from_status", previous,
+			"to_status", change.Status)
+
+		if restored == nil {
+			return nil, nil
+		}
+		return []*entities.PriceChange{restored}, nil
+	})
+	if err != nil {
+		log.Error("Failed to apply due price changes", "error", err, "processed", processed)
+		return processed, err
+	}
+
+	return processed, nil
+}
@@ -0,0 +1,182 @@
+package usecases
+
+import (
+	"context"
+	"product-service/internal/application/dto"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+	"product-service/pkg/requestctx"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestPriceHistoryUseCases() (PriceHistoryUseCases, *MockPriceHistoryRepository, *MockProductRepository) {
+	mockHistory := new(MockPriceHistoryRepository)
+	mockProducts := new(MockProductRepository)
+	useCases := NewPriceHistoryUseCases(mockHistory, mockProducts, logger.New("test"))
+	return useCases, mockHistory, mockProducts
+}
+
+func TestPriceHistoryUseCases_GetPriceTimeline_SplitsHistoryAndUpcoming(t *testing.T) {
+	// Given
+	useCases, mockHistory, mockProducts := setupTestPriceHistoryUseCases()
+	ctx := context.Background()
+
+	now := time.Now()
+	product := &entities.Product{ID: 1, Price: entities.MustParseMoney("90.00", "USD")}
+	changes := []*entities.PriceChange{
+		{ID: 1, ProductID: 1, NewPrice: entities.MustParseMoney("100.00", "USD"), EffectiveFrom: now.Add(-48 * time.Hour), Status: entities.PriceChangeApplied},
+		{ID: 2, ProductID: 1, NewPrice: entities.MustParseMoney("90.00", "USD"), EffectiveFrom: now.Add(-time.Hour), Status: entities.PriceChangeApplied},
+		{ID: 3, ProductID: 1, NewPrice: entities.MustParseMoney("80.00", "USD"), EffectiveFrom: now.Add(time.Hour), Status: entities.PriceChangeScheduled},
+		{ID: 4, ProductID: 1, NewPrice: entities.MustParseMoney("70.00", "USD"), EffectiveFrom: now.Add(48 * time.Hour), Status: entities.PriceChangeScheduled},
+	}
+
+	mockProducts.On("GetByID", ctx, uint(1)).Return(product, nil)
+	mockHistory.On("ListPriceChanges", ctx, uint(1)).Return(changes, nil)
+
+	// When
+	timeline, err := useCases.GetPriceTimeline(ctx, 1)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, entities.MustParseMoney("90.00", "USD"), timeline.CurrentPrice)
+	require.Len(t, timeline.History, 2)
+	assert.Equal(t, uint(2), timeline.History[0].ID) // most recent first
+	assert.Equal(t, uint(1), timeline.History[1].ID)
+	require.Len(t, timeline.Upcoming, 2)
+	assert.Equal(t, uint(3), timeline.Upcoming[0].ID) // soonest first
+	assert.Equal(t, uint(4), timeline.Upcoming[1].ID)
+
+	mockHistory.AssertExpectations(t)
+	mockProducts.AssertExpectations(t)
+}
+
+func TestPriceHistoryUseCases_SchedulePriceChange_Success(t *testing.T) {
+	// Given
+	useCases, mockHistory, mockProducts := setupTestPriceHistoryUseCases()
+	ctx := requestctx.WithActor(context.Background(), "alice")
+
+	effectiveFrom := time.Now().Add(24 * time.Hour)
+	effectiveTo := effectiveFrom.Add(72 * time.Hour)
+	product := &entities.Product{ID: 1, Price: entities.MustParseMoney("100.00", "USD")}
+
+	mockProducts.On("GetByID", ctx, uint(1)).Return(product, nil)
+	mockHistory.On("SchedulePriceChange", ctx, mock.MatchedBy(func(change *entities.PriceChange) bool {
+		return change.Status == entities.PriceChangeScheduled && change.Actor == "alice" && change.EffectiveTo != nil
+	})).Return(&entities.PriceChange{
+		ID:            5,
+		ProductID:     1,
+		NewPrice:      entities.MustParseMoney("79.99", "USD"),
+		EffectiveFrom: effectiveFrom,
+		EffectiveTo:   &effectiveTo,
+		Status:        entities.PriceChangeScheduled,
+	}, nil)
+
+	// When
+	result, err := useCases.SchedulePriceChange(ctx, 1, &dto.SchedulePriceRequestDTO{
+		Price:         entities.MustParseMoney("79.99", "USD"),
+		Reason:        "Black Friday",
+		EffectiveFrom: effectiveFrom,
+		EffectiveTo:   &effectiveTo,
+	})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, uint(5), result.ID)
+	assert.Equal(t, entities.PriceChangeScheduled, result.Status)
+	assert.Equal(t, entities.MustParseMoney("100.00", "USD"), product.Price, "the current price must not change yet")
+
+	mockHistory.AssertExpectations(t)
+	mockProducts.AssertExpectations(t)
+}
+
+func TestPriceHistoryUseCases_SchedulePriceChange_InPast(t *testing.T) {
+	// Given
+	useCases, mockHistory, mockProducts := setupTestPriceHistoryUseCases()
+	ctx := context.Background()
+
+	mockProducts.On("GetByID", ctx, uint(1)).Return(&entities.Product{ID: 1, Price: entities.MustParseMoney("100.00", "USD")}, nil)
+
+	// When
+	result, err := useCases.SchedulePriceChange(ctx, 1, &dto.SchedulePriceRequestDTO{
+		Price:         entities.MustParseMoney("79.99", "USD"),
+		EffectiveFrom: time.Now().Add(-time.Hour),
+	})
+
+	// Then
+	assert.Nil(t, result)
+	var domainErr *domainErrors.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, domainErrors.ErrInvalidPriceSchedule.Code, domainErr.Code)
+	mockHistory.AssertNotCalled(t, "SchedulePriceChange", mock.Anything, mock.Anything)
+}
+
+func TestPriceHistoryUseCases_CancelPriceChange_AlreadyApplied(t *testing.T) {
+	// Given
+	useCases, mockHistory, _ := setupTestPriceHistoryUseCases()
+	ctx := context.Background()
+
+	mockHistory.On("GetPriceChange", ctx, uint(1), uint(5)).Return(&entities.PriceChange{
+		ID:        5,
+		ProductID: 1,
+		Status:    entities.PriceChangeApplied,
+	}, nil)
+
+	// When
+	result, err := useCases.CancelPriceChange(ctx, 1, 5)
+
+	// Then
+	assert.Nil(t, result)
+	assert.Equal(t, domainErrors.ErrPriceChangeNotCancellable, err)
+	mockHistory.AssertNotCalled(t, "SavePriceChange", mock.Anything, mock.Anything)
+}
+
+func TestPriceHistoryUseCases_ApplyDuePriceChanges_AppliesAndExpires(t *testing.T) {
+	// Given
+	useCases, mockHistory, _ := setupTestPriceHistoryUseCases()
+	ctx := context.Background()
+
+	now := time.Now()
+	ended := now.Add(-time.Minute)
+	product := &entities.Product{ID: 1, Price: entities.MustParseMoney("100.00", "USD")}
+	sale := &entities.PriceChange{
+		ID:            7,
+		ProductID:     1,
+		NewPrice:      entities.MustParseMoney("80.00", "USD"),
+		EffectiveFrom: now.Add(-time.Hour),
+		EffectiveTo:   &ended,
+		Status:        entities.PriceChangeScheduled,
+	}
+	upcoming := &entities.PriceChange{
+		ID:            8,
+		ProductID:     1,
+		NewPrice:      entities.MustParseMoney("95.00", "USD"),
+		EffectiveFrom: now.Add(-time.Second),
+		Status:        entities.PriceChangeScheduled,
+	}
+
+	var restored []*entities.PriceChange
+	mockHistory.On("ProcessDuePriceChanges", ctx, now, duePriceChangesBatchSize).
+		Return([]*entities.PriceChange{upcoming, sale}, product, nil)
+	mockHistory.On("savedPriceChanges", mock.Anything).Run(func(args mock.Arguments) {
+		restored = append(restored, args.Get(0).([]*entities.PriceChange)...)
+	}).Return()
+
+	// When
+	processed, err := useCases.ApplyDuePriceChanges(ctx, now)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 2, processed)
+	assert.Equal(t, entities.PriceChangeApplied, upcoming.Status)
+	assert.Equal(t, entities.PriceChangeExpired, sale.Status, "a window that ended before it was applied expires")
+	assert.Equal(t, entities.MustParseMoney("95.00", "USD"), product.Price)
+	assert.Empty(t, restored)
+
+	mockHistory.AssertExpectations(t)
+}
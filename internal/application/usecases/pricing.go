@@ -0,0 +1,281 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	productErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+	"strings"
+)
+
+// PricingUseCases defines the interface for price list and exchange rate operations
+type PricingUseCases interface {
+	CreatePriceList(ctx context.Context, request *dto.CreatePriceListRequestDTO) (*dto.PriceListResponseDTO, error)
+	ListPriceLists(ctx context.Context) ([]*dto.PriceListResponseDTO, error)
+	SetProductPrice(ctx context.Context, priceListCode string, productID uint, price entities.Money) (*dto.ProductPriceResponseDTO, error)
+	DeleteProductPrice(ctx context.Context, priceListCode string, productID uint, currency string) error
+	ListProductPrices(ctx context.Context, priceListCode string, productID uint) ([]*dto.ProductPriceResponseDTO, error)
+	ListExchangeRates(ctx context.Context) ([]*dto.ExchangeRateDTO, error)
+	ImportExchangeRates(ctx context.Context, rates []dto.ExchangeRateDTO) ([]*dto.ExchangeRateDTO, error)
+	DeleteExchangeRate(ctx context.Context, base, quote string) error
+	ResolvePrices(ctx context.Context, products []*dto.ProductResponseDTO, currency, priceListCode string) error
+}
+
+// pricingUseCasesImpl implements PricingUseCases interface
+type pricingUseCasesImpl struct {
+	priceListRepo    ports.PriceListRepository
+	exchangeRateRepo ports.ExchangeRateRepository
+	productRepo      ports.ProductRepository
+	logger           logger.Logger
+}
+
+// NewPricingUseCases creates a new instance of pricing use cases
+func NewPricingUseCases(priceListRepo ports.PriceListRepository, exchangeRateRepo ports.ExchangeRateRepository, productRepo ports.ProductRepository, log logger.Logger) PricingUseCases {
+	return &pricingUseCasesImpl{
+		priceListRepo:    priceListRepo,
+		exchangeRateRepo: exchangeRateRepo,
+		productRepo:      productRepo,
+		logger:           log.With("component", "pricing_usecases"),
+	}
+}
+
+// CreatePriceList creates a new price list
+func (uc *pricingUseCasesImpl) CreatePriceList(ctx context.Context, request *dto.CreatePriceListRequestDTO) (*dto.PriceListResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("CreatePriceList use case called", "code", request.Code)
+
+	priceList, err := request.ToEntity()
+	if err != nil {
+		return nil, productErrors.NewProductValidationError("code", err.Error())
+	}
+
+	created, err := uc.priceListRepo.CreatePriceList(ctx, priceList)
+	if err != nil {
+		log.Error("Failed to create price list", "error", err, "code", priceList.Code)
+		return nil, err
+	}
+
+	log.Info("CreatePriceList success", "code", created.Code, "id", created.ID)
+	return dto.PriceListToResponseDTO(created), nil
+}
+
+// ListPriceLists returns every price list
+func (uc *pricingUseCasesImpl) ListPriceLists(ctx context.Context) ([]*dto.PriceListResponseDTO, error) {
+	priceLists, err := uc.priceListRepo.ListPriceLists(ctx)
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("Failed to list price lists", "error", err)
+		return nil, err
+	}
+
+	response := make([]*dto.PriceListResponseDTO, 0, len(priceLists))
+	for _, priceList := range priceLists {
+		response = append(response, dto.PriceListToResponseDTO(priceList))
+	}
+	return response, nil
+}
+
+// SetProductPrice sets the explicit price of a product in the price's currency
+func (uc *pricingUseCasesImpl) SetProductPrice(ctx context.Context, priceListCode string, productID uint, price entities.Money) (*dto.ProductPriceResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("SetProductPrice use case called", "price_list", priceListCode, "product_id", productID, "price", price.String())
+
+	priceList, err := uc.priceListRepo.GetPriceListByCode(ctx, priceListCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.productRepo.GetByID(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	productPrice, err := entities.NewProductPrice(priceList.ID, productID, price)
+	if err != nil {
+		return nil, productErrors.NewProductValidationError("price", err.Error())
+	}
+
+	if err := uc.priceListRepo.SetProductPrice(ctx, productPrice); err != nil {
+		log.Error("Failed to set product price", "error", err, "product_id", productID)
+		return nil, productErrors.ErrFailedToUpdatePricing
+	}
+
+	log.Info("SetProductPrice success", "price_list", priceList.Code, "product_id", productID)
+	return dto.ProductPriceToResponseDTO(priceList, productPrice), nil
+}
+
+// DeleteProductPrice removes the explicit price of a product in one currency
+func (uc *pricingUseCasesImpl) DeleteProductPrice(ctx context.Context, priceListCode string, productID uint, currency string) error {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("DeleteProductPrice use case called", "price_list", priceListCode, "product_id", productID, "currency", currency)
+
+	if !entities.IsSupportedCurrency(currency) {
+		return productErrors.ErrUnsupportedCurrency
+	}
+
+	priceList, err := uc.priceListRepo.GetPriceListByCode(ctx, priceListCode)
+	if err != nil {
+		return err
+	}
+
+	return uc.priceListRepo.DeleteProductPrice(ctx, priceList.ID, productID, currency)
+}
+
+// ListProductPrices returns the explicit prices of a price list
+func (uc *pricingUseCasesImpl) ListProductPrices(ctx context.Context, priceListCode string, productID uint) ([]*dto.ProductPriceResponseDTO, error) {
+	priceList, err := uc.priceListRepo.GetPriceListByCode(ctx, priceListCode)
+	if err != nil {
+		return nil, err
+	}
+
+	prices, err := uc.priceListRepo.ListProductPrices(ctx, priceList.ID, productID)
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("Failed to list product prices", "error", err, "price_list", priceListCode)
+		return nil, err
+	}
+
+	response := make([]*dto.ProductPriceResponseDTO, 0, len(prices))
+	for _, price := range prices {
+		response = append(response, dto.ProductPriceToResponseDTO(priceList, price))
+	}
+	return response, nil
+}
+
+// ListExchangeRates returns every configured exchange rate
+func (uc *pricingUseCasesImpl) ListExchangeRates(ctx context.Context) ([]*dto.ExchangeRateDTO, error) {
+	rates, err := uc.exchangeRateRepo.ListRates(ctx)
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("Failed to list exchange rates", "error", err)
+		return nil, err
+	}
+
+	response := make([]*dto.ExchangeRateDTO, 0, len(rates))
+	for _, rate := range rates {
+		response = append(response, dto.ExchangeRateToDTO(rate))
+	}
+	return response, nil
+}
+
+// ImportExchangeRates validates every rate and stores them all or none
+func (uc *pricingUseCasesImpl) ImportExchangeRates(ctx context.Context, rates []dto.ExchangeRateDTO) ([]*dto.ExchangeRateDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("ImportExchangeRates use case called", "count", len(rates))
+
+	parsed := make([]*entities.ExchangeRate, 0, len(rates))
+	for i := range rates {
+		rate, err := rates[i].ToEntity()
+		if err != nil {
+			log.Warn("Invalid exchange rate", "error", err, "base", rates[i].BaseCurrency, "quote", rates[i].QuoteCurrency)
+			return nil, productErrors.NewProductValidationError("rates", err.Error())
+		}
+		parsed = append(parsed, rate)
+	}
+
+	if err := uc.exchangeRateRepo.UpsertRates(ctx, parsed); err != nil {
+		log.Error("Failed to store exchange rates", "error", err)
+		return nil, err
+	}
+
+	response := make([]*dto.ExchangeRateDTO, 0, len(parsed))
+	for _, rate := range parsed {
+		response = append(response, dto.ExchangeRateToDTO(rate))
+	}
+
+	log.Info("ImportExchangeRates success", "count", len(parsed))
+	return response, nil
+}
+
+// DeleteExchangeRate removes the rate from base to quote
+func (uc *pricingUseCasesImpl) DeleteExchangeRate(ctx context.Context, base, quote string) error {
+	uc.logger.Ctx(ctx).Info("DeleteExchangeRate use case called", "base", base, "quote", quote)
+
+	return uc.exchangeRateRepo.DeleteRate(ctx, base, quote)
+}
+
+// ResolvePrices sets ResolvedPrice on each product for the requested
+// currency. Explicit prices come from the named price list, or the default
+// list when priceListCode is empty; otherwise the base price is converted.
+func (uc *pricingUseCasesImpl) ResolvePrices(ctx context.Context, products []*dto.ProductResponseDTO, currency, priceListCode string) error {
+	log := uc.logger.Ctx(ctx)
+
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if !entities.IsSupportedCurrency(currency) {
+		return productErrors.ErrUnsupportedCurrency
+	}
+
+	priceList, err := uc.resolvePriceList(ctx, priceListCode)
+	if err != nil {
+		return err
+	}
+
+	rates := make(map[string]*entities.ExchangeRate)
+	for _, product := range products {
+		var explicit *entities.ProductPrice
+		if priceList != nil {
+			explicit, err = uc.priceListRepo.GetProductPrice(ctx, priceList.ID, product.ID, currency)
+			if err != nil && !errors.Is(err, productErrors.ErrProductPriceNotFound) {
+				log.Error("Failed to get product price", "error", err, "product_id", product.ID)
+				return err
+			}
+		}
+
+		var rate *entities.ExchangeRate
+		if explicit == nil && product.Price.Currency() != currency {
+			rate, err = uc.exchangeRate(ctx, rates, product.Price.Currency(), currency)
+			if err != nil {
+				return err
+			}
+		}
+
+		resolved, err := entities.ResolvePrice(product.Price, currency, priceList, explicit, rate)
+		if err != nil {
+			log.Error("Failed to resolve price", "error", err, "product_id", product.ID)
+			return productErrors.ErrExchangeRateNotFound
+		}
+		product.ResolvedPrice = dto.ResolvedPriceToDTO(resolved)
+	}
+
+	return nil
+}
+
+func (uc *pricingUseCasesImpl) resolvePriceList(ctx context.Context, code string) (*entities.PriceList, error) {
+	if code != "" {
+		return uc.priceListRepo.GetPriceListByCode(ctx, code)
+	}
+
+	priceList, err := uc.priceListRepo.GetDefaultPriceList(ctx)
+	if errors.Is(err, productErrors.ErrPriceListNotFound) {
+		return nil, nil
+	}
+	return priceList, err
+}
+
+// exchangeRate looks up base→quote, falling back to the inverse of quote→base,
+// memoizing results for the duration of one request
+func (uc *pricingUseCasesImpl) exchangeRate(ctx context.Context, cache map[string]*entities.ExchangeRate, base, quote string) (*entities.ExchangeRate, error) {
+	key := base + "/" + quote
+	if rate, ok := cache[key]; ok {
+		return rate, nil
+	}
+
+	rate, err := uc.exchangeRateRepo.GetRate(ctx, base, quote)
+	if errors.Is(err, productErrors.ErrExchangeRateNotFound) {
+		var inverse *entities.ExchangeRate
+		inverse, err = uc.exchangeRateRepo.GetRate(ctx, quote, base)
+		if err == nil {
+			rate = inverse.Invert()
+		}
+	}
+	if err != nil {
+		uc.logger.Ctx(ctx).Warn("Exchange rate unavailable", "error", err, "base", base, "quote", quote)
+		return nil, err
+	}
+
+	cache[key] = rate
+	return rate, nil
+}
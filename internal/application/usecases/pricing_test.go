@@ -0,0 +1,317 @@
+package usecases
+
+import (
+	"context"
+	"product-service/internal/application/dto"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockPriceListRepository implements the PriceListRepository interface for testing
+type MockPriceListRepository struct {
+	mock.Mock
+}
+
+func (m *MockPriceListRepository) CreatePriceList(ctx context.Context, priceList *entities.PriceList) (*entities.PriceList, error) {
+	args := m.Called(ctx, priceList)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.PriceList), args.Error(1)
+}
+
+func (m *MockPriceListRepository) GetPriceListByCode(ctx context.Context, code string) (*entities.PriceList, error) {
+	args := m.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.PriceList), args.Error(1)
+}
+
+func (m *MockPriceListRepository) GetDefaultPriceList(ctx context.Context) (*entities.PriceList, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.PriceList), args.Error(1)
+}
+
+func (m *MockPriceListRepository) ListPriceLists(ctx context.Context) ([]*entities.PriceList, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.PriceList), args.Error(1)
+}
+
+func (m *MockPriceListRepository) SetProductPrice(ctx context.Context, price *entities.ProductPrice) error {
+	args := m.Called(ctx, price)
+	return args.Error(0)
+}
+
+func (m *MockPriceListRepository) DeleteProductPrice(ctx context.Context, priceListID, productID uint, currency string) error {
+	args := m.Called(ctx, priceListID, productID, currency)
+	return args.Error(0)
+}
+
+func (m *MockPriceListRepository) GetProductPrice(ctx context.Context, priceListID, productID uint, currency string) (*entities.ProductPrice, error) {
+	args := m.Called(ctx, priceListID, productID, currency)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ProductPrice), args.Error(1)
+}
+
+func (m *MockPriceListRepository) ListProductPrices(ctx context.Context, priceListID, productID uint) ([]*entities.ProductPrice, error) {
+	args := m.Called(ctx, priceListID, productID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.ProductPrice), args.Error(1)
+}
+
+// MockExchangeRateRepository implements the ExchangeRateRepository interface for testing
+type MockExchangeRateRepository struct {
+	mock.Mock
+}
+
+func (m *MockExchangeRateRepository) UpsertRates(ctx context.Context, rates []*entities.ExchangeRate) error {
+	args := m.Called(ctx, rates)
+	return args.Error(0)
+}
+
+func (m *MockExchangeRateRepository) GetRate(ctx context.Context, base, quote string) (*entities.ExchangeRate, error) {
+	args := m.Called(ctx, base, quote)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ExchangeRate), args.Error(1)
+}
+
+func (m *MockExchangeRateRepository) ListRates(ctx context.Context) ([]*entities.ExchangeRate, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.ExchangeRate), args.Error(1)
+}
+
+func (m *MockExchangeRateRepository) DeleteRate(ctx context.Context, base, quote string) error {
+	args := m.Called(ctx, base, quote)
+	return args.Error(0)
+}
+
+func setupTestPricingUseCases() (PricingUseCases, *MockPriceListRepository, *MockExchangeRateRepository, *MockProductRepository) {
+	priceListRepo := new(MockPriceListRepository)
+	exchangeRateRepo := new(MockExchangeRateRepository)
+	productRepo := new(MockProductRepository)
+	useCases := NewPricingUseCases(priceListRepo, exchangeRateRepo, productRepo, logger.New("test"))
+	return useCases, priceListRepo, exchangeRateRepo, productRepo
+}
+
+func mustExchangeRate(t *testing.T, base, quote, rate string) *entities.ExchangeRate {
+	t.Helper()
+	exchangeRate, err := entities.NewExchangeRate(base, quote, rate)
+	require.NoError(t, err)
+	return exchangeRate
+}
+
+// ResolvePrices Tests
+func TestPricingUseCases_ResolvePrices_ExplicitAndConverted(t *testing.T) {
+	// Given
+	useCases, priceListRepo, exchangeRateRepo, _ := setupTestPricingUseCases()
+	ctx := context.Background()
+
+	retail := &entities.PriceList{ID: 3, Code: "retail", IsDefault: true}
+	products := []*dto.ProductResponseDTO{
+		{ID: 1, Price: entities.MustParseMoney("100.00", "USD")},
+		{ID: 2, Price: entities.MustParseMoney("50.00", "USD")},
+	}
+
+	priceListRepo.On("GetDefaultPriceList", ctx).Return(retail, nil)
+	priceListRepo.On("GetProductPrice", ctx, uint(3), uint(1), "EUR").
+		Return(&entities.ProductPrice{PriceListID: 3, ProductID: 1, Price: entities.MustParseMoney("89.00", "EUR")}, nil)
+	priceListRepo.On("GetProductPrice", ctx, uint(3), uint(2), "EUR").
+		Return(nil, domainErrors.ErrProductPriceNotFound)
+	exchangeRateRepo.On("GetRate", ctx, "USD", "EUR").Return(mustExchangeRate(t, "USD", "EUR", "0.9"), nil).Once()
+
+	// When
+	err := useCases.ResolvePrices(ctx, products, "eur", "")
+
+	// Then
+	require.NoError(t, err)
+
+	require.NotNil(t, products[0].ResolvedPrice)
+	assert.Equal(t, entities.MustParseMoney("89.00", "EUR"), products[0].ResolvedPrice.Price)
+	assert.Equal(t, entities.PriceSourceExplicit, products[0].ResolvedPrice.Source)
+	assert.False(t, products[0].ResolvedPrice.Converted)
+	assert.Equal(t, "retail", products[0].ResolvedPrice.PriceList)
+
+	require.NotNil(t, products[1].ResolvedPrice)
+	assert.Equal(t, entities.MustParseMoney("45.00", "EUR"), products[1].ResolvedPrice.Price)
+	assert.True(t, products[1].ResolvedPrice.Converted)
+	assert.Equal(t, "0.9", products[1].ResolvedPrice.ExchangeRate)
+
+	priceListRepo.AssertExpectations(t)
+	exchangeRateRepo.AssertExpectations(t)
+}
+
+func TestPricingUseCases_ResolvePrices_InverseRate(t *testing.T) {
+	// Given
+	useCases, priceListRepo, exchangeRateRepo, _ := setupTestPricingUseCases()
+	ctx := context.Background()
+
+	products := []*dto.ProductResponseDTO{{ID: 1, Price: entities.MustParseMoney("8025.10", "COP")}}
+
+	priceListRepo.On("GetDefaultPriceList", ctx).Return(nil, domainErrors.ErrPriceListNotFound)
+	exchangeRateRepo.On("GetRate", ctx, "COP", "USD").Return(nil, domainErrors.ErrExchangeRateNotFound)
+	exchangeRateRepo.On("GetRate", ctx, "USD", "COP").Return(mustExchangeRate(t, "USD", "COP", "4012.55"), nil)
+
+	// When
+	err := useCases.ResolvePrices(ctx, products, "USD", "")
+
+	// Then
+	require.NoError(t, err)
+	require.NotNil(t, products[0].ResolvedPrice)
+	assert.Equal(t, entities.MustParseMoney("2.00", "USD"), products[0].ResolvedPrice.Price)
+	assert.True(t, products[0].ResolvedPrice.Converted)
+}
+
+func TestPricingUseCases_ResolvePrices_MissingRate(t *testing.T) {
+	// Given
+	useCases, priceListRepo, exchangeRateRepo, _ := setupTestPricingUseCases()
+	ctx := context.Background()
+
+	products := []*dto.ProductResponseDTO{{ID: 1, Price: entities.MustParseMoney("10.00", "USD")}}
+
+	priceListRepo.On("GetDefaultPriceList", ctx).Return(nil, domainErrors.ErrPriceListNotFound)
+	exchangeRateRepo.On("GetRate", ctx, "USD", "COP").Return(nil, domainErrors.ErrExchangeRateNotFound)
+	exchangeRateRepo.On("GetRate", ctx, "COP", "USD").Return(nil, domainErrors.ErrExchangeRateNotFound)
+
+	// When
+	err := useCases.ResolvePrices(ctx, products, "COP", "")
+
+	// Then
+	assert.ErrorIs(t, err, domainErrors.ErrExchangeRateNotFound)
+	assert.Nil(t, products[0].ResolvedPrice)
+}
+
+func TestPricingUseCases_ResolvePrices_UnsupportedCurrency(t *testing.T) {
+	// Given
+	useCases, _, _, _ := setupTestPricingUseCases()
+
+	// When
+	err := useCases.ResolvePrices(context.Background(), nil, "XYZ", "")
+
+	// Then
+	assert.ErrorIs(t, err, domainErrors.ErrUnsupportedCurrency)
+}
+
+func TestPricingUseCases_ResolvePrices_UnknownPriceList(t *testing.T) {
+	// Given
+	useCases, priceListRepo, _, _ := setupTestPricingUseCases()
+	ctx := context.Background()
+
+	priceListRepo.On("GetPriceListByCode", ctx, "wholesale").Return(nil, domainErrors.ErrPriceListNotFound)
+
+	// When
+	err := useCases.ResolvePrices(ctx, []*dto.ProductResponseDTO{{ID: 1}}, "EUR", "wholesale")
+
+	// Then
+	assert.ErrorIs(t, err, domainErrors.ErrPriceListNotFound)
+}
+
+// SetProductPrice Tests
+func TestPricingUseCases_SetProductPrice_Success(t *testing.T) {
+	// Given
+	useCases, priceListRepo, _, productRepo := setupTestPricingUseCases()
+	ctx := context.Background()
+
+	retail := &entities.PriceList{ID: 3, Code: "retail"}
+	price := entities.MustParseMoney("349900", "COP")
+
+	priceListRepo.On("GetPriceListByCode", ctx, "retail").Return(retail, nil)
+	productRepo.On("GetByID", ctx, uint(1)).Return(&entities.Product{ID: 1}, nil)
+	priceListRepo.On("SetProductPrice", ctx, mock.MatchedBy(func(p *entities.ProductPrice) bool {
+		return p.PriceListID == 3 && p.ProductID == 1 && p.Price.Equal(price)
+	})).Return(nil)
+
+	// When
+	result, err := useCases.SetProductPrice(ctx, "retail", 1, price)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "retail", result.PriceList)
+	assert.Equal(t, price, result.Price)
+
+	priceListRepo.AssertExpectations(t)
+	productRepo.AssertExpectations(t)
+}
+
+func TestPricingUseCases_SetProductPrice_ProductNotFound(t *testing.T) {
+	// Given
+	useCases, priceListRepo, _, productRepo := setupTestPricingUseCases()
+	ctx := context.Background()
+
+	priceListRepo.On("GetPriceListByCode", ctx, "retail").Return(&entities.PriceList{ID: 3, Code: "retail"}, nil)
+	productRepo.On("GetByID", ctx, uint(99)).Return(nil, domainErrors.ErrProductNotFound)
+
+	// When
+	result, err := useCases.SetProductPrice(ctx, "retail", 99, entities.MustParseMoney("10", "EUR"))
+
+	// Then
+	assert.ErrorIs(t, err, domainErrors.ErrProductNotFound)
+	assert.Nil(t, result)
+	priceListRepo.AssertNotCalled(t, "SetProductPrice", mock.Anything, mock.Anything)
+}
+
+// ImportExchangeRates Tests
+func TestPricingUseCases_ImportExchangeRates_RejectsWholeBatchOnInvalidRate(t *testing.T) {
+	// Given
+	useCases, _, exchangeRateRepo, _ := setupTestPricingUseCases()
+
+	rates := []dto.ExchangeRateDTO{
+		{BaseCurrency: "USD", QuoteCurrency: "EUR", Rate: "0.92"},
+		{BaseCurrency: "USD", QuoteCurrency: "COP", Rate: "-1"},
+	}
+
+	// When
+	result, err := useCases.ImportExchangeRates(context.Background(), rates)
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	exchangeRateRepo.AssertNotCalled(t, "UpsertRates", mock.Anything, mock.Anything)
+}
+
+func TestPricingUseCases_ImportExchangeRates_Success(t *testing.T) {
+	// Given
+	useCases, _, exchangeRateRepo, _ := setupTestPricingUseCases()
+	ctx := context.Background()
+
+	rates := []dto.ExchangeRateDTO{
+		{BaseCurrency: "usd", QuoteCurrency: "eur", Rate: "0.92"},
+		{BaseCurrency: "USD", QuoteCurrency: "COP", Rate: "4012.55"},
+	}
+
+	exchangeRateRepo.On("UpsertRates", ctx, mock.MatchedBy(func(stored []*entities.ExchangeRate) bool {
+		return len(stored) == 2 && stored[0].QuoteCurrency == "EUR" && stored[1].QuoteCurrency == "COP"
+	})).Return(nil)
+
+	// When
+	result, err := useCases.ImportExchangeRates(ctx, rates)
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "EUR", result[0].QuoteCurrency)
+	assert.Equal(t, "4012.55", result[1].Rate)
+
+	exchangeRateRepo.AssertExpectations(t)
+}
@@ -3,304 +3,664 @@ package usecases
 import (
 	"context"
 	"errors"
+	"fmt"
 	"product-service/internal/application/dto"
 	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
 	productErrors "product-service/internal/domain/errors"
 	"product-service/pkg/logger"
+	"product-service/pkg/requestctx"
 	"strings"
+	"time"
 )
 
+// maxSKUGenerationAttempts bounds how many numbers a SKU template draws
+// looking for a SKU nobody typed in by hand
+const maxSKUGenerationAttempts = 5
+
 // ProductUseCases defines the interface for product business operations
 type ProductUseCases interface {
 	CreateProduct(ctx context.Context, request *dto.CreateProductRequestDTO) (*dto.ProductResponseDTO, error)
 	GetProductByID(ctx context.Context, id uint) (*dto.ProductResponseDTO, error)
 	GetProductBySKU(ctx context.Context, sku string) (*dto.ProductResponseDTO, error)
+	GetProductByGTIN(ctx context.Context, gtin string) (*dto.ProductResponseDTO, error)
+	GetProductAsOf(ctx context.Context, id uint, at time.Time) (*dto.ProductResponseDTO, error)
+	GetProductBySKUAsOf(ctx context.Context, sku string, at time.Time) (*dto.ProductResponseDTO, error)
 	UpdateProduct(ctx context.Context, id uint, request *dto.UpdateProductRequestDTO) (*dto.ProductResponseDTO, error)
 	UpdateProductStock(ctx context.Context, id uint, stock int) (*dto.ProductResponseDTO, error)
-	UpdateProductPrice(ctx context.Context, id uint, price float64) (*dto.ProductResponseDTO, error)
+	UpdateProductPrice(ctx context.Context, id uint, price entities.Money, reason string) (*dto.ProductResponseDTO, error)
 	ActivateProduct(ctx context.Context, id uint) (*dto.ProductResponseDTO, error)
 	DeactivateProduct(ctx context.Context, id uint) (*dto.ProductResponseDTO, error)
 	DiscontinueProduct(ctx context.Context, id uint) (*dto.ProductResponseDTO, error)
-	ListProducts(ctx context.Context, page, pageSize int) (*dto.ProductListResponseDTO, error)
+	TransitionProduct(ctx context.Context, id uint, request *dto.TransitionRequestDTO) (*dto.ProductResponseDTO, error)
+	ListTransitions(ctx context.Context, id uint) (*dto.TransitionListResponseDTO, error)
+	ListProducts(ctx context.Context, page, pageSize int, tags []string) (*dto.ProductListResponseDTO, error)
+	SearchProducts(ctx context.Context, request *dto.ProductSearchRequestDTO) (*dto.ProductListResponseDTO, error)
 }
 
 // productUseCasesImpl implements ProductUseCases interface
 type productUseCasesImpl struct {
-	productRepo ports.ProductRepository
-	logger      logger.Logger
+	*productEditor
+	productRepo      ports.ProductRepository
+	priceHistoryRepo ports.PriceHistoryRepository
+	lifecycleRepo    ports.LifecycleRepository
+	logger           logger.Logger
 }
 
 // NewProductUseCases creates a new instance of product use cases
-func NewProductUseCases(productRepo ports.ProductRepository, log logger.Logger) ProductUseCases {
+func NewProductUseCases(productRepo ports.ProductRepository, priceHistoryRepo ports.PriceHistoryRepository, lifecycleRepo ports.LifecycleRepository, attributeRepo ports.AttributeRepository, categoryRepo ports.CategoryRepository, brandRepo ports.BrandRepository, log logger.Logger) ProductUseCases {
+	log = log.With("component", "product_usecases")
 	return &productUseCasesImpl{
-		productRepo: productRepo,
-		logger:      log.With("component", "product_usecases"),
+		productEditor:    newProductEditor(attributeRepo, categoryRepo, brandRepo, log),
+		productRepo:      productRepo,
+		priceHistoryRepo: priceHistoryRepo,
+		lifecycleRepo:    lifecycleRepo,
+		logger:           log,
 	}
 }
 
+// CreateProduct creates a product. Without a SKU, one is generated from the
+// SKU template of the product's category.
 func (uc *productUseCasesImpl) CreateProduct(ctx context.Context, request *dto.CreateProductRequestDTO) (*dto.ProductResponseDTO, error) {
-	uc.logger.Info("CreateProduct use case called", "sku", request.SKU)
+	log := uc.logger.Ctx(ctx)
 
-	// Validate SKU format
-	if err := validateSKU(request.SKU); err != nil {
-		return nil, productErrors.ErrInvalidProductSKU
-	}
+	log.Info("CreateProduct use case called", "sku", request.SKU)
 
-	// Check if product with this SKU already exists
-	exists, err := uc.productRepo.ExistsBySKU(ctx, request.SKU)
-	if err != nil {
-		uc.logger.Error("Failed to check product existence", "error", err, "sku", request.SKU)
-		return nil, productErrors.ErrFailedToCheckProductExistance
-	}
+	if strings.TrimSpace(request.SKU) == "" {
+		generated, err := uc.generateSKU(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		request = generated
+	} else {
+		// Validate SKU format
+		if err := entities.ValidateSKU(request.SKU); err != nil {
+			return nil, productErrors.ErrInvalidProductSKU
+		}
 
-	if exists {
-		return nil, productErrors.ErrProductAlreadyExists
+		// Check if product with this SKU already exists
+		exists, err := uc.productRepo.ExistsBySKU(ctx, request.SKU)
+		if err != nil {
+			log.Error("Failed to check product existence", "error", err, "sku", request.SKU)
+			return nil, productErrors.ErrFailedToCheckProductExistance
+		}
+
+		if exists {
+			return nil, productErrors.ErrProductAlreadyExists
+		}
 	}
 
-	// Convert DTO to domain entity
-	domainEntity, err := request.ToEntity()
+	domainEntity, err := uc.newProduct(ctx, request)
 	if err != nil {
-		uc.logger.Error("Failed to convert DTO to entity", "error", err)
+		return nil, err
+	}
+
+	if err := uc.ensureGTINFree(ctx, uc.productRepo, domainEntity.GTIN, 0); err != nil {
 		return nil, err
 	}
 
 	// Create product
 	createdProduct, err := uc.productRepo.Create(ctx, domainEntity)
 	if err != nil {
-		uc.logger.Error("Failed to create product", "error", err, "sku", request.SKU)
+		log.Error("Failed to create product", "error", err, "sku", request.SKU)
 		switch {
 		case errors.Is(err, productErrors.ErrFailedToCheckProductExistance):
 			return nil, productErrors.ErrFailedToCheckProductExistance
+		case errors.Is(err, productErrors.ErrProductGTINAlreadyExists):
+			return nil, productErrors.ErrProductGTINAlreadyExists
 		default:
 			return nil, productErrors.ErrFailedToCreateProduct
 		}
 	}
 
-	uc.logger.Info("CreateProduct success", "sku", request.SKU, "id", createdProduct.ID)
+	log.Info("CreateProduct success", "sku", request.SKU, "id", createdProduct.ID)
 	return dto.ProductToResponseDTO(createdProduct), nil
 }
 
+// generateSKU returns a copy of request with a SKU drawn from the SKU
+// template of its category. Numbers whose SKU was already typed in by hand
+// are skipped.
+func (uc *productUseCasesImpl) generateSKU(ctx context.Context, request *dto.CreateProductRequestDTO) (*dto.CreateProductRequestDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	category, err := uc.productCategory(ctx, request.Category)
+	if err != nil {
+		return nil, err
+	}
+	if category.SKUTemplate == "" {
+		return nil, &productErrors.DomainError{
+			Code:    productErrors.ErrInvalidProductSKU.Code,
+			Message: fmt.Sprintf("SKU is required, category %s has no SKU template", category.Path),
+			Field:   "sku",
+		}
+	}
+	template, err := entities.ParseSKUTemplate(category.SKUTemplate)
+	if err != nil {
+		log.Error("Invalid SKU template", "error", err, "category_id", category.ID)
+		return nil, productErrors.ErrFailedToGenerateSKU
+	}
+
+	brand := ""
+	if template.UsesBrand() {
+		if strings.TrimSpace(request.Brand) == "" {
+			return nil, &productErrors.DomainError{
+				Code:    productErrors.ErrInvalidProductBrand.Code,
+				Message: fmt.Sprintf("brand is required, the SKU template %s of category %s uses it", template, category.Path),
+				Field:   "brand",
+			}
+		}
+		resolved, err := uc.productBrand(ctx, request.Brand)
+		if err != nil {
+			return nil, err
+		}
+		brand = resolved.Name
+	}
+
+	for attempt := 0; attempt < maxSKUGenerationAttempts; attempt++ {
+		sequence, err := uc.categoryRepo.NextSKUSequence(ctx, category.ID)
+		if err != nil {
+			log.Error("Failed to draw SKU sequence", "error", err, "category_id", category.ID)
+			return nil, productErrors.ErrFailedToGenerateSKU
+		}
+		sku, err := template.Render(brand, category.Name, sequence)
+		if err != nil {
+			return nil, &productErrors.DomainError{
+				Code:    productErrors.ErrInvalidProductSKU.Code,
+				Message: err.Error(),
+				Field:   "sku",
+			}
+		}
+
+		exists, err := uc.productRepo.ExistsBySKU(ctx, sku)
+		if err != nil {
+			log.Error("Failed to check product existence", "error", err, "sku", sku)
+			return nil, productErrors.ErrFailedToCheckProductExistance
+		}
+		if !exists {
+			generated := *request
+			generated.SKU = sku
+			log.Info("SKU generated", "sku", sku, "category_id", category.ID)
+			return &generated, nil
+		}
+	}
+
+	log.Error("Every generated SKU was taken", "category_id", category.ID, "attempts", maxSKUGenerationAttempts)
+	return nil, productErrors.ErrFailedToGenerateSKU
+}
+
 // GetProductByID retrieves a product by its ID
 func (uc *productUseCasesImpl) GetProductByID(ctx context.Context, id uint) (*dto.ProductResponseDTO, error) {
-	uc.logger.Info("GetProductByID use case called", "product_id", id)
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("GetProductByID use case called", "product_id", id)
 
 	product, err := uc.productRepo.GetByID(ctx, id)
 	if err != nil {
-		uc.logger.Error("Failed to get product by ID", "error", err, "product_id", id)
+		log.Error("Failed to get product by ID", "error", err, "product_id", id)
 		return nil, err
 	}
 
-	uc.logger.Info("GetProductByID success", "product_id", id)
+	log.Info("GetProductByID success", "product_id", id)
 	return dto.ProductToResponseDTO(product), nil
 }
 
 // GetProductBySKU retrieves a product by its SKU
 func (uc *productUseCasesImpl) GetProductBySKU(ctx context.Context, sku string) (*dto.ProductResponseDTO, error) {
-	uc.logger.Info("GetProductBySKU use case called", "sku", sku)
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("GetProductBySKU use case called", "sku", sku)
 
 	product, err := uc.productRepo.GetBySKU(ctx, sku)
 	if err != nil {
-		uc.logger.Error("Failed to get product by SKU", "error", err, "sku", sku)
+		log.Error("Failed to get product by SKU", "error", err, "sku", sku)
 		return nil, err
 	}
 
-	uc.logger.Info("GetProductBySKU success", "product_id", product.ID, "sku", sku)
+	log.Info("GetProductBySKU success", "product_id", product.ID, "sku", sku)
 	return dto.ProductToResponseDTO(product), nil
 }
 
-// UpdateProduct updates an existing product
-func (uc *productUseCasesImpl) UpdateProduct(ctx context.Context, id uint, request *dto.UpdateProductRequestDTO) (*dto.ProductResponseDTO, error) {
-	uc.logger.Info("UpdateProduct use case called", "product_id", id)
+// GetProductByGTIN retrieves a product by its barcode in any GTIN format
+func (uc *productUseCasesImpl) GetProductByGTIN(ctx context.Context, gtin string) (*dto.ProductResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("GetProductByGTIN use case called", "gtin", gtin)
 
-	// Get existing product
-	existingProduct, err := uc.productRepo.GetByID(ctx, id)
+	normalized, err := entities.NormalizeGTIN(gtin)
 	if err != nil {
-		uc.logger.Error("Failed to get existing product", "error", err, "product_id", id)
-		return nil, err
+		return nil, invalidGTIN(err)
 	}
 
-	// Update fields if provided
-	if request.Name != "" {
-		existingProduct.Name = request.Name
+	product, err := uc.productRepo.GetByGTIN(ctx, normalized)
+	if err != nil {
+		log.Error("Failed to get product by GTIN", "error", err, "gtin", normalized)
+		return nil, err
 	}
 
-	if request.Description != "" {
-		existingProduct.Description = request.Description
-	}
+	log.Info("GetProductByGTIN success", "product_id", product.ID, "gtin", normalized)
+	return dto.ProductToResponseDTO(product), nil
+}
 
-	if request.Category != "" {
-		existingProduct.Category = request.Category
-	}
+// GetProductAsOf retrieves the state a product had at the given time
+func (uc *productUseCasesImpl) GetProductAsOf(ctx context.Context, id uint, at time.Time) (*dto.ProductResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
 
-	if request.Brand != "" {
-		existingProduct.Brand = request.Brand
+	log.Info("GetProductAsOf use case called", "product_id", id, "as_of", at)
+
+	product, err := uc.productRepo.GetByIDAsOf(ctx, id, at)
+	if err != nil {
+		log.Error("Failed to get product version", "error", err, "product_id", id, "as_of", at)
+		return nil, err
 	}
 
-	if request.Price != nil {
-		if err := existingProduct.UpdatePrice(*request.Price); err != nil {
-			return nil, productErrors.NewProductValidationError("price", err.Error())
-		}
+	return asOfResponse(product, at), nil
+}
+
+// GetProductBySKUAsOf retrieves the state of the product that had the SKU at
+// the given time
+func (uc *productUseCasesImpl) GetProductBySKUAsOf(ctx context.Context, sku string, at time.Time) (*dto.ProductResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("GetProductBySKUAsOf use case called", "sku", sku, "as_of", at)
+
+	product, err := uc.productRepo.GetBySKUAsOf(ctx, sku, at)
+	if err != nil {
+		log.Error("Failed to get product version by SKU", "error", err, "sku", sku, "as_of", at)
+		return nil, err
 	}
 
-	if request.Stock != nil {
-		if err := existingProduct.UpdateStock(*request.Stock); err != nil {
-			return nil, productErrors.NewProductValidationError("stock", err.Error())
+	return asOfResponse(product, at), nil
+}
+
+// asOfResponse converts a historical product state, marking the time it was
+// read at
+func asOfResponse(product *entities.Product, at time.Time) *dto.ProductResponseDTO {
+	response := dto.ProductToResponseDTO(product)
+	response.AsOf = &at
+	return response
+}
+
+// UpdateProduct updates an existing product
+func (uc *productUseCasesImpl) UpdateProduct(ctx context.Context, id uint, request *dto.UpdateProductRequestDTO) (*dto.ProductResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("UpdateProduct use case called", "product_id", id)
+
+	updatedProduct, err := uc.productRepo.ApplyEdit(ctx, id, func(product *entities.Product) ([]*entities.PriceChange, error) {
+		priceChanges, err := uc.applyChanges(ctx, product, request.ToChanges())
+		if err != nil {
+			return nil, err
+		}
+		if request.GTIN != nil {
+			if err := uc.ensureGTINFree(ctx, uc.productRepo, product.GTIN, id); err != nil {
+				return nil, err
+			}
 		}
+		return priceChanges, nil
+	})
+	if err != nil {
+		log.Error("Failed to update product", "error", err, "product_id", id)
+		var domainErr *productErrors.DomainError
+		if errors.As(err, &domainErr) {
+			return nil, err
+		}
+		return nil, productErrors.ErrFailedToUpdateProduct
 	}
 
-	// Update product in repository (assuming we add Update method to interface)
-	updatedProduct := existingProduct // For now, since Update method is not in the current interface
-
-	uc.logger.Info("UpdateProduct success", "product_id", id)
+	log.Info("UpdateProduct success", "product_id", id)
 	return dto.ProductToResponseDTO(updatedProduct), nil
 }
 
 // UpdateProductStock updates only the stock of a product
 func (uc *productUseCasesImpl) UpdateProductStock(ctx context.Context, id uint, stock int) (*dto.ProductResponseDTO, error) {
-	uc.logger.Info("UpdateProductStock use case called", "product_id", id, "stock", stock)
+	log := uc.logger.Ctx(ctx)
 
-	// Get existing product
-	product, err := uc.productRepo.GetByID(ctx, id)
-	if err != nil {
-		uc.logger.Error("Failed to get product", "error", err, "product_id", id)
-		return nil, err
-	}
+	log.Info("UpdateProductStock use case called", "product_id", id, "stock", stock)
 
-	// Update stock using domain method
-	if err := product.UpdateStock(stock); err != nil {
-		uc.logger.Error("Failed to update stock", "error", err, "product_id", id)
-		return nil, productErrors.NewProductValidationError("stock", err.Error())
+	product, err := uc.productRepo.ApplyEdit(ctx, id, func(product *entities.Product) ([]*entities.PriceChange, error) {
+		// Update stock using domain method
+		if err := product.UpdateStock(stock); err != nil {
+			return nil, productErrors.NewProductValidationError("stock", err.Error())
+		}
+		return nil, nil
+	})
+	if err != nil {
+		log.Error("Failed to update stock", "error", err, "product_id", id)
+		var domainErr *productErrors.DomainError
+		if errors.As(err, &domainErr) {
+			return nil, err
+		}
+		return nil, productErrors.ErrFailedToUpdateStock
 	}
 
-	// In a real implementation, you would save to repository here
-	// updatedProduct, err := uc.productRepo.Update(ctx, product)
-
-	uc.logger.Info("UpdateProductStock success", "product_id", id, "new_stock", stock)
+	log.Info("UpdateProductStock success", "product_id", id, "new_stock", stock)
 	return dto.ProductToResponseDTO(product), nil
 }
 
-// UpdateProductPrice updates only the price of a product
-func (uc *productUseCasesImpl) UpdateProductPrice(ctx context.Context, id uint, price float64) (*dto.ProductResponseDTO, error) {
-	uc.logger.Info("UpdateProductPrice use case called", "product_id", id, "price", price)
+// UpdateProductPrice updates only the price of a product, recording the
+// change in the price history together with the caller and reason
+func (uc *productUseCasesImpl) UpdateProductPrice(ctx context.Context, id uint, price entities.Money, reason string) (*dto.ProductResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
 
-	// Get existing product
-	product, err := uc.productRepo.GetByID(ctx, id)
-	if err != nil {
-		uc.logger.Error("Failed to get product", "error", err, "product_id", id)
-		return nil, err
-	}
+	log.Info("UpdateProductPrice use case called", "product_id", id, "price", price.String())
 
-	// Update price using domain method
-	if err := product.UpdatePrice(price); err != nil {
-		uc.logger.Error("Failed to update price", "error", err, "product_id", id)
-		return nil, productErrors.NewProductValidationError("price", err.Error())
+	product, err := uc.priceHistoryRepo.ApplyPriceChange(ctx, id, func(product *entities.Product) ([]*entities.PriceChange, error) {
+		// Update price using domain method
+		change, err := entities.ChangePrice(product, price, reason, requestctx.Actor(ctx), time.Now())
+		if err != nil {
+			return nil, productErrors.NewProductValidationError("price", err.Error())
+		}
+		return []*entities.PriceChange{change}, nil
+	})
+	if err != nil {
+		log.Error("Failed to update price", "error", err, "product_id", id)
+		var domainErr *productErrors.DomainError
+		if errors.As(err, &domainErr) {
+			return nil, err
+		}
+		return nil, productErrors.ErrFailedToUpdatePrice
 	}
 
-	// In a real implementation, you would save to repository here
-	// updatedProduct, err := uc.productRepo.Update(ctx, product)
-
-	uc.logger.Info("UpdateProductPrice success", "product_id", id, "new_price", price)
+	log.Info("UpdateProductPrice success", "product_id", id, "new_price", price.String())
 	return dto.ProductToResponseDTO(product), nil
 }
 
-// ActivateProduct activates a product
+// ActivateProduct moves a draft or inactive product to active
 func (uc *productUseCasesImpl) ActivateProduct(ctx context.Context, id uint) (*dto.ProductResponseDTO, error) {
-	uc.logger.Info("ActivateProduct use case called", "product_id", id)
+	return uc.TransitionProduct(ctx, id, &dto.TransitionRequestDTO{Status: entities.ProductStatusActive})
+}
 
-	// Get existing product
-	product, err := uc.productRepo.GetByID(ctx, id)
+// DeactivateProduct moves an active product to inactive
+func (uc *productUseCasesImpl) DeactivateProduct(ctx context.Context, id uint) (*dto.ProductResponseDTO, error) {
+	return uc.TransitionProduct(ctx, id, &dto.TransitionRequestDTO{Status: entities.ProductStatusInactive})
+}
+
+// DiscontinueProduct moves an active or inactive product to discontinued
+func (uc *productUseCasesImpl) DiscontinueProduct(ctx context.Context, id uint) (*dto.ProductResponseDTO, error) {
+	return uc.TransitionProduct(ctx, id, &dto.TransitionRequestDTO{Status: entities.ProductStatusDiscontinued})
+}
+
+// TransitionProduct moves a product through its lifecycle, recording the
+// transition with the new status
+func (uc *productUseCasesImpl) TransitionProduct(ctx context.Context, id uint, request *dto.TransitionRequestDTO) (*dto.ProductResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("TransitionProduct use case called", "product_id", id, "status", request.Status)
+
+	var from entities.ProductStatus
+	product, err := uc.lifecycleRepo.ApplyTransition(ctx, id, func(product *entities.Product) (*entities.StatusTransition, error) {
+		from = product.Status
+		return transitionProduct(ctx, product, request.Status, request.Reason)
+	})
 	if err != nil {
-		uc.logger.Error("Failed to get product", "error", err, "product_id", id)
+		log.Error("Failed to transition product", "error", err, "product_id", id, "status", request.Status)
 		return nil, err
 	}
 
-	// Activate product using domain method
-	product.Activate()
-
-	// In a real implementation, you would save to repository here
-	// updatedProduct, err := uc.productRepo.Update(ctx, product)
-
-	uc.logger.Info("ActivateProduct success", "product_id", id)
+	log.Info("TransitionProduct success", "product_id", id, "from", from, "to", product.Status)
 	return dto.ProductToResponseDTO(product), nil
 }
 
-// DeactivateProduct deactivates a product
-func (uc *productUseCasesImpl) DeactivateProduct(ctx context.Context, id uint) (*dto.ProductResponseDTO, error) {
-	uc.logger.Info("DeactivateProduct use case called", "product_id", id)
-
-	// Get existing product
+// ListTransitions returns a product's status history and the statuses it
+// can move to next
+func (uc *productUseCasesImpl) ListTransitions(ctx context.Context, id uint) (*dto.TransitionListResponseDTO, error) {
 	product, err := uc.productRepo.GetByID(ctx, id)
 	if err != nil {
-		uc.logger.Error("Failed to get product", "error", err, "product_id", id)
 		return nil, err
 	}
 
-	// Deactivate product using domain method
-	product.Deactivate()
-
-	// In a real implementation, you would save to repository here
-	// updatedProduct, err := uc.productRepo.Update(ctx, product)
+	transitions, err := uc.lifecycleRepo.ListTransitions(ctx, id)
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("Failed to list status transitions", "error", err, "product_id", id)
+		return nil, err
+	}
 
-	uc.logger.Info("DeactivateProduct success", "product_id", id)
-	return dto.ProductToResponseDTO(product), nil
+	return &dto.TransitionListResponseDTO{
+		ProductID:   product.ID,
+		Status:      product.Status,
+		Allowed:     entities.AllowedTransitions(product.Status),
+		Transitions: transitions,
+	}, nil
 }
 
-// DiscontinueProduct discontinues a product
-func (uc *productUseCasesImpl) DiscontinueProduct(ctx context.Context, id uint) (*dto.ProductResponseDTO, error) {
-	uc.logger.Info("DiscontinueProduct use case called", "product_id", id)
+// ListProducts returns one page of products, newest first, optionally only
+// those carrying every one of tags
+func (uc *productUseCasesImpl) ListProducts(ctx context.Context, page, pageSize int, tags []string) (*dto.ProductListResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
 
-	// Get existing product
-	product, err := uc.productRepo.GetByID(ctx, id)
-	if err != nil {
-		uc.logger.Error("Failed to get product", "error", err, "product_id", id)
-		return nil, err
+	log.Info("ListProducts use case called", "page", page, "page_size", pageSize, "tags", tags)
+
+	if page < 0 {
+		page = 0
 	}
 
-	// Discontinue product using domain method
-	product.Discontinue()
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	criteria := ports.ProductSearchCriteria{
+		Limit:  pageSize,
+		Offset: page * pageSize,
+	}
+	if len(tags) > 0 {
+		normalized, err := entities.NormalizeTags(tags)
+		if err != nil {
+			return nil, invalidTags(err)
+		}
+		criteria.Tags = normalized
+	}
+
+	products, total, err := uc.productRepo.Search(ctx, criteria)
+	if err != nil {
+		log.Error("Failed to list products", "error", err)
+		return nil, productErrors.ErrFailedToSearchProducts
+	}
 
-	// In a real implementation, you would save to repository here
-	// updatedProduct, err := uc.productRepo.Update(ctx, product)
+	log.Info("ListProducts success", "page", page, "page_size", pageSize, "count", len(products))
 
-	uc.logger.Info("DiscontinueProduct success", "product_id", id)
-	return dto.ProductToResponseDTO(product), nil
+	return &dto.ProductListResponseDTO{
+		Products: dto.ProductsToResponseDTOs(products),
+		Page:     page,
+		PageSize: pageSize,
+		Total:    int(total),
+	}, nil
 }
 
-// ListProducts retrieves a paginated list of products
-func (uc *productUseCasesImpl) ListProducts(ctx context.Context, page, pageSize int) (*dto.ProductListResponseDTO, error) {
-	uc.logger.Info("ListProducts use case called", "page", page, "page_size", pageSize)
+// SearchProducts returns one page of products matching the request's filters,
+// including custom attribute filters typed by the attribute schema
+func (uc *productUseCasesImpl) SearchProducts(ctx context.Context, request *dto.ProductSearchRequestDTO) (*dto.ProductListResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
 
+	log.Info("SearchProducts use case called", "query", request.Query, "category", request.Category, "page", request.Page)
+
+	page := request.Page
 	if page < 0 {
 		page = 0
 	}
-
+	pageSize := request.PageSize
 	if pageSize < 1 || pageSize > 100 {
 		pageSize = 10
 	}
 
-	products := []*dto.ProductResponseDTO{}
+	criteria, err := uc.searchCriteria(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	criteria.Limit = pageSize
+	criteria.Offset = page * pageSize
 
-	uc.logger.Info("ListProducts success", "page", page, "page_size", pageSize, "count", len(products))
+	products, total, err := uc.productRepo.Search(ctx, criteria)
+	if err != nil {
+		log.Error("Failed to search products", "error", err)
+		return nil, productErrors.ErrFailedToSearchProducts
+	}
 
+	log.Info("SearchProducts success", "count", len(products), "total", total)
 	return &dto.ProductListResponseDTO{
-		Products: products,
+		Products: dto.ProductsToResponseDTOs(products),
+		Total:    int(total),
 		Page:     page,
 		PageSize: pageSize,
-		Total:    len(products),
 	}, nil
 }
 
-// validateSKU validates SKU format
-func validateSKU(sku string) error {
-	sku = strings.TrimSpace(sku)
-	if sku == "" {
-		return errors.New("SKU is required")
+// searchCriteria resolves the filters of a search request: brand aliases,
+// category subtrees, tags and typed attribute filters. Paging is left to the
+// caller.
+func (e *productEditor) searchCriteria(ctx context.Context, request *dto.ProductSearchRequestDTO) (ports.ProductSearchCriteria, error) {
+	log := e.logger.Ctx(ctx)
+
+	criteria := ports.ProductSearchCriteria{
+		Query:    strings.TrimSpace(request.Query),
+		Brand:    request.Brand,
+		MinPrice: request.MinPrice,
+		MaxPrice: request.MaxPrice,
+		InStock:  request.InStock,
+		Status:   request.Status,
+	}
+
+	// Aliases find the products stored under the canonical brand name
+	if request.Brand != "" {
+		brand, err := e.brandRepo.FindByName(ctx, request.Brand)
+		switch {
+		case err == nil:
+			criteria.Brand = brand.Name
+		case !errors.Is(err, productErrors.ErrBrandNotFound):
+			log.Error("Failed to resolve brand", "error", err, "brand", request.Brand)
+			return ports.ProductSearchCriteria{}, productErrors.ErrFailedToSearchProducts
+		}
+	}
+
+	// A category filter covers the category and all its descendants
+	var subtree []*entities.Category
+	if request.CategoryID != nil || request.Category != "" {
+		category, err := e.searchCategory(ctx, request)
+		if err != nil {
+			return ports.ProductSearchCriteria{}, err
+		}
+		subtree, err = e.categoryRepo.ListSubtree(ctx, category.Path)
+		if err != nil {
+			log.Error("Failed to list category subtree", "error", err, "category_id", category.ID)
+			return ports.ProductSearchCriteria{}, productErrors.ErrFailedToSearchProducts
+		}
+		criteria.CategoryPath = category.Path
+	}
+
+	if len(request.Tags) > 0 {
+		tags, err := entities.NormalizeTags(request.Tags)
+		if err != nil {
+			return ports.ProductSearchCriteria{}, invalidTags(err)
+		}
+		criteria.Tags = tags
+	}
+
+	if len(request.Attributes) > 0 {
+		filters, err := e.attributeFilters(ctx, subtree, request.Attributes)
+		if err != nil {
+			return ports.ProductSearchCriteria{}, err
+		}
+		criteria.Attributes = filters
+	}
+
+	return criteria, nil
+}
+
+// attributeFilters types each requested filter by its definition in the
+// schemas of the searched categories. Without a category filter the key is
+// looked up across every category's schema.
+func (e *productEditor) attributeFilters(ctx context.Context, categories []*entities.Category, requested []dto.AttributeFilterDTO) ([]entities.AttributeFilter, error) {
+	categoryIDs := make([]uint, 0, len(categories))
+	for _, category := range categories {
+		categoryIDs = append(categoryIDs, category.ID)
+	}
+
+	schema, err := e.attributeRepo.ListDefinitions(ctx, categoryIDs...)
+	if err != nil {
+		e.logger.Ctx(ctx).Error("Failed to load attribute schema", "error", err, "category_ids", categoryIDs)
+		return nil, productErrors.ErrFailedToSearchProducts
+	}
+
+	filters := make([]entities.AttributeFilter, 0, len(requested))
+	for _, filter := range requested {
+		var definition *entities.AttributeDefinition
+		for _, candidate := range schema {
+			if candidate.Key == filter.Key {
+				definition = candidate
+				break
+			}
+		}
+		if definition == nil {
+			return nil, productErrors.NewProductValidationError("attributes."+filter.Key, "unknown attribute")
+		}
+
+		operator := filter.Operator
+		if operator == "" {
+			operator = entities.AttributeEquals
+		}
+		typed, err := entities.NewAttributeFilter(definition, operator, filter.Value)
+		if err != nil {
+			return nil, productErrors.NewProductValidationError("attributes."+filter.Key, err.Error())
+		}
+		filters = append(filters, typed)
+	}
+	return filters, nil
+}
+
+// searchCategory resolves the category a search filters on
+func (e *productEditor) searchCategory(ctx context.Context, request *dto.ProductSearchRequestDTO) (*entities.Category, error) {
+	if request.CategoryID == nil {
+		return e.productCategory(ctx, request.Category)
+	}
+
+	category, err := e.categoryRepo.GetByID(ctx, *request.CategoryID)
+	if errors.Is(err, productErrors.ErrCategoryNotFound) {
+		return nil, &productErrors.DomainError{
+			Code:    productErrors.ErrInvalidProductCategory.Code,
+			Message: fmt.Sprintf("category %d does not exist", *request.CategoryID),
+			Field:   "category_id",
+		}
+	}
+	return category, err
+}
+
+func invalidTags(err error) error {
+	return &productErrors.DomainError{
+		Code:    productErrors.ErrInvalidTag.Code,
+		Message: err.Error(),
+		Field:   "tags",
+	}
+}
+
+// transitionProduct moves product to status on behalf of the caller in ctx
+func transitionProduct(ctx context.Context, product *entities.Product, status entities.ProductStatus, reason string) (*entities.StatusTransition, error) {
+	if !product.CanTransitionTo(status) {
+		return nil, invalidTransition(product.Status, status)
+	}
+	transition, err := product.TransitionTo(status, reason, requestctx.Actor(ctx))
+	if err != nil {
+		return nil, &productErrors.DomainError{
+			Code:    productErrors.ErrTransitionGuardFailed.Code,
+			Message: err.Error(),
+			Field:   "status",
+		}
+	}
+	return transition, nil
+}
+
+func invalidTransition(from, to entities.ProductStatus) error {
+	var allowed []string
+	for _, status := range entities.AllowedTransitions(from) {
+		allowed = append(allowed, string(status))
 	}
-	if len(sku) < 3 {
-		return errors.New("SKU must be at least 3 characters long")
+	message := fmt.Sprintf("cannot move a product from %s to %s", from, to)
+	if len(allowed) > 0 {
+		message += fmt.Sprintf("; allowed: %s", strings.Join(allowed, ", "))
+	} else {
+		message += fmt.Sprintf("; %s is final", from)
 	}
-	if len(sku) > 50 {
-		return errors.New("SKU must be less than 50 characters")
+	return &productErrors.DomainError{
+		Code:    productErrors.ErrInvalidStatusTransition.Code,
+		Message: message,
+		Field:   "status",
 	}
-	return nil
 }
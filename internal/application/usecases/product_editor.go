@@ -0,0 +1,283 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	productErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+	"product-service/pkg/requestctx"
+	"time"
+)
+
+// productEditor applies edits to products the way product requests name
+// them: categories by path or name, brands by name or alias and attributes
+// checked against the category schema. Direct updates, imports and published
+// revisions share it so they all validate edits alike.
+type productEditor struct {
+	attributeRepo ports.AttributeRepository
+	categoryRepo  ports.CategoryRepository
+	brandRepo     ports.BrandRepository
+	logger        logger.Logger
+}
+
+func newProductEditor(attributeRepo ports.AttributeRepository, categoryRepo ports.CategoryRepository, brandRepo ports.BrandRepository, log logger.Logger) *productEditor {
+	return &productEditor{
+		attributeRepo: attributeRepo,
+		categoryRepo:  categoryRepo,
+		brandRepo:     brandRepo,
+		logger:        log,
+	}
+}
+
+// newProduct builds the product a creation request describes, validating it
+// as product creation does. The SKU is checked against the category's SKU
+// pattern; its format and uniqueness are checked by the caller.
+func (e *productEditor) newProduct(ctx context.Context, request *dto.CreateProductRequestDTO) (*entities.Product, error) {
+	// Convert DTO to domain entity
+	product, err := request.ToEntity()
+	if err != nil {
+		e.logger.Ctx(ctx).Error("Failed to convert DTO to entity", "error", err)
+		return nil, err
+	}
+
+	if err := product.SetGTIN(request.GTIN); err != nil {
+		return nil, invalidGTIN(err)
+	}
+
+	if err := e.prepareProduct(ctx, product, request.Attributes, request.Tags); err != nil {
+		return nil, err
+	}
+	return product, nil
+}
+
+// prepareProduct files a new product under its category and brand and sets
+// its attributes and tags, validating them as product creation does. Product
+// creation and imports share it.
+func (e *productEditor) prepareProduct(ctx context.Context, product *entities.Product, attributes entities.Attributes, tags []string) error {
+	// File the product under an existing category
+	category, err := e.productCategory(ctx, product.Category)
+	if err != nil {
+		return err
+	}
+	if err := checkCategorySKU(category, product.SKU); err != nil {
+		return err
+	}
+	product.AssignCategory(category)
+
+	// Store the brand under its canonical name
+	if product.Brand != "" {
+		brand, err := e.productBrand(ctx, product.Brand)
+		if err != nil {
+			return err
+		}
+		product.AssignBrand(brand)
+	}
+
+	// Validate custom attributes against the category schema
+	if err := e.applyAttributes(ctx, product, attributes); err != nil {
+		return err
+	}
+
+	if err := product.SetTags(tags); err != nil {
+		return invalidTags(err)
+	}
+	return nil
+}
+
+// ensureGTINFree fails when a product other than id already has gtin
+func (e *productEditor) ensureGTINFree(ctx context.Context, productRepo ports.ProductRepository, gtin string, id uint) error {
+	if gtin == "" {
+		return nil
+	}
+
+	exists, err := productRepo.ExistsByGTIN(ctx, gtin, id)
+	if err != nil {
+		e.logger.Ctx(ctx).Error("Failed to check GTIN existence", "error", err, "gtin", gtin)
+		return productErrors.ErrFailedToCheckProductExistance
+	}
+	if exists {
+		return productErrors.ErrProductGTINAlreadyExists
+	}
+	return nil
+}
+
+// applyChanges sets the changed fields on product, validating them as a
+// product update does. A new price is set with entities.ChangePrice; the
+// price history entry it returns must be saved with the product.
+func (e *productEditor) applyChanges(ctx context.Context, product *entities.Product, changes entities.ProductChanges) ([]*entities.PriceChange, error) {
+	if changes.Name != nil {
+		product.Name = *changes.Name
+	}
+
+	if changes.Description != nil {
+		product.Description = *changes.Description
+	}
+
+	categoryChanged := false
+	if changes.Category != nil {
+		category, err := e.productCategory(ctx, *changes.Category)
+		if err != nil {
+			return nil, err
+		}
+		categoryChanged = category.ID != product.CategoryID
+		// a product moving in must follow the SKU rules of its new category
+		if categoryChanged {
+			if err := checkCategorySKU(category, product.SKU); err != nil {
+				return nil, err
+			}
+		}
+		product.AssignCategory(category)
+	}
+
+	if changes.Brand != nil {
+		brand, err := e.productBrand(ctx, *changes.Brand)
+		if err != nil {
+			return nil, err
+		}
+		product.AssignBrand(brand)
+	}
+
+	if changes.GTIN != nil {
+		if err := product.SetGTIN(*changes.GTIN); err != nil {
+			return nil, invalidGTIN(err)
+		}
+	}
+
+	var priceChanges []*entities.PriceChange
+	if changes.Price != nil && !changes.Price.Equal(product.Price) {
+		change, err := entities.ChangePrice(product, *changes.Price, "", requestctx.Actor(ctx), time.Now())
+		if err != nil {
+			return nil, productErrors.NewProductValidationError("price", err.Error())
+		}
+		priceChanges = append(priceChanges, change)
+	}
+
+	if changes.Stock != nil {
+		if err := product.UpdateStock(*changes.Stock); err != nil {
+			return nil, productErrors.NewProductValidationError("stock", err.Error())
+		}
+	}
+
+	// Attributes are revalidated when they change or the category, and with
+	// it the schema, does
+	if changes.Attributes != nil || categoryChanged {
+		if err := e.applyAttributes(ctx, product, mergeAttributes(product.Attributes, changes.Attributes)); err != nil {
+			return nil, err
+		}
+	}
+
+	if changes.Tags != nil {
+		if err := product.SetTags(changes.Tags); err != nil {
+			return nil, invalidTags(err)
+		}
+	}
+
+	return priceChanges, nil
+}
+
+// applyAttributes validates values against the schema of the product's
+// category and sets them on the product
+func (e *productEditor) applyAttributes(ctx context.Context, product *entities.Product, values entities.Attributes) error {
+	schema, err := e.attributeRepo.ListDefinitions(ctx, product.CategoryID)
+	if err != nil {
+		e.logger.Ctx(ctx).Error("Failed to load attribute schema", "error", err, "category_id", product.CategoryID)
+		return err
+	}
+
+	if err := product.SetAttributes(schema, values); err != nil {
+		var attributeErr *entities.AttributeError
+		if errors.As(err, &attributeErr) {
+			return &productErrors.DomainError{
+				Code:    productErrors.ErrInvalidProductAttributes.Code,
+				Message: err.Error(),
+				Field:   "attributes." + attributeErr.Key,
+			}
+		}
+		return productErrors.NewProductValidationError("attributes", err.Error())
+	}
+	return nil
+}
+
+// productCategory resolves the category a product request names; unknown
+// categories are a validation error on the category field
+func (e *productEditor) productCategory(ctx context.Context, reference string) (*entities.Category, error) {
+	category, err := resolveCategory(ctx, e.categoryRepo, reference)
+	if errors.Is(err, productErrors.ErrCategoryNotFound) {
+		return nil, &productErrors.DomainError{
+			Code:    productErrors.ErrInvalidProductCategory.Code,
+			Message: fmt.Sprintf("category %q does not exist", reference),
+			Field:   "category",
+		}
+	}
+	if err != nil {
+		e.logger.Ctx(ctx).Error("Failed to resolve category", "error", err, "category", reference)
+		return nil, err
+	}
+	return category, nil
+}
+
+// productBrand resolves the brand a product request names by its name or an
+// alias; unknown and inactive brands are a validation error on the brand field
+func (e *productEditor) productBrand(ctx context.Context, name string) (*entities.Brand, error) {
+	brand, err := e.brandRepo.FindByName(ctx, name)
+	if errors.Is(err, productErrors.ErrBrandNotFound) {
+		return nil, &productErrors.DomainError{
+			Code:    productErrors.ErrInvalidProductBrand.Code,
+			Message: fmt.Sprintf("brand %q is not registered", name),
+			Field:   "brand",
+		}
+	}
+	if err != nil {
+		e.logger.Ctx(ctx).Error("Failed to resolve brand", "error", err, "brand", name)
+		return nil, err
+	}
+	if !brand.IsActive() {
+		return nil, &productErrors.DomainError{
+			Code:    productErrors.ErrInvalidProductBrand.Code,
+			Message: fmt.Sprintf("brand %q is inactive", brand.Name),
+			Field:   "brand",
+		}
+	}
+	return brand, nil
+}
+
+// checkCategorySKU fails when sku does not match the SKU pattern of the
+// category a product is filed under, on creation or when it moves
+func checkCategorySKU(category *entities.Category, sku string) error {
+	if err := category.CheckSKU(sku); err != nil {
+		return &productErrors.DomainError{
+			Code:    productErrors.ErrInvalidProductSKU.Code,
+			Message: err.Error(),
+			Field:   "sku",
+		}
+	}
+	return nil
+}
+
+func invalidGTIN(err error) error {
+	return &productErrors.DomainError{
+		Code:    productErrors.ErrInvalidProductGTIN.Code,
+		Message: err.Error(),
+		Field:   "gtin",
+	}
+}
+
+// mergeAttributes overlays changes on current; a nil value removes the key
+func mergeAttributes(current, changes entities.Attributes) entities.Attributes {
+	merged := make(entities.Attributes, len(current)+len(changes))
+	for key, value := range current {
+		merged[key] = value
+	}
+	for key, value := range changes {
+		if value == nil {
+			delete(merged, key)
+			continue
+		}
+		merged[key] = value
+	}
+	return merged
+}
@@ -0,0 +1,277 @@
+package usecases
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	productErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+	"product-service/pkg/xlsx"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// exportSheetName names the worksheet of XLSX exports
+const exportSheetName = "Products"
+
+// ExportUseCases defines the interface for catalog exports. Products are
+// streamed from the repository to the output one at a time, so an export
+// uses the same memory however large the catalog is.
+type ExportUseCases interface {
+	// PrepareExport validates the request and resolves its filters, so that
+	// problems are reported before any output is written
+	PrepareExport(ctx context.Context, request *dto.ExportRequestDTO) (*ProductExport, error)
+	// WriteExport writes every product matching a prepared export to w
+	WriteExport(ctx context.Context, export *ProductExport, w io.Writer) error
+}
+
+// ProductExport is an export request ready to be written
+type ProductExport struct {
+	Format   entities.ExportFormat
+	Columns  []string
+	criteria ports.ProductSearchCriteria
+	// progress, when set, is told how many rows were written so far
+	progress func(rows int64)
+}
+
+// FileName suggests a name for the exported file
+func (e *ProductExport) FileName() string {
+	return "products." + string(e.Format)
+}
+
+// exportUseCasesImpl implements ExportUseCases interface
+type exportUseCasesImpl struct {
+	*productEditor
+	productRepo ports.ProductRepository
+	logger      logger.Logger
+}
+
+// NewExportUseCases creates a new instance of export use cases
+func NewExportUseCases(productRepo ports.ProductRepository, attributeRepo ports.AttributeRepository, categoryRepo ports.CategoryRepository, brandRepo ports.BrandRepository, log logger.Logger) ExportUseCases {
+	log = log.With("component", "export_usecases")
+	return &exportUseCasesImpl{
+		productEditor: newProductEditor(attributeRepo, categoryRepo, brandRepo, log),
+		productRepo:   productRepo,
+		logger:        log,
+	}
+}
+
+// PrepareExport defaults the format to CSV and the columns to
+// entities.DefaultExportColumns
+func (uc *exportUseCasesImpl) PrepareExport(ctx context.Context, request *dto.ExportRequestDTO) (*ProductExport, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("PrepareExport use case called", "format", request.Format, "columns", len(request.Columns))
+
+	format := request.Format
+	if format == "" {
+		format = entities.ExportCSV
+	}
+	if !format.IsValid() {
+		return nil, invalidExport("format", fmt.Sprintf("unsupported export format %q", format))
+	}
+
+	columns, err := entities.ParseExportColumns(request.Columns)
+	if err != nil {
+		return nil, invalidExport("columns", err.Error())
+	}
+
+	criteria, err := uc.searchCriteria(ctx, &request.Filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProductExport{Format: format, Columns: columns, criteria: criteria}, nil
+}
+
+// WriteExport returns errors writing to w as they are; failing to read the
+// products is ErrFailedToExportProducts
+func (uc *exportUseCasesImpl) WriteExport(ctx context.Context, export *ProductExport, w io.Writer) error {
+	log := uc.logger.Ctx(ctx)
+
+	encoder, err := newExportEncoder(export, w)
+	if err != nil {
+		return err
+	}
+
+	var writeErr error
+	rows := 0
+	err = uc.productRepo.Each(ctx, export.criteria, func(product *entities.Product) error {
+		values := make([]any, len(export.Columns))
+		for i, column := range export.Columns {
+			values[i] = entities.ExportValue(product, column)
+		}
+		if writeErr = encoder.Write(values); writeErr != nil {
+			return writeErr
+		}
+		rows++
+		if export.progress != nil {
+			export.progress(int64(rows))
+		}
+		return nil
+	})
+	if err != nil {
+		if writeErr != nil {
+			log.Warn("Export stopped writing", "error", writeErr, "rows", rows)
+			return writeErr
+		}
+		log.Error("Failed to read exported products", "error", err, "rows", rows)
+		return productErrors.ErrFailedToExportProducts
+	}
+	if err := encoder.Close(); err != nil {
+		return err
+	}
+
+	log.Info("Export finished", "format", export.Format, "rows", rows)
+	return nil
+}
+
+// exportEncoder writes the rows of an export in its format
+type exportEncoder interface {
+	Write(values []any) error
+	Close() error
+}
+
+// newExportEncoder starts the output of export on w, writing its header
+// when the format has one
+func newExportEncoder(export *ProductExport, w io.Writer) (exportEncoder, error) {
+	switch export.Format {
+	case entities.ExportJSONLines:
+		return &jsonLinesEncoder{buffer: bufio.NewWriter(w), columns: export.Columns}, nil
+	case entities.ExportXLSX:
+		workbook, err := xlsx.NewWriter(w, exportSheetName)
+		if err != nil {
+			return nil, err
+		}
+		header := make([]any, len(export.Columns))
+		for i, column := range export.Columns {
+			header[i] = column
+		}
+		return &xlsxEncoder{workbook: workbook}, workbook.WriteRow(header...)
+	default:
+		encoder := &csvEncoder{writer: csv.NewWriter(w)}
+		return encoder, encoder.writer.Write(export.Columns)
+	}
+}
+
+// csvEncoder writes a header line and a line per product, with tags joined
+// as in imports
+type csvEncoder struct {
+	writer *csv.Writer
+}
+
+func (e *csvEncoder) Write(values []any) error {
+	record := make([]string, len(values))
+	for i, value := range values {
+		record[i] = exportText(value)
+	}
+	return e.writer.Write(record)
+}
+
+func (e *csvEncoder) Close() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+// jsonLinesEncoder writes a JSON object per product whose keys follow the
+// column order. Prices are decimal strings so no precision is lost.
+type jsonLinesEncoder struct {
+	buffer  *bufio.Writer
+	columns []string
+}
+
+func (e *jsonLinesEncoder) Write(values []any) error {
+	e.buffer.WriteByte('{')
+	for i, value := range values {
+		if i > 0 {
+			e.buffer.WriteByte(',')
+		}
+		if money, ok := value.(entities.Money); ok {
+			value = money.Decimal()
+		}
+		key, err := json.Marshal(e.columns[i])
+		if err != nil {
+			return err
+		}
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		e.buffer.Write(key)
+		e.buffer.WriteByte(':')
+		e.buffer.Write(encoded)
+	}
+	_, err := e.buffer.WriteString("}\n")
+	return err
+}
+
+func (e *jsonLinesEncoder) Close() error {
+	return e.buffer.Flush()
+}
+
+// xlsxEncoder writes a header row and a row per product. Prices, stock and
+// numeric attributes are number cells.
+type xlsxEncoder struct {
+	workbook *xlsx.Writer
+}
+
+func (e *xlsxEncoder) Write(values []any) error {
+	cells := make([]any, len(values))
+	for i, value := range values {
+		switch value := value.(type) {
+		case entities.Money:
+			cells[i] = xlsx.Number(value.Decimal())
+		case nil, string, bool, int, uint, float64:
+			cells[i] = value
+		default:
+			cells[i] = exportText(value)
+		}
+	}
+	return e.workbook.WriteRow(cells...)
+}
+
+func (e *xlsxEncoder) Close() error {
+	return e.workbook.Close()
+}
+
+// exportText renders an export value as text the way imports read it back
+func exportText(value any) string {
+	switch value := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case entities.Money:
+		return value.Decimal()
+	case time.Time:
+		return value.UTC().Format(time.RFC3339)
+	case []string:
+		return strings.Join(value, entities.ImportTagSeparator)
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case bool, int, uint:
+		return fmt.Sprint(value)
+	default:
+		// structured attribute values, e.g. quantities with a unit
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Sprint(value)
+		}
+		return string(encoded)
+	}
+}
+
+func invalidExport(field, message string) error {
+	return &productErrors.DomainError{
+		Code:    productErrors.ErrInvalidExport.Code,
+		Message: message,
+		Field:   field,
+	}
+}
@@ -0,0 +1,184 @@
+package usecases
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestExportUseCases() (ExportUseCases, *MockProductRepository) {
+	mockProducts := new(MockProductRepository)
+	mockAttributes := new(MockAttributeRepository)
+	mockCategories := new(MockCategoryRepository)
+	mockCategories.On("GetByPath", mock.Anything, "electronics").Return(testCategory(1, "Electronics", nil), nil).Maybe()
+	mockCategories.On("ListSubtree", mock.Anything, "electronics").Return([]*entities.Category{testCategory(1, "Electronics", nil)}, nil).Maybe()
+	mockBrands := new(MockBrandRepository)
+	mockBrands.On("FindByName", mock.Anything, "Apple Computer").Return(testBrand(1, "Apple", "Apple Computer"), nil).Maybe()
+
+	return NewExportUseCases(mockProducts, mockAttributes, mockCategories, mockBrands, logger.New("test")), mockProducts
+}
+
+func testExportProducts(t *testing.T) []*entities.Product {
+	t.Helper()
+	price, err := entities.ParseMoney("999.50", "USD")
+	require.NoError(t, err)
+	created := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+	return []*entities.Product{
+		{ID: 1, SKU: "LAP-001", Name: "Laptop, 13\"", Price: price, Stock: 5, Tags: []string{"featured", "sale"},
+			Attributes: entities.Attributes{"color": "Silver"}, CreatedAt: created},
+		{ID: 2, SKU: "LAP-002", Name: "Laptop Pro", Price: price, Tags: []string{}, CreatedAt: created},
+	}
+}
+
+func TestExportUseCases_CSV(t *testing.T) {
+	// Given
+	useCases, mockRepo := setupTestExportUseCases()
+	ctx := context.Background()
+
+	mockRepo.On("Each", ctx, ports.ProductSearchCriteria{Brand: "Apple", CategoryPath: "electronics"}).
+		Return(testExportProducts(t), nil)
+
+	// When
+	export, err := useCases.PrepareExport(ctx, &dto.ExportRequestDTO{
+		Columns: []string{"sku", "name", "price", "tags", "attributes.color", "created_at"},
+		Filters: dto.ProductSearchRequestDTO{Brand: "Apple Computer", Category: "Electronics"},
+	})
+	require.NoError(t, err)
+	var output bytes.Buffer
+	err = useCases.WriteExport(ctx, export, &output)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, entities.ExportCSV, export.Format)
+	assert.Equal(t, "products.csv", export.FileName())
+	assert.Equal(t, `sku,name,price,tags,attributes.color,created_at
+LAP-001,"Laptop, 13""",999.50,featured|sale,Silver,2026-03-01T09:30:00Z
+LAP-002,Laptop Pro,999.50,,,2026-03-01T09:30:00Z
+`, output.String())
+	mockRepo.AssertExpectations(t)
+}
+
+func TestExportUseCases_JSONLines(t *testing.T) {
+	// Given
+	useCases, mockRepo := setupTestExportUseCases()
+	ctx := context.Background()
+
+	mockRepo.On("Each", ctx, ports.ProductSearchCriteria{}).Return(testExportProducts(t)[:1], nil)
+
+	// When
+	export, err := useCases.PrepareExport(ctx, &dto.ExportRequestDTO{
+		Format:  entities.ExportJSONLines,
+		Columns: []string{"id", "price", "currency", "stock", "tags", "attributes.weight"},
+	})
+	require.NoError(t, err)
+	var output bytes.Buffer
+	err = useCases.WriteExport(ctx, export, &output)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, `{"id":1,"price":"999.50","currency":"USD","stock":5,"tags":["featured","sale"],"attributes.weight":null}`+"\n", output.String())
+}
+
+func TestExportUseCases_XLSX(t *testing.T) {
+	// Given
+	useCases, mockRepo := setupTestExportUseCases()
+	ctx := context.Background()
+
+	mockRepo.On("Each", ctx, ports.ProductSearchCriteria{}).Return(testExportProducts(t), nil)
+
+	// When
+	export, err := useCases.PrepareExport(ctx, &dto.ExportRequestDTO{
+		Format:  entities.ExportXLSX,
+		Columns: []string{"sku", "price", "stock"},
+	})
+	require.NoError(t, err)
+	var output bytes.Buffer
+	err = useCases.WriteExport(ctx, export, &output)
+
+	// Then
+	require.NoError(t, err)
+	archive, err := zip.NewReader(bytes.NewReader(output.Bytes()), int64(output.Len()))
+	require.NoError(t, err)
+	sheet, err := archive.Open("xl/worksheets/sheet1.xml")
+	require.NoError(t, err)
+	content, err := io.ReadAll(sheet)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), `<c r="A2" t="inlineStr"><is><t xml:space="preserve">LAP-001</t></is></c><c r="B2"><v>999.50</v></c><c r="C2"><v>5</v></c>`)
+	assert.Contains(t, string(content), `<row r="3">`)
+}
+
+func TestExportUseCases_PrepareExport_Invalid(t *testing.T) {
+	tests := []struct {
+		name      string
+		request   *dto.ExportRequestDTO
+		wantCode  string
+		wantField string
+	}{
+		{
+			name:      "unsupported format",
+			request:   &dto.ExportRequestDTO{Format: "pdf"},
+			wantCode:  domainErrors.ErrInvalidExport.Code,
+			wantField: "format",
+		},
+		{
+			name:      "unknown column",
+			request:   &dto.ExportRequestDTO{Columns: []string{"sku", "margin"}},
+			wantCode:  domainErrors.ErrInvalidExport.Code,
+			wantField: "columns",
+		},
+		{
+			name:      "unknown category",
+			request:   &dto.ExportRequestDTO{Filters: dto.ProductSearchRequestDTO{CategoryID: new(uint)}},
+			wantCode:  domainErrors.ErrInvalidProductCategory.Code,
+			wantField: "category_id",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			useCases, _ := setupTestExportUseCases()
+			categories := useCases.(*exportUseCasesImpl).categoryRepo.(*MockCategoryRepository)
+			categories.On("GetByID", mock.Anything, uint(0)).Return(nil, domainErrors.ErrCategoryNotFound).Maybe()
+
+			// When
+			export, err := useCases.PrepareExport(context.Background(), tt.request)
+
+			// Then
+			assert.Nil(t, export)
+			var domainErr *domainErrors.DomainError
+			require.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, tt.wantCode, domainErr.Code)
+			assert.Equal(t, tt.wantField, domainErr.Field)
+		})
+	}
+}
+
+func TestExportUseCases_WriteExport_RepositoryError(t *testing.T) {
+	// Given
+	useCases, mockRepo := setupTestExportUseCases()
+	ctx := context.Background()
+
+	mockRepo.On("Each", ctx, ports.ProductSearchCriteria{}).Return(nil, errors.New("connection reset"))
+	export, err := useCases.PrepareExport(ctx, &dto.ExportRequestDTO{})
+	require.NoError(t, err)
+
+	// When
+	err = useCases.WriteExport(ctx, export, io.Discard)
+
+	// Then
+	assert.Equal(t, domainErrors.ErrFailedToExportProducts, err)
+}
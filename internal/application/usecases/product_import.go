@@ -0,0 +1,461 @@
+package usecases
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	productErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+	"product-service/pkg/requestctx"
+	"strconv"
+	"time"
+)
+
+// importFlushRows is how many rows an import reads between saving its
+// progress and the new lines of its error report
+const importFlushRows = 100
+
+// importFieldCodes are the error codes of invalid import fields
+var importFieldCodes = map[string]string{
+	entities.ImportFieldSKU:      productErrors.ErrInvalidProductSKU.Code,
+	entities.ImportFieldGTIN:     productErrors.ErrInvalidProductGTIN.Code,
+	entities.ImportFieldName:     productErrors.ErrInvalidProductName.Code,
+	entities.ImportFieldPrice:    productErrors.ErrInvalidProductPrice.Code,
+	entities.ImportFieldCurrency: productErrors.ErrUnsupportedCurrency.Code,
+	entities.ImportFieldStock:    productErrors.ErrInvalidProductStock.Code,
+	entities.ImportFieldCategory: productErrors.ErrInvalidProductCategory.Code,
+}
+
+// ImportOptions limits uploaded import files and says where they are kept
+// while the import reads them
+type ImportOptions struct {
+	MaxUploadSize int64
+	// SpoolDirectory holds uploaded files until their import job reads
+	// them; the system temporary directory when empty. Workers running the
+	// jobs must see the same directory.
+	SpoolDirectory string
+}
+
+// ImportUseCases defines the interface for bulk product imports from CSV
+// files. Rows are upserted by SKU, each validated like a new product, and
+// rows that fail are collected in a per-row error report.
+type ImportUseCases interface {
+	// StartImport saves source and queues a job that imports it
+	StartImport(ctx context.Context, request *dto.ImportRequestDTO, source io.Reader) (*dto.ImportResponseDTO, error)
+	// RunImport imports source before returning
+	RunImport(ctx context.Context, request *dto.ImportRequestDTO, source io.Reader) (*dto.ImportResponseDTO, error)
+	// ProcessImport runs a queued import from its spooled file, which is
+	// removed afterwards; the product import job calls it
+	ProcessImport(ctx context.Context, request *dto.ImportJobRequestDTO) (*dto.ImportResponseDTO, error)
+	GetImport(ctx context.Context, id uint) (*dto.ImportResponseDTO, error)
+	// WriteErrorReport writes the error report of a finished import as CSV
+	WriteErrorReport(ctx context.Context, id uint, w io.Writer) error
+}
+
+// importUseCasesImpl implements ImportUseCases interface
+type importUseCasesImpl struct {
+	*productEditor
+	importRepo  ports.ImportRepository
+	jobRepo     ports.JobRepository
+	productRepo ports.ProductRepository
+	options     ImportOptions
+	logger      logger.Logger
+}
+
+// NewImportUseCases creates a new instance of import use cases
+func NewImportUseCases(importRepo ports.ImportRepository, jobRepo ports.JobRepository, productRepo ports.ProductRepository, attributeRepo ports.AttributeRepository, categoryRepo ports.CategoryRepository, brandRepo ports.BrandRepository, options ImportOptions, log logger.Logger) ImportUseCases {
+	log = log.With("component", "import_usecases")
+	return &importUseCasesImpl{
+		productEditor: newProductEditor(attributeRepo, categoryRepo, brandRepo, log),
+		importRepo:    importRepo,
+		jobRepo:       jobRepo,
+		productRepo:   productRepo,
+		options:       options,
+		logger:        log,
+	}
+}
+
+// StartImport copies source to the spool directory, so the file is never
+// held in memory, and queues a product import job that reads it. The job
+// runs with a single attempt: a retry would count the rows already imported
+// again.
+func (uc *importUseCasesImpl) StartImport(ctx context.Context, request *dto.ImportRequestDTO, source io.Reader) (*dto.ImportResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("StartImport use case called", "file_name", request.FileName, "dry_run", request.DryRun)
+
+	productImport, err := uc.newImport(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := uc.spool(source)
+	if err != nil {
+		if errors.Is(err, errImportTooLarge) {
+			return nil, &productErrors.DomainError{
+				Code:    productErrors.ErrInvalidImport.Code,
+				Message: fmt.Sprintf("import files must be at most %d bytes", uc.options.MaxUploadSize),
+				Field:   "file",
+			}
+		}
+		log.Error("Failed to spool import file", "error", err)
+		return nil, productErrors.ErrFailedToImportProducts
+	}
+
+	created, err := uc.importRepo.Create(ctx, productImport)
+	if err != nil {
+		os.Remove(file)
+		log.Error("Failed to create import", "error", err)
+		return nil, productErrors.ErrFailedToImportProducts
+	}
+
+	payload := &dto.ImportJobRequestDTO{ImportID: created.ID, File: filepath.Base(file)}
+	job, err := entities.NewJob(entities.JobProductImport, payload, 1, requestctx.Actor(ctx), requestctx.RequestID(ctx), time.Now())
+	if err == nil {
+		_, err = uc.jobRepo.Create(ctx, job)
+	}
+	if err != nil {
+		os.Remove(file)
+		log.Error("Failed to queue import job", "error", err, "import_id", created.ID)
+		created.Fail("the import could not be queued", time.Now())
+		if err := uc.importRepo.Save(ctx, created, nil); err != nil {
+			log.Error("Failed to save import progress", "error", err, "import_id", created.ID)
+		}
+		return nil, productErrors.ErrFailedToImportProducts
+	}
+
+	log.Info("StartImport success", "import_id", created.ID)
+	return &dto.ImportResponseDTO{ProductImport: created}, nil
+}
+
+// RunImport records the import and reads source to its end
+func (uc *importUseCasesImpl) RunImport(ctx context.Context, request *dto.ImportRequestDTO, source io.Reader) (*dto.ImportResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("RunImport use case called", "file_name", request.FileName, "dry_run", request.DryRun)
+
+	productImport, err := uc.newImport(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := uc.importRepo.Create(ctx, productImport)
+	if err != nil {
+		log.Error("Failed to create import", "error", err)
+		return nil, productErrors.ErrFailedToImportProducts
+	}
+
+	if err := uc.run(ctx, created, source); err != nil {
+		return nil, productErrors.ErrFailedToImportProducts
+	}
+	return &dto.ImportResponseDTO{ProductImport: created}, nil
+}
+
+// ProcessImport reads the spooled file of a queued import. Imports that
+// already finished are returned as they are, and an import whose file is
+// gone fails.
+func (uc *importUseCasesImpl) ProcessImport(ctx context.Context, request *dto.ImportJobRequestDTO) (*dto.ImportResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("ProcessImport use case called", "import_id", request.ImportID)
+
+	productImport, err := uc.importRepo.GetByID(ctx, request.ImportID)
+	if err != nil {
+		return nil, err
+	}
+	if productImport.IsFinished() {
+		return &dto.ImportResponseDTO{ProductImport: productImport}, nil
+	}
+
+	path := filepath.Join(uc.spoolDirectory(), filepath.Base(request.File))
+	defer os.Remove(path)
+
+	file, err := os.Open(path)
+	if err != nil {
+		log.Error("Failed to open spooled import file", "error", err, "import_id", productImport.ID)
+		productImport.Fail("the uploaded file is no longer available", time.Now())
+		if err := uc.importRepo.Save(ctx, productImport, nil); err != nil {
+			return nil, err
+		}
+		return nil, err
+	}
+	defer file.Close()
+
+	if err := uc.run(ctx, productImport, file); err != nil {
+		return nil, err
+	}
+	return &dto.ImportResponseDTO{ProductImport: productImport}, nil
+}
+
+// GetImport returns the progress of an import
+func (uc *importUseCasesImpl) GetImport(ctx context.Context, id uint) (*dto.ImportResponseDTO, error) {
+	productImport, err := uc.importRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.ImportResponseDTO{ProductImport: productImport}, nil
+}
+
+// WriteErrorReport writes row,sku,field,code,message lines, one per failed
+// row, after a header line
+func (uc *importUseCasesImpl) WriteErrorReport(ctx context.Context, id uint, w io.Writer) error {
+	productImport, err := uc.importRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !productImport.IsFinished() {
+		return productErrors.ErrImportNotFinished
+	}
+
+	report := csv.NewWriter(w)
+	if err := report.Write([]string{"row", "sku", "field", "code", "message"}); err != nil {
+		return err
+	}
+	err = uc.importRepo.EachError(ctx, id, func(rowError entities.ImportRowError) error {
+		return report.Write([]string{strconv.Itoa(rowError.Row), rowError.SKU, rowError.Field, rowError.Code, rowError.Message})
+	})
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("Failed to write import error report", "error", err, "import_id", id)
+		return err
+	}
+	report.Flush()
+	return report.Error()
+}
+
+func (uc *importUseCasesImpl) newImport(ctx context.Context, request *dto.ImportRequestDTO) (*entities.ProductImport, error) {
+	productImport, err := entities.NewProductImport(request.FileName, request.Mapping, request.Currency, request.DryRun,
+		requestctx.Actor(ctx), requestctx.RequestID(ctx), time.Now())
+	if err != nil {
+		invalid := &productErrors.DomainError{Code: productErrors.ErrInvalidImport.Code, Message: err.Error()}
+		var fieldErr *entities.ImportFieldError
+		if errors.As(err, &fieldErr) {
+			invalid.Field = fieldErr.Field
+		}
+		return nil, invalid
+	}
+	return productImport, nil
+}
+
+// run reads the rows of source into products, saving the progress of
+// productImport as it goes. Row errors end up in the error report; only
+// failing to read the file or to save progress stops the import.
+func (uc *importUseCasesImpl) run(ctx context.Context, productImport *entities.ProductImport, source io.Reader) error {
+	log := uc.logger.Ctx(ctx).With("import_id", productImport.ID)
+
+	productImport.Start(time.Now())
+	if err := uc.importRepo.Save(ctx, productImport, nil); err != nil {
+		log.Error("Failed to save import progress", "error", err)
+		return err
+	}
+
+	var pending []entities.ImportRowError
+	fail := func(reason string) error {
+		productImport.Fail(reason, time.Now())
+		return uc.importRepo.Save(ctx, productImport, pending)
+	}
+
+	reader := csv.NewReader(source)
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return fail("the file is empty")
+	}
+	if err != nil {
+		return fail("invalid header: " + err.Error())
+	}
+	columns, err := productImport.Mapping.ResolveColumns(header)
+	if err != nil {
+		return fail(err.Error())
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			productImport.Fail("the import was cancelled", time.Now())
+			// ctx is done, so the last save must not depend on it
+			if err := uc.importRepo.Save(context.WithoutCancel(ctx), productImport, pending); err != nil {
+				log.Error("Failed to save import progress", "error", err)
+			}
+			return err
+		}
+
+		values, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		line, _ := reader.FieldPos(0)
+		if err != nil && !errors.Is(err, csv.ErrFieldCount) {
+			log.Warn("Import stopped on a malformed line", "error", err, "line", line)
+			return fail(err.Error())
+		}
+
+		productImport.TotalRows++
+		record := columns.Record(line, values)
+		if err != nil {
+			pending = append(pending, entities.ImportRowError{
+				Row:     line,
+				SKU:     record.SKU(),
+				Code:    productErrors.ErrInvalidImport.Code,
+				Message: fmt.Sprintf("expected %d columns, got %d", len(header), len(values)),
+			})
+			productImport.Failed++
+		} else if created, err := uc.importRow(ctx, productImport, record); err != nil {
+			pending = append(pending, uc.rowError(ctx, record, err))
+			productImport.Failed++
+		} else if created {
+			productImport.Created++
+		} else {
+			productImport.Updated++
+		}
+
+		if productImport.TotalRows%importFlushRows == 0 {
+			if err := uc.importRepo.Save(ctx, productImport, pending); err != nil {
+				log.Error("Failed to save import progress", "error", err)
+				return err
+			}
+			pending = nil
+		}
+	}
+
+	productImport.Complete(time.Now())
+	if err := uc.importRepo.Save(ctx, productImport, pending); err != nil {
+		log.Error("Failed to save import progress", "error", err)
+		return err
+	}
+
+	log.Info("Import finished",
+		"rows", productImport.TotalRows,
+		"created", productImport.Created,
+		"updated", productImport.Updated,
+		"failed", productImport.Failed,
+		"dry_run", productImport.DryRun)
+	return nil
+}
+
+// importRow upserts the product of record by SKU and reports whether it was
+// new. Dry runs stop right before writing.
+func (uc *importUseCasesImpl) importRow(ctx context.Context, productImport *entities.ProductImport, record entities.ImportRecord) (bool, error) {
+	product, err := record.Product(productImport.Currency)
+	if err != nil {
+		return false, err
+	}
+
+	existing, err := uc.productRepo.GetBySKU(ctx, product.SKU)
+	if errors.Is(err, productErrors.ErrProductNotFound) {
+		return true, uc.createProduct(ctx, product, record, productImport.DryRun)
+	}
+	if err != nil {
+		return false, err
+	}
+	return false, uc.updateProduct(ctx, existing, product, record, productImport.DryRun)
+}
+
+// createProduct prepares product as product creation does and saves it
+func (uc *importUseCasesImpl) createProduct(ctx context.Context, product *entities.Product, record entities.ImportRecord, dryRun bool) error {
+	if err := uc.prepareProduct(ctx, product, record.Attributes(), record.Tags()); err != nil {
+		return err
+	}
+	if err := uc.ensureGTINFree(ctx, uc.productRepo, product.GTIN, 0); err != nil {
+		return err
+	}
+
+	if dryRun {
+		return nil
+	}
+	_, err := uc.productRepo.Create(ctx, product)
+	return err
+}
+
+// updateProduct applies the changes of record to existing as a product
+// update does
+func (uc *importUseCasesImpl) updateProduct(ctx context.Context, existing, product *entities.Product, record entities.ImportRecord, dryRun bool) error {
+	changes := record.Changes(product)
+	edit := func(target *entities.Product) ([]*entities.PriceChange, error) {
+		priceChanges, err := uc.applyChanges(ctx, target, changes)
+		if err != nil {
+			return nil, err
+		}
+		if changes.GTIN != nil {
+			if err := uc.ensureGTINFree(ctx, uc.productRepo, target.GTIN, target.ID); err != nil {
+				return nil, err
+			}
+		}
+		return priceChanges, nil
+	}
+
+	if dryRun {
+		_, err := edit(existing)
+		return err
+	}
+	_, err := uc.productRepo.ApplyEdit(ctx, existing.ID, edit)
+	return err
+}
+
+// rowError turns the failure of a row into a line of the error report
+func (uc *importUseCasesImpl) rowError(ctx context.Context, record entities.ImportRecord, err error) entities.ImportRowError {
+	rowError := entities.ImportRowError{Row: record.Line, SKU: record.SKU(), Message: err.Error()}
+
+	var fieldErr *entities.ImportFieldError
+	var domainErr *productErrors.DomainError
+	switch {
+	case errors.As(err, &fieldErr):
+		rowError.Field = fieldErr.Field
+		rowError.Code = importFieldCodes[fieldErr.Field]
+	case errors.As(err, &domainErr):
+		rowError.Field = domainErr.Field
+		rowError.Code = domainErr.Code
+		rowError.Message = domainErr.Message
+	default:
+		uc.logger.Ctx(ctx).Error("Failed to import row", "error", err, "row", record.Line, "sku", record.SKU())
+		rowError.Code = productErrors.ErrFailedToImportProducts.Code
+		rowError.Message = productErrors.ErrFailedToImportProducts.Message
+	}
+	if rowError.Code == "" {
+		rowError.Code = productErrors.ErrInvalidImport.Code
+	}
+	return rowError
+}
+
+var errImportTooLarge = errors.New("import file too large")
+
+// spool copies source to a file in the spool directory and returns its path
+func (uc *importUseCasesImpl) spool(source io.Reader) (string, error) {
+	file, err := os.CreateTemp(uc.options.SpoolDirectory, "product-import-*.csv")
+	if err != nil {
+		return "", err
+	}
+
+	limit := uc.options.MaxUploadSize
+	if limit <= 0 {
+		_, err = io.Copy(file, source)
+	} else {
+		var written int64
+		written, err = io.Copy(file, io.LimitReader(source, limit+1))
+		if err == nil && written > limit {
+			err = errImportTooLarge
+		}
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		return "", err
+	}
+	return file.Name(), nil
+}
+
+// spoolDirectory is where spool keeps uploaded files
+func (uc *importUseCasesImpl) spoolDirectory() string {
+	if uc.options.SpoolDirectory == "" {
+		return os.TempDir()
+	}
+	return uc.options.SpoolDirectory
+}
@@ -0,0 +1,411 @@
+package usecases
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"product-service/internal/application/dto"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockImportRepository implements the ImportRepository interface for testing.
+// Save collects the error report lines it is given in RowErrors.
+type MockImportRepository struct {
+	mock.Mock
+	RowErrors []entities.ImportRowError
+}
+
+func (m *MockImportRepository) Create(ctx context.Context, productImport *entities.ProductImport) (*entities.ProductImport, error) {
+	args := m.Called(ctx, productImport)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ProductImport), args.Error(1)
+}
+
+func (m *MockImportRepository) GetByID(ctx context.Context, id uint) (*entities.ProductImport, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ProductImport), args.Error(1)
+}
+
+func (m *MockImportRepository) Save(ctx context.Context, productImport *entities.ProductImport, rowErrors []entities.ImportRowError) error {
+	m.RowErrors = append(m.RowErrors, rowErrors...)
+	args := m.Called(ctx, productImport)
+	return args.Error(0)
+}
+
+func (m *MockImportRepository) EachError(ctx context.Context, importID uint, fn func(entities.ImportRowError) error) error {
+	args := m.Called(ctx, importID)
+	for _, rowError := range args.Get(0).([]entities.ImportRowError) {
+		if err := fn(rowError); err != nil {
+			return err
+		}
+	}
+	return args.Error(1)
+}
+
+func setupTestImportUseCases(t *testing.T) (ImportUseCases, *MockImportRepository, *MockProductRepository) {
+	useCases, mockImports, mockProducts, _ := setupTestImportUseCasesWithJobs(t)
+	return useCases, mockImports, mockProducts
+}
+
+func setupTestImportUseCasesWithJobs(t *testing.T) (ImportUseCases, *MockImportRepository, *MockProductRepository, *MockJobRepository) {
+	mockImports := new(MockImportRepository)
+	mockJobs := new(MockJobRepository)
+	mockProducts := new(MockProductRepository)
+
+	mockAttributes := new(MockAttributeRepository)
+	mockAttributes.On("ListDefinitions", mock.Anything, mock.Anything).Return([]*entities.AttributeDefinition{}, nil).Maybe()
+	mockCategories := new(MockCategoryRepository)
+	mockCategories.On("GetByPath", mock.Anything, "electronics").Return(testCategory(1, "Electronics", nil), nil).Maybe()
+	mockCategories.On("GetByPath", mock.Anything, mock.Anything).Return(nil, domainErrors.ErrCategoryNotFound).Maybe()
+	mockCategories.On("FindBySlug", mock.Anything, mock.Anything).Return([]*entities.Category{}, nil).Maybe()
+	mockBrands := new(MockBrandRepository)
+	mockBrands.On("FindByName", mock.Anything, "Apple").Return(testBrand(1, "Apple"), nil).Maybe()
+
+	useCases := NewImportUseCases(mockImports, mockJobs, mockProducts, mockAttributes, mockCategories, mockBrands, ImportOptions{
+		MaxUploadSize:  1 << 10,
+		SpoolDirectory: t.TempDir(),
+	}, logger.New("test"))
+	return useCases, mockImports, mockProducts, mockJobs
+}
+
+const testImportCSV = `sku,name,price,category,brand,stock,tags
+new-001,Laptop,999.00,Electronics,Apple,5,featured|sale
+OLD-001,Phone v2,499.00,Electronics,,3,
+BAD-001,X,10.00,Electronics,,1,
+BAD-002,Tablet,12.00,Garden,,1,
+`
+
+func expectImportRows(mockProducts *MockProductRepository) {
+	existing := &entities.Product{
+		ID:         2,
+		Name:       "Phone",
+		SKU:        "OLD-001",
+		Price:      entities.MustParseMoney("549.00", "USD"),
+		Category:   "Electronics",
+		CategoryID: 1,
+		Stock:      10,
+		Status:     entities.ProductStatusActive,
+		Tags:       []string{"clearance"},
+	}
+	mockProducts.On("GetBySKU", mock.Anything, "NEW-001").Return(nil, domainErrors.ErrProductNotFound)
+	mockProducts.On("GetBySKU", mock.Anything, "OLD-001").Return(existing, nil)
+	mockProducts.On("GetBySKU", mock.Anything, "BAD-002").Return(nil, domainErrors.ErrProductNotFound)
+}
+
+func TestImportUseCases_RunImport_UpsertsBySKU(t *testing.T) {
+	// Given
+	useCases, mockImports, mockProducts := setupTestImportUseCases(t)
+	ctx := context.Background()
+
+	mockImports.On("Create", ctx, mock.Anything).Return(&entities.ProductImport{ID: 7, Status: entities.ImportPending, Currency: "USD"}, nil)
+	mockImports.On("Save", ctx, mock.Anything).Return(nil)
+	expectImportRows(mockProducts)
+	mockProducts.On("Create", ctx, mock.MatchedBy(func(product *entities.Product) bool {
+		return product.SKU == "NEW-001" && product.Brand == "Apple" && product.CategoryID == 1 &&
+			product.Stock == 5 && assert.ObjectsAreEqual([]string{"featured", "sale"}, product.Tags)
+	})).Return(&entities.Product{ID: 1}, nil)
+	locked := &entities.Product{
+		ID:         2,
+		Name:       "Phone",
+		SKU:        "OLD-001",
+		Price:      entities.MustParseMoney("549.00", "USD"),
+		Category:   "Electronics",
+		CategoryID: 1,
+		Stock:      10,
+		Status:     entities.ProductStatusActive,
+		Tags:       []string{"clearance"},
+	}
+	mockProducts.On("ApplyEdit", ctx, uint(2)).Return(locked, nil)
+	mockProducts.On("savedPriceChanges", mock.MatchedBy(func(changes []*entities.PriceChange) bool {
+		return len(changes) == 1 && changes[0].ProductID == 2 &&
+			changes[0].OldPrice.Equal(entities.MustParseMoney("549.00", "USD")) &&
+			changes[0].NewPrice.Equal(entities.MustParseMoney("499.00", "USD"))
+	})).Return()
+
+	// When
+	result, err := useCases.RunImport(ctx, &dto.ImportRequestDTO{FileName: "products.csv", Currency: "USD"}, strings.NewReader(testImportCSV))
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, entities.ImportCompleted, result.Status)
+	assert.Equal(t, 4, result.TotalRows)
+	assert.Equal(t, 1, result.Created)
+	assert.Equal(t, 1, result.Updated)
+	assert.Equal(t, 2, result.Failed)
+	assert.Equal(t, []entities.ImportRowError{
+		{Row: 4, SKU: "BAD-001", Field: "name", Code: domainErrors.ErrInvalidProductName.Code, Message: "product name must be at least 2 characters long"},
+		{Row: 5, SKU: "BAD-002", Field: "category", Code: domainErrors.ErrInvalidProductCategory.Code, Message: `category "Garden" does not exist`},
+	}, mockImports.RowErrors)
+	assert.Equal(t, "Phone v2", locked.Name)
+	assert.Equal(t, 3, locked.Stock)
+	assert.Empty(t, locked.Tags)
+
+	mockProducts.AssertExpectations(t)
+}
+
+func TestImportUseCases_RunImport_DryRunWritesNothing(t *testing.T) {
+	// Given
+	useCases, mockImports, mockProducts := setupTestImportUseCases(t)
+	ctx := context.Background()
+
+	mockImports.On("Create", ctx, mock.MatchedBy(func(productImport *entities.ProductImport) bool {
+		return productImport.DryRun
+	})).Return(&entities.ProductImport{ID: 7, Status: entities.ImportPending, Currency: "USD", DryRun: true}, nil)
+	mockImports.On("Save", ctx, mock.Anything).Return(nil)
+	expectImportRows(mockProducts)
+
+	// When
+	result, err := useCases.RunImport(ctx, &dto.ImportRequestDTO{Currency: "USD", DryRun: true}, strings.NewReader(testImportCSV))
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Created)
+	assert.Equal(t, 1, result.Updated)
+	assert.Equal(t, 2, result.Failed)
+	mockProducts.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	mockProducts.AssertNotCalled(t, "ApplyEdit", mock.Anything, mock.Anything)
+}
+
+func TestImportUseCases_RunImport_GTINTaken(t *testing.T) {
+	// Given
+	useCases, mockImports, mockProducts := setupTestImportUseCases(t)
+	ctx := context.Background()
+
+	mockImports.On("Create", ctx, mock.Anything).Return(&entities.ProductImport{ID: 7, Currency: "USD"}, nil)
+	mockImports.On("Save", ctx, mock.Anything).Return(nil)
+	mockProducts.On("GetBySKU", ctx, "NEW-001").Return(nil, domainErrors.ErrProductNotFound)
+	mockProducts.On("ExistsByGTIN", ctx, "4006381333931", uint(0)).Return(true, nil)
+
+	// When
+	result, err := useCases.RunImport(ctx, &dto.ImportRequestDTO{Currency: "USD"}, strings.NewReader(
+		"sku,name,price,category,gtin\nNEW-001,Laptop,999.00,Electronics,4006381333931\n"))
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Failed)
+	require.Len(t, mockImports.RowErrors, 1)
+	assert.Equal(t, domainErrors.ErrProductGTINAlreadyExists.Code, mockImports.RowErrors[0].Code)
+	assert.Equal(t, "gtin", mockImports.RowErrors[0].Field)
+	mockProducts.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestImportUseCases_RunImport_MappedColumns(t *testing.T) {
+	// Given
+	useCases, mockImports, mockProducts := setupTestImportUseCases(t)
+	ctx := context.Background()
+
+	mapping := entities.ImportMapping{"sku": "Item Number", "name": "Title"}
+	mockImports.On("Create", ctx, mock.Anything).Return(&entities.ProductImport{ID: 7, Mapping: mapping}, nil)
+	mockImports.On("Save", ctx, mock.Anything).Return(nil)
+	mockProducts.On("GetBySKU", ctx, "NEW-001").Return(nil, domainErrors.ErrProductNotFound)
+	mockProducts.On("Create", ctx, mock.MatchedBy(func(product *entities.Product) bool {
+		return product.Name == "Laptop" && product.Price.Equal(entities.MustParseMoney("999.00", "EUR"))
+	})).Return(&entities.Product{ID: 1}, nil)
+
+	// When
+	result, err := useCases.RunImport(ctx, &dto.ImportRequestDTO{Mapping: mapping}, strings.NewReader(
+		"Item Number,Title,price,currency,category\nNEW-001,Laptop,999.00,EUR,Electronics\n"))
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Created)
+	assert.Empty(t, mockImports.RowErrors)
+	mockProducts.AssertExpectations(t)
+}
+
+func TestImportUseCases_RunImport_MissingColumnFailsImport(t *testing.T) {
+	// Given
+	useCases, mockImports, mockProducts := setupTestImportUseCases(t)
+	ctx := context.Background()
+
+	mockImports.On("Create", ctx, mock.Anything).Return(&entities.ProductImport{ID: 7}, nil)
+	mockImports.On("Save", ctx, mock.Anything).Return(nil)
+
+	// When
+	result, err := useCases.RunImport(ctx, &dto.ImportRequestDTO{}, strings.NewReader("sku,name,category\nNEW-001,Laptop,Electronics\n"))
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, entities.ImportFailed, result.Status)
+	assert.Equal(t, `required column "price" is missing`, result.Error)
+	assert.Zero(t, result.TotalRows)
+	mockProducts.AssertNotCalled(t, "GetBySKU", mock.Anything, mock.Anything)
+}
+
+func TestImportUseCases_RunImport_InvalidMapping(t *testing.T) {
+	// Given
+	useCases, mockImports, _ := setupTestImportUseCases(t)
+
+	// When
+	_, err := useCases.RunImport(context.Background(), &dto.ImportRequestDTO{
+		Mapping: entities.ImportMapping{"weight": "Weight"},
+	}, strings.NewReader(testImportCSV))
+
+	// Then
+	var domainErr *domainErrors.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, domainErrors.ErrInvalidImport.Code, domainErr.Code)
+	assert.Equal(t, "mapping", domainErr.Field)
+	mockImports.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestImportUseCases_StartImport_QueuesJob(t *testing.T) {
+	// Given
+	useCases, mockImports, mockProducts, mockJobs := setupTestImportUseCasesWithJobs(t)
+	ctx := context.Background()
+
+	var queued *entities.Job
+	mockImports.On("Create", mock.Anything, mock.Anything).Return(&entities.ProductImport{ID: 7, Status: entities.ImportPending, Currency: "USD"}, nil)
+	mockJobs.On("Create", ctx, mock.MatchedBy(func(job *entities.Job) bool {
+		return job.Type == entities.JobProductImport && job.MaxAttempts == 1
+	})).Return(&entities.Job{ID: 3}, nil).Run(func(args mock.Arguments) {
+		queued = args.Get(1).(*entities.Job)
+	})
+
+	// When
+	result, err := useCases.StartImport(ctx, &dto.ImportRequestDTO{Currency: "USD"}, strings.NewReader(
+		"sku,name,price,category\nNEW-001,Laptop,999.00,Electronics\n"))
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, uint(7), result.ID)
+	assert.Equal(t, entities.ImportPending, result.Status)
+	mockImports.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	mockProducts.AssertNotCalled(t, "GetBySKU", mock.Anything, mock.Anything)
+
+	var request dto.ImportJobRequestDTO
+	require.NoError(t, json.Unmarshal(queued.Payload, &request))
+	assert.Equal(t, uint(7), request.ImportID)
+	assert.FileExists(t, filepath.Join(testSpoolDirectory(t, useCases), request.File))
+}
+
+func TestImportUseCases_ProcessImport(t *testing.T) {
+	// Given
+	useCases, mockImports, mockProducts, mockJobs := setupTestImportUseCasesWithJobs(t)
+	ctx := context.Background()
+
+	var queued *entities.Job
+	mockImports.On("Create", mock.Anything, mock.Anything).Return(&entities.ProductImport{ID: 7, Status: entities.ImportPending, Currency: "USD"}, nil)
+	mockJobs.On("Create", ctx, mock.Anything).Return(&entities.Job{ID: 3}, nil).Run(func(args mock.Arguments) {
+		queued = args.Get(1).(*entities.Job)
+	})
+	_, err := useCases.StartImport(ctx, &dto.ImportRequestDTO{Currency: "USD"}, strings.NewReader(
+		"sku,name,price,category\nNEW-001,Laptop,999.00,Electronics\n"))
+	require.NoError(t, err)
+
+	var request dto.ImportJobRequestDTO
+	require.NoError(t, json.Unmarshal(queued.Payload, &request))
+	mockImports.On("GetByID", ctx, uint(7)).Return(&entities.ProductImport{ID: 7, Status: entities.ImportPending, Currency: "USD"}, nil)
+	mockImports.On("Save", ctx, mock.Anything).Return(nil)
+	mockProducts.On("GetBySKU", ctx, "NEW-001").Return(nil, domainErrors.ErrProductNotFound)
+	mockProducts.On("Create", ctx, mock.Anything).Return(&entities.Product{ID: 1}, nil)
+
+	// When
+	result, err := useCases.ProcessImport(ctx, &request)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, entities.ImportCompleted, result.Status)
+	assert.Equal(t, 1, result.Created)
+	assert.NoFileExists(t, filepath.Join(testSpoolDirectory(t, useCases), request.File), "the spooled file is removed once read")
+}
+
+func TestImportUseCases_ProcessImport_FileGone(t *testing.T) {
+	// Given
+	useCases, mockImports, _ := setupTestImportUseCases(t)
+	ctx := context.Background()
+
+	mockImports.On("GetByID", ctx, uint(7)).Return(&entities.ProductImport{ID: 7, Status: entities.ImportPending, Currency: "USD"}, nil)
+	mockImports.On("Save", ctx, mock.MatchedBy(func(productImport *entities.ProductImport) bool {
+		return productImport.Status == entities.ImportFailed
+	})).Return(nil)
+
+	// When
+	_, err := useCases.ProcessImport(ctx, &dto.ImportJobRequestDTO{ImportID: 7, File: "product-import-missing.csv"})
+
+	// Then
+	require.Error(t, err)
+	mockImports.AssertExpectations(t)
+}
+
+// testSpoolDirectory is the spool directory of import use cases
+func testSpoolDirectory(t *testing.T, useCases ImportUseCases) string {
+	t.Helper()
+	return useCases.(*importUseCasesImpl).spoolDirectory()
+}
+
+func TestImportUseCases_StartImport_FileTooLarge(t *testing.T) {
+	// Given
+	useCases, mockImports, _ := setupTestImportUseCases(t)
+
+	// When
+	_, err := useCases.StartImport(context.Background(), &dto.ImportRequestDTO{}, strings.NewReader(strings.Repeat("x", 2<<10)))
+
+	// Then
+	var domainErr *domainErrors.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, domainErrors.ErrInvalidImport.Code, domainErr.Code)
+	assert.Equal(t, "file", domainErr.Field)
+	mockImports.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestImportUseCases_WriteErrorReport(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  entities.ImportStatus
+		want    string
+		wantErr error
+	}{
+		{
+			name:   "finished import",
+			status: entities.ImportCompleted,
+			want: "row,sku,field,code,message\n" +
+				"4,BAD-001,name,INVALID_PRODUCT_NAME,product name must be at least 2 characters long\n",
+		},
+		{
+			name:    "running import",
+			status:  entities.ImportRunning,
+			wantErr: domainErrors.ErrImportNotFinished,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			useCases, mockImports, _ := setupTestImportUseCases(t)
+			ctx := context.Background()
+
+			mockImports.On("GetByID", ctx, uint(7)).Return(&entities.ProductImport{ID: 7, Status: tt.status, Failed: 1}, nil)
+			mockImports.On("EachError", ctx, uint(7)).Return([]entities.ImportRowError{
+				{Row: 4, SKU: "BAD-001", Field: "name", Code: "INVALID_PRODUCT_NAME", Message: "product name must be at least 2 characters long"},
+			}, nil).Maybe()
+
+			// When
+			var report bytes.Buffer
+			err := useCases.WriteErrorReport(ctx, 7, &report)
+
+			// Then
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Empty(t, report.String())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, report.String())
+		})
+	}
+}
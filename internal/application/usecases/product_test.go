@@ -2,10 +2,14 @@ package usecases
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"product-service/internal/application/dto"
+	"product-service/internal/application/ports"
 	"product-service/internal/domain/entities"
 	domainErrors "product-service/internal/domain/errors"
 	"product-service/pkg/logger"
+	"product-service/pkg/requestctx"
 	"testing"
 	"time"
 
@@ -43,16 +47,249 @@ func (m *MockProductRepository) GetBySKU(ctx context.Context, sku string) (*enti
 	return args.Get(0).(*entities.Product), args.Error(1)
 }
 
+func (m *MockProductRepository) GetByGTIN(ctx context.Context, gtin string) (*entities.Product, error) {
+	args := m.Called(ctx, gtin)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Product), args.Error(1)
+}
+
+func (m *MockProductRepository) GetByIDAsOf(ctx context.Context, id uint, at time.Time) (*entities.Product, error) {
+	args := m.Called(ctx, id, at)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Product), args.Error(1)
+}
+
+func (m *MockProductRepository) GetBySKUAsOf(ctx context.Context, sku string, at time.Time) (*entities.Product, error) {
+	args := m.Called(ctx, sku, at)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Product), args.Error(1)
+}
+
 func (m *MockProductRepository) ExistsBySKU(ctx context.Context, sku string) (bool, error) {
 	args := m.Called(ctx, sku)
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *MockProductRepository) ExistsByGTIN(ctx context.Context, gtin string, excludeID uint) (bool, error) {
+	args := m.Called(ctx, gtin, excludeID)
+	return args.Bool(0), args.Error(1)
+}
+
+// ApplyEdit runs fn on the product returned by the expectation; price
+// history entries it returns are reported as a "savedPriceChanges" call
+func (m *MockProductRepository) ApplyEdit(ctx context.Context, id uint, fn ports.ProductEditFunc) (*entities.Product, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	product := args.Get(0).(*entities.Product)
+	changes, err := fn(product)
+	if err != nil {
+		return nil, err
+	}
+	if len(changes) > 0 {
+		m.MethodCalled("savedPriceChanges", changes)
+	}
+	return product, args.Error(1)
+}
+
+func (m *MockProductRepository) Search(ctx context.Context, criteria ports.ProductSearchCriteria) ([]*entities.Product, int64, error) {
+	args := m.Called(ctx, criteria)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*entities.Product), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockProductRepository) Each(ctx context.Context, criteria ports.ProductSearchCriteria, fn func(*entities.Product) error) error {
+	args := m.Called(ctx, criteria)
+	if products, ok := args.Get(0).([]*entities.Product); ok {
+		for _, product := range products {
+			if err := fn(product); err != nil {
+				return err
+			}
+		}
+	}
+	return args.Error(1)
+}
+
+// MockAttributeRepository implements the AttributeRepository interface for testing
+type MockAttributeRepository struct {
+	mock.Mock
+}
+
+func (m *MockAttributeRepository) ListDefinitions(ctx context.Context, categoryIDs ...uint) ([]*entities.AttributeDefinition, error) {
+	args := m.Called(ctx, categoryIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.AttributeDefinition), args.Error(1)
+}
+
+func (m *MockAttributeRepository) GetDefinition(ctx context.Context, categoryID uint, key string) (*entities.AttributeDefinition, error) {
+	args := m.Called(ctx, categoryID, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.AttributeDefinition), args.Error(1)
+}
+
+func (m *MockAttributeRepository) SaveDefinition(ctx context.Context, definition *entities.AttributeDefinition) (*entities.AttributeDefinition, error) {
+	args := m.Called(ctx, definition)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.AttributeDefinition), args.Error(1)
+}
+
+func (m *MockAttributeRepository) DeleteDefinition(ctx context.Context, categoryID uint, key string) error {
+	args := m.Called(ctx, categoryID, key)
+	return args.Error(0)
+}
+
+// MockPriceHistoryRepository implements the PriceHistoryRepository interface
+// for testing. ApplyPriceChange and ProcessDuePriceChanges run the callback
+// on the product or change returned by the expectation.
+type MockPriceHistoryRepository struct {
+	mock.Mock
+}
+
+func (m *MockPriceHistoryRepository) ApplyPriceChange(ctx context.Context, productID uint, fn ports.PriceChangeFunc) (*entities.Product, error) {
+	args := m.Called(ctx, productID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	product := args.Get(0).(*entities.Product)
+	changes, err := fn(product)
+	if err != nil {
+		return nil, err
+	}
+	m.MethodCalled("savedPriceChanges", changes)
+	return product, args.Error(1)
+}
+
+func (m *MockPriceHistoryRepository) ProcessDuePriceChanges(ctx context.Context, now time.Time, limit int, fn ports.DuePriceChangeFunc) (int, error) {
+	args := m.Called(ctx, now, limit)
+	processed := 0
+	if args.Get(0) != nil {
+		product := args.Get(1).(*entities.Product)
+		for _, change := range args.Get(0).([]*entities.PriceChange) {
+			additional, err := fn(change, product)
+			if err != nil {
+				return processed, err
+			}
+			m.MethodCalled("savedPriceChanges", additional)
+			processed++
+		}
+	}
+	return processed, args.Error(2)
+}
+
+func (m *MockPriceHistoryRepository) SchedulePriceChange(ctx context.Context, change *entities.PriceChange) (*entities.PriceChange, error) {
+	args := m.Called(ctx, change)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.PriceChange), args.Error(1)
+}
+
+func (m *MockPriceHistoryRepository) GetPriceChange(ctx context.Context, productID, changeID uint) (*entities.PriceChange, error) {
+	args := m.Called(ctx, productID, changeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.PriceChange), args.Error(1)
+}
+
+func (m *MockPriceHistoryRepository) SavePriceChange(ctx context.Context, change *entities.PriceChange) error {
+	args := m.Called(ctx, change)
+	return args.Error(0)
+}
+
+func (m *MockPriceHistoryRepository) ListPriceChanges(ctx context.Context, productID uint) ([]*entities.PriceChange, error) {
+	args := m.Called(ctx, productID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.PriceChange), args.Error(1)
+}
+
+// MockLifecycleRepository implements the LifecycleRepository interface for
+// testing. ApplyTransition runs the callback on the product returned by the
+// expectation.
+type MockLifecycleRepository struct {
+	mock.Mock
+}
+
+func (m *MockLifecycleRepository) ApplyTransition(ctx context.Context, productID uint, fn ports.StatusTransitionFunc) (*entities.Product, error) {
+	args := m.Called(ctx, productID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	product := args.Get(0).(*entities.Product)
+	transition, err := fn(product)
+	if err != nil {
+		return nil, err
+	}
+	m.MethodCalled("savedTransition", transition)
+	return product, args.Error(1)
+}
+
+func (m *MockLifecycleRepository) ListTransitions(ctx context.Context, productID uint) ([]*entities.StatusTransition, error) {
+	args := m.Called(ctx, productID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.StatusTransition), args.Error(1)
+}
+
 func setupTestUseCases() (ProductUseCases, *MockProductRepository) {
+	useCases, mockRepo, _ := setupTestUseCasesWithPriceHistory()
+	return useCases, mockRepo
+}
+
+func setupTestUseCasesWithPriceHistory() (ProductUseCases, *MockProductRepository, *MockPriceHistoryRepository) {
+	mockAttributes := new(MockAttributeRepository)
+	mockAttributes.On("ListDefinitions", mock.Anything, mock.Anything).Return([]*entities.AttributeDefinition{}, nil).Maybe()
+	useCases, mockRepo, mockHistory := setupTestUseCasesWithAttributes(mockAttributes)
+	return useCases, mockRepo, mockHistory
+}
+
+func setupTestUseCasesWithAttributes(mockAttributes *MockAttributeRepository) (ProductUseCases, *MockProductRepository, *MockPriceHistoryRepository) {
+	mockCategories := new(MockCategoryRepository)
+	mockCategories.On("GetByPath", mock.Anything, "electronics").Return(testCategory(1, "Electronics", nil), nil).Maybe()
+	mockCategories.On("GetByPath", mock.Anything, "laptops").Return(testCategory(3, "Laptops", nil), nil).Maybe()
+	mockCategories.On("ListSubtree", mock.Anything, "laptops").Return([]*entities.Category{testCategory(3, "Laptops", nil)}, nil).Maybe()
+	return setupTestUseCasesWithCategories(mockAttributes, mockCategories)
+}
+
+func setupTestUseCasesWithCategories(mockAttributes *MockAttributeRepository, mockCategories *MockCategoryRepository) (ProductUseCases, *MockProductRepository, *MockPriceHistoryRepository) {
 	mockRepo := new(MockProductRepository)
+	mockHistory := new(MockPriceHistoryRepository)
+	mockBrands := new(MockBrandRepository)
+	apple := testBrand(1, "Apple", "Apple Computer")
+	sun := testBrand(2, "Sun Microsystems")
+	sun.Status = entities.BrandStatusInactive
+	mockBrands.On("FindByName", mock.Anything, "Apple").Return(apple, nil).Maybe()
+	mockBrands.On("FindByName", mock.Anything, "apple computer").Return(apple, nil).Maybe()
+	mockBrands.On("FindByName", mock.Anything, "Sun Microsystems").Return(sun, nil).Maybe()
+	mockBrands.On("FindByName", mock.Anything, mock.Anything).Return(nil, domainErrors.ErrBrandNotFound).Maybe()
 	log := logger.New("test")
-	useCases := NewProductUseCases(mockRepo, log)
-	return useCases, mockRepo
+	useCases := NewProductUseCases(mockRepo, mockHistory, new(MockLifecycleRepository), mockAttributes, mockCategories, mockBrands, log)
+	return useCases, mockRepo, mockHistory
+}
+
+func setupTestLifecycleUseCases() (ProductUseCases, *MockProductRepository, *MockLifecycleRepository) {
+	mockRepo := new(MockProductRepository)
+	mockLifecycle := new(MockLifecycleRepository)
+	useCases := NewProductUseCases(mockRepo, new(MockPriceHistoryRepository), mockLifecycle, new(MockAttributeRepository), new(MockCategoryRepository), new(MockBrandRepository), logger.New("test"))
+	return useCases, mockRepo, mockLifecycle
 }
 
 // CreateProduct Tests
@@ -65,7 +302,7 @@ func TestProductUseCases_CreateProduct_Success(t *testing.T) {
 		Name:        "iPhone 15",
 		Description: "Latest Apple smartphone",
 		SKU:         "IPH15-128GB",
-		Price:       999.99,
+		Price:       entities.MustParseMoney("999.99", "USD"),
 		Category:    "Electronics",
 		Brand:       "Apple",
 		Stock:       100,
@@ -80,7 +317,7 @@ func TestProductUseCases_CreateProduct_Success(t *testing.T) {
 		Name:        "iPhone 15",
 		Description: "Latest Apple smartphone",
 		SKU:         "IPH15-128GB",
-		Price:       999.99,
+		Price:       entities.MustParseMoney("999.99", "USD"),
 		Category:    "Electronics",
 		Brand:       "Apple",
 		Stock:       100,
@@ -92,7 +329,7 @@ func TestProductUseCases_CreateProduct_Success(t *testing.T) {
 	mockRepo.On("Create", ctx, mock.MatchedBy(func(product *entities.Product) bool {
 		return product.Name == "iPhone 15" &&
 			product.SKU == "IPH15-128GB" &&
-			product.Price == 999.99 &&
+			product.Price.Equal(entities.MustParseMoney("999.99", "USD")) &&
 			product.Category == "Electronics" &&
 			product.Brand == "Apple" &&
 			product.Stock == 100 &&
@@ -108,7 +345,7 @@ func TestProductUseCases_CreateProduct_Success(t *testing.T) {
 	assert.Equal(t, uint(1), result.ID)
 	assert.Equal(t, "iPhone 15", result.Name)
 	assert.Equal(t, "IPH15-128GB", result.SKU)
-	assert.Equal(t, 999.99, result.Price)
+	assert.Equal(t, entities.MustParseMoney("999.99", "USD"), result.Price)
 	assert.Equal(t, "Electronics", result.Category)
 	assert.Equal(t, "Apple", result.Brand)
 	assert.Equal(t, 100, result.Stock)
@@ -117,6 +354,71 @@ func TestProductUseCases_CreateProduct_Success(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
+func TestProductUseCases_CreateProduct_BrandAlias(t *testing.T) {
+	// Given
+	useCases, mockRepo := setupTestUseCases()
+	ctx := context.Background()
+
+	request := &dto.CreateProductRequestDTO{
+		Name:     "Macintosh Plus",
+		SKU:      "MAC-PLUS-1986",
+		Price:    entities.MustParseMoney("2599.00", "USD"),
+		Category: "Electronics",
+		Brand:    "apple computer",
+		Stock:    3,
+	}
+
+	mockRepo.On("ExistsBySKU", ctx, "MAC-PLUS-1986").Return(false, nil)
+	mockRepo.On("Create", ctx, mock.MatchedBy(func(product *entities.Product) bool {
+		return product.Brand == "Apple" && product.BrandID != nil && *product.BrandID == 1
+	})).Return(&entities.Product{ID: 7, SKU: "MAC-PLUS-1986", Brand: "Apple"}, nil)
+
+	// When
+	result, err := useCases.CreateProduct(ctx, request)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "Apple", result.Brand)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestProductUseCases_CreateProduct_InvalidBrand(t *testing.T) {
+	tests := []struct {
+		name  string
+		brand string
+	}{
+		{"unregistered brand", "Blackberry"},
+		{"inactive brand", "Sun Microsystems"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			useCases, mockRepo := setupTestUseCases()
+			ctx := context.Background()
+
+			mockRepo.On("ExistsBySKU", ctx, "SKU-BRAND-1").Return(false, nil)
+
+			// When
+			result, err := useCases.CreateProduct(ctx, &dto.CreateProductRequestDTO{
+				Name:     "Phone",
+				SKU:      "SKU-BRAND-1",
+				Price:    entities.MustParseMoney("99.00", "USD"),
+				Category: "Electronics",
+				Brand:    tt.brand,
+			})
+
+			// Then
+			assert.Nil(t, result)
+			var domainErr *domainErrors.DomainError
+			require.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, domainErrors.ErrInvalidProductBrand.Code, domainErr.Code)
+			assert.Equal(t, "brand", domainErr.Field)
+			mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
 func TestProductUseCases_CreateProduct_SKUAlreadyExists(t *testing.T) {
 	// Given
 	useCases, mockRepo := setupTestUseCases()
@@ -126,7 +428,7 @@ func TestProductUseCases_CreateProduct_SKUAlreadyExists(t *testing.T) {
 		Name:        "iPhone 15",
 		Description: "Latest Apple smartphone",
 		SKU:         "EXISTING-SKU",
-		Price:       999.99,
+		Price:       entities.MustParseMoney("999.99", "USD"),
 		Category:    "Electronics",
 		Brand:       "Apple",
 		Stock:       100,
@@ -155,7 +457,7 @@ func TestProductUseCases_CreateProduct_InvalidSKU(t *testing.T) {
 		Name:        "iPhone 15",
 		Description: "Latest Apple smartphone",
 		SKU:         "AB", // Invalid SKU - too short
-		Price:       999.99,
+		Price:       entities.MustParseMoney("999.99", "USD"),
 		Category:    "Electronics",
 		Brand:       "Apple",
 		Stock:       100,
@@ -170,6 +472,218 @@ func TestProductUseCases_CreateProduct_InvalidSKU(t *testing.T) {
 	assert.Equal(t, domainErrors.ErrInvalidProductSKU, err)
 }
 
+// setupTestSKURulesUseCases files products under a Phones category with the
+// given SKU rules
+func setupTestSKURulesUseCases(t *testing.T, pattern, template string) (ProductUseCases, *MockProductRepository, *MockCategoryRepository) {
+	phones := testCategory(4, "Phones", nil)
+	require.NoError(t, phones.SetSKURules(pattern, template))
+
+	mockAttributes := new(MockAttributeRepository)
+	mockAttributes.On("ListDefinitions", mock.Anything, mock.Anything).Return([]*entities.AttributeDefinition{}, nil).Maybe()
+	mockCategories := new(MockCategoryRepository)
+	mockCategories.On("GetByPath", mock.Anything, "phones").Return(phones, nil).Maybe()
+	useCases, mockRepo, _ := setupTestUseCasesWithCategories(mockAttributes, mockCategories)
+	return useCases, mockRepo, mockCategories
+}
+
+func TestProductUseCases_CreateProduct_GeneratesSKU(t *testing.T) {
+	// Given
+	useCases, mockRepo, mockCategories := setupTestSKURulesUseCases(t, `APP-PHO-\d{4}`, "{BRAND}-{CATEGORY}-{SEQ}")
+	ctx := context.Background()
+
+	// the first number drawn gives a SKU that was typed in by hand
+	mockCategories.On("NextSKUSequence", ctx, uint(4)).Return(int64(41), nil).Once()
+	mockCategories.On("NextSKUSequence", ctx, uint(4)).Return(int64(42), nil).Once()
+	mockRepo.On("ExistsBySKU", ctx, "APP-PHO-0041").Return(true, nil)
+	mockRepo.On("ExistsBySKU", ctx, "APP-PHO-0042").Return(false, nil)
+	mockRepo.On("Create", ctx, mock.MatchedBy(func(product *entities.Product) bool {
+		return product.SKU == "APP-PHO-0042" && product.CategoryID == 4
+	})).Return(&entities.Product{ID: 9, SKU: "APP-PHO-0042"}, nil)
+
+	// When
+	result, err := useCases.CreateProduct(ctx, &dto.CreateProductRequestDTO{
+		Name:     "iPhone 15",
+		Price:    entities.MustParseMoney("999.99", "USD"),
+		Category: "Phones",
+		Brand:    "Apple",
+	})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "APP-PHO-0042", result.SKU)
+	mockRepo.AssertExpectations(t)
+	mockCategories.AssertExpectations(t)
+}
+
+func TestProductUseCases_CreateProduct_SKURequiredWithoutTemplate(t *testing.T) {
+	// Given
+	useCases, mockRepo := setupTestUseCases()
+	ctx := context.Background()
+
+	// When
+	result, err := useCases.CreateProduct(ctx, &dto.CreateProductRequestDTO{
+		Name:     "iPhone 15",
+		Price:    entities.MustParseMoney("999.99", "USD"),
+		Category: "Electronics",
+	})
+
+	// Then
+	assert.Nil(t, result)
+	var domainErr *domainErrors.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, domainErrors.ErrInvalidProductSKU.Code, domainErr.Code)
+	assert.Equal(t, "sku", domainErr.Field)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestProductUseCases_CreateProduct_SKUTemplateNeedsBrand(t *testing.T) {
+	// Given
+	useCases, mockRepo, mockCategories := setupTestSKURulesUseCases(t, "", "{BRAND}-{SEQ}")
+	ctx := context.Background()
+
+	// When
+	result, err := useCases.CreateProduct(ctx, &dto.CreateProductRequestDTO{
+		Name:     "Unbranded phone",
+		Price:    entities.MustParseMoney("99.00", "USD"),
+		Category: "Phones",
+	})
+
+	// Then
+	assert.Nil(t, result)
+	var domainErr *domainErrors.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "brand", domainErr.Field)
+	mockCategories.AssertNotCalled(t, "NextSKUSequence", mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestProductUseCases_CreateProduct_SKUPatternMismatch(t *testing.T) {
+	// Given
+	useCases, mockRepo, _ := setupTestSKURulesUseCases(t, `PHN-\d{4}`, "")
+	ctx := context.Background()
+
+	mockRepo.On("ExistsBySKU", ctx, "IPH15-128GB").Return(false, nil)
+
+	// When
+	result, err := useCases.CreateProduct(ctx, &dto.CreateProductRequestDTO{
+		Name:     "iPhone 15",
+		SKU:      "IPH15-128GB",
+		Price:    entities.MustParseMoney("999.99", "USD"),
+		Category: "Phones",
+	})
+
+	// Then
+	assert.Nil(t, result)
+	var domainErr *domainErrors.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, domainErrors.ErrInvalidProductSKU.Code, domainErr.Code)
+	assert.Equal(t, "sku", domainErr.Field)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestProductUseCases_UpdateProduct_CategorySKUPattern(t *testing.T) {
+	tests := []struct {
+		name       string
+		categoryID uint
+		sku        string
+		wantErr    bool
+	}{
+		{name: "moving in with a matching SKU", categoryID: 1, sku: "PHN-0001"},
+		{name: "moving in with another SKU", categoryID: 1, sku: "IPH15-128GB", wantErr: true},
+		{name: "staying keeps an SKU from before the rules", categoryID: 4, sku: "IPH15-128GB"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			useCases, mockRepo, _ := setupTestSKURulesUseCases(t, `PHN-\d{4}`, "")
+			ctx := context.Background()
+
+			existingProduct := &entities.Product{
+				ID:         1,
+				Name:       "iPhone 15",
+				SKU:        tt.sku,
+				Price:      entities.MustParseMoney("999.99", "USD"),
+				CategoryID: tt.categoryID,
+				Category:   "Electronics",
+				Status:     entities.ProductStatusActive,
+			}
+			mockRepo.On("ApplyEdit", ctx, uint(1)).Return(existingProduct, nil)
+
+			// When
+			result, err := useCases.UpdateProduct(ctx, 1, &dto.UpdateProductRequestDTO{Category: "Phones"})
+
+			// Then
+			if !tt.wantErr {
+				require.NoError(t, err)
+				assert.Equal(t, uint(4), result.CategoryID)
+				return
+			}
+			assert.Nil(t, result)
+			var domainErr *domainErrors.DomainError
+			require.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, domainErrors.ErrInvalidProductSKU.Code, domainErr.Code)
+			assert.Equal(t, "sku", domainErr.Field)
+			assert.Equal(t, uint(1), existingProduct.CategoryID, "the product stays where it was")
+		})
+	}
+}
+
+func TestProductUseCases_CreateProduct_GTIN(t *testing.T) {
+	tests := []struct {
+		name    string
+		gtin    string
+		taken   bool
+		wantErr error
+		code    string
+	}{
+		{name: "free", gtin: "4006381-333931"},
+		{name: "taken", gtin: "4006381333931", taken: true, wantErr: domainErrors.ErrProductGTINAlreadyExists},
+		{name: "wrong check digit", gtin: "4006381333932", code: domainErrors.ErrInvalidProductGTIN.Code},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			useCases, mockRepo := setupTestUseCases()
+			ctx := context.Background()
+
+			mockRepo.On("ExistsBySKU", ctx, "EAN-PHONE-1").Return(false, nil)
+			mockRepo.On("ExistsByGTIN", ctx, "4006381333931", uint(0)).Return(tt.taken, nil).Maybe()
+			mockRepo.On("Create", ctx, mock.MatchedBy(func(product *entities.Product) bool {
+				return product.GTIN == "4006381333931"
+			})).Return(&entities.Product{ID: 5, SKU: "EAN-PHONE-1", GTIN: "4006381333931"}, nil).Maybe()
+
+			// When
+			result, err := useCases.CreateProduct(ctx, &dto.CreateProductRequestDTO{
+				Name:     "Phone",
+				SKU:      "EAN-PHONE-1",
+				GTIN:     tt.gtin,
+				Price:    entities.MustParseMoney("99.00", "USD"),
+				Category: "Electronics",
+			})
+
+			// Then
+			switch {
+			case tt.wantErr != nil:
+				assert.Nil(t, result)
+				assert.Equal(t, tt.wantErr, err)
+				mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+			case tt.code != "":
+				assert.Nil(t, result)
+				var domainErr *domainErrors.DomainError
+				require.ErrorAs(t, err, &domainErr)
+				assert.Equal(t, tt.code, domainErr.Code)
+				assert.Equal(t, "gtin", domainErr.Field)
+				mockRepo.AssertNotCalled(t, "ExistsByGTIN", mock.Anything, mock.Anything, mock.Anything)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, "4006381333931", result.GTIN)
+			}
+		})
+	}
+}
+
 func TestProductUseCases_CreateProduct_RepositoryExistsError(t *testing.T) {
 	// Given
 	useCases, mockRepo := setupTestUseCases()
@@ -179,7 +693,7 @@ func TestProductUseCases_CreateProduct_RepositoryExistsError(t *testing.T) {
 		Name:        "iPhone 15",
 		Description: "Latest Apple smartphone",
 		SKU:         "IPH15-128GB",
-		Price:       999.99,
+		Price:       entities.MustParseMoney("999.99", "USD"),
 		Category:    "Electronics",
 		Brand:       "Apple",
 		Stock:       100,
@@ -208,7 +722,7 @@ func TestProductUseCases_CreateProduct_RepositoryCreateError(t *testing.T) {
 		Name:        "iPhone 15",
 		Description: "Latest Apple smartphone",
 		SKU:         "IPH15-128GB",
-		Price:       999.99,
+		Price:       entities.MustParseMoney("999.99", "USD"),
 		Category:    "Electronics",
 		Brand:       "Apple",
 		Stock:       100,
@@ -240,7 +754,7 @@ func TestProductUseCases_GetProductByID_Success(t *testing.T) {
 		Name:        "iPhone 15",
 		Description: "Latest Apple smartphone",
 		SKU:         "IPH15-128GB",
-		Price:       999.99,
+		Price:       entities.MustParseMoney("999.99", "USD"),
 		Category:    "Electronics",
 		Brand:       "Apple",
 		Stock:       100,
@@ -293,7 +807,7 @@ func TestProductUseCases_GetProductBySKU_Success(t *testing.T) {
 		Name:        "iPhone 15",
 		Description: "Latest Apple smartphone",
 		SKU:         "IPH15-128GB",
-		Price:       999.99,
+		Price:       entities.MustParseMoney("999.99", "USD"),
 		Category:    "Electronics",
 		Brand:       "Apple",
 		Status:      entities.ProductStatusActive,
@@ -331,6 +845,87 @@ func TestProductUseCases_GetProductBySKU_NotFound(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
+func TestProductUseCases_GetProductByGTIN(t *testing.T) {
+	// Given
+	useCases, mockRepo := setupTestUseCases()
+	ctx := context.Background()
+
+	mockRepo.On("GetByGTIN", ctx, "036000291452").Return(&entities.Product{ID: 3, SKU: "UPC-1", GTIN: "0036000291452"}, nil)
+
+	// When
+	result, err := useCases.GetProductByGTIN(ctx, "0360-0029-1452")
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, uint(3), result.ID)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestProductUseCases_GetProductByGTIN_Invalid(t *testing.T) {
+	// Given
+	useCases, mockRepo := setupTestUseCases()
+	ctx := context.Background()
+
+	// When
+	result, err := useCases.GetProductByGTIN(ctx, "12345")
+
+	// Then
+	assert.Nil(t, result)
+	var domainErr *domainErrors.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, domainErrors.ErrInvalidProductGTIN.Code, domainErr.Code)
+	mockRepo.AssertNotCalled(t, "GetByGTIN", mock.Anything, mock.Anything)
+}
+
+// GetProductAsOf Tests
+func TestProductUseCases_GetProductAsOf_Success(t *testing.T) {
+	// Given
+	useCases, mockRepo := setupTestUseCases()
+	ctx := context.Background()
+	asOf := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+
+	version := &entities.Product{
+		ID:     1,
+		Name:   "iPhone 15",
+		SKU:    "IPH15-128GB",
+		Price:  entities.MustParseMoney("899.00", "USD"),
+		Status: entities.ProductStatusInactive,
+	}
+
+	mockRepo.On("GetByIDAsOf", ctx, uint(1), asOf).Return(version, nil)
+
+	// When
+	result, err := useCases.GetProductAsOf(ctx, 1, asOf)
+
+	// Then
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, entities.MustParseMoney("899.00", "USD"), result.Price)
+	assert.Equal(t, entities.ProductStatusInactive, result.Status)
+	require.NotNil(t, result.AsOf)
+	assert.Equal(t, asOf, *result.AsOf)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestProductUseCases_GetProductBySKUAsOf_NoVersion(t *testing.T) {
+	// Given
+	useCases, mockRepo := setupTestUseCases()
+	ctx := context.Background()
+	asOf := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mockRepo.On("GetBySKUAsOf", ctx, "IPH15-128GB", asOf).Return(nil, domainErrors.ErrProductVersionNotFound)
+
+	// When
+	result, err := useCases.GetProductBySKUAsOf(ctx, "IPH15-128GB", asOf)
+
+	// Then
+	assert.Nil(t, result)
+	assert.Equal(t, domainErrors.ErrProductVersionNotFound, err)
+
+	mockRepo.AssertExpectations(t)
+}
+
 // UpdateProduct Tests
 func TestProductUseCases_UpdateProduct_Success(t *testing.T) {
 	// Given
@@ -342,14 +937,14 @@ func TestProductUseCases_UpdateProduct_Success(t *testing.T) {
 		Name:        "iPhone 15",
 		Description: "Latest Apple smartphone",
 		SKU:         "IPH15-128GB",
-		Price:       999.99,
+		Price:       entities.MustParseMoney("999.99", "USD"),
 		Category:    "Electronics",
 		Brand:       "Apple",
 		Stock:       100,
 		Status:      entities.ProductStatusActive,
 	}
 
-	newPrice := 899.99
+	newPrice := entities.MustParseMoney("899.99", "USD")
 	newStock := 150
 	request := &dto.UpdateProductRequestDTO{
 		Name:        "iPhone 15 Pro",
@@ -358,7 +953,12 @@ func TestProductUseCases_UpdateProduct_Success(t *testing.T) {
 		Stock:       &newStock,
 	}
 
-	mockRepo.On("GetByID", ctx, uint(1)).Return(existingProduct, nil)
+	mockRepo.On("ApplyEdit", ctx, uint(1)).Return(existingProduct, nil)
+	mockRepo.On("savedPriceChanges", mock.MatchedBy(func(changes []*entities.PriceChange) bool {
+		return len(changes) == 1 && changes[0].ProductID == 1 &&
+			changes[0].OldPrice.Equal(entities.MustParseMoney("999.99", "USD")) &&
+			changes[0].NewPrice.Equal(newPrice)
+	})).Return()
 
 	// When
 	result, err := useCases.UpdateProduct(ctx, 1, request)
@@ -369,7 +969,7 @@ func TestProductUseCases_UpdateProduct_Success(t *testing.T) {
 	assert.Equal(t, uint(1), result.ID)
 	assert.Equal(t, "iPhone 15 Pro", result.Name)
 	assert.Equal(t, "Updated description", result.Description)
-	assert.Equal(t, 899.99, result.Price)
+	assert.Equal(t, entities.MustParseMoney("899.99", "USD"), result.Price)
 	assert.Equal(t, 150, result.Stock)
 
 	mockRepo.AssertExpectations(t)
@@ -384,7 +984,7 @@ func TestProductUseCases_UpdateProduct_ProductNotFound(t *testing.T) {
 		Name: "Updated Name",
 	}
 
-	mockRepo.On("GetByID", ctx, uint(999)).Return(nil, domainErrors.ErrProductNotFound)
+	mockRepo.On("ApplyEdit", ctx, uint(999)).Return(nil, domainErrors.ErrProductNotFound)
 
 	// When
 	result, err := useCases.UpdateProduct(ctx, 999, request)
@@ -407,7 +1007,7 @@ func TestProductUseCases_UpdateProduct_PartialUpdate(t *testing.T) {
 		Name:        "iPhone 15",
 		Description: "Original description",
 		SKU:         "IPH15-128GB",
-		Price:       999.99,
+		Price:       entities.MustParseMoney("999.99", "USD"),
 		Category:    "Electronics",
 		Brand:       "Apple",
 		Stock:       100,
@@ -419,7 +1019,7 @@ func TestProductUseCases_UpdateProduct_PartialUpdate(t *testing.T) {
 		// Only name updated - other fields should remain the same
 	}
 
-	mockRepo.On("GetByID", ctx, uint(1)).Return(existingProduct, nil)
+	mockRepo.On("ApplyEdit", ctx, uint(1)).Return(existingProduct, nil)
 
 	// When
 	result, err := useCases.UpdateProduct(ctx, 1, request)
@@ -428,10 +1028,11 @@ func TestProductUseCases_UpdateProduct_PartialUpdate(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, result)
 	assert.Equal(t, "iPhone 15 Pro", result.Name)
-	assert.Equal(t, "Original description", result.Description) // Should remain unchanged
-	assert.Equal(t, 999.99, result.Price)                       // Should remain unchanged
-	assert.Equal(t, 100, result.Stock)                          // Should remain unchanged
+	assert.Equal(t, "Original description", result.Description)             // Should remain unchanged
+	assert.Equal(t, entities.MustParseMoney("999.99", "USD"), result.Price) // Should remain unchanged
+	assert.Equal(t, 100, result.Stock)                                      // Should remain unchanged
 
+	mockRepo.AssertNotCalled(t, "savedPriceChanges", mock.Anything)
 	mockRepo.AssertExpectations(t)
 }
 
@@ -450,7 +1051,7 @@ func TestProductUseCases_UpdateProductStock_Success(t *testing.T) {
 		UpdatedAt: time.Now().Add(-time.Hour),
 	}
 
-	mockRepo.On("GetByID", ctx, uint(1)).Return(existingProduct, nil)
+	mockRepo.On("ApplyEdit", ctx, uint(1)).Return(existingProduct, nil)
 
 	// When
 	result, err := useCases.UpdateProductStock(ctx, 1, 150)
@@ -477,7 +1078,7 @@ func TestProductUseCases_UpdateProductStock_InvalidStock(t *testing.T) {
 		Status: entities.ProductStatusActive,
 	}
 
-	mockRepo.On("GetByID", ctx, uint(1)).Return(existingProduct, nil)
+	mockRepo.On("ApplyEdit", ctx, uint(1)).Return(existingProduct, nil)
 
 	// When
 	result, err := useCases.UpdateProductStock(ctx, 1, -10) // Invalid negative stock
@@ -493,73 +1094,106 @@ func TestProductUseCases_UpdateProductStock_InvalidStock(t *testing.T) {
 // UpdateProductPrice Tests
 func TestProductUseCases_UpdateProductPrice_Success(t *testing.T) {
 	// Given
-	useCases, mockRepo := setupTestUseCases()
-	ctx := context.Background()
+	useCases, _, mockHistory := setupTestUseCasesWithPriceHistory()
+	ctx := requestctx.WithActor(context.Background(), "alice")
 
 	existingProduct := &entities.Product{
 		ID:        1,
 		Name:      "iPhone 15",
 		SKU:       "IPH15-128GB",
-		Price:     999.99,
+		Price:     entities.MustParseMoney("999.99", "USD"),
 		Status:    entities.ProductStatusActive,
 		UpdatedAt: time.Now().Add(-time.Hour),
 	}
 
-	mockRepo.On("GetByID", ctx, uint(1)).Return(existingProduct, nil)
+	var recorded []*entities.PriceChange
+	mockHistory.On("ApplyPriceChange", ctx, uint(1)).Return(existingProduct, nil)
+	mockHistory.On("savedPriceChanges", mock.Anything).Run(func(args mock.Arguments) {
+		recorded = args.Get(0).([]*entities.PriceChange)
+	}).Return()
 
 	// When
-	result, err := useCases.UpdateProductPrice(ctx, 1, 899.99)
+	result, err := useCases.UpdateProductPrice(ctx, 1, entities.MustParseMoney("899.99", "USD"), "competitor match")
 
 	// Then
 	require.NoError(t, err)
 	require.NotNil(t, result)
 	assert.Equal(t, uint(1), result.ID)
-	assert.Equal(t, 899.99, result.Price)
+	assert.Equal(t, entities.MustParseMoney("899.99", "USD"), result.Price)
 
-	mockRepo.AssertExpectations(t)
+	require.Len(t, recorded, 1)
+	assert.Equal(t, entities.MustParseMoney("999.99", "USD"), recorded[0].OldPrice)
+	assert.Equal(t, entities.MustParseMoney("899.99", "USD"), recorded[0].NewPrice)
+	assert.Equal(t, "alice", recorded[0].Actor)
+	assert.Equal(t, "competitor match", recorded[0].Reason)
+	assert.Equal(t, entities.PriceChangeApplied, recorded[0].Status)
+
+	mockHistory.AssertExpectations(t)
 }
 
 func TestProductUseCases_UpdateProductPrice_InvalidPrice(t *testing.T) {
 	// Given
-	useCases, mockRepo := setupTestUseCases()
+	useCases, _, mockHistory := setupTestUseCasesWithPriceHistory()
 	ctx := context.Background()
 
 	existingProduct := &entities.Product{
 		ID:     1,
 		Name:   "iPhone 15",
 		SKU:    "IPH15-128GB",
-		Price:  999.99,
+		Price:  entities.MustParseMoney("999.99", "USD"),
 		Status: entities.ProductStatusActive,
 	}
 
-	mockRepo.On("GetByID", ctx, uint(1)).Return(existingProduct, nil)
+	mockHistory.On("ApplyPriceChange", ctx, uint(1)).Return(existingProduct, nil)
 
 	// When
-	result, err := useCases.UpdateProductPrice(ctx, 1, -100.0) // Invalid negative price
+	result, err := useCases.UpdateProductPrice(ctx, 1, entities.MustParseMoney("-100", "USD"), "") // Invalid negative price
 
 	// Then
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "price cannot be negative")
 
-	mockRepo.AssertExpectations(t)
+	mockHistory.AssertExpectations(t)
+}
+
+func TestProductUseCases_UpdateProductPrice_ProductNotFound(t *testing.T) {
+	// Given
+	useCases, _, mockHistory := setupTestUseCasesWithPriceHistory()
+	ctx := context.Background()
+
+	mockHistory.On("ApplyPriceChange", ctx, uint(999)).Return(nil, domainErrors.ErrProductNotFound)
+
+	// When
+	result, err := useCases.UpdateProductPrice(ctx, 999, entities.MustParseMoney("10.00", "USD"), "")
+
+	// Then
+	assert.Nil(t, result)
+	assert.Equal(t, domainErrors.ErrProductNotFound, err)
+
+	mockHistory.AssertExpectations(t)
 }
 
 // ActivateProduct Tests
 func TestProductUseCases_ActivateProduct_Success(t *testing.T) {
 	// Given
-	useCases, mockRepo := setupTestUseCases()
-	ctx := context.Background()
+	useCases, _, mockLifecycle := setupTestLifecycleUseCases()
+	ctx := requestctx.WithActor(context.Background(), "alice")
 
 	existingProduct := &entities.Product{
 		ID:        1,
 		Name:      "iPhone 15",
 		SKU:       "IPH15-128GB",
+		Price:     entities.MustParseMoney("999.99", "USD"),
+		Category:  "Electronics",
 		Status:    entities.ProductStatusInactive,
 		UpdatedAt: time.Now().Add(-time.Hour),
 	}
 
-	mockRepo.On("GetByID", ctx, uint(1)).Return(existingProduct, nil)
+	mockLifecycle.On("ApplyTransition", ctx, uint(1)).Return(existingProduct, nil)
+	mockLifecycle.On("savedTransition", mock.MatchedBy(func(transition *entities.StatusTransition) bool {
+		return transition.From == entities.ProductStatusInactive && transition.To == entities.ProductStatusActive && transition.Actor == "alice"
+	})).Return()
 
 	// When
 	result, err := useCases.ActivateProduct(ctx, 1)
@@ -570,13 +1204,66 @@ func TestProductUseCases_ActivateProduct_Success(t *testing.T) {
 	assert.Equal(t, uint(1), result.ID)
 	assert.Equal(t, entities.ProductStatusActive, result.Status)
 
-	mockRepo.AssertExpectations(t)
+	mockLifecycle.AssertExpectations(t)
+}
+
+func TestProductUseCases_ActivateProduct_Discontinued(t *testing.T) {
+	// Given
+	useCases, _, mockLifecycle := setupTestLifecycleUseCases()
+	ctx := context.Background()
+
+	existingProduct := &entities.Product{
+		ID:       1,
+		Name:     "iPhone 15",
+		Price:    entities.MustParseMoney("999.99", "USD"),
+		Category: "Electronics",
+		Status:   entities.ProductStatusDiscontinued,
+	}
+
+	mockLifecycle.On("ApplyTransition", ctx, uint(1)).Return(existingProduct, nil)
+
+	// When
+	result, err := useCases.ActivateProduct(ctx, 1)
+
+	// Then
+	assert.Nil(t, result)
+	var domainErr *domainErrors.DomainError
+	require.True(t, errors.As(err, &domainErr))
+	assert.Equal(t, domainErrors.ErrInvalidStatusTransition.Code, domainErr.Code)
+	assert.Contains(t, domainErr.Message, "allowed: archived")
+	assert.Equal(t, entities.ProductStatusDiscontinued, existingProduct.Status)
+	mockLifecycle.AssertNotCalled(t, "savedTransition", mock.Anything)
+}
+
+func TestProductUseCases_TransitionProduct_GuardFailed(t *testing.T) {
+	// Given a draft without a category
+	useCases, _, mockLifecycle := setupTestLifecycleUseCases()
+	ctx := context.Background()
+
+	draft := &entities.Product{
+		ID:     1,
+		Name:   "iPhone 15",
+		Price:  entities.MustParseMoney("999.99", "USD"),
+		Status: entities.ProductStatusDraft,
+	}
+
+	mockLifecycle.On("ApplyTransition", ctx, uint(1)).Return(draft, nil)
+
+	// When
+	_, err := useCases.TransitionProduct(ctx, 1, &dto.TransitionRequestDTO{Status: entities.ProductStatusActive})
+
+	// Then
+	var domainErr *domainErrors.DomainError
+	require.True(t, errors.As(err, &domainErr))
+	assert.Equal(t, domainErrors.ErrTransitionGuardFailed.Code, domainErr.Code)
+	assert.Contains(t, domainErr.Message, "category is required")
+	assert.Equal(t, entities.ProductStatusDraft, draft.Status)
 }
 
 // DeactivateProduct Tests
 func TestProductUseCases_DeactivateProduct_Success(t *testing.T) {
 	// Given
-	useCases, mockRepo := setupTestUseCases()
+	useCases, _, mockLifecycle := setupTestLifecycleUseCases()
 	ctx := context.Background()
 
 	existingProduct := &entities.Product{
@@ -587,7 +1274,8 @@ func TestProductUseCases_DeactivateProduct_Success(t *testing.T) {
 		UpdatedAt: time.Now().Add(-time.Hour),
 	}
 
-	mockRepo.On("GetByID", ctx, uint(1)).Return(existingProduct, nil)
+	mockLifecycle.On("ApplyTransition", ctx, uint(1)).Return(existingProduct, nil)
+	mockLifecycle.On("savedTransition", mock.Anything).Return()
 
 	// When
 	result, err := useCases.DeactivateProduct(ctx, 1)
@@ -598,13 +1286,13 @@ func TestProductUseCases_DeactivateProduct_Success(t *testing.T) {
 	assert.Equal(t, uint(1), result.ID)
 	assert.Equal(t, entities.ProductStatusInactive, result.Status)
 
-	mockRepo.AssertExpectations(t)
+	mockLifecycle.AssertExpectations(t)
 }
 
 // DiscontinueProduct Tests
 func TestProductUseCases_DiscontinueProduct_Success(t *testing.T) {
 	// Given
-	useCases, mockRepo := setupTestUseCases()
+	useCases, _, mockLifecycle := setupTestLifecycleUseCases()
 	ctx := context.Background()
 
 	existingProduct := &entities.Product{
@@ -615,7 +1303,8 @@ func TestProductUseCases_DiscontinueProduct_Success(t *testing.T) {
 		UpdatedAt: time.Now().Add(-time.Hour),
 	}
 
-	mockRepo.On("GetByID", ctx, uint(1)).Return(existingProduct, nil)
+	mockLifecycle.On("ApplyTransition", ctx, uint(1)).Return(existingProduct, nil)
+	mockLifecycle.On("savedTransition", mock.Anything).Return()
 
 	// When
 	result, err := useCases.DiscontinueProduct(ctx, 1)
@@ -626,33 +1315,63 @@ func TestProductUseCases_DiscontinueProduct_Success(t *testing.T) {
 	assert.Equal(t, uint(1), result.ID)
 	assert.Equal(t, entities.ProductStatusDiscontinued, result.Status)
 
-	mockRepo.AssertExpectations(t)
+	mockLifecycle.AssertExpectations(t)
+}
+
+func TestProductUseCases_ListTransitions(t *testing.T) {
+	// Given
+	useCases, mockRepo, mockLifecycle := setupTestLifecycleUseCases()
+	ctx := context.Background()
+
+	mockRepo.On("GetByID", ctx, uint(1)).Return(&entities.Product{ID: 1, Status: entities.ProductStatusInactive}, nil)
+	mockLifecycle.On("ListTransitions", ctx, uint(1)).Return([]*entities.StatusTransition{
+		{ID: 1, ProductID: 1, From: entities.ProductStatusDraft, To: entities.ProductStatusActive},
+		{ID: 2, ProductID: 1, From: entities.ProductStatusActive, To: entities.ProductStatusInactive},
+	}, nil)
+
+	// When
+	result, err := useCases.ListTransitions(ctx, 1)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, entities.ProductStatusInactive, result.Status)
+	assert.Equal(t, []entities.ProductStatus{entities.ProductStatusActive, entities.ProductStatusDiscontinued}, result.Allowed)
+	assert.Len(t, result.Transitions, 2)
 }
 
 // ListProducts Tests
 func TestProductUseCases_ListProducts_Success(t *testing.T) {
 	// Given
-	useCases, _ := setupTestUseCases()
+	useCases, mockRepo := setupTestUseCases()
 	ctx := context.Background()
 
+	products := []*entities.Product{{ID: 1, Name: "iPhone 15", SKU: "IPH15-128GB", Status: entities.ProductStatusActive, Tags: []string{"new-arrivals"}}}
+	mockRepo.On("Search", ctx, ports.ProductSearchCriteria{Limit: 10, Offset: 0}).Return(products, int64(1), nil)
+
 	// When
-	result, err := useCases.ListProducts(ctx, 0, 10)
+	result, err := useCases.ListProducts(ctx, 0, 10, nil)
 
 	// Then
 	require.NoError(t, err)
 	require.NotNil(t, result)
-	assert.Equal(t, 0, result.Total) // Empty list since we don't have List method in repository yet
+	assert.Equal(t, 1, result.Total)
 	assert.Equal(t, 0, result.Page)
 	assert.Equal(t, 10, result.PageSize)
+	require.Len(t, result.Products, 1)
+	assert.Equal(t, []string{"new-arrivals"}, result.Products[0].Tags)
+
+	mockRepo.AssertExpectations(t)
 }
 
 func TestProductUseCases_ListProducts_InvalidPagination(t *testing.T) {
 	// Given
-	useCases, _ := setupTestUseCases()
+	useCases, mockRepo := setupTestUseCases()
 	ctx := context.Background()
 
+	mockRepo.On("Search", ctx, ports.ProductSearchCriteria{Limit: 10, Offset: 0}).Return([]*entities.Product{}, int64(0), nil)
+
 	// When - Pass invalid pagination parameters
-	result, err := useCases.ListProducts(ctx, -1, 150) // Invalid page and page_size
+	result, err := useCases.ListProducts(ctx, -1, 150, nil) // Invalid page and page_size
 
 	// Then
 	require.NoError(t, err)
@@ -661,27 +1380,152 @@ func TestProductUseCases_ListProducts_InvalidPagination(t *testing.T) {
 	assert.Equal(t, 10, result.PageSize) // Should default to 10
 }
 
-func TestValidateSKU(t *testing.T) {
-	tests := []struct {
-		name        string
-		sku         string
-		expectError bool
-	}{
-		{"valid SKU", "IPH15-128", false},
-		{"minimum length", "ABC", false},
-		{"empty SKU", "", true},
-		{"SKU too short", "AB", true},
-		{"SKU with spaces", " ABC123 ", false},
+func TestProductUseCases_ListProducts_NormalizesTags(t *testing.T) {
+	// Given
+	useCases, mockRepo := setupTestUseCases()
+	ctx := context.Background()
+
+	mockRepo.On("Search", ctx, ports.ProductSearchCriteria{Tags: []string{"summer-sale"}, Limit: 10, Offset: 20}).Return([]*entities.Product{}, int64(0), nil)
+
+	// When
+	_, err := useCases.ListProducts(ctx, 2, 10, []string{"Summer Sale", "summer-sale"})
+
+	// Then
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+// Tag Tests
+func TestProductUseCases_CreateProduct_NormalizesTags(t *testing.T) {
+	// Given
+	useCases, mockRepo := setupTestUseCases()
+	ctx := context.Background()
+
+	request := &dto.CreateProductRequestDTO{
+		Name:     "iPhone 15",
+		SKU:      "IPH15-128GB",
+		Price:    entities.MustParseMoney("999.99", "USD"),
+		Category: "Electronics",
+		Stock:    10,
+		Tags:     []string{"Summer Sale", "new_arrivals", "summer-sale"},
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			err := validateSKU(tt.sku)
-			if tt.expectError {
-				assert.Error(t, err)
-			} else {
-				assert.NoError(t, err)
-			}
-		})
+	mockRepo.On("ExistsBySKU", ctx, "IPH15-128GB").Return(false, nil)
+	mockRepo.On("Create", ctx, mock.MatchedBy(func(product *entities.Product) bool {
+		return assert.ObjectsAreEqual([]string{"summer-sale", "new-arrivals"}, product.Tags)
+	})).Return(&entities.Product{ID: 1, SKU: "IPH15-128GB", Tags: []string{"new-arrivals", "summer-sale"}}, nil)
+
+	// When
+	result, err := useCases.CreateProduct(ctx, request)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, []string{"new-arrivals", "summer-sale"}, result.Tags)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestProductUseCases_UpdateProduct_InvalidTag(t *testing.T) {
+	// Given
+	useCases, mockRepo := setupTestUseCases()
+	ctx := context.Background()
+
+	mockRepo.On("ApplyEdit", ctx, uint(1)).Return(&entities.Product{ID: 1, SKU: "IPH15-128GB", Tags: []string{}}, nil)
+
+	// When
+	_, err := useCases.UpdateProduct(ctx, 1, &dto.UpdateProductRequestDTO{Tags: []string{"---"}})
+
+	// Then
+	var domainErr *domainErrors.DomainError
+	require.True(t, errors.As(err, &domainErr))
+	assert.Equal(t, domainErrors.ErrInvalidTag.Code, domainErr.Code)
+	assert.Equal(t, "tags", domainErr.Field)
+	mockRepo.AssertNotCalled(t, "savedPriceChanges", mock.Anything)
+}
+
+// Attribute Tests
+func TestProductUseCases_CreateProduct_InvalidAttributes(t *testing.T) {
+	// Given
+	mockAttributes := new(MockAttributeRepository)
+	useCases, mockRepo, _ := setupTestUseCasesWithAttributes(mockAttributes)
+	ctx := context.Background()
+
+	request := &dto.CreateProductRequestDTO{
+		Name:       "MacBook Air",
+		SKU:        "MBA-13",
+		Price:      entities.MustParseMoney("1099.00", "USD"),
+		Category:   "Laptops",
+		Stock:      5,
+		Attributes: entities.Attributes{"storage_gb": "a lot"},
 	}
+
+	mockRepo.On("ExistsBySKU", ctx, "MBA-13").Return(false, nil)
+	mockAttributes.On("ListDefinitions", ctx, []uint{3}).Return([]*entities.AttributeDefinition{
+		{CategoryID: 3, Key: "storage_gb", Type: entities.AttributeUnit, Unit: "GB"},
+	}, nil)
+
+	// When
+	result, err := useCases.CreateProduct(ctx, request)
+
+	// Then
+	assert.Nil(t, result)
+	var domainErr *domainErrors.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, domainErrors.ErrInvalidProductAttributes.Code, domainErr.Code)
+	assert.Equal(t, "attributes.storage_gb", domainErr.Field)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestProductUseCases_SearchProducts_TypedAttributeFilter(t *testing.T) {
+	// Given
+	mockAttributes := new(MockAttributeRepository)
+	useCases, mockRepo, _ := setupTestUseCasesWithAttributes(mockAttributes)
+	ctx := context.Background()
+
+	mockAttributes.On("ListDefinitions", ctx, []uint{3}).Return([]*entities.AttributeDefinition{
+		{CategoryID: 3, Key: "storage_gb", Type: entities.AttributeUnit, Unit: "GB"},
+	}, nil)
+	mockRepo.On("Search", ctx, ports.ProductSearchCriteria{
+		CategoryPath: "laptops",
+		Attributes: []entities.AttributeFilter{
+			{Key: "storage_gb", Type: entities.AttributeUnit, Operator: entities.AttributeMin, Value: json.Number("128")},
+		},
+		Limit:  20,
+		Offset: 20,
+	}).Return([]*entities.Product{
+		{ID: 7, Name: "MacBook Air", SKU: "MBA-13", Category: "Laptops", Attributes: entities.Attributes{"storage_gb": json.Number("256")}},
+	}, int64(21), nil)
+
+	// When
+	result, err := useCases.SearchProducts(ctx, &dto.ProductSearchRequestDTO{
+		Category:   "Laptops",
+		Page:       1,
+		PageSize:   20,
+		Attributes: []dto.AttributeFilterDTO{{Key: "storage_gb", Operator: entities.AttributeMin, Value: "128 GB"}},
+	})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 21, result.Total)
+	require.Len(t, result.Products, 1)
+	assert.Equal(t, json.Number("256"), result.Products[0].Attributes["storage_gb"])
+	mockRepo.AssertExpectations(t)
+}
+
+func TestProductUseCases_SearchProducts_UnknownAttribute(t *testing.T) {
+	// Given
+	useCases, mockRepo := setupTestUseCases()
+	ctx := context.Background()
+
+	// When
+	result, err := useCases.SearchProducts(ctx, &dto.ProductSearchRequestDTO{
+		PageSize:   10,
+		Attributes: []dto.AttributeFilterDTO{{Key: "storage_gb", Value: "128"}},
+	})
+
+	// Then
+	assert.Nil(t, result)
+	var domainErr *domainErrors.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "attributes.storage_gb", domainErr.Field)
+	mockRepo.AssertNotCalled(t, "Search", mock.Anything, mock.Anything)
 }
@@ -0,0 +1,181 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"product-service/internal/application/dto"
+	"product-service/internal/domain/entities"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "product-service/usecases"
+
+// tracedProductUseCases decorates ProductUseCases with one span per method
+type tracedProductUseCases struct {
+	next   ProductUseCases
+	tracer trace.Tracer
+}
+
+// NewTracedProductUseCases wraps the given use cases so every call is recorded
+// as a child span of the span found in the incoming context
+func NewTracedProductUseCases(next ProductUseCases) ProductUseCases {
+	return &tracedProductUseCases{
+		next:   next,
+		tracer: otel.Tracer(tracerName),
+	}
+}
+
+func (t *tracedProductUseCases) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return t.tracer.Start(ctx, "ProductUseCases."+method,
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(attrs...),
+	)
+}
+
+// endSpan records err on the span, if any, and closes it
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func (t *tracedProductUseCases) CreateProduct(ctx context.Context, request *dto.CreateProductRequestDTO) (*dto.ProductResponseDTO, error) {
+	ctx, span := t.start(ctx, "CreateProduct", attribute.String("product.sku", request.SKU))
+	response, err := t.next.CreateProduct(ctx, request)
+	if err == nil {
+		span.SetAttributes(attribute.Int64("product.id", int64(response.ID)))
+	}
+	endSpan(span, err)
+	return response, err
+}
+
+func (t *tracedProductUseCases) GetProductByID(ctx context.Context, id uint) (*dto.ProductResponseDTO, error) {
+	ctx, span := t.start(ctx, "GetProductByID", attribute.Int64("product.id", int64(id)))
+	response, err := t.next.GetProductByID(ctx, id)
+	endSpan(span, err)
+	return response, err
+}
+
+func (t *tracedProductUseCases) GetProductBySKU(ctx context.Context, sku string) (*dto.ProductResponseDTO, error) {
+	ctx, span := t.start(ctx, "GetProductBySKU", attribute.String("product.sku", sku))
+	response, err := t.next.GetProductBySKU(ctx, sku)
+	endSpan(span, err)
+	return response, err
+}
+
+func (t *tracedProductUseCases) GetProductByGTIN(ctx context.Context, gtin string) (*dto.ProductResponseDTO, error) {
+	ctx, span := t.start(ctx, "GetProductByGTIN", attribute.String("product.gtin", gtin))
+	response, err := t.next.GetProductByGTIN(ctx, gtin)
+	endSpan(span, err)
+	return response, err
+}
+
+func (t *tracedProductUseCases) GetProductAsOf(ctx context.Context, id uint, at time.Time) (*dto.ProductResponseDTO, error) {
+	ctx, span := t.start(ctx, "GetProductAsOf",
+		attribute.Int64("product.id", int64(id)),
+		attribute.String("product.as_of", at.Format(time.RFC3339)))
+	response, err := t.next.GetProductAsOf(ctx, id, at)
+	endSpan(span, err)
+	return response, err
+}
+
+func (t *tracedProductUseCases) GetProductBySKUAsOf(ctx context.Context, sku string, at time.Time) (*dto.ProductResponseDTO, error) {
+	ctx, span := t.start(ctx, "GetProductBySKUAsOf",
+		attribute.String("product.sku", sku),
+		attribute.String("product.as_of", at.Format(time.RFC3339)))
+	response, err := t.next.GetProductBySKUAsOf(ctx, sku, at)
+	endSpan(span, err)
+	return response, err
+}
+
+func (t *tracedProductUseCases) UpdateProduct(ctx context.Context, id uint, request *dto.UpdateProductRequestDTO) (*dto.ProductResponseDTO, error) {
+	ctx, span := t.start(ctx, "UpdateProduct", attribute.Int64("product.id", int64(id)))
+	response, err := t.next.UpdateProduct(ctx, id, request)
+	endSpan(span, err)
+	return response, err
+}
+
+func (t *tracedProductUseCases) UpdateProductStock(ctx context.Context, id uint, stock int) (*dto.ProductResponseDTO, error) {
+	ctx, span := t.start(ctx, "UpdateProductStock",
+		attribute.Int64("product.id", int64(id)),
+		attribute.Int("product.stock", stock))
+	response, err := t.next.UpdateProductStock(ctx, id, stock)
+	endSpan(span, err)
+	return response, err
+}
+
+func (t *tracedProductUseCases) UpdateProductPrice(ctx context.Context, id uint, price entities.Money, reason string) (*dto.ProductResponseDTO, error) {
+	ctx, span := t.start(ctx, "UpdateProductPrice",
+		attribute.Int64("product.id", int64(id)),
+		attribute.String("product.price", price.String()))
+	response, err := t.next.UpdateProductPrice(ctx, id, price, reason)
+	endSpan(span, err)
+	return response, err
+}
+
+func (t *tracedProductUseCases) ActivateProduct(ctx context.Context, id uint) (*dto.ProductResponseDTO, error) {
+	ctx, span := t.start(ctx, "ActivateProduct", attribute.Int64("product.id", int64(id)))
+	response, err := t.next.ActivateProduct(ctx, id)
+	endSpan(span, err)
+	return response, err
+}
+
+func (t *tracedProductUseCases) DeactivateProduct(ctx context.Context, id uint) (*dto.ProductResponseDTO, error) {
+	ctx, span := t.start(ctx, "DeactivateProduct", attribute.Int64("product.id", int64(id)))
+	response, err := t.next.DeactivateProduct(ctx, id)
+	endSpan(span, err)
+	return response, err
+}
+
+func (t *tracedProductUseCases) DiscontinueProduct(ctx context.Context, id uint) (*dto.ProductResponseDTO, error) {
+	ctx, span := t.start(ctx, "DiscontinueProduct", attribute.Int64("product.id", int64(id)))
+	response, err := t.next.DiscontinueProduct(ctx, id)
+	endSpan(span, err)
+	return response, err
+}
+
+func (t *tracedProductUseCases) TransitionProduct(ctx context.Context, id uint, request *dto.TransitionRequestDTO) (*dto.ProductResponseDTO, error) {
+	ctx, span := t.start(ctx, "TransitionProduct",
+		attribute.Int64("product.id", int64(id)),
+		attribute.String("product.status", string(request.Status)))
+	response, err := t.next.TransitionProduct(ctx, id, request)
+	endSpan(span, err)
+	return response, err
+}
+
+func (t *tracedProductUseCases) ListTransitions(ctx context.Context, id uint) (*dto.TransitionListResponseDTO, error) {
+	ctx, span := t.start(ctx, "ListTransitions", attribute.Int64("product.id", int64(id)))
+	response, err := t.next.ListTransitions(ctx, id)
+	endSpan(span, err)
+	return response, err
+}
+
+func (t *tracedProductUseCases) ListProducts(ctx context.Context, page, pageSize int, tags []string) (*dto.ProductListResponseDTO, error) {
+	ctx, span := t.start(ctx, "ListProducts",
+		attribute.Int("page", page),
+		attribute.Int("page_size", pageSize),
+		attribute.StringSlice("tags", tags))
+	response, err := t.next.ListProducts(ctx, page, pageSize, tags)
+	endSpan(span, err)
+	return response, err
+}
+
+func (t *tracedProductUseCases) SearchProducts(ctx context.Context, request *dto.ProductSearchRequestDTO) (*dto.ProductListResponseDTO, error) {
+	ctx, span := t.start(ctx, "SearchProducts",
+		attribute.Int("page", request.Page),
+		attribute.Int("page_size", request.PageSize),
+		attribute.Int("attribute_filters", len(request.Attributes)))
+	response, err := t.next.SearchProducts(ctx, request)
+	if err == nil {
+		span.SetAttributes(attribute.Int("result.total", response.Total))
+	}
+	endSpan(span, err)
+	return response, err
+}
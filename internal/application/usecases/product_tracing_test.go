@@ -0,0 +1,109 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func setupTracedUseCases(t *testing.T) (ProductUseCases, *MockProductRepository, *tracetest.SpanRecorder) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	mockRepo := new(MockProductRepository)
+	useCases := NewTracedProductUseCases(NewProductUseCases(mockRepo, new(MockPriceHistoryRepository), new(MockLifecycleRepository), new(MockAttributeRepository), new(MockCategoryRepository), new(MockBrandRepository), logger.New("test")))
+	return useCases, mockRepo, recorder
+}
+
+func TestTracedProductUseCases_GetProductByID_RecordsSpan(t *testing.T) {
+	// Given
+	useCases, mockRepo, recorder := setupTracedUseCases(t)
+
+	mockRepo.On("GetByID", mock.Anything, uint(1)).Return(&entities.Product{
+		ID:        1,
+		Name:      "iPhone 15",
+		SKU:       "IPH15-128GB",
+		Status:    entities.ProductStatusActive,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}, nil)
+
+	// When
+	result, err := useCases.GetProductByID(context.Background(), 1)
+
+	// Then
+	require.NoError(t, err)
+	require.NotNil(t, result)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "ProductUseCases.GetProductByID", spans[0].Name())
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTracedProductUseCases_GetProductByID_RecordsError(t *testing.T) {
+	// Given
+	useCases, mockRepo, recorder := setupTracedUseCases(t)
+
+	mockRepo.On("GetByID", mock.Anything, uint(999)).Return(nil, domainErrors.ErrProductNotFound)
+
+	// When
+	result, err := useCases.GetProductByID(context.Background(), 999)
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, domainErrors.ErrProductNotFound, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Len(t, spans[0].Events(), 1)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTracedProductUseCases_PropagatesParentSpan(t *testing.T) {
+	// Given
+	useCases, mockRepo, recorder := setupTracedUseCases(t)
+
+	mockRepo.On("GetBySKU", mock.Anything, "IPH15-128GB").Return(&entities.Product{
+		ID:     1,
+		SKU:    "IPH15-128GB",
+		Status: entities.ProductStatusActive,
+	}, nil)
+
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "parent")
+
+	// When
+	_, err := useCases.GetProductBySKU(ctx, "IPH15-128GB")
+	parent.End()
+
+	// Then
+	require.NoError(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, parent.SpanContext().TraceID(), spans[0].SpanContext().TraceID())
+	assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,250 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	productErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+	"strings"
+	"time"
+)
+
+// PromotionUseCases defines the interface for promotion operations
+type PromotionUseCases interface {
+	CreatePromotion(ctx context.Context, request *dto.PromotionRequestDTO) (*dto.PromotionResponseDTO, error)
+	GetPromotion(ctx context.Context, id uint) (*dto.PromotionResponseDTO, error)
+	UpdatePromotion(ctx context.Context, id uint, request *dto.PromotionRequestDTO) (*dto.PromotionResponseDTO, error)
+	DeletePromotion(ctx context.Context, id uint) error
+	ListPromotions(ctx context.Context) ([]*dto.PromotionResponseDTO, error)
+	ApplyPromotions(ctx context.Context, products []*dto.ProductResponseDTO, at time.Time) error
+}
+
+// promotionUseCasesImpl implements PromotionUseCases interface
+type promotionUseCasesImpl struct {
+	promotionRepo ports.PromotionRepository
+	categoryRepo  ports.CategoryRepository
+	brandRepo     ports.BrandRepository
+	logger        logger.Logger
+}
+
+// NewPromotionUseCases creates a new instance of promotion use cases
+func NewPromotionUseCases(promotionRepo ports.PromotionRepository, categoryRepo ports.CategoryRepository, brandRepo ports.BrandRepository, log logger.Logger) PromotionUseCases {
+	return &promotionUseCasesImpl{
+		promotionRepo: promotionRepo,
+		categoryRepo:  categoryRepo,
+		brandRepo:     brandRepo,
+		logger:        log.With("component", "promotion_usecases"),
+	}
+}
+
+// CreatePromotion creates a new promotion
+func (uc *promotionUseCasesImpl) CreatePromotion(ctx context.Context, request *dto.PromotionRequestDTO) (*dto.PromotionResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("CreatePromotion use case called", "name", request.Name, "discount_type", request.DiscountType)
+
+	categories, err := uc.promotionCategories(ctx, request.Categories)
+	if err != nil {
+		return nil, err
+	}
+	brands, err := uc.promotionBrands(ctx, request.Brands)
+	if err != nil {
+		return nil, err
+	}
+	promotion, err := request.ToEntity(categories, brands)
+	if err != nil {
+		return nil, invalidPromotion(err)
+	}
+
+	created, err := uc.promotionRepo.Create(ctx, promotion)
+	if err != nil {
+		log.Error("Failed to create promotion", "error", err, "name", promotion.Name)
+		return nil, err
+	}
+
+	log.Info("CreatePromotion success", "promotion_id", created.ID)
+	return dto.PromotionToResponseDTO(created), nil
+}
+
+// GetPromotion retrieves a promotion by ID
+func (uc *promotionUseCasesImpl) GetPromotion(ctx context.Context, id uint) (*dto.PromotionResponseDTO, error) {
+	promotion, err := uc.promotionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return dto.PromotionToResponseDTO(promotion), nil
+}
+
+// UpdatePromotion replaces a promotion's definition
+func (uc *promotionUseCasesImpl) UpdatePromotion(ctx context.Context, id uint, request *dto.PromotionRequestDTO) (*dto.PromotionResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("UpdatePromotion use case called", "promotion_id", id)
+
+	categories, err := uc.promotionCategories(ctx, request.Categories)
+	if err != nil {
+		return nil, err
+	}
+	brands, err := uc.promotionBrands(ctx, request.Brands)
+	if err != nil {
+		return nil, err
+	}
+	promotion, err := request.ToEntity(categories, brands)
+	if err != nil {
+		return nil, invalidPromotion(err)
+	}
+	promotion.ID = id
+
+	updated, err := uc.promotionRepo.Update(ctx, promotion)
+	if err != nil {
+		log.Error("Failed to update promotion", "error", err, "promotion_id", id)
+		return nil, err
+	}
+
+	log.Info("UpdatePromotion success", "promotion_id", id)
+	return dto.PromotionToResponseDTO(updated), nil
+}
+
+// DeletePromotion removes a promotion
+func (uc *promotionUseCasesImpl) DeletePromotion(ctx context.Context, id uint) error {
+	uc.logger.Ctx(ctx).Info("DeletePromotion use case called", "promotion_id", id)
+
+	return uc.promotionRepo.Delete(ctx, id)
+}
+
+// ListPromotions returns every promotion, highest priority first
+func (uc *promotionUseCasesImpl) ListPromotions(ctx context.Context) ([]*dto.PromotionResponseDTO, error) {
+	promotions, err := uc.promotionRepo.List(ctx)
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("Failed to list promotions", "error", err)
+		return nil, err
+	}
+
+	response := make([]*dto.PromotionResponseDTO, 0, len(promotions))
+	for _, promotion := range promotions {
+		response = append(response, dto.PromotionToResponseDTO(promotion))
+	}
+	return response, nil
+}
+
+// ApplyPromotions sets EffectivePrice and AppliedPromotions on each product
+// for the promotions live at the given time
+func (uc *promotionUseCasesImpl) ApplyPromotions(ctx context.Context, products []*dto.ProductResponseDTO, at time.Time) error {
+	if len(products) == 0 {
+		return nil
+	}
+
+	log := uc.logger.Ctx(ctx)
+
+	promotions, err := uc.promotionRepo.ListLiveAt(ctx, at)
+	if err != nil {
+		log.Error("Failed to load live promotions", "error", err)
+		return productErrors.ErrFailedToEvaluatePromotions
+	}
+
+	categoryPaths, err := uc.productCategoryPaths(ctx, products, promotions)
+	if err != nil {
+		log.Error("Failed to load product categories", "error", err)
+		return productErrors.ErrFailedToEvaluatePromotions
+	}
+
+	for _, product := range products {
+		target := product.PromotionTarget(categoryPaths[product.CategoryID])
+		evaluation, err := entities.EvaluatePromotions(product.Price, target, promotions, at)
+		if err != nil {
+			log.Error("Failed to evaluate promotions", "error", err, "product_id", product.ID)
+			return productErrors.ErrFailedToEvaluatePromotions
+		}
+		product.ApplyPriceEvaluation(evaluation)
+	}
+	return nil
+}
+
+// promotionCategories resolves the categories a promotion request names by
+// path or name; promotions store their IDs
+func (uc *promotionUseCasesImpl) promotionCategories(ctx context.Context, references []string) ([]entities.PromotionCategory, error) {
+	categories := make([]entities.PromotionCategory, 0, len(references))
+	for _, reference := range references {
+		category, err := resolveCategory(ctx, uc.categoryRepo, reference)
+		var domainErr *productErrors.DomainError
+		switch {
+		case errors.Is(err, productErrors.ErrCategoryNotFound):
+			return nil, invalidPromotionTarget("categories", fmt.Sprintf("category %q does not exist", reference))
+		case errors.As(err, &domainErr):
+			return nil, invalidPromotionTarget("categories", domainErr.Message)
+		case err != nil:
+			uc.logger.Ctx(ctx).Error("Failed to resolve category", "error", err, "category", reference)
+			return nil, err
+		}
+		categories = append(categories, entities.PromotionCategory{ID: category.ID, Path: category.Path})
+	}
+	return categories, nil
+}
+
+// promotionBrands resolves the brands a promotion request names by their
+// name or an alias; promotions store their IDs
+func (uc *promotionUseCasesImpl) promotionBrands(ctx context.Context, names []string) ([]entities.PromotionBrand, error) {
+	brands := make([]entities.PromotionBrand, 0, len(names))
+	for _, name := range names {
+		if strings.TrimSpace(name) == "" {
+			continue
+		}
+		brand, err := uc.brandRepo.FindByName(ctx, name)
+		if errors.Is(err, productErrors.ErrBrandNotFound) {
+			return nil, invalidPromotionTarget("brands", fmt.Sprintf("brand %q is not registered", name))
+		}
+		if err != nil {
+			uc.logger.Ctx(ctx).Error("Failed to resolve brand", "error", err, "brand", name)
+			return nil, err
+		}
+		brands = append(brands, entities.PromotionBrand{ID: brand.ID, Name: brand.Name})
+	}
+	return brands, nil
+}
+
+// productCategoryPaths returns the category paths of products by category
+// ID. Categories are only looked up when a promotion targets them.
+func (uc *promotionUseCasesImpl) productCategoryPaths(ctx context.Context, products []*dto.ProductResponseDTO, promotions []*entities.Promotion) (map[uint]string, error) {
+	paths := make(map[uint]string)
+
+	targetsCategories := false
+	for _, promotion := range promotions {
+		targetsCategories = targetsCategories || len(promotion.Categories) > 0
+	}
+	if !targetsCategories {
+		return paths, nil
+	}
+
+	for _, product := range products {
+		if _, ok := paths[product.CategoryID]; ok {
+			continue
+		}
+		category, err := uc.categoryRepo.GetByID(ctx, product.CategoryID)
+		if err != nil {
+			return nil, fmt.Errorf("category %d: %w", product.CategoryID, err)
+		}
+		paths[product.CategoryID] = category.Path
+	}
+	return paths, nil
+}
+
+// invalidPromotionTarget reports a category or brand a promotion cannot target
+func invalidPromotionTarget(field, message string) error {
+	return &productErrors.DomainError{
+		Code:    productErrors.ErrInvalidPromotion.Code,
+		Message: message,
+		Field:   field,
+	}
+}
+
+// invalidPromotion reports why a promotion definition was rejected
+func invalidPromotion(err error) error {
+	return &productErrors.DomainError{
+		Code:    productErrors.ErrInvalidPromotion.Code,
+		Message: err.Error(),
+	}
+}
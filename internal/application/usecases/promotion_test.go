@@ -0,0 +1,294 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"product-service/internal/application/dto"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockPromotionRepository implements the PromotionRepository interface for testing
+type MockPromotionRepository struct {
+	mock.Mock
+}
+
+func (m *MockPromotionRepository) Create(ctx context.Context, promotion *entities.Promotion) (*entities.Promotion, error) {
+	args := m.Called(ctx, promotion)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Promotion), args.Error(1)
+}
+
+func (m *MockPromotionRepository) GetByID(ctx context.Context, id uint) (*entities.Promotion, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Promotion), args.Error(1)
+}
+
+func (m *MockPromotionRepository) Update(ctx context.Context, promotion *entities.Promotion) (*entities.Promotion, error) {
+	args := m.Called(ctx, promotion)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Promotion), args.Error(1)
+}
+
+func (m *MockPromotionRepository) Delete(ctx context.Context, id uint) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockPromotionRepository) List(ctx context.Context) ([]*entities.Promotion, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Promotion), args.Error(1)
+}
+
+func (m *MockPromotionRepository) ListLiveAt(ctx context.Context, at time.Time) ([]*entities.Promotion, error) {
+	args := m.Called(ctx, at)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Promotion), args.Error(1)
+}
+
+func setupTestPromotionUseCases() (PromotionUseCases, *MockPromotionRepository, *MockCategoryRepository, *MockBrandRepository) {
+	mockRepo := new(MockPromotionRepository)
+	mockCategoryRepo := new(MockCategoryRepository)
+	mockBrandRepo := new(MockBrandRepository)
+	useCases := NewPromotionUseCases(mockRepo, mockCategoryRepo, mockBrandRepo, logger.New("test"))
+	return useCases, mockRepo, mockCategoryRepo, mockBrandRepo
+}
+
+func TestPromotionUseCases_CreatePromotion_Success(t *testing.T) {
+	// Given
+	useCases, mockRepo, _, mockBrandRepo := setupTestPromotionUseCases()
+	ctx := context.Background()
+
+	apple := &entities.Brand{ID: 4, Name: "Apple", Aliases: []string{"Apple Inc"}}
+	mockBrandRepo.On("FindByName", ctx, " Apple ").Return(apple, nil).Once()
+	mockBrandRepo.On("FindByName", ctx, "apple inc").Return(apple, nil).Once()
+	mockRepo.On("Create", ctx, mock.MatchedBy(func(promotion *entities.Promotion) bool {
+		return promotion.Active && promotion.Percentage.Cmp(big.NewRat(25, 2)) == 0 &&
+			len(promotion.Brands) == 1 && promotion.Brands[0] == entities.PromotionBrand{ID: 4, Name: "Apple"}
+	})).Return(&entities.Promotion{
+		ID:           1,
+		Name:         "Apple week",
+		DiscountType: entities.DiscountPercentage,
+		Percentage:   big.NewRat(25, 2),
+		Brands:       []entities.PromotionBrand{{ID: 4, Name: "Apple"}},
+		Active:       true,
+	}, nil)
+
+	// When
+	result, err := useCases.CreatePromotion(ctx, &dto.PromotionRequestDTO{
+		Name:         "Apple week",
+		DiscountType: entities.DiscountPercentage,
+		Percentage:   "12.5",
+		Brands:       []string{" Apple ", "apple inc"},
+	})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, uint(1), result.ID)
+	assert.Equal(t, "12.5", result.Percentage)
+	assert.Equal(t, []uint{4}, result.BrandIDs)
+	assert.Equal(t, []string{"Apple"}, result.Brands)
+
+	mockRepo.AssertExpectations(t)
+	mockBrandRepo.AssertExpectations(t)
+}
+
+func TestPromotionUseCases_CreatePromotion_UnregisteredBrand(t *testing.T) {
+	// Given
+	useCases, mockRepo, _, mockBrandRepo := setupTestPromotionUseCases()
+	ctx := context.Background()
+
+	mockBrandRepo.On("FindByName", ctx, "Acme").Return(nil, domainErrors.ErrBrandNotFound).Once()
+
+	// When
+	result, err := useCases.CreatePromotion(ctx, &dto.PromotionRequestDTO{
+		Name:         "Acme days",
+		DiscountType: entities.DiscountPercentage,
+		Percentage:   "10",
+		Brands:       []string{"Acme"},
+	})
+
+	// Then
+	assert.Nil(t, result)
+	var domainErr *domainErrors.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, domainErrors.ErrInvalidPromotion.Code, domainErr.Code)
+	assert.Equal(t, "brands", domainErr.Field)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestPromotionUseCases_CreatePromotion_Invalid(t *testing.T) {
+	// Given
+	useCases, mockRepo, _, _ := setupTestPromotionUseCases()
+	ctx := context.Background()
+
+	// When
+	result, err := useCases.CreatePromotion(ctx, &dto.PromotionRequestDTO{
+		Name:         "No amount",
+		DiscountType: entities.DiscountFixedAmount,
+	})
+
+	// Then
+	assert.Nil(t, result)
+	var domainErr *domainErrors.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, domainErrors.ErrInvalidPromotion.Code, domainErr.Code)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestPromotionUseCases_CreatePromotion_StoresCategoryIDs(t *testing.T) {
+	// Given
+	useCases, mockRepo, mockCategoryRepo, _ := setupTestPromotionUseCases()
+	ctx := context.Background()
+
+	phones := &entities.Category{ID: 2, Name: "Phones", Slug: "phones", Path: "electronics/phones"}
+	mockCategoryRepo.On("GetByPath", ctx, "electronics/phones").Return(phones, nil).Once()
+	mockCategoryRepo.On("GetByPath", ctx, "phones").Return(nil, domainErrors.ErrCategoryNotFound).Once()
+	mockCategoryRepo.On("FindBySlug", ctx, "phones").Return([]*entities.Category{phones}, nil).Once()
+	mockRepo.On("Create", ctx, mock.MatchedBy(func(promotion *entities.Promotion) bool {
+		return len(promotion.Categories) == 1 && promotion.Categories[0] == entities.PromotionCategory{ID: 2, Path: "electronics/phones"}
+	})).Return(&entities.Promotion{
+		ID:           1,
+		Name:         "Phones week",
+		DiscountType: entities.DiscountPercentage,
+		Percentage:   big.NewRat(10, 1),
+		Categories:   []entities.PromotionCategory{{ID: 2, Path: "electronics/phones"}},
+		Active:       true,
+	}, nil)
+
+	// When
+	result, err := useCases.CreatePromotion(ctx, &dto.PromotionRequestDTO{
+		Name:         "Phones week",
+		DiscountType: entities.DiscountPercentage,
+		Percentage:   "10",
+		Categories:   []string{"Electronics/Phones", "Phones"},
+	})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, []uint{2}, result.CategoryIDs)
+	assert.Equal(t, []string{"electronics/phones"}, result.Categories)
+	mockRepo.AssertExpectations(t)
+	mockCategoryRepo.AssertExpectations(t)
+}
+
+func TestPromotionUseCases_CreatePromotion_UnknownCategory(t *testing.T) {
+	// Given
+	useCases, mockRepo, mockCategoryRepo, _ := setupTestPromotionUseCases()
+	ctx := context.Background()
+
+	mockCategoryRepo.On("GetByPath", ctx, "garden").Return(nil, domainErrors.ErrCategoryNotFound).Once()
+	mockCategoryRepo.On("FindBySlug", ctx, "garden").Return([]*entities.Category{}, nil).Once()
+
+	// When
+	result, err := useCases.CreatePromotion(ctx, &dto.PromotionRequestDTO{
+		Name:         "Garden days",
+		DiscountType: entities.DiscountPercentage,
+		Percentage:   "10",
+		Categories:   []string{"Garden"},
+	})
+
+	// Then
+	assert.Nil(t, result)
+	var domainErr *domainErrors.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, domainErrors.ErrInvalidPromotion.Code, domainErr.Code)
+	assert.Equal(t, "categories", domainErr.Field)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestPromotionUseCases_ApplyPromotions(t *testing.T) {
+	// Given
+	useCases, mockRepo, mockCategoryRepo, _ := setupTestPromotionUseCases()
+	ctx := context.Background()
+	at := time.Now()
+
+	promotion := &entities.Promotion{
+		ID:           1,
+		Name:         "Phones 10%",
+		DiscountType: entities.DiscountPercentage,
+		Percentage:   big.NewRat(10, 1),
+		Categories:   []entities.PromotionCategory{{ID: 2, Path: "electronics/phones"}},
+		Active:       true,
+	}
+	phone := &dto.ProductResponseDTO{ID: 1, CategoryID: 3, Category: "Smartphones", Price: entities.MustParseMoney("200.00", "USD")}
+	otherPhone := &dto.ProductResponseDTO{ID: 3, CategoryID: 3, Category: "Smartphones", Price: entities.MustParseMoney("100.00", "USD")}
+	toy := &dto.ProductResponseDTO{ID: 2, CategoryID: 4, Category: "Toys", Price: entities.MustParseMoney("20.00", "USD")}
+
+	mockRepo.On("ListLiveAt", ctx, at).Return([]*entities.Promotion{promotion}, nil).Once()
+	mockCategoryRepo.On("GetByID", ctx, uint(3)).Return(&entities.Category{ID: 3, Name: "Smartphones", Path: "electronics/phones/smartphones"}, nil).Once()
+	mockCategoryRepo.On("GetByID", ctx, uint(4)).Return(&entities.Category{ID: 4, Name: "Toys", Path: "toys"}, nil).Once()
+
+	// When
+	err := useCases.ApplyPromotions(ctx, []*dto.ProductResponseDTO{phone, otherPhone, toy}, at)
+
+	// Then
+	require.NoError(t, err)
+	require.NotNil(t, phone.EffectivePrice)
+	assert.Equal(t, entities.MustParseMoney("180.00", "USD"), *phone.EffectivePrice, "products in subcategories are covered")
+	require.Len(t, phone.AppliedPromotions, 1)
+	assert.Equal(t, "Phones 10%", phone.AppliedPromotions[0].Name)
+	require.NotNil(t, otherPhone.EffectivePrice)
+	assert.Equal(t, entities.MustParseMoney("90.00", "USD"), *otherPhone.EffectivePrice)
+
+	require.NotNil(t, toy.EffectivePrice)
+	assert.Equal(t, toy.Price, *toy.EffectivePrice)
+	assert.Empty(t, toy.AppliedPromotions)
+
+	mockRepo.AssertExpectations(t)
+	mockCategoryRepo.AssertExpectations(t)
+}
+
+func TestPromotionUseCases_ApplyPromotions_NoCategoryTargets(t *testing.T) {
+	// Given
+	useCases, mockRepo, mockCategoryRepo, _ := setupTestPromotionUseCases()
+	ctx := context.Background()
+	at := time.Now()
+
+	sitewide := &entities.Promotion{ID: 1, Name: "Sitewide", DiscountType: entities.DiscountPercentage, Percentage: big.NewRat(5, 1), Active: true}
+	product := &dto.ProductResponseDTO{ID: 1, CategoryID: 3, Price: entities.MustParseMoney("100.00", "USD")}
+	mockRepo.On("ListLiveAt", ctx, at).Return([]*entities.Promotion{sitewide}, nil).Once()
+
+	// When
+	err := useCases.ApplyPromotions(ctx, []*dto.ProductResponseDTO{product}, at)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, entities.MustParseMoney("95.00", "USD"), *product.EffectivePrice)
+	mockCategoryRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+}
+
+func TestPromotionUseCases_ApplyPromotions_RepositoryError(t *testing.T) {
+	// Given
+	useCases, mockRepo, _, _ := setupTestPromotionUseCases()
+	ctx := context.Background()
+	at := time.Now()
+
+	mockRepo.On("ListLiveAt", ctx, at).Return(nil, errors.New("connection refused"))
+
+	// When
+	err := useCases.ApplyPromotions(ctx, []*dto.ProductResponseDTO{{ID: 1, Price: entities.MustParseMoney("1.00", "USD")}}, at)
+
+	// Then
+	assert.Equal(t, domainErrors.ErrFailedToEvaluatePromotions, err)
+}
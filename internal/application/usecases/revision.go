@@ -0,0 +1,220 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	productErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+	"product-service/pkg/requestctx"
+	"time"
+)
+
+// RevisionUseCases defines the interface for the draft, review and publish
+// workflow of product edits
+type RevisionUseCases interface {
+	SubmitRevision(ctx context.Context, productID uint, request *dto.SubmitRevisionRequestDTO) (*dto.RevisionResponseDTO, error)
+	ListRevisions(ctx context.Context, productID uint, status entities.RevisionStatus) (*dto.RevisionListResponseDTO, error)
+	GetRevision(ctx context.Context, id uint) (*dto.RevisionResponseDTO, error)
+	ApproveRevision(ctx context.Context, id uint, request *dto.ReviewRevisionRequestDTO) (*dto.RevisionResponseDTO, error)
+	RejectRevision(ctx context.Context, id uint, request *dto.ReviewRevisionRequestDTO) (*dto.RevisionResponseDTO, error)
+	PublishRevision(ctx context.Context, id uint) (*dto.RevisionResponseDTO, error)
+}
+
+// revisionUseCasesImpl implements RevisionUseCases interface
+type revisionUseCasesImpl struct {
+	*productEditor
+	revisionRepo ports.RevisionRepository
+	productRepo  ports.ProductRepository
+	logger       logger.Logger
+}
+
+// NewRevisionUseCases creates a new instance of revision use cases
+func NewRevisionUseCases(revisionRepo ports.RevisionRepository, productRepo ports.ProductRepository, attributeRepo ports.AttributeRepository, categoryRepo ports.CategoryRepository, brandRepo ports.BrandRepository, log logger.Logger) RevisionUseCases {
+	log = log.With("component", "revision_usecases")
+	return &revisionUseCasesImpl{
+		productEditor: newProductEditor(attributeRepo, categoryRepo, brandRepo, log),
+		revisionRepo:  revisionRepo,
+		productRepo:   productRepo,
+		logger:        log,
+	}
+}
+
+// SubmitRevision drafts changes to a product for review. The changes are
+// validated against the live product now, so reviewers only see edits that
+// could be published.
+func (uc *revisionUseCasesImpl) SubmitRevision(ctx context.Context, productID uint, request *dto.SubmitRevisionRequestDTO) (*dto.RevisionResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("SubmitRevision use case called", "product_id", productID)
+
+	product, err := uc.productRepo.GetByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := request.Changes.ToChanges()
+	candidate := *product
+	if _, err := uc.applyChanges(ctx, &candidate, changes); err != nil {
+		return nil, err
+	}
+
+	revision, err := entities.NewProductRevision(product, changes, request.Comment, requestctx.Actor(ctx), time.Now())
+	if err != nil {
+		return nil, &productErrors.DomainError{
+			Code:    productErrors.ErrInvalidRevision.Code,
+			Message: err.Error(),
+			Field:   "changes",
+		}
+	}
+
+	created, err := uc.revisionRepo.Create(ctx, revision)
+	if err != nil {
+		log.Error("Failed to create revision", "error", err, "product_id", productID)
+		return nil, productErrors.ErrFailedToUpdateProduct
+	}
+
+	log.Info("SubmitRevision success", "product_id", productID, "revision_id", created.ID)
+	return dto.RevisionToResponseDTO(created), nil
+}
+
+// ListRevisions returns the revisions of a product, or of every product when
+// productID is 0, optionally only those in status
+func (uc *revisionUseCasesImpl) ListRevisions(ctx context.Context, productID uint, status entities.RevisionStatus) (*dto.RevisionListResponseDTO, error) {
+	if productID != 0 {
+		if _, err := uc.productRepo.GetByID(ctx, productID); err != nil {
+			return nil, err
+		}
+	}
+
+	revisions, err := uc.revisionRepo.List(ctx, ports.RevisionCriteria{ProductID: productID, Status: status})
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("Failed to list revisions", "error", err, "product_id", productID, "status", status)
+		return nil, err
+	}
+
+	return &dto.RevisionListResponseDTO{Revisions: dto.RevisionsToResponseDTOs(revisions)}, nil
+}
+
+// GetRevision returns a revision; open revisions come with their diff
+// against the live product
+func (uc *revisionUseCasesImpl) GetRevision(ctx context.Context, id uint) (*dto.RevisionResponseDTO, error) {
+	revision, err := uc.revisionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	response := dto.RevisionToResponseDTO(revision)
+	if !revision.IsOpen() {
+		return response, nil
+	}
+
+	live, err := uc.productRepo.GetByID(ctx, revision.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	proposed := *live
+	if _, err := uc.applyChanges(ctx, &proposed, revision.Changes); err != nil {
+		return nil, err
+	}
+
+	response.Diff = entities.DiffProducts(live, &proposed)
+	response.Stale = revision.IsStale(live)
+	return response, nil
+}
+
+// ApproveRevision accepts a pending revision. Authors cannot approve their
+// own revisions.
+func (uc *revisionUseCasesImpl) ApproveRevision(ctx context.Context, id uint, request *dto.ReviewRevisionRequestDTO) (*dto.RevisionResponseDTO, error) {
+	return uc.review(ctx, id, entities.RevisionApproved, request)
+}
+
+// RejectRevision turns down a pending revision
+func (uc *revisionUseCasesImpl) RejectRevision(ctx context.Context, id uint, request *dto.ReviewRevisionRequestDTO) (*dto.RevisionResponseDTO, error) {
+	return uc.review(ctx, id, entities.RevisionRejected, request)
+}
+
+func (uc *revisionUseCasesImpl) review(ctx context.Context, id uint, decision entities.RevisionStatus, request *dto.ReviewRevisionRequestDTO) (*dto.RevisionResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+	reviewer := requestctx.Actor(ctx)
+
+	log.Info("Review revision use case called", "revision_id", id, "decision", decision)
+
+	revision, err := uc.revisionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !revision.IsPending() {
+		return nil, productErrors.ErrRevisionNotPending
+	}
+	if decision == entities.RevisionApproved && revision.IsAuthoredBy(reviewer) {
+		return nil, productErrors.ErrRevisionSelfApproval
+	}
+
+	if decision == entities.RevisionApproved {
+		err = revision.Approve(reviewer, request.Comment, time.Now())
+	} else {
+		err = revision.Reject(reviewer, request.Comment, time.Now())
+	}
+	if err != nil {
+		return nil, &productErrors.DomainError{
+			Code:    productErrors.ErrInvalidRevision.Code,
+			Message: err.Error(),
+			Field:   "comment",
+		}
+	}
+
+	if err := uc.revisionRepo.SaveReview(ctx, revision); err != nil {
+		log.Warn("Failed to save revision review", "error", err, "revision_id", id)
+		return nil, err
+	}
+
+	log.Info("Review revision success", "revision_id", id, "product_id", revision.ProductID, "status", revision.Status)
+	return dto.RevisionToResponseDTO(revision), nil
+}
+
+// PublishRevision applies an approved revision to the live product in one
+// transaction. Revisions drafted before the product last changed are stale
+// and must be resubmitted, as their reviewed diff no longer holds.
+func (uc *revisionUseCasesImpl) PublishRevision(ctx context.Context, id uint) (*dto.RevisionResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("PublishRevision use case called", "revision_id", id)
+
+	revision, product, err := uc.revisionRepo.Publish(ctx, id, func(revision *entities.ProductRevision, product *entities.Product) ([]*entities.PriceChange, error) {
+		if !revision.IsApproved() {
+			return nil, productErrors.ErrRevisionNotApproved
+		}
+		if revision.IsStale(product) {
+			return nil, productErrors.ErrRevisionStale
+		}
+		priceChanges, err := uc.applyChanges(ctx, product, revision.Changes)
+		if err != nil {
+			return nil, err
+		}
+		for _, change := range priceChanges {
+			change.Reason = fmt.Sprintf("revision #%d", revision.ID)
+		}
+
+		now := time.Now()
+		product.UpdatedAt = now
+		return priceChanges, revision.MarkPublished(requestctx.Actor(ctx), now)
+	})
+	if err != nil {
+		log.Error("Failed to publish revision", "error", err, "revision_id", id)
+		var domainErr *productErrors.DomainError
+		if errors.As(err, &domainErr) {
+			return nil, err
+		}
+		return nil, productErrors.ErrFailedToUpdateProduct
+	}
+
+	log.Info("PublishRevision success", "revision_id", id, "product_id", product.ID)
+	response := dto.RevisionToResponseDTO(revision)
+	response.Product = dto.ProductToResponseDTO(product)
+	return response, nil
+}
@@ -0,0 +1,266 @@
+package usecases
+
+import (
+	"context"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+	"product-service/pkg/requestctx"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockRevisionRepository implements the RevisionRepository interface for
+// testing. Publish runs the callback on the revision and product returned by
+// the expectation.
+type MockRevisionRepository struct {
+	mock.Mock
+}
+
+func (m *MockRevisionRepository) Create(ctx context.Context, revision *entities.ProductRevision) (*entities.ProductRevision, error) {
+	args := m.Called(ctx, revision)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ProductRevision), args.Error(1)
+}
+
+func (m *MockRevisionRepository) GetByID(ctx context.Context, id uint) (*entities.ProductRevision, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ProductRevision), args.Error(1)
+}
+
+func (m *MockRevisionRepository) List(ctx context.Context, criteria ports.RevisionCriteria) ([]*entities.ProductRevision, error) {
+	args := m.Called(ctx, criteria)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.ProductRevision), args.Error(1)
+}
+
+func (m *MockRevisionRepository) SaveReview(ctx context.Context, revision *entities.ProductRevision) error {
+	args := m.Called(ctx, revision)
+	return args.Error(0)
+}
+
+func (m *MockRevisionRepository) Publish(ctx context.Context, revisionID uint, fn ports.RevisionPublishFunc) (*entities.ProductRevision, *entities.Product, error) {
+	args := m.Called(ctx, revisionID)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	revision := args.Get(0).(*entities.ProductRevision)
+	product := args.Get(1).(*entities.Product)
+	changes, err := fn(revision, product)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(changes) > 0 {
+		m.MethodCalled("savedPriceChanges", changes)
+	}
+	return revision, product, args.Error(2)
+}
+
+func setupTestRevisionUseCases() (RevisionUseCases, *MockRevisionRepository, *MockProductRepository) {
+	mockRevisions := new(MockRevisionRepository)
+	mockProducts := new(MockProductRepository)
+	useCases := NewRevisionUseCases(mockRevisions, mockProducts, new(MockAttributeRepository), new(MockCategoryRepository), new(MockBrandRepository), logger.New("test"))
+	return useCases, mockRevisions, mockProducts
+}
+
+func testRevisionProduct(updatedAt time.Time) *entities.Product {
+	return &entities.Product{
+		ID:        1,
+		Name:      "Laptop",
+		SKU:       "LAP-001",
+		Price:     entities.MustParseMoney("999.00", "USD"),
+		Category:  "Electronics",
+		Status:    entities.ProductStatusActive,
+		Tags:      []string{},
+		UpdatedAt: updatedAt,
+	}
+}
+
+func TestRevisionUseCases_SubmitRevision_LeavesProductUnchanged(t *testing.T) {
+	// Given
+	useCases, mockRevisions, mockProducts := setupTestRevisionUseCases()
+	ctx := requestctx.WithActor(context.Background(), "alice")
+
+	updatedAt := time.Now().Add(-time.Hour)
+	product := testRevisionProduct(updatedAt)
+	price := entities.MustParseMoney("899.00", "USD")
+
+	mockProducts.On("GetByID", ctx, uint(1)).Return(product, nil)
+	mockRevisions.On("Create", ctx, mock.MatchedBy(func(revision *entities.ProductRevision) bool {
+		return revision.Status == entities.RevisionPending &&
+			revision.Author == "alice" &&
+			revision.BaseUpdatedAt.Equal(updatedAt) &&
+			*revision.Changes.Name == "Laptop Pro" &&
+			revision.Changes.Price.Equal(price)
+	})).Return(&entities.ProductRevision{ID: 4, ProductID: 1, Status: entities.RevisionPending, Author: "alice"}, nil)
+
+	// When
+	result, err := useCases.SubmitRevision(ctx, 1, &dto.SubmitRevisionRequestDTO{
+		Changes: dto.UpdateProductRequestDTO{Name: "Laptop Pro", Price: &price},
+		Comment: "spring refresh",
+	})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, uint(4), result.ID)
+	assert.Equal(t, "Laptop", product.Name)
+	assert.Equal(t, entities.MustParseMoney("999.00", "USD"), product.Price)
+	mockProducts.AssertNotCalled(t, "ApplyEdit", mock.Anything, mock.Anything)
+	mockRevisions.AssertExpectations(t)
+}
+
+func TestRevisionUseCases_SubmitRevision_InvalidChanges(t *testing.T) {
+	// Given
+	useCases, mockRevisions, mockProducts := setupTestRevisionUseCases()
+	ctx := context.Background()
+
+	price := entities.MustParseMoney("-1.00", "USD")
+	mockProducts.On("GetByID", ctx, uint(1)).Return(testRevisionProduct(time.Now()), nil)
+
+	// When
+	_, err := useCases.SubmitRevision(ctx, 1, &dto.SubmitRevisionRequestDTO{
+		Changes: dto.UpdateProductRequestDTO{Price: &price},
+	})
+
+	// Then
+	var domainErr *domainErrors.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "price", domainErr.Field)
+	mockRevisions.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestRevisionUseCases_GetRevision_DiffAgainstLive(t *testing.T) {
+	// Given
+	useCases, mockRevisions, mockProducts := setupTestRevisionUseCases()
+	ctx := context.Background()
+
+	drafted := time.Now().Add(-time.Hour)
+	name := "Laptop Pro"
+	revision := &entities.ProductRevision{ID: 4, ProductID: 1, Status: entities.RevisionPending, Changes: entities.ProductChanges{Name: &name}, BaseUpdatedAt: drafted}
+
+	mockRevisions.On("GetByID", ctx, uint(4)).Return(revision, nil)
+	mockProducts.On("GetByID", ctx, uint(1)).Return(testRevisionProduct(drafted.Add(time.Minute)), nil)
+
+	// When
+	result, err := useCases.GetRevision(ctx, 4)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, []entities.FieldChange{{Field: "name", Live: "Laptop", Proposed: "Laptop Pro"}}, result.Diff)
+	assert.True(t, result.Stale)
+}
+
+func TestRevisionUseCases_ApproveRevision_ByAuthor(t *testing.T) {
+	// Given
+	useCases, mockRevisions, _ := setupTestRevisionUseCases()
+	ctx := requestctx.WithActor(context.Background(), "alice")
+
+	mockRevisions.On("GetByID", ctx, uint(4)).Return(&entities.ProductRevision{ID: 4, Status: entities.RevisionPending, Author: "alice"}, nil)
+
+	// When
+	_, err := useCases.ApproveRevision(ctx, 4, &dto.ReviewRevisionRequestDTO{})
+
+	// Then
+	assert.Equal(t, domainErrors.ErrRevisionSelfApproval, err)
+	mockRevisions.AssertNotCalled(t, "SaveReview", mock.Anything, mock.Anything)
+}
+
+func TestRevisionUseCases_RejectRevision_Success(t *testing.T) {
+	// Given
+	useCases, mockRevisions, _ := setupTestRevisionUseCases()
+	ctx := requestctx.WithActor(context.Background(), "bob")
+
+	mockRevisions.On("GetByID", ctx, uint(4)).Return(&entities.ProductRevision{ID: 4, ProductID: 1, Status: entities.RevisionPending, Author: "alice"}, nil)
+	mockRevisions.On("SaveReview", ctx, mock.MatchedBy(func(revision *entities.ProductRevision) bool {
+		return revision.Status == entities.RevisionRejected && revision.Reviewer == "bob" && revision.ReviewComment == "wrong price"
+	})).Return(nil)
+
+	// When
+	result, err := useCases.RejectRevision(ctx, 4, &dto.ReviewRevisionRequestDTO{Comment: "wrong price"})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, entities.RevisionRejected, result.Status)
+	mockRevisions.AssertExpectations(t)
+}
+
+func TestRevisionUseCases_PublishRevision(t *testing.T) {
+	drafted := time.Now().Add(-time.Hour)
+	name := "Laptop Pro"
+
+	tests := []struct {
+		name        string
+		status      entities.RevisionStatus
+		liveUpdated time.Time
+		wantErr     error
+	}{
+		{"approved and current", entities.RevisionApproved, drafted, nil},
+		{"still pending", entities.RevisionPending, drafted, domainErrors.ErrRevisionNotApproved},
+		{"product changed since", entities.RevisionApproved, drafted.Add(time.Minute), domainErrors.ErrRevisionStale},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			useCases, mockRevisions, _ := setupTestRevisionUseCases()
+			ctx := requestctx.WithActor(context.Background(), "carol")
+
+			revision := &entities.ProductRevision{ID: 4, ProductID: 1, Status: tt.status, Changes: entities.ProductChanges{Name: &name}, BaseUpdatedAt: drafted}
+			product := testRevisionProduct(tt.liveUpdated)
+			mockRevisions.On("Publish", ctx, uint(4)).Return(revision, product, nil)
+
+			// When
+			result, err := useCases.PublishRevision(ctx, 4)
+
+			// Then
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Equal(t, "Laptop", product.Name)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, entities.RevisionPublished, result.Status)
+			assert.Equal(t, "carol", result.Publisher)
+			require.NotNil(t, result.Product)
+			assert.Equal(t, "Laptop Pro", result.Product.Name)
+		})
+	}
+}
+
+func TestRevisionUseCases_PublishRevision_RecordsPriceChange(t *testing.T) {
+	// Given
+	useCases, mockRevisions, _ := setupTestRevisionUseCases()
+	ctx := requestctx.WithActor(context.Background(), "carol")
+
+	drafted := time.Now().Add(-time.Hour)
+	price := entities.MustParseMoney("899.00", "USD")
+	revision := &entities.ProductRevision{ID: 4, ProductID: 1, Status: entities.RevisionApproved, Changes: entities.ProductChanges{Price: &price}, BaseUpdatedAt: drafted}
+	mockRevisions.On("Publish", ctx, uint(4)).Return(revision, testRevisionProduct(drafted), nil)
+	mockRevisions.On("savedPriceChanges", mock.MatchedBy(func(changes []*entities.PriceChange) bool {
+		return len(changes) == 1 && changes[0].ProductID == 1 &&
+			changes[0].OldPrice.Equal(entities.MustParseMoney("999.00", "USD")) &&
+			changes[0].NewPrice.Equal(price) &&
+			changes[0].Reason == "revision #4" && changes[0].Actor == "carol"
+	})).Return()
+
+	// When
+	result, err := useCases.PublishRevision(ctx, 4)
+
+	// Then
+	require.NoError(t, err)
+	assert.True(t, result.Product.Price.Equal(price))
+	mockRevisions.AssertExpectations(t)
+}
@@ -0,0 +1,114 @@
+package usecases
+
+import (
+	"context"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	productErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+)
+
+// TagUseCases defines the interface for managing tags. Products are tagged
+// through the product use cases.
+type TagUseCases interface {
+	ListTags(ctx context.Context) ([]*dto.TagResponseDTO, error)
+	CreateTag(ctx context.Context, request *dto.CreateTagRequestDTO) (*dto.TagResponseDTO, error)
+	RenameTag(ctx context.Context, id uint, request *dto.RenameTagRequestDTO) (*dto.TagResponseDTO, error)
+	DeleteTag(ctx context.Context, id uint) error
+}
+
+// tagUseCasesImpl implements TagUseCases interface
+type tagUseCasesImpl struct {
+	tagRepo ports.TagRepository
+	logger  logger.Logger
+}
+
+// NewTagUseCases creates a new instance of tag use cases
+func NewTagUseCases(tagRepo ports.TagRepository, log logger.Logger) TagUseCases {
+	return &tagUseCasesImpl{
+		tagRepo: tagRepo,
+		logger:  log.With("component", "tag_usecases"),
+	}
+}
+
+// ListTags returns all tags by name with the number of products carrying each
+func (uc *tagUseCasesImpl) ListTags(ctx context.Context) ([]*dto.TagResponseDTO, error) {
+	tags, err := uc.tagRepo.List(ctx)
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("Failed to list tags", "error", err)
+		return nil, err
+	}
+
+	response := make([]*dto.TagResponseDTO, 0, len(tags))
+	for _, tag := range tags {
+		response = append(response, dto.TagToResponseDTO(tag))
+	}
+	return response, nil
+}
+
+// CreateTag registers a tag under its normalized name
+func (uc *tagUseCasesImpl) CreateTag(ctx context.Context, request *dto.CreateTagRequestDTO) (*dto.TagResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("CreateTag use case called", "name", request.Name)
+
+	tag, err := entities.NewTag(request.Name)
+	if err != nil {
+		return nil, invalidTag(err)
+	}
+
+	created, err := uc.tagRepo.Create(ctx, tag)
+	if err != nil {
+		log.Error("Failed to create tag", "error", err, "name", tag.Name)
+		return nil, err
+	}
+
+	log.Info("CreateTag success", "tag_id", created.ID, "name", created.Name)
+	return dto.TagToResponseDTO(created), nil
+}
+
+// RenameTag renames a tag; renaming onto another tag's name is a conflict
+func (uc *tagUseCasesImpl) RenameTag(ctx context.Context, id uint, request *dto.RenameTagRequestDTO) (*dto.TagResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("RenameTag use case called", "tag_id", id, "name", request.Name)
+
+	tag, err := uc.tagRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := tag.Rename(request.Name); err != nil {
+		return nil, invalidTag(err)
+	}
+
+	renamed, err := uc.tagRepo.Rename(ctx, tag)
+	if err != nil {
+		log.Error("Failed to rename tag", "error", err, "tag_id", id)
+		return nil, err
+	}
+
+	log.Info("RenameTag success", "tag_id", id, "name", renamed.Name)
+	return dto.TagToResponseDTO(renamed), nil
+}
+
+// DeleteTag removes a tag from every product carrying it
+func (uc *tagUseCasesImpl) DeleteTag(ctx context.Context, id uint) error {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("DeleteTag use case called", "tag_id", id)
+
+	if err := uc.tagRepo.Delete(ctx, id); err != nil {
+		log.Error("Failed to delete tag", "error", err, "tag_id", id)
+		return err
+	}
+	return nil
+}
+
+func invalidTag(err error) error {
+	return &productErrors.DomainError{
+		Code:    productErrors.ErrInvalidTag.Code,
+		Message: err.Error(),
+		Field:   "name",
+	}
+}
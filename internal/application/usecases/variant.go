@@ -0,0 +1,309 @@
+package usecases
+
+import (
+	"context"
+	"product-service/internal/application/dto"
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	productErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+)
+
+// VariantUseCases defines the interface for product option and variant operations
+type VariantUseCases interface {
+	GetVariants(ctx context.Context, productID uint) (*dto.ProductVariantsResponseDTO, error)
+	SetOptions(ctx context.Context, productID uint, request *dto.SetProductOptionsRequestDTO) ([]*entities.ProductOption, error)
+	CreateVariant(ctx context.Context, productID uint, request *dto.CreateVariantRequestDTO) (*dto.VariantResponseDTO, error)
+	GenerateVariants(ctx context.Context, productID uint, request *dto.GenerateVariantsRequestDTO) ([]*dto.VariantResponseDTO, error)
+	UpdateVariant(ctx context.Context, productID, variantID uint, request *dto.UpdateVariantRequestDTO) (*dto.VariantResponseDTO, error)
+	DeleteVariant(ctx context.Context, productID, variantID uint) error
+	ApplyVariantAvailability(ctx context.Context, products []*dto.ProductResponseDTO) error
+}
+
+// variantUseCasesImpl implements VariantUseCases interface
+type variantUseCasesImpl struct {
+	variantRepo ports.VariantRepository
+	productRepo ports.ProductRepository
+	logger      logger.Logger
+}
+
+// NewVariantUseCases creates a new instance of variant use cases
+func NewVariantUseCases(variantRepo ports.VariantRepository, productRepo ports.ProductRepository, log logger.Logger) VariantUseCases {
+	return &variantUseCasesImpl{
+		variantRepo: variantRepo,
+		productRepo: productRepo,
+		logger:      log.With("component", "variant_usecases"),
+	}
+}
+
+// GetVariants returns a product's options and variants
+func (uc *variantUseCasesImpl) GetVariants(ctx context.Context, productID uint) (*dto.ProductVariantsResponseDTO, error) {
+	product, err := uc.productRepo.GetByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	options, err := uc.variantRepo.ListOptions(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	variants, err := uc.variantRepo.ListVariants(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := entities.SummarizeVariants(variants)
+	return &dto.ProductVariantsResponseDTO{
+		ProductID:      productID,
+		Options:        options,
+		Variants:       dto.VariantsToResponseDTOs(variants, product.Price),
+		TotalStock:     summary.TotalStock,
+		AvailableCount: summary.AvailableCount,
+	}, nil
+}
+
+// SetOptions replaces a product's option axes. Existing variants must still
+// pick exactly one value of every axis, so delete them before dropping an axis
+// or a value they use.
+func (uc *variantUseCasesImpl) SetOptions(ctx context.Context, productID uint, request *dto.SetProductOptionsRequestDTO) ([]*entities.ProductOption, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("SetOptions use case called", "product_id", productID, "options", len(request.Options))
+
+	if _, err := uc.productRepo.GetByID(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	options, err := entities.NewProductOptions(productID, dto.ProductOptionsToEntities(request.Options))
+	if err != nil {
+		return nil, invalidProductOptions(err)
+	}
+
+	variants, err := uc.variantRepo.ListVariants(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if len(variants) > 0 {
+		if err := entities.CheckVariantsFitOptions(options, variants); err != nil {
+			return nil, invalidProductOptions(err)
+		}
+	}
+
+	saved, err := uc.variantRepo.ReplaceOptions(ctx, productID, options)
+	if err != nil {
+		log.Error("Failed to save product options", "error", err, "product_id", productID)
+		return nil, err
+	}
+
+	log.Info("SetOptions success", "product_id", productID)
+	return saved, nil
+}
+
+// CreateVariant adds one variant to a product
+func (uc *variantUseCasesImpl) CreateVariant(ctx context.Context, productID uint, request *dto.CreateVariantRequestDTO) (*dto.VariantResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("CreateVariant use case called", "product_id", productID, "sku", request.SKU)
+
+	product, options, existing, err := uc.loadParent(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	var priceOverride entities.Money
+	if request.PriceOverride != nil {
+		priceOverride = *request.PriceOverride
+	}
+
+	variant, err := entities.NewProductVariant(product, options, request.SKU, request.Options, priceOverride, request.Stock)
+	if err != nil {
+		return nil, invalidVariant(err)
+	}
+	for _, other := range existing {
+		if other.OptionKey() == variant.OptionKey() {
+			return nil, productErrors.ErrVariantAlreadyExists
+		}
+	}
+
+	created, err := uc.createVariants(ctx, []*entities.ProductVariant{variant})
+	if err != nil {
+		log.Error("Failed to create variant", "error", err, "product_id", productID, "sku", request.SKU)
+		return nil, err
+	}
+
+	log.Info("CreateVariant success", "product_id", productID, "variant_id", created[0].ID)
+	return dto.VariantToResponseDTO(created[0], product.Price), nil
+}
+
+// GenerateVariants creates a variant for every option combination the
+// product does not have yet
+func (uc *variantUseCasesImpl) GenerateVariants(ctx context.Context, productID uint, request *dto.GenerateVariantsRequestDTO) ([]*dto.VariantResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("GenerateVariants use case called", "product_id", productID)
+
+	product, options, existing, err := uc.loadParent(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	generated, err := entities.GenerateVariantMatrix(product, options, existing, request.Stock)
+	if err != nil {
+		return nil, invalidVariant(err)
+	}
+
+	created, err := uc.createVariants(ctx, generated)
+	if err != nil {
+		log.Error("Failed to create generated variants", "error", err, "product_id", productID)
+		return nil, err
+	}
+
+	log.Info("GenerateVariants success", "product_id", productID, "created", len(created))
+	return dto.VariantsToResponseDTOs(created, product.Price), nil
+}
+
+// UpdateVariant changes a variant's price override, stock or active flag
+func (uc *variantUseCasesImpl) UpdateVariant(ctx context.Context, productID, variantID uint, request *dto.UpdateVariantRequestDTO) (*dto.VariantResponseDTO, error) {
+	log := uc.logger.Ctx(ctx)
+
+	log.Info("UpdateVariant use case called", "product_id", productID, "variant_id", variantID)
+
+	product, err := uc.productRepo.GetByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	variant, err := uc.variantRepo.GetVariant(ctx, productID, variantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.ClearPrice {
+		if err := variant.UpdatePriceOverride(entities.Money{}); err != nil {
+			return nil, invalidVariant(err)
+		}
+	} else if request.PriceOverride != nil {
+		if err := variant.UpdatePriceOverride(*request.PriceOverride); err != nil {
+			return nil, invalidVariant(err)
+		}
+	}
+	if request.Stock != nil {
+		if err := variant.UpdateStock(*request.Stock); err != nil {
+			return nil, invalidVariant(err)
+		}
+	}
+	if request.Active != nil {
+		variant.SetActive(*request.Active)
+	}
+
+	updated, err := uc.variantRepo.UpdateVariant(ctx, variant)
+	if err != nil {
+		log.Error("Failed to update variant", "error", err, "variant_id", variantID)
+		return nil, err
+	}
+
+	log.Info("UpdateVariant success", "variant_id", variantID)
+	return dto.VariantToResponseDTO(updated, product.Price), nil
+}
+
+// DeleteVariant removes a variant from a product
+func (uc *variantUseCasesImpl) DeleteVariant(ctx context.Context, productID, variantID uint) error {
+	uc.logger.Ctx(ctx).Info("DeleteVariant use case called", "product_id", productID, "variant_id", variantID)
+
+	return uc.variantRepo.DeleteVariant(ctx, productID, variantID)
+}
+
+// ApplyVariantAvailability derives stock and availability of parent products
+// from their variants
+func (uc *variantUseCasesImpl) ApplyVariantAvailability(ctx context.Context, products []*dto.ProductResponseDTO) error {
+	if len(products) == 0 {
+		return nil
+	}
+
+	ids := make([]uint, 0, len(products))
+	for _, product := range products {
+		ids = append(ids, product.ID)
+	}
+
+	variants, err := uc.variantRepo.ListVariantsForProducts(ctx, ids)
+	if err != nil {
+		uc.logger.Ctx(ctx).Error("Failed to load product variants", "error", err)
+		return err
+	}
+
+	for _, product := range products {
+		product.ApplyVariantSummary(entities.SummarizeVariants(variants[product.ID]))
+	}
+	return nil
+}
+
+// loadParent loads everything needed to add variants to a product
+func (uc *variantUseCasesImpl) loadParent(ctx context.Context, productID uint) (*entities.Product, []*entities.ProductOption, []*entities.ProductVariant, error) {
+	product, err := uc.productRepo.GetByID(ctx, productID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	options, err := uc.variantRepo.ListOptions(ctx, productID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	existing, err := uc.variantRepo.ListVariants(ctx, productID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return product, options, existing, nil
+}
+
+// createVariants saves variants after checking their SKUs are not taken by a
+// product, another variant or each other
+func (uc *variantUseCasesImpl) createVariants(ctx context.Context, variants []*entities.ProductVariant) ([]*entities.ProductVariant, error) {
+	seen := make(map[string]bool, len(variants))
+	for _, variant := range variants {
+		if seen[variant.SKU] {
+			return nil, variantSKUTaken(variant.SKU)
+		}
+		seen[variant.SKU] = true
+
+		productExists, err := uc.productRepo.ExistsBySKU(ctx, variant.SKU)
+		if err != nil {
+			return nil, err
+		}
+		variantExists, err := uc.variantRepo.ExistsBySKU(ctx, variant.SKU)
+		if err != nil {
+			return nil, err
+		}
+		if productExists || variantExists {
+			return nil, variantSKUTaken(variant.SKU)
+		}
+	}
+
+	return uc.variantRepo.CreateVariants(ctx, variants)
+}
+
+// invalidVariant reports why a variant was rejected
+func invalidVariant(err error) error {
+	return &productErrors.DomainError{
+		Code:    productErrors.ErrInvalidVariant.Code,
+		Message: err.Error(),
+	}
+}
+
+// invalidProductOptions reports why option definitions were rejected
+func invalidProductOptions(err error) error {
+	return &productErrors.DomainError{
+		Code:    productErrors.ErrInvalidProductOptions.Code,
+		Message: err.Error(),
+	}
+}
+
+func variantSKUTaken(sku string) error {
+	return &productErrors.DomainError{
+		Code:    productErrors.ErrVariantAlreadyExists.Code,
+		Message: "SKU " + sku + " is already used by a product or variant",
+		Field:   "sku",
+	}
+}
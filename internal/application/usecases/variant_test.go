@@ -0,0 +1,216 @@
+package usecases
+
+import (
+	"context"
+	"product-service/internal/application/dto"
+	"product-service/internal/domain/entities"
+	domainErrors "product-service/internal/domain/errors"
+	"product-service/pkg/logger"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockVariantRepository implements the VariantRepository interface for testing
+type MockVariantRepository struct {
+	mock.Mock
+}
+
+func (m *MockVariantRepository) ReplaceOptions(ctx context.Context, productID uint, options []*entities.ProductOption) ([]*entities.ProductOption, error) {
+	args := m.Called(ctx, productID, options)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.ProductOption), args.Error(1)
+}
+
+func (m *MockVariantRepository) ListOptions(ctx context.Context, productID uint) ([]*entities.ProductOption, error) {
+	args := m.Called(ctx, productID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.ProductOption), args.Error(1)
+}
+
+func (m *MockVariantRepository) CreateVariants(ctx context.Context, variants []*entities.ProductVariant) ([]*entities.ProductVariant, error) {
+	args := m.Called(ctx, variants)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.ProductVariant), args.Error(1)
+}
+
+func (m *MockVariantRepository) GetVariant(ctx context.Context, productID, variantID uint) (*entities.ProductVariant, error) {
+	args := m.Called(ctx, productID, variantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ProductVariant), args.Error(1)
+}
+
+func (m *MockVariantRepository) UpdateVariant(ctx context.Context, variant *entities.ProductVariant) (*entities.ProductVariant, error) {
+	args := m.Called(ctx, variant)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ProductVariant), args.Error(1)
+}
+
+func (m *MockVariantRepository) DeleteVariant(ctx context.Context, productID, variantID uint) error {
+	args := m.Called(ctx, productID, variantID)
+	return args.Error(0)
+}
+
+func (m *MockVariantRepository) ListVariants(ctx context.Context, productID uint) ([]*entities.ProductVariant, error) {
+	args := m.Called(ctx, productID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.ProductVariant), args.Error(1)
+}
+
+func (m *MockVariantRepository) ListVariantsForProducts(ctx context.Context, productIDs []uint) (map[uint][]*entities.ProductVariant, error) {
+	args := m.Called(ctx, productIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[uint][]*entities.ProductVariant), args.Error(1)
+}
+
+func (m *MockVariantRepository) ExistsBySKU(ctx context.Context, sku string) (bool, error) {
+	args := m.Called(ctx, sku)
+	return args.Bool(0), args.Error(1)
+}
+
+func setupTestVariantUseCases() (VariantUseCases, *MockVariantRepository, *MockProductRepository) {
+	mockVariantRepo := new(MockVariantRepository)
+	mockProductRepo := new(MockProductRepository)
+	useCases := NewVariantUseCases(mockVariantRepo, mockProductRepo, logger.New("test"))
+	return useCases, mockVariantRepo, mockProductRepo
+}
+
+func variantParent() *entities.Product {
+	return &entities.Product{
+		ID:     1,
+		Name:   "T-Shirt",
+		SKU:    "TSHIRT",
+		Price:  entities.MustParseMoney("20.00", "USD"),
+		Status: entities.ProductStatusActive,
+	}
+}
+
+func TestVariantUseCases_GenerateVariants_Success(t *testing.T) {
+	// Given
+	useCases, mockVariantRepo, mockProductRepo := setupTestVariantUseCases()
+	ctx := context.Background()
+
+	options := []*entities.ProductOption{
+		{ID: 1, ProductID: 1, Name: "Size", Position: 0, Values: []string{"S", "M"}},
+		{ID: 2, ProductID: 1, Name: "Color", Position: 1, Values: []string{"Red"}},
+	}
+
+	mockProductRepo.On("GetByID", ctx, uint(1)).Return(variantParent(), nil)
+	mockVariantRepo.On("ListOptions", ctx, uint(1)).Return(options, nil)
+	mockVariantRepo.On("ListVariants", ctx, uint(1)).Return([]*entities.ProductVariant{}, nil)
+	mockProductRepo.On("ExistsBySKU", ctx, mock.Anything).Return(false, nil)
+	mockVariantRepo.On("ExistsBySKU", ctx, mock.Anything).Return(false, nil)
+	mockVariantRepo.On("CreateVariants", ctx, mock.MatchedBy(func(variants []*entities.ProductVariant) bool {
+		return len(variants) == 2 && variants[0].SKU == "TSHIRT-S-RED" && variants[1].SKU == "TSHIRT-M-RED"
+	})).Return([]*entities.ProductVariant{
+		{ID: 10, ProductID: 1, SKU: "TSHIRT-S-RED", Options: map[string]string{"Size": "S", "Color": "Red"}, Stock: 3, Active: true},
+		{ID: 11, ProductID: 1, SKU: "TSHIRT-M-RED", Options: map[string]string{"Size": "M", "Color": "Red"}, Stock: 3, Active: true},
+	}, nil)
+
+	// When
+	result, err := useCases.GenerateVariants(ctx, 1, &dto.GenerateVariantsRequestDTO{Stock: 3})
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, entities.MustParseMoney("20.00", "USD"), result[0].Price, "variants without an override sell at the parent's price")
+
+	mockVariantRepo.AssertExpectations(t)
+	mockProductRepo.AssertExpectations(t)
+}
+
+func TestVariantUseCases_CreateVariant_SKUTakenByProduct(t *testing.T) {
+	// Given
+	useCases, mockVariantRepo, mockProductRepo := setupTestVariantUseCases()
+	ctx := context.Background()
+
+	mockProductRepo.On("GetByID", ctx, uint(1)).Return(variantParent(), nil)
+	mockVariantRepo.On("ListOptions", ctx, uint(1)).Return([]*entities.ProductOption{
+		{ID: 1, ProductID: 1, Name: "Size", Values: []string{"S", "M"}},
+	}, nil)
+	mockVariantRepo.On("ListVariants", ctx, uint(1)).Return([]*entities.ProductVariant{}, nil)
+	mockProductRepo.On("ExistsBySKU", ctx, "IPH15-128GB").Return(true, nil)
+	mockVariantRepo.On("ExistsBySKU", ctx, "IPH15-128GB").Return(false, nil)
+
+	// When
+	result, err := useCases.CreateVariant(ctx, 1, &dto.CreateVariantRequestDTO{
+		SKU:     "IPH15-128GB",
+		Options: map[string]string{"Size": "S"},
+		Stock:   1,
+	})
+
+	// Then
+	assert.Nil(t, result)
+	var domainErr *domainErrors.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, domainErrors.ErrVariantAlreadyExists.Code, domainErr.Code)
+	mockVariantRepo.AssertNotCalled(t, "CreateVariants", mock.Anything, mock.Anything)
+}
+
+func TestVariantUseCases_SetOptions_RejectsOrphanedVariants(t *testing.T) {
+	// Given
+	useCases, mockVariantRepo, mockProductRepo := setupTestVariantUseCases()
+	ctx := context.Background()
+
+	mockProductRepo.On("GetByID", ctx, uint(1)).Return(variantParent(), nil)
+	mockVariantRepo.On("ListVariants", ctx, uint(1)).Return([]*entities.ProductVariant{
+		{ID: 10, ProductID: 1, SKU: "TSHIRT-S-RED", Options: map[string]string{"Size": "S", "Color": "Red"}},
+	}, nil)
+
+	// When
+	result, err := useCases.SetOptions(ctx, 1, &dto.SetProductOptionsRequestDTO{
+		Options: []dto.ProductOptionDTO{{Name: "Size", Values: []string{"S", "M"}}},
+	})
+
+	// Then
+	assert.Nil(t, result)
+	var domainErr *domainErrors.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, domainErrors.ErrInvalidProductOptions.Code, domainErr.Code)
+	mockVariantRepo.AssertNotCalled(t, "ReplaceOptions", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestVariantUseCases_ApplyVariantAvailability(t *testing.T) {
+	// Given
+	useCases, mockVariantRepo, _ := setupTestVariantUseCases()
+	ctx := context.Background()
+
+	parent := &dto.ProductResponseDTO{ID: 1, Stock: 0, IsActive: true}
+	simple := &dto.ProductResponseDTO{ID: 2, Stock: 5, IsActive: true, IsInStock: true, IsAvailable: true}
+
+	mockVariantRepo.On("ListVariantsForProducts", ctx, []uint{1, 2}).Return(map[uint][]*entities.ProductVariant{
+		1: {
+			{ID: 10, ProductID: 1, Stock: 0, Active: true},
+			{ID: 11, ProductID: 1, Stock: 4, Active: true},
+		},
+	}, nil)
+
+	// When
+	err := useCases.ApplyVariantAvailability(ctx, []*dto.ProductResponseDTO{parent, simple})
+
+	// Then
+	require.NoError(t, err)
+	assert.True(t, parent.HasVariants)
+	assert.Equal(t, 2, parent.VariantCount)
+	assert.Equal(t, 4, parent.Stock)
+	assert.True(t, parent.IsAvailable)
+
+	assert.False(t, simple.HasVariants)
+	assert.Equal(t, 5, simple.Stock, "products without variants keep their own stock")
+}
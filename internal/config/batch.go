@@ -0,0 +1,12 @@
+package config
+
+import "github.com/spf13/viper"
+
+type BatchConfig struct {
+	// MaxOperations caps how many operations one batch request may carry
+	MaxOperations int `mapstructure:"max_operations"`
+}
+
+func DefaultBatch(v *viper.Viper) {
+	v.SetDefault("batch.max_operations", 500)
+}
@@ -10,13 +10,20 @@ import (
 )
 
 type Config struct {
-	Environment string         `mapstructure:"environment"`
-	Version     string         `mapstructure:"version"`
-	LogLevel    string         `mapstructure:"loglevel"`
-	Server      ServerConfig   `mapstructure:"server"`
-	Database    DatabaseConfig `mapstructure:"database"`
-	Security    SecurityConfig `mapstructure:"security"`
-	Logging     LoggingConfig  `mapstructure:"logging"`
+	Environment string          `mapstructure:"environment"`
+	Version     string          `mapstructure:"version"`
+	LogLevel    string          `mapstructure:"loglevel"`
+	Server      ServerConfig    `mapstructure:"server"`
+	Database    DatabaseConfig  `mapstructure:"database"`
+	Security    SecurityConfig  `mapstructure:"security"`
+	Logging     LoggingConfig   `mapstructure:"logging"`
+	Tracing     TracingConfig   `mapstructure:"tracing"`
+	Scheduler   SchedulerConfig `mapstructure:"scheduler"`
+	Media       MediaConfig     `mapstructure:"media"`
+	Imports     ImportsConfig   `mapstructure:"imports"`
+	Feeds       FeedsConfig     `mapstructure:"feeds"`
+	Batch       BatchConfig     `mapstructure:"batch"`
+	Jobs        JobsConfig      `mapstructure:"jobs"`
 }
 
 type ServerConfig struct {
@@ -102,4 +109,18 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("security.rate_limit_burst", 200)
 
 	DefaultLogger(v)
+
+	DefaultTracing(v)
+
+	DefaultScheduler(v)
+
+	DefaultMedia(v)
+
+	DefaultImports(v)
+
+	DefaultFeeds(v)
+
+	DefaultBatch(v)
+
+	DefaultJobs(v)
 }
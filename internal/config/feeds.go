@@ -0,0 +1,29 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+type FeedsConfig struct {
+	// Directory caches the generated feed files
+	Directory string `mapstructure:"directory"`
+	// Interval is how often the scheduler checks whether products changed
+	// and regenerates the feeds; zero disables the job
+	Interval time.Duration `mapstructure:"interval"`
+	Title    string        `mapstructure:"title"`
+	// StoreURL is the storefront the feeds link to; relative media URLs are
+	// resolved against it
+	StoreURL string `mapstructure:"store_url"`
+	// ProductURL is the product page link, with {sku} and {id} placeholders
+	ProductURL string `mapstructure:"product_url"`
+}
+
+func DefaultFeeds(v *viper.Viper) {
+	v.SetDefault("feeds.directory", "./data/feeds")
+	v.SetDefault("feeds.interval", 15*time.Minute)
+	v.SetDefault("feeds.title", "Products")
+	v.SetDefault("feeds.store_url", "")
+	v.SetDefault("feeds.product_url", "")
+}
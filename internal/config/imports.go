@@ -0,0 +1,16 @@
+package config
+
+import "github.com/spf13/viper"
+
+type ImportsConfig struct {
+	MaxUploadSize int64 `mapstructure:"max_upload_size"`
+	// SpoolDirectory keeps uploaded CSV files until an import job reads
+	// them; the system temporary directory when empty. The API and the
+	// workers must share it.
+	SpoolDirectory string `mapstructure:"spool_directory"`
+}
+
+func DefaultImports(v *viper.Viper) {
+	v.SetDefault("imports.max_upload_size", 100<<20)
+	v.SetDefault("imports.spool_directory", "")
+}
@@ -0,0 +1,34 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+type JobsConfig struct {
+	// InServer runs workers inside `product-service server`; turn it off
+	// when jobs run in separate `product-service worker` processes
+	InServer bool `mapstructure:"in_server"`
+	// Workers is how many jobs a process runs at once
+	Workers int `mapstructure:"workers"`
+	// PollInterval is how long an idle worker waits before looking for jobs
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	// Lease is how long a job stays with its worker without a heartbeat
+	// before another worker may take it over
+	Lease        time.Duration `mapstructure:"lease"`
+	MaxAttempts  int           `mapstructure:"max_attempts"`
+	RetryBackoff time.Duration `mapstructure:"retry_backoff"`
+	// Directory keeps the files jobs produce, such as exports
+	Directory string `mapstructure:"directory"`
+}
+
+func DefaultJobs(v *viper.Viper) {
+	v.SetDefault("jobs.in_server", true)
+	v.SetDefault("jobs.workers", 4)
+	v.SetDefault("jobs.poll_interval", time.Second)
+	v.SetDefault("jobs.lease", time.Minute)
+	v.SetDefault("jobs.max_attempts", 3)
+	v.SetDefault("jobs.retry_backoff", 30*time.Second)
+	v.SetDefault("jobs.directory", "./data/jobs")
+}
@@ -0,0 +1,43 @@
+package config
+
+import "github.com/spf13/viper"
+
+type MediaConfig struct {
+	// Storage selects the backend: local or s3
+	Storage       string           `mapstructure:"storage"`
+	MaxUploadSize int64            `mapstructure:"max_upload_size"`
+	ThumbnailSize int              `mapstructure:"thumbnail_size"`
+	Local         LocalMediaConfig `mapstructure:"local"`
+	S3            S3MediaConfig    `mapstructure:"s3"`
+}
+
+type LocalMediaConfig struct {
+	// Directory is where files are written; the server serves it at BaseURL
+	Directory string `mapstructure:"directory"`
+	BaseURL   string `mapstructure:"base_url"`
+}
+
+// S3MediaConfig works with AWS S3 and S3-compatible stores such as MinIO
+type S3MediaConfig struct {
+	Endpoint        string `mapstructure:"endpoint"`
+	Region          string `mapstructure:"region"`
+	Bucket          string `mapstructure:"bucket"`
+	AccessKeyID     string `mapstructure:"access_key_id"`
+	SecretAccessKey string `mapstructure:"secret_access_key"`
+	// UsePathStyle addresses the bucket as endpoint/bucket instead of bucket.endpoint
+	UsePathStyle bool `mapstructure:"use_path_style"`
+	// PublicURL is the base URL objects are downloaded from, e.g. a CDN;
+	// defaults to the bucket URL
+	PublicURL string `mapstructure:"public_url"`
+}
+
+func DefaultMedia(v *viper.Viper) {
+	v.SetDefault("media.storage", "local")
+	v.SetDefault("media.max_upload_size", 10<<20)
+	v.SetDefault("media.thumbnail_size", 320)
+	v.SetDefault("media.local.directory", "./data/media")
+	v.SetDefault("media.local.base_url", "/media")
+	v.SetDefault("media.s3.endpoint", "https://s3.amazonaws.com")
+	v.SetDefault("media.s3.region", "us-east-1")
+	v.SetDefault("media.s3.use_path_style", false)
+}
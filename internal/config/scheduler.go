@@ -0,0 +1,17 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+type SchedulerConfig struct {
+	Enabled       bool          `mapstructure:"enabled"`
+	PriceInterval time.Duration `mapstructure:"price_interval"`
+}
+
+func DefaultScheduler(v *viper.Viper) {
+	v.SetDefault("scheduler.enabled", true)
+	v.SetDefault("scheduler.price_interval", time.Minute)
+}
@@ -0,0 +1,21 @@
+package config
+
+import "github.com/spf13/viper"
+
+type TracingConfig struct {
+	Exporter    string  `mapstructure:"exporter"`
+	Endpoint    string  `mapstructure:"endpoint"`
+	Protocol    string  `mapstructure:"protocol"`
+	Insecure    bool    `mapstructure:"insecure"`
+	ServiceName string  `mapstructure:"service_name"`
+	SampleRatio float64 `mapstructure:"sample_ratio"`
+}
+
+func DefaultTracing(v *viper.Viper) {
+	v.SetDefault("tracing.exporter", "none")
+	v.SetDefault("tracing.endpoint", "localhost:4317")
+	v.SetDefault("tracing.protocol", "grpc")
+	v.SetDefault("tracing.insecure", true)
+	v.SetDefault("tracing.service_name", "product-service")
+	v.SetDefault("tracing.sample_ratio", 1.0)
+}
@@ -0,0 +1,318 @@
+package entities
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AttributeType is the kind of value a custom attribute holds
+type AttributeType string
+
+const (
+	AttributeString  AttributeType = "string"
+	AttributeInt     AttributeType = "int"
+	AttributeDecimal AttributeType = "decimal"
+	AttributeBool    AttributeType = "bool"
+	AttributeEnum    AttributeType = "enum"
+	// AttributeUnit is a decimal measured in the definition's Unit, e.g. 128 GB
+	AttributeUnit AttributeType = "unit"
+)
+
+const (
+	maxAttributeStringLength = 500
+	maxAttributeKeyLength    = 50
+)
+
+var attributeKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// Attributes holds a product's custom attribute values keyed by attribute
+// key. Values are strings, bools or json.Number once validated.
+type Attributes map[string]interface{}
+
+// AttributeDefinition declares a custom attribute products of a category carry
+type AttributeDefinition struct {
+	ID            uint          `json:"id"`
+	CategoryID    uint          `json:"category_id"`
+	Key           string        `json:"key"`
+	Label         string        `json:"label"`
+	Type          AttributeType `json:"type"`
+	Required      bool          `json:"required"`
+	AllowedValues []string      `json:"allowed_values,omitempty"`
+	Unit          string        `json:"unit,omitempty"`
+	CreatedAt     time.Time     `json:"created_at"`
+	UpdatedAt     time.Time     `json:"updated_at"`
+}
+
+// Validate checks the definition and normalizes its key, label and values
+func (d *AttributeDefinition) Validate() error {
+	d.Key = strings.TrimSpace(d.Key)
+	d.Label = strings.TrimSpace(d.Label)
+	d.Unit = strings.TrimSpace(d.Unit)
+
+	if d.CategoryID == 0 {
+		return errors.New("category is required")
+	}
+	if !attributeKeyPattern.MatchString(d.Key) || len(d.Key) > maxAttributeKeyLength {
+		return fmt.Errorf("key must be snake_case and at most %d characters", maxAttributeKeyLength)
+	}
+	if d.Label == "" {
+		d.Label = d.Key
+	}
+
+	switch d.Type {
+	case AttributeString, AttributeInt, AttributeDecimal, AttributeBool:
+		if len(d.AllowedValues) > 0 {
+			return fmt.Errorf("allowed values only apply to %s attributes", AttributeEnum)
+		}
+	case AttributeEnum:
+		values, err := normalizeAllowedValues(d.AllowedValues)
+		if err != nil {
+			return err
+		}
+		d.AllowedValues = values
+	case AttributeUnit:
+		if d.Unit == "" {
+			return errors.New("unit attributes need a unit, e.g. GB")
+		}
+	default:
+		return fmt.Errorf("unknown attribute type %q", d.Type)
+	}
+
+	if d.Type != AttributeUnit && d.Unit != "" {
+		return fmt.Errorf("a unit only applies to %s attributes", AttributeUnit)
+	}
+	return nil
+}
+
+// IsNumeric reports whether values of the attribute are numbers
+func (d *AttributeDefinition) IsNumeric() bool {
+	return d.Type == AttributeInt || d.Type == AttributeDecimal || d.Type == AttributeUnit
+}
+
+// Normalize converts a raw value, as decoded from JSON or a query string,
+// into the attribute's canonical form
+func (d *AttributeDefinition) Normalize(raw interface{}) (interface{}, error) {
+	switch d.Type {
+	case AttributeString:
+		value, ok := raw.(string)
+		if !ok {
+			return nil, errors.New("must be a string")
+		}
+		value = strings.TrimSpace(value)
+		if len(value) > maxAttributeStringLength {
+			return nil, fmt.Errorf("must be at most %d characters", maxAttributeStringLength)
+		}
+		return value, nil
+
+	case AttributeInt:
+		number, err := attributeNumber(raw, "")
+		if err != nil {
+			return nil, err
+		}
+		if !number.IsInt() {
+			return nil, errors.New("must be a whole number")
+		}
+		return json.Number(number.Num().String()), nil
+
+	case AttributeDecimal:
+		number, err := attributeNumber(raw, "")
+		if err != nil {
+			return nil, err
+		}
+		return decimalNumber(number), nil
+
+	case AttributeUnit:
+		number, err := attributeNumber(raw, d.Unit)
+		if err != nil {
+			return nil, err
+		}
+		return decimalNumber(number), nil
+
+	case AttributeBool:
+		switch value := raw.(type) {
+		case bool:
+			return value, nil
+		case string:
+			parsed, err := strconv.ParseBool(strings.TrimSpace(value))
+			if err != nil {
+				return nil, errors.New("must be true or false")
+			}
+			return parsed, nil
+		}
+		return nil, errors.New("must be true or false")
+
+	case AttributeEnum:
+		value, ok := raw.(string)
+		if !ok {
+			return nil, errors.New("must be a string")
+		}
+		for _, allowed := range d.AllowedValues {
+			if strings.EqualFold(allowed, strings.TrimSpace(value)) {
+				return allowed, nil
+			}
+		}
+		return nil, fmt.Errorf("must be one of %s", strings.Join(d.AllowedValues, ", "))
+	}
+
+	return nil, fmt.Errorf("unknown attribute type %q", d.Type)
+}
+
+// AttributeError reports which attribute of a product is invalid
+type AttributeError struct {
+	Key     string
+	Message string
+}
+
+func (e *AttributeError) Error() string {
+	return e.Key + ": " + e.Message
+}
+
+// ValidateAttributes checks values against a category's attribute schema and
+// returns them normalized. Unknown keys and missing required attributes are
+// rejected.
+func ValidateAttributes(schema []*AttributeDefinition, values Attributes) (Attributes, error) {
+	definitions := make(map[string]*AttributeDefinition, len(schema))
+	for _, definition := range schema {
+		definitions[definition.Key] = definition
+	}
+
+	normalized := make(Attributes, len(values))
+	for key, raw := range values {
+		definition, ok := definitions[key]
+		if !ok {
+			return nil, &AttributeError{Key: key, Message: "is not defined for this category"}
+		}
+		if raw == nil {
+			continue
+		}
+		value, err := definition.Normalize(raw)
+		if err != nil {
+			return nil, &AttributeError{Key: key, Message: err.Error()}
+		}
+		if value == "" {
+			continue
+		}
+		normalized[key] = value
+	}
+
+	for _, definition := range schema {
+		if _, ok := normalized[definition.Key]; definition.Required && !ok {
+			return nil, &AttributeError{Key: definition.Key, Message: "is required"}
+		}
+	}
+
+	return normalized, nil
+}
+
+// AttributeFilterOperator compares a product's attribute with a filter value
+type AttributeFilterOperator string
+
+const (
+	AttributeEquals AttributeFilterOperator = "eq"
+	AttributeMin    AttributeFilterOperator = "min"
+	AttributeMax    AttributeFilterOperator = "max"
+)
+
+// AttributeFilter narrows a search to products whose attribute matches
+type AttributeFilter struct {
+	Key      string
+	Type     AttributeType
+	Operator AttributeFilterOperator
+	Value    interface{}
+}
+
+// NewAttributeFilter builds a filter from a raw query value, typed by the
+// attribute's definition
+func NewAttributeFilter(definition *AttributeDefinition, operator AttributeFilterOperator, raw string) (AttributeFilter, error) {
+	if operator != AttributeEquals && !definition.IsNumeric() {
+		return AttributeFilter{}, fmt.Errorf("%s only supports equality filters", definition.Key)
+	}
+
+	var value interface{}
+	var err error
+	if definition.Type == AttributeString {
+		value = strings.TrimSpace(raw)
+	} else {
+		value, err = definition.Normalize(raw)
+		if err != nil {
+			return AttributeFilter{}, fmt.Errorf("%s %s", definition.Key, err.Error())
+		}
+	}
+
+	return AttributeFilter{
+		Key:      definition.Key,
+		Type:     definition.Type,
+		Operator: operator,
+		Value:    value,
+	}, nil
+}
+
+func normalizeAllowedValues(values []string) ([]string, error) {
+	if len(values) == 0 {
+		return nil, fmt.Errorf("%s attributes need at least one allowed value", AttributeEnum)
+	}
+
+	seen := make(map[string]bool, len(values))
+	normalized := make([]string, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			return nil, errors.New("allowed values cannot be empty")
+		}
+		if seen[strings.ToLower(value)] {
+			return nil, fmt.Errorf("allowed value %q is listed twice", value)
+		}
+		seen[strings.ToLower(value)] = true
+		normalized = append(normalized, value)
+	}
+	return normalized, nil
+}
+
+// attributeNumber parses a JSON number, a numeric string or, when unit is
+// set, a string such as "128 GB" in that unit
+func attributeNumber(raw interface{}, unit string) (*big.Rat, error) {
+	var text string
+	switch value := raw.(type) {
+	case json.Number:
+		text = value.String()
+	case float64:
+		text = strconv.FormatFloat(value, 'f', -1, 64)
+	case int:
+		text = strconv.Itoa(value)
+	case int64:
+		text = strconv.FormatInt(value, 10)
+	case string:
+		text = strings.TrimSpace(value)
+		if unit != "" && len(text) > len(unit) && strings.EqualFold(text[len(text)-len(unit):], unit) {
+			text = strings.TrimSpace(text[:len(text)-len(unit)])
+		}
+	default:
+		return nil, errors.New("must be a number")
+	}
+
+	number, ok := new(big.Rat).SetString(text)
+	if !ok {
+		if unit != "" {
+			return nil, fmt.Errorf("must be a number in %s", unit)
+		}
+		return nil, errors.New("must be a number")
+	}
+	return number, nil
+}
+
+// decimalNumber renders a rational as the shortest exact decimal, falling
+// back to six decimal places for values such as 1/3
+func decimalNumber(number *big.Rat) json.Number {
+	if number.IsInt() {
+		return json.Number(number.Num().String())
+	}
+	text := number.FloatString(6)
+	text = strings.TrimRight(text, "0")
+	return json.Number(strings.TrimSuffix(text, "."))
+}
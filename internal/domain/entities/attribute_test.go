@@ -0,0 +1,134 @@
+package entities
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func laptopSchema() []*AttributeDefinition {
+	return []*AttributeDefinition{
+		{CategoryID: 3, Key: "storage_gb", Type: AttributeUnit, Unit: "GB", Required: true},
+		{CategoryID: 3, Key: "ram_gb", Type: AttributeInt},
+		{CategoryID: 3, Key: "touchscreen", Type: AttributeBool},
+		{CategoryID: 3, Key: "color", Type: AttributeEnum, AllowedValues: []string{"Silver", "Space Gray"}},
+		{CategoryID: 3, Key: "model", Type: AttributeString},
+	}
+}
+
+func TestAttributeDefinition_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		definition AttributeDefinition
+		expectErr  bool
+	}{
+		{"valid string", AttributeDefinition{CategoryID: 3, Key: "model", Type: AttributeString}, false},
+		{"valid enum", AttributeDefinition{CategoryID: 3, Key: "color", Type: AttributeEnum, AllowedValues: []string{"Silver"}}, false},
+		{"valid unit", AttributeDefinition{CategoryID: 3, Key: "storage_gb", Type: AttributeUnit, Unit: "GB"}, false},
+		{"missing category", AttributeDefinition{Key: "model", Type: AttributeString}, true},
+		{"key not snake_case", AttributeDefinition{CategoryID: 3, Key: "Storage-GB", Type: AttributeInt}, true},
+		{"unknown type", AttributeDefinition{CategoryID: 3, Key: "model", Type: "text"}, true},
+		{"enum without values", AttributeDefinition{CategoryID: 3, Key: "color", Type: AttributeEnum}, true},
+		{"enum with duplicate values", AttributeDefinition{CategoryID: 3, Key: "color", Type: AttributeEnum, AllowedValues: []string{"Silver", "silver"}}, true},
+		{"allowed values on int", AttributeDefinition{CategoryID: 3, Key: "ram_gb", Type: AttributeInt, AllowedValues: []string{"8"}}, true},
+		{"unit without unit", AttributeDefinition{CategoryID: 3, Key: "storage_gb", Type: AttributeUnit}, true},
+		{"unit on decimal", AttributeDefinition{CategoryID: 3, Key: "weight", Type: AttributeDecimal, Unit: "kg"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.definition.Validate()
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAttributeDefinition_Validate_DefaultsLabelToKey(t *testing.T) {
+	definition := AttributeDefinition{CategoryID: 3, Key: " model ", Type: AttributeString}
+
+	require.NoError(t, definition.Validate())
+	assert.Equal(t, "model", definition.Key)
+	assert.Equal(t, "model", definition.Label)
+}
+
+func TestAttributeDefinition_Normalize(t *testing.T) {
+	schema := laptopSchema()
+	storage, ram, touchscreen, color := schema[0], schema[1], schema[2], schema[3]
+
+	tests := []struct {
+		name       string
+		definition *AttributeDefinition
+		raw        interface{}
+		expected   interface{}
+		expectErr  bool
+	}{
+		{"unit from number", storage, json.Number("128"), json.Number("128"), false},
+		{"unit with suffix", storage, "512 GB", json.Number("512"), false},
+		{"unit decimal", storage, "0.50gb", json.Number("0.5"), false},
+		{"unit in another unit", storage, "1 TB", nil, true},
+		{"int from float", ram, float64(16), json.Number("16"), false},
+		{"int rejects fraction", ram, "16.5", nil, true},
+		{"bool from string", touchscreen, "true", true, false},
+		{"bool rejects word", touchscreen, "yes please", nil, true},
+		{"enum canonical case", color, "space gray", "Space Gray", false},
+		{"enum outside allowed values", color, "Gold", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := tt.definition.Normalize(tt.raw)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, value)
+		})
+	}
+}
+
+func TestValidateAttributes(t *testing.T) {
+	t.Run("normalizes valid values", func(t *testing.T) {
+		values, err := ValidateAttributes(laptopSchema(), Attributes{
+			"storage_gb": "256 GB",
+			"color":      "silver",
+			"model":      nil,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, Attributes{"storage_gb": json.Number("256"), "color": "Silver"}, values)
+	})
+
+	t.Run("rejects unknown keys", func(t *testing.T) {
+		_, err := ValidateAttributes(laptopSchema(), Attributes{"storage_gb": 128, "weight": 1.2})
+
+		var attributeErr *AttributeError
+		require.ErrorAs(t, err, &attributeErr)
+		assert.Equal(t, "weight", attributeErr.Key)
+	})
+
+	t.Run("requires required attributes", func(t *testing.T) {
+		_, err := ValidateAttributes(laptopSchema(), Attributes{"ram_gb": 16})
+
+		var attributeErr *AttributeError
+		require.ErrorAs(t, err, &attributeErr)
+		assert.Equal(t, "storage_gb", attributeErr.Key)
+	})
+}
+
+func TestNewAttributeFilter(t *testing.T) {
+	schema := laptopSchema()
+
+	filter, err := NewAttributeFilter(schema[0], AttributeMin, "128GB")
+	require.NoError(t, err)
+	assert.Equal(t, AttributeFilter{Key: "storage_gb", Type: AttributeUnit, Operator: AttributeMin, Value: json.Number("128")}, filter)
+
+	_, err = NewAttributeFilter(schema[3], AttributeMin, "Silver")
+	assert.Error(t, err, "range filters only apply to numeric attributes")
+}
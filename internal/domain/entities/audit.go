@@ -0,0 +1,91 @@
+package entities
+
+import "time"
+
+type AuditOperation string
+
+const (
+	// AuditCreate records a new product; every field starts from nothing
+	AuditCreate AuditOperation = "create"
+	// AuditUpdate records a direct edit of the product
+	AuditUpdate AuditOperation = "update"
+	// AuditRevisionPublish records an approved revision going live
+	AuditRevisionPublish AuditOperation = "revision_publish"
+	// AuditPriceChange records a manual or scheduled price change
+	AuditPriceChange AuditOperation = "price_change"
+	// AuditStatusChange records a lifecycle transition
+	AuditStatusChange AuditOperation = "status_change"
+	// AuditCategoryChange records a category rename or merge carried over to
+	// the products filed under it
+	AuditCategoryChange AuditOperation = "category_change"
+	// AuditBrandChange records a brand rename or merge carried over to its
+	// products
+	AuditBrandChange AuditOperation = "brand_change"
+)
+
+// IsValidAuditOperation reports whether operation is a known audit operation
+func IsValidAuditOperation(operation AuditOperation) bool {
+	switch operation {
+	case AuditCreate, AuditUpdate, AuditRevisionPublish, AuditPriceChange,
+		AuditStatusChange, AuditCategoryChange, AuditBrandChange:
+		return true
+	}
+	return false
+}
+
+// AuditChange is the value of one field before and after a mutation
+type AuditChange struct {
+	Field  string      `json:"field"`
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// AuditEntry records who changed which fields of a product, and when
+type AuditEntry struct {
+	ID        uint           `json:"id"`
+	ProductID uint           `json:"product_id"`
+	Operation AuditOperation `json:"operation"`
+	Actor     string         `json:"actor,omitempty"`
+	RequestID string         `json:"request_id,omitempty"`
+	Changes   []AuditChange  `json:"changes"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+// NewAuditEntry records the fields that differ between before and after. A
+// nil before stands for a product that did not exist yet. It returns nil when
+// no field changed, as there is nothing to audit.
+func NewAuditEntry(operation AuditOperation, before, after *Product, actor, requestID string, now time.Time) *AuditEntry {
+	if before == nil {
+		before = &Product{}
+	}
+
+	changes := AuditProductChanges(before, after)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	return &AuditEntry{
+		ProductID: after.ID,
+		Operation: operation,
+		Actor:     actor,
+		RequestID: requestID,
+		Changes:   changes,
+		CreatedAt: now,
+	}
+}
+
+// AuditProductChanges lists the fields that differ between before and after:
+// the SKU and status followed by the editable fields in DiffProducts order
+func AuditProductChanges(before, after *Product) []AuditChange {
+	var changes []AuditChange
+	if before.SKU != after.SKU {
+		changes = append(changes, AuditChange{Field: "sku", Before: before.SKU, After: after.SKU})
+	}
+	if before.Status != after.Status {
+		changes = append(changes, AuditChange{Field: "status", Before: before.Status, After: after.Status})
+	}
+	for _, change := range DiffProducts(before, after) {
+		changes = append(changes, AuditChange{Field: change.Field, Before: change.Live, After: change.Proposed})
+	}
+	return changes
+}
@@ -0,0 +1,61 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAuditEntry(t *testing.T) {
+	now := time.Now()
+	before := &Product{
+		ID:     7,
+		Name:   "Laptop",
+		SKU:    "LAP-001",
+		Price:  MustParseMoney("999.00", "USD"),
+		Status: ProductStatusActive,
+		Tags:   []string{},
+	}
+
+	t.Run("records only changed fields", func(t *testing.T) {
+		after := *before
+		after.Price = MustParseMoney("899.00", "USD")
+		after.Status = ProductStatusInactive
+
+		entry := NewAuditEntry(AuditUpdate, before, &after, "alice", "req-1", now)
+
+		require.NotNil(t, entry)
+		assert.Equal(t, uint(7), entry.ProductID)
+		assert.Equal(t, AuditUpdate, entry.Operation)
+		assert.Equal(t, "alice", entry.Actor)
+		assert.Equal(t, "req-1", entry.RequestID)
+		assert.Equal(t, []AuditChange{
+			{Field: "status", Before: ProductStatusActive, After: ProductStatusInactive},
+			{Field: "price", Before: MustParseMoney("999.00", "USD"), After: MustParseMoney("899.00", "USD")},
+		}, entry.Changes)
+	})
+
+	t.Run("creation starts from nothing", func(t *testing.T) {
+		entry := NewAuditEntry(AuditCreate, nil, before, "alice", "", now)
+
+		require.NotNil(t, entry)
+		fields := make([]string, 0, len(entry.Changes))
+		for _, change := range entry.Changes {
+			fields = append(fields, change.Field)
+			assert.Empty(t, change.Before, change.Field)
+		}
+		assert.Equal(t, []string{"sku", "status", "name", "price"}, fields)
+	})
+
+	t.Run("nothing changed", func(t *testing.T) {
+		after := *before
+		assert.Nil(t, NewAuditEntry(AuditUpdate, before, &after, "alice", "", now))
+	})
+}
+
+func TestIsValidAuditOperation(t *testing.T) {
+	assert.True(t, IsValidAuditOperation(AuditPriceChange))
+	assert.False(t, IsValidAuditOperation("delete"))
+}
@@ -0,0 +1,33 @@
+package entities
+
+// BatchAction is what one operation of a product batch does
+type BatchAction string
+
+const (
+	// BatchCreate creates a new product
+	BatchCreate BatchAction = "create"
+	// BatchUpdate edits a product like a product update
+	BatchUpdate BatchAction = "update"
+	// BatchStock sets the stock of a product
+	BatchStock BatchAction = "stock"
+	// BatchPrice changes the price of a product, recording it in the price
+	// history
+	BatchPrice BatchAction = "price"
+	// BatchStatus moves a product to another lifecycle status
+	BatchStatus BatchAction = "status"
+)
+
+// IsValid reports whether a is a supported batch action
+func (a BatchAction) IsValid() bool {
+	switch a {
+	case BatchCreate, BatchUpdate, BatchStock, BatchPrice, BatchStatus:
+		return true
+	}
+	return false
+}
+
+// AddressesProduct reports whether operations of a act on an existing
+// product, which they name by ID or SKU
+func (a BatchAction) AddressesProduct() bool {
+	return a.IsValid() && a != BatchCreate
+}
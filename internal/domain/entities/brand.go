@@ -0,0 +1,163 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	maxBrandNameLength = 100
+	maxBrandAliases    = 20
+	maxLogoURLLength   = 500
+)
+
+// BrandStatus controls whether new products can be assigned to a brand
+type BrandStatus string
+
+const (
+	BrandStatusActive   BrandStatus = "active"
+	BrandStatusInactive BrandStatus = "inactive"
+)
+
+// Brand is an entry of the brand registry. Products referring to the brand by
+// its name or by any of its aliases are stored with the canonical Name.
+type Brand struct {
+	ID        uint        `json:"id"`
+	Name      string      `json:"name"`
+	Slug      string      `json:"slug"`
+	Aliases   []string    `json:"aliases"`
+	LogoURL   string      `json:"logo_url,omitempty"`
+	Status    BrandStatus `json:"status"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at"`
+}
+
+// NewBrand creates an active brand
+func NewBrand(name string, aliases []string, logoURL string) (*Brand, error) {
+	now := time.Now()
+	brand := &Brand{
+		Status:    BrandStatusActive,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := brand.Rename(name); err != nil {
+		return nil, err
+	}
+	if err := brand.SetAliases(aliases); err != nil {
+		return nil, err
+	}
+	if err := brand.SetLogoURL(logoURL); err != nil {
+		return nil, err
+	}
+	return brand, nil
+}
+
+// Rename changes the canonical name and slug. An alias equal to the new name
+// is dropped.
+func (b *Brand) Rename(name string) error {
+	name = strings.Join(strings.Fields(name), " ")
+	if len(name) < 1 || len(name) > maxBrandNameLength {
+		return fmt.Errorf("brand name must be between 1 and %d characters", maxBrandNameLength)
+	}
+	slug := Slugify(name)
+	if slug == "" {
+		return errors.New("brand name must contain letters or digits")
+	}
+
+	b.Name = name
+	b.Slug = slug
+	b.Aliases = b.otherNames(b.Aliases)
+	b.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetAliases replaces the brand's aliases. Aliases differing only in case or
+// spacing from the name or from each other are collapsed.
+func (b *Brand) SetAliases(aliases []string) error {
+	cleaned := make([]string, 0, len(aliases))
+	for _, alias := range aliases {
+		alias = strings.Join(strings.Fields(alias), " ")
+		if alias == "" || len(alias) > maxBrandNameLength {
+			return fmt.Errorf("brand aliases must be between 1 and %d characters", maxBrandNameLength)
+		}
+		cleaned = append(cleaned, alias)
+	}
+
+	cleaned = b.otherNames(cleaned)
+	if len(cleaned) > maxBrandAliases {
+		return fmt.Errorf("a brand can have at most %d aliases", maxBrandAliases)
+	}
+
+	b.Aliases = cleaned
+	b.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetLogoURL sets the logo URL; an empty URL removes the logo
+func (b *Brand) SetLogoURL(logoURL string) error {
+	logoURL = strings.TrimSpace(logoURL)
+	if logoURL != "" {
+		parsed, err := url.Parse(logoURL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return errors.New("logo URL must be an absolute http or https URL")
+		}
+		if len(logoURL) > maxLogoURLLength {
+			return fmt.Errorf("logo URL must be at most %d characters", maxLogoURLLength)
+		}
+	}
+
+	b.LogoURL = logoURL
+	b.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetStatus activates or deactivates the brand
+func (b *Brand) SetStatus(status BrandStatus) error {
+	if status != BrandStatusActive && status != BrandStatusInactive {
+		return fmt.Errorf("brand status must be %s or %s", BrandStatusActive, BrandStatusInactive)
+	}
+
+	b.Status = status
+	b.UpdatedAt = time.Now()
+	return nil
+}
+
+// IsActive reports whether products can be assigned to the brand
+func (b *Brand) IsActive() bool {
+	return b.Status == BrandStatusActive
+}
+
+// Names returns the canonical name followed by the aliases
+func (b *Brand) Names() []string {
+	return append([]string{b.Name}, b.Aliases...)
+}
+
+// Absorb makes the names of a brand being merged into b aliases of b
+func (b *Brand) Absorb(source *Brand) {
+	b.Aliases = b.otherNames(append(b.Aliases, source.Names()...))
+	b.UpdatedAt = time.Now()
+}
+
+// otherNames drops names that match the canonical name or an earlier name
+func (b *Brand) otherNames(names []string) []string {
+	seen := map[string]bool{NormalizeBrandName(b.Name): true}
+	result := make([]string, 0, len(names))
+	for _, name := range names {
+		key := NormalizeBrandName(name)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, name)
+	}
+	return result
+}
+
+// NormalizeBrandName is the case- and spacing-insensitive key brand names and
+// aliases are looked up by, e.g. " Hewlett  Packard" becomes "hewlett packard"
+func NormalizeBrandName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
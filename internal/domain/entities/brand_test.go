@@ -0,0 +1,78 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeBrandName(t *testing.T) {
+	assert.Equal(t, "hewlett packard", NormalizeBrandName("  Hewlett   PACKARD "))
+	assert.Equal(t, "hp", NormalizeBrandName("HP"))
+}
+
+func TestNewBrand(t *testing.T) {
+	tests := []struct {
+		name      string
+		brandName string
+		aliases   []string
+		logoURL   string
+		expectErr bool
+	}{
+		{"valid", "Apple", []string{"Apple Computer"}, "https://cdn.example.com/apple.png", false},
+		{"empty name", "  ", nil, "", true},
+		{"name without letters", "---", nil, "", true},
+		{"empty alias", "Apple", []string{" "}, "", true},
+		{"relative logo URL", "Apple", nil, "/logos/apple.png", true},
+		{"non-http logo URL", "Apple", nil, "ftp://example.com/apple.png", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			brand, err := NewBrand(tt.brandName, tt.aliases, tt.logoURL)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, BrandStatusActive, brand.Status)
+		})
+	}
+}
+
+func TestBrand_Aliases(t *testing.T) {
+	// Given
+	brand, err := NewBrand(" Hewlett-Packard ", []string{"HP", "hewlett-packard", " hp ", "Hewlett  Packard"}, "")
+	require.NoError(t, err)
+
+	// Then
+	assert.Equal(t, "Hewlett-Packard", brand.Name)
+	assert.Equal(t, "hewlett-packard", brand.Slug)
+	assert.Equal(t, []string{"HP", "Hewlett Packard"}, brand.Aliases)
+	assert.Equal(t, []string{"Hewlett-Packard", "HP", "Hewlett Packard"}, brand.Names())
+
+	// Renaming to an alias drops that alias
+	require.NoError(t, brand.Rename("HP"))
+	assert.Equal(t, []string{"Hewlett Packard"}, brand.Aliases)
+}
+
+func TestBrand_Absorb(t *testing.T) {
+	// Given
+	target, _ := NewBrand("Hewlett-Packard", []string{"HP"}, "")
+	source, _ := NewBrand("HP Inc.", []string{"hp", "HPI"}, "")
+
+	// When
+	target.Absorb(source)
+
+	// Then
+	assert.Equal(t, []string{"HP", "HP Inc.", "HPI"}, target.Aliases)
+}
+
+func TestBrand_SetStatus(t *testing.T) {
+	brand, _ := NewBrand("Apple", nil, "")
+
+	require.NoError(t, brand.SetStatus(BrandStatusInactive))
+	assert.False(t, brand.IsActive())
+	assert.Error(t, brand.SetStatus("retired"))
+}
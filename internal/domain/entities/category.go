@@ -0,0 +1,240 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	maxCategoryNameLength = 100
+	// maxCategoryDepth bounds the tree so paths stay short; roots have depth 0
+	maxCategoryDepth = 7
+
+	categoryPathSeparator = "/"
+)
+
+// Category is a node in the category tree. Path is the materialized path of
+// slugs from the root, e.g. "electronics/phones", so a subtree is every
+// category whose path is Path or starts with Path + "/".
+type Category struct {
+	ID        uint      `json:"id"`
+	ParentID  *uint     `json:"parent_id,omitempty"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+	Path      string    `json:"path"`
+	Depth     int       `json:"depth"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// SKUPattern, when set, is a regular expression the SKUs of new products
+	// in the category must match; SKUTemplate generates the SKUs of new
+	// products that come without one. See SetSKURules.
+	SKUPattern  string `json:"sku_pattern,omitempty"`
+	SKUTemplate string `json:"sku_template,omitempty"`
+}
+
+// NewCategory creates a category under parent, or a root category when
+// parent is nil
+func NewCategory(name string, parent *Category) (*Category, error) {
+	name, slug, err := categoryNameAndSlug(name)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	category := &Category{
+		Name:      name,
+		Slug:      slug,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := category.place(parent); err != nil {
+		return nil, err
+	}
+	return category, nil
+}
+
+// Rename changes the category's name and, with it, its slug and path. The
+// paths of its descendants change accordingly.
+func (c *Category) Rename(name string) error {
+	name, slug, err := categoryNameAndSlug(name)
+	if err != nil {
+		return err
+	}
+
+	c.Name = name
+	c.Slug = slug
+	c.Path = joinCategoryPath(c.ParentPath(), slug)
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+// MoveTo moves the category under parent, or to the root when parent is nil.
+// A category cannot move under itself or one of its descendants.
+func (c *Category) MoveTo(parent *Category, subtreeHeight int) error {
+	if parent != nil && (parent.ID == c.ID || parent.IsDescendantOf(c)) {
+		return errors.New("a category cannot be moved under itself or one of its descendants")
+	}
+	if parent != nil {
+		if err := parent.CheckRoomBelow(1 + subtreeHeight); err != nil {
+			return err
+		}
+	}
+
+	if err := c.place(parent); err != nil {
+		return err
+	}
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetSKURules sets the pattern new product SKUs must match and the template
+// generating them; empty strings remove either rule
+func (c *Category) SetSKURules(pattern, template string) error {
+	pattern, template = strings.TrimSpace(pattern), strings.TrimSpace(template)
+	if pattern != "" {
+		if _, err := CompileSKUPattern(pattern); err != nil {
+			return err
+		}
+	}
+	if template != "" {
+		if _, err := ParseSKUTemplate(template); err != nil {
+			return err
+		}
+	}
+
+	c.SKUPattern = pattern
+	c.SKUTemplate = template
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+// CheckSKU fails when sku does not match the category's SKU pattern
+func (c *Category) CheckSKU(sku string) error {
+	if c.SKUPattern == "" {
+		return nil
+	}
+	pattern, err := CompileSKUPattern(c.SKUPattern)
+	if err != nil {
+		return err
+	}
+	if !pattern.MatchString(sku) {
+		return fmt.Errorf("SKU %s does not match the pattern %s of category %s", sku, c.SKUPattern, c.Path)
+	}
+	return nil
+}
+
+// CheckRoomBelow fails when levels more levels below the category would
+// exceed the maximum depth
+func (c *Category) CheckRoomBelow(levels int) error {
+	if c.Depth+levels > maxCategoryDepth {
+		return fmt.Errorf("categories can be at most %d levels deep", maxCategoryDepth+1)
+	}
+	return nil
+}
+
+// ParentPath is the path of the category's parent, empty for roots
+func (c *Category) ParentPath() string {
+	index := strings.LastIndex(c.Path, categoryPathSeparator)
+	if index < 0 {
+		return ""
+	}
+	return c.Path[:index]
+}
+
+// IsDescendantOf reports whether the category sits anywhere below other
+func (c *Category) IsDescendantOf(other *Category) bool {
+	return strings.HasPrefix(c.Path, other.Path+categoryPathSeparator)
+}
+
+// ChildPath is the path a child with the given slug would have
+func (c *Category) ChildPath(slug string) string {
+	return joinCategoryPath(c.Path, slug)
+}
+
+// SubtreeHeight returns how many levels below root the deepest of the given
+// descendants sits
+func SubtreeHeight(root *Category, descendants []*Category) int {
+	height := 0
+	for _, descendant := range descendants {
+		if descendant.Depth-root.Depth > height {
+			height = descendant.Depth - root.Depth
+		}
+	}
+	return height
+}
+
+// Slugify lowercases s and replaces every run of characters other than a-z
+// and 0-9 with a single hyphen, e.g. "Mobile Phones" becomes "mobile-phones"
+func Slugify(s string) string {
+	var builder strings.Builder
+	pendingHyphen := false
+	for _, r := range strings.ToLower(s) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if pendingHyphen && builder.Len() > 0 {
+				builder.WriteByte('-')
+			}
+			pendingHyphen = false
+			builder.WriteRune(r)
+			continue
+		}
+		pendingHyphen = true
+	}
+	return builder.String()
+}
+
+// CategoryPath normalizes a reference such as "Electronics / Mobile Phones"
+// into the materialized path "electronics/mobile-phones"
+func CategoryPath(reference string) string {
+	segments := make([]string, 0, 4)
+	for _, segment := range strings.Split(reference, categoryPathSeparator) {
+		if slug := Slugify(segment); slug != "" {
+			segments = append(segments, slug)
+		}
+	}
+	return strings.Join(segments, categoryPathSeparator)
+}
+
+// place sets the parent, path and depth of the category for the given parent
+func (c *Category) place(parent *Category) error {
+	if parent == nil {
+		c.ParentID = nil
+		c.Path = c.Slug
+		c.Depth = 0
+		return nil
+	}
+
+	if err := parent.CheckRoomBelow(1); err != nil {
+		return err
+	}
+	parentID := parent.ID
+	c.ParentID = &parentID
+	c.Path = parent.ChildPath(c.Slug)
+	c.Depth = parent.Depth + 1
+	return nil
+}
+
+func categoryNameAndSlug(name string) (string, string, error) {
+	name = strings.TrimSpace(name)
+	if len(name) < 2 || len(name) > maxCategoryNameLength {
+		return "", "", fmt.Errorf("category name must be between 2 and %d characters", maxCategoryNameLength)
+	}
+	if strings.Contains(name, categoryPathSeparator) {
+		return "", "", fmt.Errorf("category name cannot contain %q", categoryPathSeparator)
+	}
+
+	slug := Slugify(name)
+	if slug == "" {
+		return "", "", errors.New("category name must contain letters or digits")
+	}
+	return name, slug, nil
+}
+
+func joinCategoryPath(parentPath, slug string) string {
+	if parentPath == "" {
+		return slug
+	}
+	return parentPath + categoryPathSeparator + slug
+}
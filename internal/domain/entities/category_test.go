@@ -0,0 +1,122 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"Electronics", "electronics"},
+		{"Mobile Phones", "mobile-phones"},
+		{"  TV & Audio  ", "tv-audio"},
+		{"USB-C -- Cables", "usb-c-cables"},
+		{"!!!", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Slugify(tt.input))
+		})
+	}
+}
+
+func TestCategoryPath(t *testing.T) {
+	assert.Equal(t, "electronics/mobile-phones", CategoryPath("Electronics / Mobile Phones"))
+	assert.Equal(t, "electronics", CategoryPath("/Electronics/"))
+	assert.Equal(t, "", CategoryPath(" / "))
+}
+
+func TestNewCategory(t *testing.T) {
+	// Given
+	root, err := NewCategory("Electronics", nil)
+	require.NoError(t, err)
+	root.ID = 1
+
+	// When
+	child, err := NewCategory(" Mobile Phones ", root)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "Mobile Phones", child.Name)
+	assert.Equal(t, "mobile-phones", child.Slug)
+	assert.Equal(t, "electronics/mobile-phones", child.Path)
+	assert.Equal(t, 1, child.Depth)
+	require.NotNil(t, child.ParentID)
+	assert.Equal(t, uint(1), *child.ParentID)
+	assert.True(t, child.IsDescendantOf(root))
+	assert.Equal(t, "electronics", child.ParentPath())
+
+	_, err = NewCategory("Audio/Video", nil)
+	assert.Error(t, err)
+	_, err = NewCategory("--", nil)
+	assert.Error(t, err)
+}
+
+func TestCategory_Rename(t *testing.T) {
+	// Given
+	root, _ := NewCategory("Electronics", nil)
+	child, _ := NewCategory("Phones", root)
+
+	// When
+	err := child.Rename("Smart Phones")
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "electronics/smart-phones", child.Path)
+	assert.Equal(t, 1, child.Depth)
+}
+
+func TestCategory_MoveTo(t *testing.T) {
+	electronics, _ := NewCategory("Electronics", nil)
+	electronics.ID = 1
+	phones, _ := NewCategory("Phones", electronics)
+	phones.ID = 2
+	gadgets, _ := NewCategory("Gadgets", nil)
+	gadgets.ID = 3
+
+	t.Run("under another parent", func(t *testing.T) {
+		moved := *phones
+		require.NoError(t, moved.MoveTo(gadgets, 0))
+		assert.Equal(t, "gadgets/phones", moved.Path)
+		assert.Equal(t, uint(3), *moved.ParentID)
+	})
+
+	t.Run("to the root", func(t *testing.T) {
+		moved := *phones
+		require.NoError(t, moved.MoveTo(nil, 0))
+		assert.Equal(t, "phones", moved.Path)
+		assert.Nil(t, moved.ParentID)
+		assert.Equal(t, 0, moved.Depth)
+	})
+
+	t.Run("under itself", func(t *testing.T) {
+		moved := *electronics
+		assert.Error(t, moved.MoveTo(electronics, 0))
+	})
+
+	t.Run("under a descendant", func(t *testing.T) {
+		moved := *electronics
+		assert.Error(t, moved.MoveTo(phones, 1))
+	})
+
+	t.Run("too deep", func(t *testing.T) {
+		moved := *gadgets
+		assert.Error(t, moved.MoveTo(phones, maxCategoryDepth-1))
+	})
+}
+
+func TestSubtreeHeight(t *testing.T) {
+	root, _ := NewCategory("Electronics", nil)
+	child, _ := NewCategory("Phones", root)
+	grandchild, _ := NewCategory("Cases", child)
+
+	assert.Equal(t, 0, SubtreeHeight(root, []*Category{root}))
+	assert.Equal(t, 2, SubtreeHeight(root, []*Category{root, child, grandchild}))
+	assert.Equal(t, 1, SubtreeHeight(child, []*Category{child, grandchild}))
+}
@@ -0,0 +1,322 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	maxCollectionNameLength        = 100
+	maxCollectionDescriptionLength = 1000
+	maxCollectionRules             = 10
+	maxCollectionProducts          = 1000
+)
+
+// CollectionType says how a collection's products are chosen
+type CollectionType string
+
+const (
+	// CollectionTypeManual holds hand-picked products in a curated order
+	CollectionTypeManual CollectionType = "manual"
+	// CollectionTypeRule holds every product matching all of its rules
+	CollectionTypeRule CollectionType = "rule"
+)
+
+// CollectionRuleField is the product property a rule tests
+type CollectionRuleField string
+
+const (
+	RuleFieldCategory CollectionRuleField = "category"
+	RuleFieldBrand    CollectionRuleField = "brand"
+	RuleFieldTag      CollectionRuleField = "tag"
+	RuleFieldPrice    CollectionRuleField = "price"
+	RuleFieldInStock  CollectionRuleField = "in_stock"
+	RuleFieldStatus   CollectionRuleField = "status"
+)
+
+// CollectionRuleOperator compares a product property with a rule's value
+type CollectionRuleOperator string
+
+const (
+	RuleEquals      CollectionRuleOperator = "eq"
+	RuleLess        CollectionRuleOperator = "lt"
+	RuleLessOrEqual CollectionRuleOperator = "lte"
+	RuleMore        CollectionRuleOperator = "gt"
+	RuleMoreOrEqual CollectionRuleOperator = "gte"
+)
+
+// CollectionRule is one membership condition, e.g. price lt "50.00".
+// Category takes a path or a name and includes the category's descendants;
+// price takes an amount optionally followed by a currency, "50.00 EUR",
+// defaulting to USD; only price accepts operators other than eq.
+type CollectionRule struct {
+	Field    CollectionRuleField    `json:"field"`
+	Operator CollectionRuleOperator `json:"operator"`
+	Value    string                 `json:"value"`
+}
+
+// Collection is a merchandising grouping such as "new-arrivals". Manual
+// collections list ProductIDs in display order; rule collections match
+// products against all of their Rules whenever they are read.
+type Collection struct {
+	ID          uint             `json:"id"`
+	Name        string           `json:"name"`
+	Slug        string           `json:"slug"`
+	Description string           `json:"description"`
+	Type        CollectionType   `json:"type"`
+	Rules       []CollectionRule `json:"rules,omitempty"`
+	ProductIDs  []uint           `json:"product_ids,omitempty"`
+	CreatedAt   time.Time        `json:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at"`
+}
+
+// CollectionFilter is the product search a rule collection stands for
+type CollectionFilter struct {
+	Category string
+	Brand    string
+	Tags     []string
+	MinPrice *Money
+	MaxPrice *Money
+	InStock  *bool
+	Status   *ProductStatus
+}
+
+// NewCollection creates an empty manual collection or a rule collection
+func NewCollection(name, description string, collectionType CollectionType, rules []CollectionRule) (*Collection, error) {
+	if collectionType != CollectionTypeManual && collectionType != CollectionTypeRule {
+		return nil, fmt.Errorf("collection type must be %s or %s", CollectionTypeManual, CollectionTypeRule)
+	}
+
+	now := time.Now()
+	collection := &Collection{
+		Type:       collectionType,
+		ProductIDs: []uint{},
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if err := collection.Rename(name); err != nil {
+		return nil, err
+	}
+	if err := collection.SetDescription(description); err != nil {
+		return nil, err
+	}
+	if collectionType == CollectionTypeRule {
+		if err := collection.SetRules(rules); err != nil {
+			return nil, err
+		}
+	} else if len(rules) > 0 {
+		return nil, errors.New("manual collections cannot have rules")
+	}
+	return collection, nil
+}
+
+// Rename changes the collection's name and slug
+func (c *Collection) Rename(name string) error {
+	name = strings.TrimSpace(name)
+	if len(name) < 2 || len(name) > maxCollectionNameLength {
+		return fmt.Errorf("collection name must be between 2 and %d characters", maxCollectionNameLength)
+	}
+	slug := Slugify(name)
+	if slug == "" {
+		return errors.New("collection name must contain letters or digits")
+	}
+
+	c.Name = name
+	c.Slug = slug
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetDescription changes the collection's description
+func (c *Collection) SetDescription(description string) error {
+	description = strings.TrimSpace(description)
+	if len(description) > maxCollectionDescriptionLength {
+		return fmt.Errorf("collection description must be at most %d characters", maxCollectionDescriptionLength)
+	}
+
+	c.Description = description
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetRules replaces the rules of a rule collection. The rules must describe
+// a single search: one category, brand, status and in_stock at most, and at
+// most one lower and one upper price bound in a single currency.
+func (c *Collection) SetRules(rules []CollectionRule) error {
+	if c.Type != CollectionTypeRule {
+		return errors.New("only rule collections have rules")
+	}
+	if len(rules) == 0 {
+		return errors.New("a rule collection needs at least one rule")
+	}
+	if len(rules) > maxCollectionRules {
+		return fmt.Errorf("a collection can have at most %d rules", maxCollectionRules)
+	}
+
+	normalized := make([]CollectionRule, 0, len(rules))
+	for _, rule := range rules {
+		rule.Value = strings.TrimSpace(rule.Value)
+		if rule.Operator == "" {
+			rule.Operator = RuleEquals
+		}
+		normalized = append(normalized, rule)
+	}
+	if _, err := buildCollectionFilter(normalized); err != nil {
+		return err
+	}
+
+	c.Rules = normalized
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetProducts replaces the products of a manual collection, in display
+// order. Repeated IDs keep their first position.
+func (c *Collection) SetProducts(productIDs []uint) error {
+	if c.Type != CollectionTypeManual {
+		return errors.New("the products of a rule collection follow from its rules")
+	}
+
+	ordered := make([]uint, 0, len(productIDs))
+	for _, id := range productIDs {
+		if !slices.Contains(ordered, id) {
+			ordered = append(ordered, id)
+		}
+	}
+	if len(ordered) > maxCollectionProducts {
+		return fmt.Errorf("a collection can hold at most %d products", maxCollectionProducts)
+	}
+
+	c.ProductIDs = ordered
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+// Filter translates the rules of a rule collection into a product search
+func (c *Collection) Filter() (*CollectionFilter, error) {
+	if c.Type != CollectionTypeRule {
+		return nil, errors.New("only rule collections have a filter")
+	}
+	return buildCollectionFilter(c.Rules)
+}
+
+func buildCollectionFilter(rules []CollectionRule) (*CollectionFilter, error) {
+	filter := &CollectionFilter{}
+	for _, rule := range rules {
+		if rule.Value == "" {
+			return nil, fmt.Errorf("the %s rule needs a value", rule.Field)
+		}
+		if rule.Field != RuleFieldPrice && rule.Operator != RuleEquals {
+			return nil, fmt.Errorf("the %s rule only supports %s", rule.Field, RuleEquals)
+		}
+
+		var err error
+		switch rule.Field {
+		case RuleFieldCategory:
+			err = setOnce(&filter.Category, rule)
+		case RuleFieldBrand:
+			err = setOnce(&filter.Brand, rule)
+		case RuleFieldTag:
+			var tag string
+			tag, err = NormalizeTag(rule.Value)
+			if err == nil && !slices.Contains(filter.Tags, tag) {
+				filter.Tags = append(filter.Tags, tag)
+			}
+		case RuleFieldPrice:
+			err = filter.addPriceBound(rule)
+		case RuleFieldInStock:
+			var inStock bool
+			inStock, err = strconv.ParseBool(rule.Value)
+			if err != nil {
+				err = errors.New("the in_stock rule takes true or false")
+			} else if filter.InStock != nil {
+				err = errors.New("only one in_stock rule is allowed")
+			}
+			filter.InStock = &inStock
+		case RuleFieldStatus:
+			status := ProductStatus(rule.Value)
+			switch {
+			case !IsValidProductStatus(status):
+				err = fmt.Errorf("unknown product status %q", rule.Value)
+			case filter.Status != nil:
+				err = errors.New("only one status rule is allowed")
+			}
+			filter.Status = &status
+		default:
+			err = fmt.Errorf("unknown rule field %q", rule.Field)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return filter, nil
+}
+
+func setOnce(target *string, rule CollectionRule) error {
+	if *target != "" {
+		return fmt.Errorf("only one %s rule is allowed", rule.Field)
+	}
+	*target = rule.Value
+	return nil
+}
+
+// addPriceBound turns a price comparison into an inclusive bound. Prices
+// are whole minor units, so price < 50.00 is price <= 49.99.
+func (f *CollectionFilter) addPriceBound(rule CollectionRule) error {
+	amount, currency, _ := strings.Cut(rule.Value, " ")
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" {
+		currency = "USD"
+	}
+	price, err := ParseMoney(amount, currency)
+	if err != nil {
+		return fmt.Errorf("invalid price rule value %q: %w", rule.Value, err)
+	}
+	for _, bound := range []*Money{f.MinPrice, f.MaxPrice} {
+		if bound != nil && bound.Currency() != price.Currency() {
+			return errors.New("price rules must use a single currency")
+		}
+	}
+
+	var lower, upper *Money
+	switch rule.Operator {
+	case RuleEquals:
+		lower, upper = &price, &price
+	case RuleMoreOrEqual:
+		lower = &price
+	case RuleMore:
+		above, err := NewMoney(price.MinorUnits()+1, price.Currency())
+		if err != nil {
+			return err
+		}
+		lower = &above
+	case RuleLessOrEqual:
+		upper = &price
+	case RuleLess:
+		below, err := NewMoney(price.MinorUnits()-1, price.Currency())
+		if err != nil {
+			return err
+		}
+		upper = &below
+	default:
+		return fmt.Errorf("unknown rule operator %q", rule.Operator)
+	}
+
+	if lower != nil {
+		if f.MinPrice != nil {
+			return errors.New("only one lower price bound is allowed")
+		}
+		f.MinPrice = lower
+	}
+	if upper != nil {
+		if f.MaxPrice != nil {
+			return errors.New("only one upper price bound is allowed")
+		}
+		f.MaxPrice = upper
+	}
+	return nil
+}
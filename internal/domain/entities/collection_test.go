@@ -0,0 +1,69 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	tags, err := NormalizeTags([]string{" Summer Sale ", "summer-sale", "Eco"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"summer-sale", "eco"}, tags)
+
+	_, err = NormalizeTags([]string{"!!!"})
+	assert.Error(t, err, "tags without letters or digits are rejected")
+}
+
+func TestCollection_Filter(t *testing.T) {
+	// Given
+	collection, err := NewCollection("Cheap Shoes", "", CollectionTypeRule, []CollectionRule{
+		{Field: RuleFieldCategory, Value: "shoes"},
+		{Field: RuleFieldPrice, Operator: RuleLess, Value: "50.00"},
+		{Field: RuleFieldTag, Value: "Summer Sale"},
+		{Field: RuleFieldInStock, Value: "true"},
+	})
+	require.NoError(t, err)
+
+	// When
+	filter, err := collection.Filter()
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "cheap-shoes", collection.Slug)
+	assert.Equal(t, "shoes", filter.Category)
+	assert.Nil(t, filter.MinPrice)
+	assert.Equal(t, MustParseMoney("49.99", "USD"), *filter.MaxPrice)
+	assert.Equal(t, []string{"summer-sale"}, filter.Tags)
+	assert.True(t, *filter.InStock)
+}
+
+func TestCollection_SetRules_Invalid(t *testing.T) {
+	collection, err := NewCollection("Deals", "", CollectionTypeRule, []CollectionRule{{Field: RuleFieldTag, Value: "deal"}})
+	require.NoError(t, err)
+
+	assert.Error(t, collection.SetRules(nil), "rule collections need a rule")
+	assert.Error(t, collection.SetRules([]CollectionRule{
+		{Field: RuleFieldBrand, Value: "Acme"},
+		{Field: RuleFieldBrand, Value: "Globex"},
+	}), "only one brand rule")
+	assert.Error(t, collection.SetRules([]CollectionRule{
+		{Field: RuleFieldPrice, Operator: RuleMore, Value: "10.00 EUR"},
+		{Field: RuleFieldPrice, Operator: RuleLess, Value: "50.00 USD"},
+	}), "price rules share a currency")
+	assert.Error(t, collection.SetRules([]CollectionRule{{Field: RuleFieldTag, Operator: RuleLess, Value: "deal"}}), "only price compares")
+	assert.Error(t, collection.SetProducts([]uint{1}), "rule collections have no manual products")
+}
+
+func TestCollection_SetProducts(t *testing.T) {
+	collection, err := NewCollection("Staff Picks", "", CollectionTypeManual, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, collection.SetProducts([]uint{3, 1, 3, 2}))
+	assert.Equal(t, []uint{3, 1, 2}, collection.ProductIDs)
+
+	_, err = NewCollection("Staff Picks", "", CollectionTypeManual, []CollectionRule{{Field: RuleFieldTag, Value: "x"}})
+	assert.Error(t, err, "manual collections cannot have rules")
+}
@@ -0,0 +1,158 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// FeedFormat is the file format of a product feed
+type FeedFormat string
+
+const (
+	// FeedGoogleRSS is a Google Merchant Center RSS 2.0 feed
+	FeedGoogleRSS FeedFormat = "google-rss"
+	// FeedGoogleTSV is a Google Merchant Center tab-separated feed
+	FeedGoogleTSV FeedFormat = "google-tsv"
+	// FeedXML is a plain XML feed for other marketplaces and partners
+	FeedXML FeedFormat = "xml"
+)
+
+// FeedFormats lists every feed format, in the order they are generated
+var FeedFormats = []FeedFormat{FeedGoogleRSS, FeedGoogleTSV, FeedXML}
+
+// IsValid reports whether f is a supported feed format
+func (f FeedFormat) IsValid() bool {
+	return f == FeedGoogleRSS || f == FeedGoogleTSV || f == FeedXML
+}
+
+// FileName is the name of the feed file
+func (f FeedFormat) FileName() string {
+	switch f {
+	case FeedGoogleRSS:
+		return "google.xml"
+	case FeedGoogleTSV:
+		return "google.tsv"
+	default:
+		return "products.xml"
+	}
+}
+
+// Attributes feeds read identifiers and condition from
+const (
+	FeedAttributeGTIN      = "gtin"
+	FeedAttributeMPN       = "mpn"
+	FeedAttributeCondition = "condition"
+)
+
+// Availability and condition values, as Google Merchant Center spells them
+const (
+	FeedInStock    = "in_stock"
+	FeedOutOfStock = "out_of_stock"
+
+	FeedConditionNew         = "new"
+	FeedConditionRefurbished = "refurbished"
+	FeedConditionUsed        = "used"
+)
+
+// Google Merchant Center truncates longer titles and descriptions
+const (
+	maxFeedTitle       = 150
+	maxFeedDescription = 5000
+)
+
+// FeedItem is a product as product feeds list it
+type FeedItem struct {
+	ID           string
+	Title        string
+	Description  string
+	Link         string
+	ImageLink    string
+	Availability string
+	Price        Money
+	Brand        string
+	GTIN         string
+	MPN          string
+	Condition    string
+	ProductType  string
+}
+
+// NewFeedItem maps a product to its feed item. The SKU identifies the item,
+// the category is its product type, and MPN and condition come from the mpn
+// and condition attributes; condition defaults to new. The GTIN is the
+// product's own, or the gtin attribute of products without one.
+func NewFeedItem(product *Product, link, imageLink string) FeedItem {
+	description := product.Description
+	if strings.TrimSpace(description) == "" {
+		description = product.Name
+	}
+
+	availability := FeedOutOfStock
+	if product.IsAvailable() {
+		availability = FeedInStock
+	}
+
+	gtin := product.GTIN
+	if gtin == "" {
+		gtin = feedAttribute(product, FeedAttributeGTIN)
+	}
+
+	condition := strings.ToLower(feedAttribute(product, FeedAttributeCondition))
+	switch condition {
+	case FeedConditionNew, FeedConditionRefurbished, FeedConditionUsed:
+	default:
+		condition = FeedConditionNew
+	}
+
+	return FeedItem{
+		ID:           product.SKU,
+		Title:        truncateRunes(product.Name, maxFeedTitle),
+		Description:  truncateRunes(description, maxFeedDescription),
+		Link:         link,
+		ImageLink:    imageLink,
+		Availability: availability,
+		Price:        product.Price,
+		Brand:        product.Brand,
+		GTIN:         gtin,
+		MPN:          feedAttribute(product, FeedAttributeMPN),
+		Condition:    condition,
+		ProductType:  product.Category,
+	}
+}
+
+// FeedPrice formats the price the way Google Merchant Center reads it,
+// e.g. "19.99 USD"
+func (i FeedItem) FeedPrice() string {
+	return i.Price.Decimal() + " " + i.Price.Currency()
+}
+
+// IdentifierExists reports whether the item has a GTIN or a brand and MPN,
+// the unique product identifiers Google Merchant Center asks for
+func (i FeedItem) IdentifierExists() bool {
+	return i.GTIN != "" || (i.Brand != "" && i.MPN != "")
+}
+
+func feedAttribute(product *Product, key string) string {
+	value, ok := product.Attributes[key]
+	if !ok || value == nil {
+		return ""
+	}
+	return strings.TrimSpace(fmt.Sprint(value))
+}
+
+func truncateRunes(text string, limit int) string {
+	if runes := []rune(text); len(runes) > limit {
+		return string(runes[:limit])
+	}
+	return text
+}
+
+// Feed describes a generated feed file. Fingerprint identifies the state of
+// the catalog it was generated from, so it is only generated again once
+// products change.
+type Feed struct {
+	Format      FeedFormat `json:"format"`
+	Items       int        `json:"items"`
+	Fingerprint string     `json:"fingerprint"`
+	GeneratedAt time.Time  `json:"generated_at"`
+}
@@ -0,0 +1,62 @@
+package entities
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFeedItem(t *testing.T) {
+	price, err := ParseMoney("19.99", "EUR")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		product *Product
+		check   func(t *testing.T, item FeedItem)
+	}{
+		{
+			name: "available product with identifiers",
+			product: &Product{SKU: "CAM-001", Name: "Camera", Description: "Mirrorless camera", Price: price,
+				Brand: "Acme", Category: "Electronics", Stock: 3, Status: ProductStatusActive,
+				Attributes: Attributes{"gtin": "4006381333931", "condition": "Refurbished"}},
+			check: func(t *testing.T, item FeedItem) {
+				assert.Equal(t, "CAM-001", item.ID)
+				assert.Equal(t, FeedInStock, item.Availability)
+				assert.Equal(t, "19.99 EUR", item.FeedPrice())
+				assert.Equal(t, "4006381333931", item.GTIN)
+				assert.Equal(t, FeedConditionRefurbished, item.Condition)
+				assert.Equal(t, "Electronics", item.ProductType)
+				assert.True(t, item.IdentifierExists())
+			},
+		},
+		{
+			name:    "out of stock product without identifiers",
+			product: &Product{SKU: "CAM-002", Name: "Camera", Price: price, Status: ProductStatusActive},
+			check: func(t *testing.T, item FeedItem) {
+				assert.Equal(t, FeedOutOfStock, item.Availability)
+				assert.Equal(t, "Camera", item.Description)
+				assert.Equal(t, FeedConditionNew, item.Condition)
+				assert.False(t, item.IdentifierExists())
+			},
+		},
+		{
+			name: "unknown condition and long title",
+			product: &Product{SKU: "CAM-003", Name: strings.Repeat("é", 200), Price: price, Brand: "Acme",
+				Attributes: Attributes{"condition": "vintage", "mpn": "X100"}},
+			check: func(t *testing.T, item FeedItem) {
+				assert.Equal(t, FeedConditionNew, item.Condition)
+				assert.Len(t, []rune(item.Title), 150)
+				assert.True(t, item.IdentifierExists())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.check(t, NewFeedItem(tt.product, "https://shop.example.com/p/1", ""))
+		})
+	}
+}
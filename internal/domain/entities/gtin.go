@@ -0,0 +1,64 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// gtinKeyLength is the length of GTIN-14, the form every GTIN is compared in
+const gtinKeyLength = 14
+
+// NormalizeGTIN validates a GTIN-8, GTIN-12 (UPC-A), GTIN-13 (EAN-13) or
+// GTIN-14 barcode and returns its digits. Spaces and hyphens, as printed
+// under barcodes, are dropped; the last digit must be the GS1 check digit.
+func NormalizeGTIN(code string) (string, error) {
+	digits := strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' {
+			return -1
+		}
+		return r
+	}, code)
+
+	if digits == "" {
+		return "", errors.New("GTIN is required")
+	}
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return "", errors.New("GTIN can only contain digits")
+		}
+	}
+	switch len(digits) {
+	case 8, 12, 13, 14:
+	default:
+		return "", fmt.Errorf("GTIN must have 8, 12, 13 or 14 digits, got %d", len(digits))
+	}
+
+	if check := gtinCheckDigit(digits[:len(digits)-1]); digits[len(digits)-1] != check {
+		return "", fmt.Errorf("GTIN check digit must be %c", check)
+	}
+	return digits, nil
+}
+
+// GTINKey is the GTIN-14 form of a normalized GTIN. The same product is
+// 12 digits as a UPC-A and 13 as an EAN-13, so GTINs are compared by key.
+func GTINKey(gtin string) string {
+	if len(gtin) >= gtinKeyLength {
+		return gtin
+	}
+	return strings.Repeat("0", gtinKeyLength-len(gtin)) + gtin
+}
+
+// gtinCheckDigit computes the GS1 check digit of the digits before it:
+// weights alternate 3 and 1 from the rightmost digit
+func gtinCheckDigit(digits string) byte {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		digit := int(digits[i] - '0')
+		if (len(digits)-1-i)%2 == 0 {
+			digit *= 3
+		}
+		sum += digit
+	}
+	return byte('0' + (10-sum%10)%10)
+}
@@ -0,0 +1,58 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeGTIN(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    string
+		want    string
+		wantErr bool
+	}{
+		{name: "GTIN-8", code: "96385074", want: "96385074"},
+		{name: "UPC-A", code: "036000291452", want: "036000291452"},
+		{name: "EAN-13", code: "4006381333931", want: "4006381333931"},
+		{name: "GTIN-14", code: "00012345600012", want: "00012345600012"},
+		{name: "printed with spaces and hyphens", code: " 4 006381-333931 ", want: "4006381333931"},
+		{name: "wrong check digit", code: "4006381333932", wantErr: true},
+		{name: "unsupported length", code: "12345678901", wantErr: true},
+		{name: "letters", code: "40063813339A1", wantErr: true},
+		{name: "empty", code: " ", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeGTIN(tt.code)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGTINKey(t *testing.T) {
+	assert.Equal(t, "00036000291452", GTINKey("036000291452"))
+	assert.Equal(t, GTINKey("036000291452"), GTINKey("0036000291452"), "a UPC-A and its EAN-13 form are the same GTIN")
+	assert.Equal(t, "00012345600012", GTINKey("00012345600012"))
+}
+
+func TestProduct_SetGTIN(t *testing.T) {
+	product := &Product{}
+
+	require.NoError(t, product.SetGTIN("4006381-333931"))
+	assert.Equal(t, "4006381333931", product.GTIN)
+
+	assert.Error(t, product.SetGTIN("4006381333932"))
+	assert.Equal(t, "4006381333931", product.GTIN, "an invalid GTIN leaves the product's as it was")
+
+	require.NoError(t, product.SetGTIN(""))
+	assert.Empty(t, product.GTIN)
+}
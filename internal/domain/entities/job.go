@@ -0,0 +1,191 @@
+package entities
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// JobType names the work a background job does
+type JobType string
+
+const (
+	// JobProductExport writes a catalog export to a file kept with the job
+	JobProductExport JobType = "product_export"
+	// JobProductReprice changes the price of every product matching a filter
+	JobProductReprice JobType = "product_reprice"
+	// JobFeedGenerate regenerates the merchant feeds
+	JobFeedGenerate JobType = "feed_generate"
+	// JobProductImport reads the spooled file of a queued product import
+	JobProductImport JobType = "product_import"
+)
+
+// IsValid reports whether t is a known job type
+func (t JobType) IsValid() bool {
+	return t == JobProductExport || t == JobProductReprice || t == JobFeedGenerate || t == JobProductImport
+}
+
+type JobStatus string
+
+const (
+	// JobQueued waits for a worker, possibly until RunAt for a retry
+	JobQueued JobStatus = "queued"
+	// JobRunning is held by a worker until its lease expires
+	JobRunning JobStatus = "running"
+	// JobSucceeded finished; Result holds what it produced
+	JobSucceeded JobStatus = "succeeded"
+	// JobFailed used up its attempts
+	JobFailed JobStatus = "failed"
+	// JobCancelled was cancelled before it finished
+	JobCancelled JobStatus = "cancelled"
+)
+
+// IsValid reports whether s is a known job status
+func (s JobStatus) IsValid() bool {
+	switch s {
+	case JobQueued, JobRunning, JobSucceeded, JobFailed, JobCancelled:
+		return true
+	}
+	return false
+}
+
+// Job is a unit of long-running work run by a worker outside the request
+// that queued it. Payload holds the job's parameters and Result what it
+// produced, both as JSON whose shape depends on Type.
+type Job struct {
+	ID       uint            `json:"id"`
+	Type     JobType         `json:"type"`
+	Status   JobStatus       `json:"status"`
+	Payload  json.RawMessage `json:"payload,omitempty"`
+	Result   json.RawMessage `json:"result,omitempty"`
+	Error    string          `json:"error,omitempty"`
+	Progress int64           `json:"progress"`
+	// Total is the amount of work Progress counts towards, zero when unknown
+	Total int64 `json:"total"`
+
+	Attempts    int `json:"attempts"`
+	MaxAttempts int `json:"max_attempts"`
+	// CancelRequested asks the worker running the job to stop it
+	CancelRequested bool `json:"cancel_requested"`
+
+	// Worker holds a running job until LeaseExpiresAt; it renews the lease
+	// while the job runs, so an expired lease means the worker is gone
+	Worker         string     `json:"worker,omitempty"`
+	LeaseExpiresAt *time.Time `json:"-"`
+	RunAt          time.Time  `json:"run_at"`
+
+	Actor      string     `json:"actor,omitempty"`
+	RequestID  string     `json:"request_id,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+// NewJob queues a job of jobType with payload encoded as JSON. maxAttempts
+// below one allows a single attempt.
+func NewJob(jobType JobType, payload any, maxAttempts int, actor, requestID string, now time.Time) (*Job, error) {
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	return &Job{
+		Type:        jobType,
+		Status:      JobQueued,
+		Payload:     encoded,
+		MaxAttempts: maxAttempts,
+		RunAt:       now,
+		Actor:       actor,
+		RequestID:   requestID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+// IsFinished reports whether the job reached a final status
+func (j *Job) IsFinished() bool {
+	return j.Status == JobSucceeded || j.Status == JobFailed || j.Status == JobCancelled
+}
+
+// CanRetry reports whether a failed attempt leaves attempts to retry with
+func (j *Job) CanRetry() bool {
+	return j.Attempts < j.MaxAttempts
+}
+
+// Start hands the job to worker for an attempt, held until leaseExpiresAt
+func (j *Job) Start(worker string, leaseExpiresAt, now time.Time) {
+	j.Status = JobRunning
+	j.Attempts++
+	j.Worker = worker
+	j.LeaseExpiresAt = &leaseExpiresAt
+	j.Error = ""
+	if j.StartedAt == nil {
+		j.StartedAt = &now
+	}
+	j.UpdatedAt = now
+}
+
+// ReportProgress records that done of total units of work are finished
+func (j *Job) ReportProgress(done, total int64) {
+	j.Progress = done
+	j.Total = total
+}
+
+// Succeed finishes the job with result encoded as JSON
+func (j *Job) Succeed(result any, now time.Time) error {
+	encoded, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	j.Result = encoded
+	j.finish(JobSucceeded, now)
+	return nil
+}
+
+// Fail records a failed attempt. The job is queued again after backoff
+// while it has attempts left; otherwise it fails for good.
+func (j *Job) Fail(reason string, backoff time.Duration, now time.Time) {
+	j.Error = reason
+	if j.CanRetry() {
+		j.Status = JobQueued
+		j.RunAt = now.Add(backoff)
+		j.release(now)
+		return
+	}
+	j.finish(JobFailed, now)
+}
+
+// Cancel cancels a queued job right away and asks the worker of a running
+// one to stop it. It reports false for jobs that already finished.
+func (j *Job) Cancel(now time.Time) bool {
+	switch {
+	case j.IsFinished():
+		return false
+	case j.Status == JobQueued:
+		j.finish(JobCancelled, now)
+	default:
+		j.CancelRequested = true
+		j.UpdatedAt = now
+	}
+	return true
+}
+
+// MarkCancelled finishes a running job its worker stopped on request
+func (j *Job) MarkCancelled(now time.Time) {
+	j.finish(JobCancelled, now)
+}
+
+func (j *Job) finish(status JobStatus, now time.Time) {
+	j.Status = status
+	j.FinishedAt = &now
+	j.release(now)
+}
+
+func (j *Job) release(now time.Time) {
+	j.Worker = ""
+	j.LeaseExpiresAt = nil
+	j.UpdatedAt = now
+}
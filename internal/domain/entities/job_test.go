@@ -0,0 +1,92 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewJob(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	job, err := NewJob(JobFeedGenerate, map[string]bool{"force": true}, 0, "ops@example.com", "req-1", now)
+
+	require.NoError(t, err)
+	assert.Equal(t, JobQueued, job.Status)
+	assert.JSONEq(t, `{"force":true}`, string(job.Payload))
+	assert.Equal(t, 1, job.MaxAttempts)
+	assert.Equal(t, now, job.RunAt)
+}
+
+func TestJob_Lifecycle(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	lease := now.Add(time.Minute)
+
+	t.Run("failed attempts are retried until none is left", func(t *testing.T) {
+		job, err := NewJob(JobProductExport, nil, 2, "", "", now)
+		require.NoError(t, err)
+
+		job.Start("worker-1", lease, now)
+		job.Fail("connection reset", 30*time.Second, now)
+		assert.Equal(t, JobQueued, job.Status)
+		assert.Equal(t, now.Add(30*time.Second), job.RunAt)
+		assert.Empty(t, job.Worker)
+		assert.Nil(t, job.LeaseExpiresAt)
+
+		job.Start("worker-2", lease, now.Add(time.Minute))
+		assert.Equal(t, 2, job.Attempts)
+		assert.Equal(t, now, *job.StartedAt)
+		assert.Empty(t, job.Error)
+		job.Fail("connection reset", 30*time.Second, now)
+		assert.Equal(t, JobFailed, job.Status)
+		assert.Equal(t, "connection reset", job.Error)
+		assert.True(t, job.IsFinished())
+	})
+
+	t.Run("single attempt jobs are not retried", func(t *testing.T) {
+		job, err := NewJob(JobProductReprice, nil, 1, "", "", now)
+		require.NoError(t, err)
+
+		job.Start("worker-1", lease, now)
+		job.Fail("connection reset", time.Minute, now)
+
+		assert.Equal(t, JobFailed, job.Status)
+		assert.Equal(t, now, *job.FinishedAt)
+	})
+
+	t.Run("success keeps the result", func(t *testing.T) {
+		job, err := NewJob(JobProductExport, nil, 1, "", "", now)
+		require.NoError(t, err)
+
+		job.Start("worker-1", lease, now)
+		job.ReportProgress(40, 40)
+		require.NoError(t, job.Succeed(map[string]int{"rows": 40}, now))
+
+		assert.Equal(t, JobSucceeded, job.Status)
+		assert.JSONEq(t, `{"rows":40}`, string(job.Result))
+		assert.Equal(t, int64(40), job.Progress)
+		assert.Equal(t, now, *job.FinishedAt)
+	})
+}
+
+func TestJob_Cancel(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	queued, err := NewJob(JobProductReprice, nil, 1, "", "", now)
+	require.NoError(t, err)
+	assert.True(t, queued.Cancel(now))
+	assert.Equal(t, JobCancelled, queued.Status)
+	assert.False(t, queued.Cancel(now))
+
+	running, err := NewJob(JobProductReprice, nil, 1, "", "", now)
+	require.NoError(t, err)
+	running.Start("worker-1", now.Add(time.Minute), now)
+	assert.True(t, running.Cancel(now))
+	assert.Equal(t, JobRunning, running.Status)
+	assert.True(t, running.CancelRequested)
+
+	running.MarkCancelled(now)
+	assert.Equal(t, JobCancelled, running.Status)
+}
@@ -0,0 +1,103 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+const maxTransitionReasonLength = 500
+
+// productTransitions lists the statuses each status can move to:
+//
+//	draft → active ⇄ inactive → discontinued → archived
+//
+// Active products can be discontinued directly; archived is final.
+var productTransitions = map[ProductStatus][]ProductStatus{
+	ProductStatusDraft:        {ProductStatusActive},
+	ProductStatusActive:       {ProductStatusInactive, ProductStatusDiscontinued},
+	ProductStatusInactive:     {ProductStatusActive, ProductStatusDiscontinued},
+	ProductStatusDiscontinued: {ProductStatusArchived},
+	ProductStatusArchived:     {},
+}
+
+// StatusTransition records one move of a product through its lifecycle
+type StatusTransition struct {
+	ID        uint          `json:"id"`
+	ProductID uint          `json:"product_id"`
+	From      ProductStatus `json:"from"`
+	To        ProductStatus `json:"to"`
+	Reason    string        `json:"reason,omitempty"`
+	Actor     string        `json:"actor,omitempty"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+// IsValidProductStatus reports whether status is a lifecycle status
+func IsValidProductStatus(status ProductStatus) bool {
+	_, ok := productTransitions[status]
+	return ok
+}
+
+// AllowedTransitions returns the statuses a product in status can move to
+func AllowedTransitions(status ProductStatus) []ProductStatus {
+	return slices.Clone(productTransitions[status])
+}
+
+// CanTransitionTo reports whether the lifecycle allows moving the product
+// to status, regardless of its guard conditions
+func (p *Product) CanTransitionTo(status ProductStatus) bool {
+	return slices.Contains(productTransitions[p.Status], status)
+}
+
+// TransitionTo moves the product to status after checking the lifecycle and
+// the guard conditions of the target status, and returns the history entry
+func (p *Product) TransitionTo(status ProductStatus, reason, actor string) (*StatusTransition, error) {
+	if !p.CanTransitionTo(status) {
+		return nil, fmt.Errorf("cannot move a product from %s to %s", p.Status, status)
+	}
+	reason = strings.TrimSpace(reason)
+	if len(reason) > maxTransitionReasonLength {
+		return nil, fmt.Errorf("reason must be at most %d characters", maxTransitionReasonLength)
+	}
+	if err := p.checkTransitionGuards(status); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	transition := &StatusTransition{
+		ProductID: p.ID,
+		From:      p.Status,
+		To:        status,
+		Reason:    reason,
+		Actor:     actor,
+		CreatedAt: now,
+	}
+	p.Status = status
+	p.UpdatedAt = now
+	return transition, nil
+}
+
+// checkTransitionGuards checks what a product needs before entering status:
+// a sellable product needs a name, a valid price and a category, and only
+// products without stock left can be archived
+func (p *Product) checkTransitionGuards(status ProductStatus) error {
+	switch status {
+	case ProductStatusActive:
+		if err := validateProductName(p.Name); err != nil {
+			return fmt.Errorf("cannot activate: %w", err)
+		}
+		if err := validatePrice(p.Price); err != nil {
+			return fmt.Errorf("cannot activate: %w", err)
+		}
+		if p.CategoryID == 0 && strings.TrimSpace(p.Category) == "" {
+			return errors.New("cannot activate: category is required")
+		}
+	case ProductStatusArchived:
+		if p.Stock > 0 {
+			return fmt.Errorf("cannot archive: %d units are still in stock", p.Stock)
+		}
+	}
+	return nil
+}
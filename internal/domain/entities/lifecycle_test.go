@@ -0,0 +1,66 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProduct_TransitionTo(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    ProductStatus
+		to      ProductStatus
+		wantErr bool
+	}{
+		{"draft to active", ProductStatusDraft, ProductStatusActive, false},
+		{"active to inactive", ProductStatusActive, ProductStatusInactive, false},
+		{"inactive to active", ProductStatusInactive, ProductStatusActive, false},
+		{"inactive to discontinued", ProductStatusInactive, ProductStatusDiscontinued, false},
+		{"discontinued to archived", ProductStatusDiscontinued, ProductStatusArchived, false},
+		{"draft to inactive", ProductStatusDraft, ProductStatusInactive, true},
+		{"discontinued back to active", ProductStatusDiscontinued, ProductStatusActive, true},
+		{"active to archived", ProductStatusActive, ProductStatusArchived, true},
+		{"archived is final", ProductStatusArchived, ProductStatusActive, true},
+		{"same status", ProductStatusActive, ProductStatusActive, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			product := &Product{ID: 7, Name: "Laptop", Price: MustParseMoney("10.00", "USD"), Category: "Electronics", Status: tt.from}
+
+			transition, err := product.TransitionTo(tt.to, " restock ", "bob")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.from, product.Status)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.to, product.Status)
+			assert.Equal(t, &StatusTransition{ProductID: 7, From: tt.from, To: tt.to, Reason: "restock", Actor: "bob", CreatedAt: transition.CreatedAt}, transition)
+		})
+	}
+}
+
+func TestProduct_TransitionTo_Guards(t *testing.T) {
+	noPrice := &Product{Name: "Laptop", Category: "Electronics", Status: ProductStatusDraft}
+	_, err := noPrice.TransitionTo(ProductStatusActive, "", "")
+	assert.ErrorContains(t, err, "price is required")
+
+	noCategory := &Product{Name: "Laptop", Price: MustParseMoney("10.00", "USD"), Status: ProductStatusDraft}
+	_, err = noCategory.TransitionTo(ProductStatusActive, "", "")
+	assert.ErrorContains(t, err, "category is required")
+
+	stocked := &Product{Stock: 3, Status: ProductStatusDiscontinued}
+	_, err = stocked.TransitionTo(ProductStatusArchived, "", "")
+	assert.ErrorContains(t, err, "3 units are still in stock")
+}
+
+func TestAllowedTransitions(t *testing.T) {
+	assert.Equal(t, []ProductStatus{ProductStatusActive, ProductStatusDiscontinued}, AllowedTransitions(ProductStatusInactive))
+	assert.Empty(t, AllowedTransitions(ProductStatusArchived))
+	assert.True(t, IsValidProductStatus(ProductStatusDraft))
+	assert.False(t, IsValidProductStatus("deleted"))
+}
@@ -0,0 +1,171 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	maxMediaAltTextLength  = 255
+	maxMediaFileNameLength = 255
+)
+
+// MediaKind distinguishes images, which can have thumbnails and be a
+// product's primary image, from documents such as manuals
+type MediaKind string
+
+const (
+	MediaKindImage    MediaKind = "image"
+	MediaKindDocument MediaKind = "document"
+)
+
+// mediaKinds lists the accepted content types, as sniffed from the uploaded
+// bytes rather than taken from the client
+var mediaKinds = map[string]MediaKind{
+	"image/jpeg":      MediaKindImage,
+	"image/png":       MediaKindImage,
+	"image/gif":       MediaKindImage,
+	"image/webp":      MediaKindImage,
+	"application/pdf": MediaKindDocument,
+}
+
+// mediaExtensions is the file extension stored objects get per content type
+var mediaExtensions = map[string]string{
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"image/gif":       ".gif",
+	"image/webp":      ".webp",
+	"application/pdf": ".pdf",
+}
+
+// ProductMedia is an image or document attached to a product. StorageKey and
+// ThumbnailKey locate the stored objects; Position orders a product's media.
+type ProductMedia struct {
+	ID           uint      `json:"id"`
+	ProductID    uint      `json:"product_id"`
+	Kind         MediaKind `json:"kind"`
+	ContentType  string    `json:"content_type"`
+	FileName     string    `json:"file_name"`
+	Size         int64     `json:"size"`
+	Width        int       `json:"width,omitempty"`
+	Height       int       `json:"height,omitempty"`
+	StorageKey   string    `json:"storage_key"`
+	ThumbnailKey string    `json:"thumbnail_key,omitempty"`
+	AltText      string    `json:"alt_text"`
+	Position     int       `json:"position"`
+	Primary      bool      `json:"primary"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// NewProductMedia describes an upload whose content type was sniffed from
+// its bytes. Unsupported content types are rejected.
+func NewProductMedia(productID uint, fileName, contentType string, size int64, altText string) (*ProductMedia, error) {
+	kind, ok := mediaKinds[contentType]
+	if !ok {
+		return nil, fmt.Errorf("content type %s is not supported", contentType)
+	}
+
+	fileName = path.Base(strings.ReplaceAll(strings.TrimSpace(fileName), "\\", "/"))
+	if fileName == "." || fileName == "/" {
+		fileName = "upload" + mediaExtensions[contentType]
+	}
+	if len(fileName) > maxMediaFileNameLength {
+		return nil, fmt.Errorf("file name must be at most %d characters", maxMediaFileNameLength)
+	}
+
+	now := time.Now()
+	media := &ProductMedia{
+		ProductID:   productID,
+		Kind:        kind,
+		ContentType: contentType,
+		FileName:    fileName,
+		Size:        size,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := media.SetAltText(altText); err != nil {
+		return nil, err
+	}
+	return media, nil
+}
+
+// SupportedMediaType reports whether media of contentType can be uploaded
+func SupportedMediaType(contentType string) bool {
+	_, ok := mediaKinds[contentType]
+	return ok
+}
+
+// IsImage reports whether the media is an image
+func (m *ProductMedia) IsImage() bool {
+	return m.Kind == MediaKindImage
+}
+
+// Extension is the file extension for the media's content type
+func (m *ProductMedia) Extension() string {
+	return mediaExtensions[m.ContentType]
+}
+
+// SetAltText sets the text describing the media for screen readers
+func (m *ProductMedia) SetAltText(altText string) error {
+	altText = strings.TrimSpace(altText)
+	if len(altText) > maxMediaAltTextLength {
+		return fmt.Errorf("alt text must be at most %d characters", maxMediaAltTextLength)
+	}
+
+	m.AltText = altText
+	m.UpdatedAt = time.Now()
+	return nil
+}
+
+// MakePrimary marks the media as the product's primary image. Only images
+// can be primary.
+func (m *ProductMedia) MakePrimary() error {
+	if !m.IsImage() {
+		return errors.New("only images can be the primary media")
+	}
+
+	m.Primary = true
+	m.UpdatedAt = time.Now()
+	return nil
+}
+
+// PrimaryMedia returns the product's primary image, or nil if it has none
+func PrimaryMedia(media []*ProductMedia) *ProductMedia {
+	for _, item := range media {
+		if item.Primary {
+			return item
+		}
+	}
+	return nil
+}
+
+// ReorderMedia assigns positions following ids, which must list every one
+// of the product's media exactly once
+func ReorderMedia(media []*ProductMedia, ids []uint) error {
+	if len(ids) != len(media) {
+		return fmt.Errorf("the order must list all %d media of the product", len(media))
+	}
+
+	byID := make(map[uint]*ProductMedia, len(media))
+	for _, item := range media {
+		byID[item.ID] = item
+	}
+
+	seen := make(map[uint]bool, len(ids))
+	for position, id := range ids {
+		item, ok := byID[id]
+		if !ok {
+			return fmt.Errorf("media %d does not belong to the product", id)
+		}
+		if seen[id] {
+			return fmt.Errorf("media %d is listed twice", id)
+		}
+		seen[id] = true
+		item.Position = position
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProductMedia(t *testing.T) {
+	// Given a client-supplied path
+	media, err := NewProductMedia(1, `C:\Users\me\front.jpg`, "image/jpeg", 1024, " Front ")
+
+	// Then only the base name is kept
+	require.NoError(t, err)
+	assert.Equal(t, "front.jpg", media.FileName)
+	assert.Equal(t, MediaKindImage, media.Kind)
+	assert.Equal(t, "Front", media.AltText)
+	assert.Equal(t, ".jpg", media.Extension())
+
+	_, err = NewProductMedia(1, "page.html", "text/html", 10, "")
+	assert.Error(t, err)
+}
+
+func TestProductMedia_MakePrimary(t *testing.T) {
+	image, _ := NewProductMedia(1, "front.png", "image/png", 10, "")
+	manual, _ := NewProductMedia(1, "manual.pdf", "application/pdf", 10, "")
+
+	require.NoError(t, image.MakePrimary())
+	assert.True(t, image.Primary)
+	assert.Error(t, manual.MakePrimary(), "documents cannot be the primary image")
+	assert.Equal(t, image, PrimaryMedia([]*ProductMedia{manual, image}))
+}
+
+func TestReorderMedia(t *testing.T) {
+	media := []*ProductMedia{{ID: 1}, {ID: 2}, {ID: 3}}
+
+	require.NoError(t, ReorderMedia(media, []uint{3, 1, 2}))
+	assert.Equal(t, []int{1, 2, 0}, []int{media[0].Position, media[1].Position, media[2].Position})
+
+	assert.Error(t, ReorderMedia(media, []uint{1, 2}), "every media must be listed")
+	assert.Error(t, ReorderMedia(media, []uint{1, 1, 2}), "duplicates are rejected")
+	assert.Error(t, ReorderMedia(media, []uint{1, 2, 9}), "foreign media are rejected")
+}
@@ -0,0 +1,270 @@
+package entities
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// DefaultCurrency is assumed when a client sends a bare numeric price
+const DefaultCurrency = "USD"
+
+// currencyExponents lists the supported ISO 4217 currencies and the number of
+// digits in their minor unit
+var currencyExponents = map[string]int{
+	"USD": 2,
+	"EUR": 2,
+	"GBP": 2,
+	"COP": 2,
+	"MXN": 2,
+	"BRL": 2,
+	"CAD": 2,
+	"JPY": 0,
+	"CLP": 0,
+	"KWD": 3,
+}
+
+// IsSupportedCurrency reports whether code is a supported ISO 4217 currency
+func IsSupportedCurrency(code string) bool {
+	_, ok := currencyExponents[strings.ToUpper(strings.TrimSpace(code))]
+	return ok
+}
+
+// Money is an exact monetary amount stored as an integer number of minor units
+// (e.g. cents) of a currency. The zero value has no currency and is only
+// useful as "not set".
+type Money struct {
+	amount   int64
+	currency string
+}
+
+// NewMoney creates a Money from minor units
+func NewMoney(minorUnits int64, currency string) (Money, error) {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if _, ok := currencyExponents[currency]; !ok {
+		return Money{}, fmt.Errorf("unsupported currency %q", currency)
+	}
+	return Money{amount: minorUnits, currency: currency}, nil
+}
+
+// decimalAmount is the only amount syntax ParseMoney accepts; big.Rat on its
+// own would also take fractions, exponents and hexadecimal
+var decimalAmount = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+// ParseMoney parses a decimal amount such as "19.99" in the given currency.
+// Amounts with more decimals than the currency allows are rejected rather
+// than rounded.
+func ParseMoney(amount, currency string) (Money, error) {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	exponent, ok := currencyExponents[currency]
+	if !ok {
+		return Money{}, fmt.Errorf("unsupported currency %q", currency)
+	}
+
+	trimmed := strings.TrimSpace(amount)
+	if !decimalAmount.MatchString(trimmed) {
+		return Money{}, fmt.Errorf("invalid amount %q", amount)
+	}
+	rat, ok := new(big.Rat).SetString(trimmed)
+	if !ok {
+		return Money{}, fmt.Errorf("invalid amount %q", amount)
+	}
+
+	minor := new(big.Rat).Mul(rat, new(big.Rat).SetInt(pow10(exponent)))
+	if !minor.IsInt() {
+		return Money{}, fmt.Errorf("amount %q has more than %d decimal places for %s", amount, exponent, currency)
+	}
+	if !minor.Num().IsInt64() {
+		return Money{}, fmt.Errorf("amount %q is out of range", amount)
+	}
+
+	return Money{amount: minor.Num().Int64(), currency: currency}, nil
+}
+
+// MustParseMoney is like ParseMoney but panics on error. Intended for
+// constants and tests.
+func MustParseMoney(amount, currency string) Money {
+	money, err := ParseMoney(amount, currency)
+	if err != nil {
+		panic(err)
+	}
+	return money
+}
+
+// MinorUnits returns the amount in minor units (e.g. cents)
+func (m Money) MinorUnits() int64 {
+	return m.amount
+}
+
+// Currency returns the ISO 4217 currency code
+func (m Money) Currency() string {
+	return m.currency
+}
+
+// Exponent returns the number of minor-unit digits of the currency
+func (m Money) Exponent() int {
+	return currencyExponents[m.currency]
+}
+
+// IsZero reports whether the value was never set
+func (m Money) IsZero() bool {
+	return m.currency == ""
+}
+
+// IsNegative reports whether the amount is below zero
+func (m Money) IsNegative() bool {
+	return m.amount < 0
+}
+
+// Equal reports whether both amount and currency match
+func (m Money) Equal(other Money) bool {
+	return m.amount == other.amount && m.currency == other.currency
+}
+
+// Cmp compares two amounts of the same currency, returning -1, 0 or +1
+func (m Money) Cmp(other Money) (int, error) {
+	if m.currency != other.currency {
+		return 0, fmt.Errorf("cannot compare %s with %s", m.currency, other.currency)
+	}
+	switch {
+	case m.amount < other.amount:
+		return -1, nil
+	case m.amount > other.amount:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+// Add returns m + other; both must share a currency
+func (m Money) Add(other Money) (Money, error) {
+	if m.currency != other.currency {
+		return Money{}, fmt.Errorf("cannot add %s to %s", other.currency, m.currency)
+	}
+	if (other.amount > 0 && m.amount > math.MaxInt64-other.amount) ||
+		(other.amount < 0 && m.amount < math.MinInt64-other.amount) {
+		return Money{}, errors.New("money amount overflow")
+	}
+	return Money{amount: m.amount + other.amount, currency: m.currency}, nil
+}
+
+// Sub returns m - other; both must share a currency
+func (m Money) Sub(other Money) (Money, error) {
+	return m.Add(Money{amount: -other.amount, currency: other.currency})
+}
+
+// Decimal returns the amount as an exact decimal string, e.g. "19.99"
+func (m Money) Decimal() string {
+	exponent := m.Exponent()
+	rat := new(big.Rat).SetFrac(big.NewInt(m.amount), pow10(exponent))
+	return rat.FloatString(exponent)
+}
+
+// String returns the amount followed by the currency, e.g. "19.99 USD"
+func (m Money) String() string {
+	if m.IsZero() {
+		return ""
+	}
+	return m.Decimal() + " " + m.currency
+}
+
+// Convert multiplies the amount by rate and expresses the result in currency,
+// rounding half away from zero to the target currency's minor unit
+func (m Money) Convert(currency string, rate *big.Rat) (Money, error) {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	exponent, ok := currencyExponents[currency]
+	if !ok {
+		return Money{}, fmt.Errorf("unsupported currency %q", currency)
+	}
+	if rate == nil || rate.Sign() <= 0 {
+		return Money{}, errors.New("exchange rate must be positive")
+	}
+
+	major := new(big.Rat).SetFrac(big.NewInt(m.amount), pow10(m.Exponent()))
+	minor := major.Mul(major, rate)
+	minor.Mul(minor, new(big.Rat).SetInt(pow10(exponent)))
+
+	// round half away from zero: trunc(x + sign(x)/2)
+	half := big.NewRat(int64(minor.Sign()), 2)
+	minor.Add(minor, half)
+	rounded := new(big.Int).Quo(minor.Num(), minor.Denom())
+	if !rounded.IsInt64() {
+		return Money{}, errors.New("money amount overflow")
+	}
+
+	return Money{amount: rounded.Int64(), currency: currency}, nil
+}
+
+// MarshalJSON encodes Money as {"amount":"19.99","currency":"USD"}. The
+// amount is a string so no client parses it through a float.
+func (m Money) MarshalJSON() ([]byte, error) {
+	if m.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(struct {
+		Amount   string `json:"amount"`
+		Currency string `json:"currency"`
+	}{m.Decimal(), m.currency})
+}
+
+// UnmarshalJSON accepts the object form as well as the legacy bare number
+// (19.99) or string ("19.99") sent by older clients, which are read in
+// DefaultCurrency. Numbers are parsed from their literal text, never through
+// float64.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*m = Money{}
+		return nil
+	}
+
+	var parsed Money
+	var err error
+	switch data[0] {
+	case '{':
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		var wire struct {
+			Amount   interface{} `json:"amount"`
+			Currency string      `json:"currency"`
+		}
+		if err := decoder.Decode(&wire); err != nil {
+			return err
+		}
+		currency := wire.Currency
+		if currency == "" {
+			currency = DefaultCurrency
+		}
+		switch amount := wire.Amount.(type) {
+		case json.Number:
+			parsed, err = ParseMoney(amount.String(), currency)
+		case string:
+			parsed, err = ParseMoney(amount, currency)
+		default:
+			return errors.New("money amount must be a number or a decimal string")
+		}
+	case '"':
+		var amount string
+		if err := json.Unmarshal(data, &amount); err != nil {
+			return err
+		}
+		parsed, err = ParseMoney(amount, DefaultCurrency)
+	default:
+		parsed, err = ParseMoney(string(data), DefaultCurrency)
+	}
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+	return nil
+}
+
+func pow10(exponent int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exponent)), nil)
+}
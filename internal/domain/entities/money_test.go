@@ -0,0 +1,125 @@
+package entities
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		name          string
+		amount        string
+		currency      string
+		expectedMinor int64
+		expectError   bool
+	}{
+		{"two decimals", "19.99", "USD", 1999, false},
+		{"whole amount", "20", "usd", 2000, false},
+		{"trailing zeros beyond precision", "19.9900", "USD", 1999, false},
+		{"negative amount", "-5.50", "EUR", -550, false},
+		{"zero-decimal currency", "1500", "JPY", 1500, false},
+		{"three-decimal currency", "1.234", "KWD", 1234, false},
+		{"excess precision", "19.999", "USD", 0, true},
+		{"fraction on zero-decimal currency", "10.5", "JPY", 0, true},
+		{"unsupported currency", "10", "XYZ", 0, true},
+		{"not a number", "ten", "USD", 0, true},
+		{"fraction", "1/2", "USD", 0, true},
+		{"hexadecimal", "0x10", "USD", 0, true},
+		{"exponent", "1e2", "USD", 0, true},
+		{"upper-case exponent", "1E2", "USD", 0, true},
+		{"leading plus", "+5", "USD", 0, true},
+		{"missing integer part", ".5", "USD", 0, true},
+		{"trailing point", "5.", "USD", 0, true},
+		{"empty", "", "USD", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			money, err := ParseMoney(tt.amount, tt.currency)
+
+			// Then
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedMinor, money.MinorUnits())
+		})
+	}
+}
+
+func TestMoney_Decimal(t *testing.T) {
+	assert.Equal(t, "19.99", MustParseMoney("19.99", "USD").Decimal())
+	assert.Equal(t, "0.05", MustParseMoney("0.05", "USD").Decimal())
+	assert.Equal(t, "-1.50", MustParseMoney("-1.5", "USD").Decimal())
+	assert.Equal(t, "1500", MustParseMoney("1500", "JPY").Decimal())
+	assert.Equal(t, "19.99 USD", MustParseMoney("19.99", "USD").String())
+}
+
+func TestMoney_Arithmetic(t *testing.T) {
+	// Given
+	a := MustParseMoney("10.10", "USD")
+	b := MustParseMoney("0.20", "USD")
+
+	// When
+	sum, err := a.Add(b)
+	require.NoError(t, err)
+	diff, err := a.Sub(b)
+	require.NoError(t, err)
+
+	// Then
+	assert.Equal(t, "10.30", sum.Decimal())
+	assert.Equal(t, "9.90", diff.Decimal())
+
+	_, err = a.Add(MustParseMoney("1", "EUR"))
+	assert.Error(t, err)
+}
+
+func TestMoney_MarshalJSON(t *testing.T) {
+	data, err := json.Marshal(MustParseMoney("999.99", "USD"))
+
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"amount":"999.99","currency":"USD"}`, string(data))
+}
+
+func TestMoney_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    Money
+		expectError bool
+	}{
+		{"object with string amount", `{"amount":"19.99","currency":"EUR"}`, MustParseMoney("19.99", "EUR"), false},
+		{"object with numeric amount", `{"amount":19.99,"currency":"EUR"}`, MustParseMoney("19.99", "EUR"), false},
+		{"object without currency", `{"amount":"19.99"}`, MustParseMoney("19.99", DefaultCurrency), false},
+		{"legacy bare number", `19.99`, MustParseMoney("19.99", DefaultCurrency), false},
+		{"legacy string", `"19.99"`, MustParseMoney("19.99", DefaultCurrency), false},
+		{"null", `null`, Money{}, false},
+		{"excess precision", `19.999`, Money{}, true},
+		{"unsupported currency", `{"amount":"1","currency":"XYZ"}`, Money{}, true},
+		{"boolean amount", `{"amount":true}`, Money{}, true},
+		{"fraction string amount", `{"amount":"1/2","currency":"EUR"}`, Money{}, true},
+		{"hexadecimal legacy string", `"0x10"`, Money{}, true},
+		{"exponent amount", `{"amount":1e2}`, Money{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			var money Money
+			err := json.Unmarshal([]byte(tt.input), &money)
+
+			// Then
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, money)
+		})
+	}
+}
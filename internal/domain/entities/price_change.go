@@ -0,0 +1,183 @@
+package entities
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type PriceChangeStatus string
+
+const (
+	// PriceChangeScheduled waits for its effective_from time
+	PriceChangeScheduled PriceChangeStatus = "scheduled"
+	// PriceChangeApplied is, or was, the product's price
+	PriceChangeApplied PriceChangeStatus = "applied"
+	// PriceChangeReverted had an effective_to window that has ended
+	PriceChangeReverted PriceChangeStatus = "reverted"
+	// PriceChangeCancelled was withdrawn before it took effect
+	PriceChangeCancelled PriceChangeStatus = "cancelled"
+	// PriceChangeExpired reached the end of its window before it could be applied
+	PriceChangeExpired PriceChangeStatus = "expired"
+	// PriceChangeFailed could not be applied or reverted; the scheduler gave
+	// up on it so it does not hold back later changes
+	PriceChangeFailed PriceChangeStatus = "failed"
+)
+
+// SchedulerActor is recorded as the actor of changes made by the price scheduler
+const SchedulerActor = "system:price-scheduler"
+
+// maxPriceChangeReasonLength matches the reason column size
+const maxPriceChangeReasonLength = 500
+
+// PriceChange is one entry of a product's price history: an applied change,
+// or a change scheduled for a future window
+type PriceChange struct {
+	ID            uint              `json:"id"`
+	ProductID     uint              `json:"product_id"`
+	OldPrice      Money             `json:"old_price"`
+	NewPrice      Money             `json:"new_price"`
+	Reason        string            `json:"reason"`
+	Actor         string            `json:"actor"`
+	EffectiveFrom time.Time         `json:"effective_from"`
+	EffectiveTo   *time.Time        `json:"effective_to,omitempty"`
+	Status        PriceChangeStatus `json:"status"`
+	AppliedAt     *time.Time        `json:"applied_at,omitempty"`
+	EndedAt       *time.Time        `json:"ended_at,omitempty"`
+	CreatedAt     time.Time         `json:"created_at"`
+}
+
+// ChangePrice sets the product's price right away and returns the history
+// entry describing the change
+func ChangePrice(product *Product, newPrice Money, reason, actor string, now time.Time) (*PriceChange, error) {
+	reason, err := validatePriceChangeReason(reason)
+	if err != nil {
+		return nil, err
+	}
+
+	oldPrice := product.Price
+	if err := product.UpdatePrice(newPrice); err != nil {
+		return nil, err
+	}
+
+	return &PriceChange{
+		ProductID:     product.ID,
+		OldPrice:      oldPrice,
+		NewPrice:      newPrice,
+		Reason:        reason,
+		Actor:         actor,
+		EffectiveFrom: now,
+		Status:        PriceChangeApplied,
+		AppliedAt:     &now,
+		CreatedAt:     now,
+	}, nil
+}
+
+// NewScheduledPriceChange creates a change that the scheduler applies at
+// effectiveFrom. When effectiveTo is set the previous price is restored then.
+func NewScheduledPriceChange(productID uint, newPrice Money, reason, actor string, effectiveFrom time.Time, effectiveTo *time.Time, now time.Time) (*PriceChange, error) {
+	if err := validatePrice(newPrice); err != nil {
+		return nil, err
+	}
+
+	reason, err := validatePriceChangeReason(reason)
+	if err != nil {
+		return nil, err
+	}
+
+	if !effectiveFrom.After(now) {
+		return nil, errors.New("effective_from must be in the future")
+	}
+	if effectiveTo != nil && !effectiveTo.After(effectiveFrom) {
+		return nil, errors.New("effective_to must be after effective_from")
+	}
+
+	return &PriceChange{
+		ProductID:     productID,
+		NewPrice:      newPrice,
+		Reason:        reason,
+		Actor:         actor,
+		EffectiveFrom: effectiveFrom,
+		EffectiveTo:   effectiveTo,
+		Status:        PriceChangeScheduled,
+		CreatedAt:     now,
+	}, nil
+}
+
+// IsDue reports whether the scheduler should act on the change at now:
+// either it is scheduled and has started, or it is applied and its window ended
+func (c *PriceChange) IsDue(now time.Time) bool {
+	switch c.Status {
+	case PriceChangeScheduled:
+		return !c.EffectiveFrom.After(now)
+	case PriceChangeApplied:
+		return c.windowEnded(now)
+	default:
+		return false
+	}
+}
+
+// IsUpcoming reports whether the change is still waiting to take effect
+func (c *PriceChange) IsUpcoming() bool {
+	return c.Status == PriceChangeScheduled
+}
+
+// Advance moves a due change forward on product. A scheduled change is
+// applied, or expired when its whole window has already passed. An applied
+// change whose window ended restores the price it replaced, unless the price
+// was changed again in the meantime; the restoration is returned as a new
+// history entry. Changes that are not due are left untouched.
+func (c *PriceChange) Advance(product *Product, now time.Time) (*PriceChange, error) {
+	if !c.IsDue(now) {
+		return nil, nil
+	}
+
+	if c.Status == PriceChangeScheduled {
+		if c.windowEnded(now) {
+			c.Status = PriceChangeExpired
+			c.EndedAt = &now
+			return nil, nil
+		}
+
+		c.OldPrice = product.Price
+		if err := product.UpdatePrice(c.NewPrice); err != nil {
+			return nil, err
+		}
+		c.Status = PriceChangeApplied
+		c.AppliedAt = &now
+		return nil, nil
+	}
+
+	c.Status = PriceChangeReverted
+	c.EndedAt = &now
+
+	if !product.Price.Equal(c.NewPrice) || c.OldPrice.IsZero() {
+		// superseded by a later change; keep the current price
+		return nil, nil
+	}
+
+	return ChangePrice(product, c.OldPrice, "price window of change #"+strconv.FormatUint(uint64(c.ID), 10)+" ended", SchedulerActor, now)
+}
+
+// Cancel withdraws a change that has not taken effect yet
+func (c *PriceChange) Cancel(now time.Time) error {
+	if c.Status != PriceChangeScheduled {
+		return errors.New("only scheduled price changes can be cancelled")
+	}
+	c.Status = PriceChangeCancelled
+	c.EndedAt = &now
+	return nil
+}
+
+func (c *PriceChange) windowEnded(now time.Time) bool {
+	return c.EffectiveTo != nil && !c.EffectiveTo.After(now)
+}
+
+func validatePriceChangeReason(reason string) (string, error) {
+	reason = strings.TrimSpace(reason)
+	if len(reason) > maxPriceChangeReasonLength {
+		return "", errors.New("reason must be less than 500 characters")
+	}
+	return reason, nil
+}
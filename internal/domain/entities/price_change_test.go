@@ -0,0 +1,129 @@
+package entities
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangePrice(t *testing.T) {
+	now := time.Now()
+	product := &Product{ID: 1, Price: MustParseMoney("100.00", "USD")}
+
+	change, err := ChangePrice(product, MustParseMoney("90.00", "USD"), "  clearance  ", "alice", now)
+
+	require.NoError(t, err)
+	assert.Equal(t, MustParseMoney("90.00", "USD"), product.Price)
+	assert.Equal(t, MustParseMoney("100.00", "USD"), change.OldPrice)
+	assert.Equal(t, MustParseMoney("90.00", "USD"), change.NewPrice)
+	assert.Equal(t, "clearance", change.Reason)
+	assert.Equal(t, "alice", change.Actor)
+	assert.Equal(t, PriceChangeApplied, change.Status)
+
+	_, err = ChangePrice(product, MustParseMoney("80.00", "USD"), strings.Repeat("x", 501), "alice", now)
+	assert.Error(t, err)
+	assert.Equal(t, MustParseMoney("90.00", "USD"), product.Price, "a rejected change leaves the price untouched")
+}
+
+func TestNewScheduledPriceChange(t *testing.T) {
+	now := time.Now()
+	from := now.Add(time.Hour)
+	to := from.Add(time.Hour)
+	before := from.Add(-time.Minute)
+
+	tests := []struct {
+		name        string
+		price       Money
+		from        time.Time
+		to          *time.Time
+		expectError bool
+	}{
+		{"open ended", MustParseMoney("10.00", "USD"), from, nil, false},
+		{"window", MustParseMoney("10.00", "USD"), from, &to, false},
+		{"starts in the past", MustParseMoney("10.00", "USD"), now.Add(-time.Hour), nil, true},
+		{"ends before it starts", MustParseMoney("10.00", "USD"), from, &before, true},
+		{"negative price", MustParseMoney("-1", "USD"), from, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			change, err := NewScheduledPriceChange(1, tt.price, "", "alice", tt.from, tt.to, now)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, PriceChangeScheduled, change.Status)
+			assert.True(t, change.IsUpcoming())
+			assert.False(t, change.IsDue(now))
+			assert.True(t, change.IsDue(tt.from))
+		})
+	}
+}
+
+func TestPriceChange_Advance_AppliesAndRestores(t *testing.T) {
+	now := time.Now()
+	from := now.Add(time.Hour)
+	to := from.Add(time.Hour)
+	product := &Product{ID: 1, Price: MustParseMoney("100.00", "USD")}
+
+	change, err := NewScheduledPriceChange(1, MustParseMoney("80.00", "USD"), "sale", "alice", from, &to, now)
+	require.NoError(t, err)
+	change.ID = 3
+
+	// Window starts
+	restored, err := change.Advance(product, from)
+	require.NoError(t, err)
+	assert.Nil(t, restored)
+	assert.Equal(t, PriceChangeApplied, change.Status)
+	assert.Equal(t, MustParseMoney("100.00", "USD"), change.OldPrice)
+	assert.Equal(t, MustParseMoney("80.00", "USD"), product.Price)
+
+	// Window ends
+	restored, err = change.Advance(product, to)
+	require.NoError(t, err)
+	require.NotNil(t, restored)
+	assert.Equal(t, PriceChangeReverted, change.Status)
+	assert.Equal(t, MustParseMoney("100.00", "USD"), product.Price)
+	assert.Equal(t, SchedulerActor, restored.Actor)
+	assert.Equal(t, MustParseMoney("80.00", "USD"), restored.OldPrice)
+	assert.Contains(t, restored.Reason, "#3")
+}
+
+func TestPriceChange_Advance_KeepsSupersedingPrice(t *testing.T) {
+	now := time.Now()
+	to := now.Add(time.Hour)
+	applied := now.Add(-time.Hour)
+	product := &Product{ID: 1, Price: MustParseMoney("70.00", "USD")}
+	change := &PriceChange{
+		ID:            3,
+		ProductID:     1,
+		OldPrice:      MustParseMoney("100.00", "USD"),
+		NewPrice:      MustParseMoney("80.00", "USD"),
+		EffectiveFrom: applied,
+		EffectiveTo:   &to,
+		Status:        PriceChangeApplied,
+		AppliedAt:     &applied,
+	}
+
+	restored, err := change.Advance(product, to)
+
+	require.NoError(t, err)
+	assert.Nil(t, restored)
+	assert.Equal(t, PriceChangeReverted, change.Status)
+	assert.Equal(t, MustParseMoney("70.00", "USD"), product.Price)
+}
+
+func TestPriceChange_Cancel(t *testing.T) {
+	now := time.Now()
+	change, err := NewScheduledPriceChange(1, MustParseMoney("80.00", "USD"), "", "alice", now.Add(time.Hour), nil, now)
+	require.NoError(t, err)
+
+	require.NoError(t, change.Cancel(now))
+	assert.Equal(t, PriceChangeCancelled, change.Status)
+	assert.NotNil(t, change.EndedAt)
+	assert.Error(t, change.Cancel(now), "a cancelled change cannot be cancelled again")
+}
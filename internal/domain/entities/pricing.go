@@ -0,0 +1,170 @@
+package entities
+
+import (
+	"errors"
+	"math/big"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// PriceSource tells where a resolved price came from
+type PriceSource string
+
+const (
+	// PriceSourceBase is the product's own price, already in the requested currency
+	PriceSourceBase PriceSource = "base"
+	// PriceSourceExplicit is a price set for the currency in a price list
+	PriceSourceExplicit PriceSource = "explicit"
+	// PriceSourceConverted is the base price converted with an exchange rate
+	PriceSourceConverted PriceSource = "converted"
+)
+
+var priceListCodePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{1,49}$`)
+
+// PriceList groups explicit per-currency prices for products, e.g. "retail"
+// or "wholesale". At most one list is the default used when a caller does not
+// name one.
+type PriceList struct {
+	ID        uint      `json:"id"`
+	Code      string    `json:"code"`
+	Name      string    `json:"name"`
+	IsDefault bool      `json:"is_default"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ProductPrice is the explicit price of a product in one currency of a price list
+type ProductPrice struct {
+	PriceListID uint      `json:"price_list_id"`
+	ProductID   uint      `json:"product_id"`
+	Price       Money     `json:"price"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// ExchangeRate converts amounts from BaseCurrency into QuoteCurrency:
+// 1 BaseCurrency = Rate QuoteCurrency
+type ExchangeRate struct {
+	BaseCurrency  string    `json:"base_currency"`
+	QuoteCurrency string    `json:"quote_currency"`
+	Rate          *big.Rat  `json:"-"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// ResolvedPrice is a product price expressed in a requested currency
+type ResolvedPrice struct {
+	Price     Money
+	Source    PriceSource
+	PriceList string
+	Rate      *ExchangeRate
+}
+
+func NewPriceList(code, name string, isDefault bool) (*PriceList, error) {
+	code = strings.ToLower(strings.TrimSpace(code))
+	if !priceListCodePattern.MatchString(code) {
+		return nil, errors.New("price list code must be 2-50 lowercase letters, digits, '-' or '_'")
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, errors.New("price list name is required")
+	}
+
+	now := time.Now()
+	return &PriceList{
+		Code:      code,
+		Name:      name,
+		IsDefault: isDefault,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+func NewProductPrice(priceListID, productID uint, price Money) (*ProductPrice, error) {
+	if err := validatePrice(price); err != nil {
+		return nil, err
+	}
+	return &ProductPrice{
+		PriceListID: priceListID,
+		ProductID:   productID,
+		Price:       price,
+		UpdatedAt:   time.Now(),
+	}, nil
+}
+
+// NewExchangeRate parses rate as an exact decimal such as "4012.55"
+func NewExchangeRate(base, quote, rate string) (*ExchangeRate, error) {
+	base = strings.ToUpper(strings.TrimSpace(base))
+	quote = strings.ToUpper(strings.TrimSpace(quote))
+	if !IsSupportedCurrency(base) {
+		return nil, errors.New("unsupported base currency " + base)
+	}
+	if !IsSupportedCurrency(quote) {
+		return nil, errors.New("unsupported quote currency " + quote)
+	}
+	if base == quote {
+		return nil, errors.New("base and quote currencies must differ")
+	}
+
+	parsed, ok := new(big.Rat).SetString(strings.TrimSpace(rate))
+	if !ok || parsed.Sign() <= 0 {
+		return nil, errors.New("exchange rate must be a positive decimal")
+	}
+
+	return &ExchangeRate{
+		BaseCurrency:  base,
+		QuoteCurrency: quote,
+		Rate:          parsed,
+		UpdatedAt:     time.Now(),
+	}, nil
+}
+
+// RateString returns the rate as a decimal with up to 10 fractional digits
+func (r *ExchangeRate) RateString() string {
+	return strings.TrimRight(strings.TrimRight(r.Rate.FloatString(10), "0"), ".")
+}
+
+// Invert returns the rate for the opposite direction
+func (r *ExchangeRate) Invert() *ExchangeRate {
+	return &ExchangeRate{
+		BaseCurrency:  r.QuoteCurrency,
+		QuoteCurrency: r.BaseCurrency,
+		Rate:          new(big.Rat).Inv(r.Rate),
+		UpdatedAt:     r.UpdatedAt,
+	}
+}
+
+// ResolvePrice picks the price of a product in currency: the base price when
+// it already matches, otherwise the explicit price list entry, otherwise the
+// base price converted with rate. explicit and rate may be nil.
+func ResolvePrice(base Money, currency string, priceList *PriceList, explicit *ProductPrice, rate *ExchangeRate) (*ResolvedPrice, error) {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if !IsSupportedCurrency(currency) {
+		return nil, errors.New("unsupported currency " + currency)
+	}
+
+	if explicit != nil && explicit.Price.Currency() == currency {
+		resolved := &ResolvedPrice{Price: explicit.Price, Source: PriceSourceExplicit}
+		if priceList != nil {
+			resolved.PriceList = priceList.Code
+		}
+		return resolved, nil
+	}
+
+	if base.Currency() == currency {
+		return &ResolvedPrice{Price: base, Source: PriceSourceBase}, nil
+	}
+
+	if rate == nil {
+		return nil, errors.New("no exchange rate from " + base.Currency() + " to " + currency)
+	}
+	if rate.BaseCurrency != base.Currency() || rate.QuoteCurrency != currency {
+		return nil, errors.New("exchange rate does not match the requested conversion")
+	}
+
+	converted, err := base.Convert(currency, rate.Rate)
+	if err != nil {
+		return nil, err
+	}
+	return &ResolvedPrice{Price: converted, Source: PriceSourceConverted, Rate: rate}, nil
+}
@@ -0,0 +1,123 @@
+package entities
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExchangeRate(t *testing.T) {
+	tests := []struct {
+		name        string
+		base        string
+		quote       string
+		rate        string
+		expectError bool
+	}{
+		{"valid rate", "usd", "cop", "4012.55", false},
+		{"same currency", "USD", "USD", "1", true},
+		{"unsupported currency", "USD", "XYZ", "1", true},
+		{"zero rate", "USD", "EUR", "0", true},
+		{"negative rate", "USD", "EUR", "-0.9", true},
+		{"not a number", "USD", "EUR", "abc", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, err := NewExchangeRate(tt.base, tt.quote, tt.rate)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "USD", rate.BaseCurrency)
+			assert.Equal(t, "COP", rate.QuoteCurrency)
+			assert.Equal(t, "4012.55", rate.RateString())
+		})
+	}
+}
+
+func TestMoney_Convert(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   Money
+		currency string
+		rate     string
+		expected Money
+	}{
+		{"usd to eur", MustParseMoney("100.00", "USD"), "EUR", "0.92", MustParseMoney("92.00", "EUR")},
+		{"rounds half up", MustParseMoney("0.05", "USD"), "EUR", "0.5", MustParseMoney("0.03", "EUR")},
+		{"usd to cop", MustParseMoney("19.99", "USD"), "COP", "4012.55", MustParseMoney("80210.87", "COP")},
+		{"to zero-decimal currency", MustParseMoney("10.00", "USD"), "JPY", "149.555", MustParseMoney("1496", "JPY")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, _ := new(big.Rat).SetString(tt.rate)
+
+			converted, err := tt.amount.Convert(tt.currency, rate)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, converted)
+		})
+	}
+}
+
+func TestResolvePrice(t *testing.T) {
+	base := MustParseMoney("100.00", "USD")
+	retail := &PriceList{ID: 1, Code: "retail"}
+	eurRate, err := NewExchangeRate("USD", "EUR", "0.9")
+	require.NoError(t, err)
+
+	t.Run("explicit price wins", func(t *testing.T) {
+		explicit := &ProductPrice{PriceListID: 1, ProductID: 7, Price: MustParseMoney("89.00", "EUR")}
+
+		resolved, err := ResolvePrice(base, "EUR", retail, explicit, eurRate)
+
+		require.NoError(t, err)
+		assert.Equal(t, PriceSourceExplicit, resolved.Source)
+		assert.Equal(t, "retail", resolved.PriceList)
+		assert.Equal(t, MustParseMoney("89.00", "EUR"), resolved.Price)
+	})
+
+	t.Run("base currency needs no conversion", func(t *testing.T) {
+		resolved, err := ResolvePrice(base, "usd", retail, nil, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, PriceSourceBase, resolved.Source)
+		assert.Equal(t, base, resolved.Price)
+	})
+
+	t.Run("converted with exchange rate", func(t *testing.T) {
+		resolved, err := ResolvePrice(base, "EUR", retail, nil, eurRate)
+
+		require.NoError(t, err)
+		assert.Equal(t, PriceSourceConverted, resolved.Source)
+		assert.Equal(t, MustParseMoney("90.00", "EUR"), resolved.Price)
+		assert.Same(t, eurRate, resolved.Rate)
+	})
+
+	t.Run("inverted rate", func(t *testing.T) {
+		eurToUSD, err := NewExchangeRate("EUR", "USD", "1.25")
+		require.NoError(t, err)
+
+		resolved, err := ResolvePrice(base, "EUR", nil, nil, eurToUSD.Invert())
+
+		require.NoError(t, err)
+		assert.Equal(t, MustParseMoney("80.00", "EUR"), resolved.Price)
+	})
+
+	t.Run("missing exchange rate", func(t *testing.T) {
+		_, err := ResolvePrice(base, "COP", retail, nil, nil)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("unsupported currency", func(t *testing.T) {
+		_, err := ResolvePrice(base, "XYZ", retail, nil, nil)
+
+		assert.Error(t, err)
+	})
+}
@@ -2,16 +2,20 @@ package entities
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 )
 
 type ProductStatus string
 
+// Product statuses; see lifecycle.go for the transitions between them
 const (
+	ProductStatusDraft        ProductStatus = "draft"
 	ProductStatusActive       ProductStatus = "active"
 	ProductStatusInactive     ProductStatus = "inactive"
 	ProductStatusDiscontinued ProductStatus = "discontinued"
+	ProductStatusArchived     ProductStatus = "archived"
 )
 
 type Product struct {
@@ -19,11 +23,16 @@ type Product struct {
 	Name        string        `json:"name"`
 	Description string        `json:"description"`
 	SKU         string        `json:"sku"`
-	Price       float64       `json:"price"`
+	GTIN        string        `json:"gtin,omitempty"`
+	Price       Money         `json:"price"`
+	CategoryID  uint          `json:"category_id"`
 	Category    string        `json:"category"`
+	BrandID     *uint         `json:"brand_id,omitempty"`
 	Brand       string        `json:"brand"`
 	Stock       int           `json:"stock"`
 	Status      ProductStatus `json:"status"`
+	Attributes  Attributes    `json:"attributes"`
+	Tags        []string      `json:"tags"`
 	CreatedAt   time.Time     `json:"created_at"`
 	UpdatedAt   time.Time     `json:"updated_at"`
 }
@@ -40,19 +49,19 @@ func (p *Product) IsAvailable() bool {
 	return p.IsActive() && p.IsInStock()
 }
 
-func (p *Product) Activate() {
-	p.Status = ProductStatusActive
-	p.UpdatedAt = time.Now()
+func (p *Product) Activate() error {
+	_, err := p.TransitionTo(ProductStatusActive, "", "")
+	return err
 }
 
-func (p *Product) Deactivate() {
-	p.Status = ProductStatusInactive
-	p.UpdatedAt = time.Now()
+func (p *Product) Deactivate() error {
+	_, err := p.TransitionTo(ProductStatusInactive, "", "")
+	return err
 }
 
-func (p *Product) Discontinue() {
-	p.Status = ProductStatusDiscontinued
-	p.UpdatedAt = time.Now()
+func (p *Product) Discontinue() error {
+	_, err := p.TransitionTo(ProductStatusDiscontinued, "", "")
+	return err
 }
 
 func (p *Product) UpdateStock(quantity int) error {
@@ -85,34 +94,101 @@ func (p *Product) AddStock(quantity int) error {
 	return nil
 }
 
-func (p *Product) UpdatePrice(price float64) error {
-	if price < 0 {
-		return errors.New("price cannot be negative")
+func (p *Product) UpdatePrice(price Money) error {
+	if err := validatePrice(price); err != nil {
+		return err
 	}
 	p.Price = price
 	p.UpdatedAt = time.Now()
 	return nil
 }
 
-func NewProduct(name, description, sku, category, brand string, price float64, stock int) (*Product, error) {
+// SetGTIN stores the product's barcode as normalized by NormalizeGTIN; an
+// empty code removes it
+func (p *Product) SetGTIN(code string) error {
+	gtin := ""
+	if strings.TrimSpace(code) != "" {
+		normalized, err := NormalizeGTIN(code)
+		if err != nil {
+			return err
+		}
+		gtin = normalized
+	}
+	p.GTIN = gtin
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
+// AssignCategory files the product under category
+func (p *Product) AssignCategory(category *Category) {
+	p.CategoryID = category.ID
+	p.Category = category.Name
+	p.UpdatedAt = time.Now()
+}
+
+// AssignBrand stores the brand under its canonical name
+func (p *Product) AssignBrand(brand *Brand) {
+	brandID := brand.ID
+	p.BrandID = &brandID
+	p.Brand = brand.Name
+	p.UpdatedAt = time.Now()
+}
+
+// SetTags replaces the product's tags with their normalized names
+func (p *Product) SetTags(tags []string) error {
+	normalized, err := NormalizeTags(tags)
+	if err != nil {
+		return err
+	}
+	if len(normalized) > maxProductTags {
+		return fmt.Errorf("a product can have at most %d tags", maxProductTags)
+	}
+	p.Tags = normalized
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetAttributes validates values against the schema of the product's
+// category and stores them normalized
+func (p *Product) SetAttributes(schema []*AttributeDefinition, values Attributes) error {
+	normalized, err := ValidateAttributes(schema, values)
+	if err != nil {
+		return err
+	}
+	p.Attributes = normalized
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
+// ProductFieldError reports which field of a new product is invalid
+type ProductFieldError struct {
+	Field   string
+	Message string
+}
+
+func (e *ProductFieldError) Error() string {
+	return e.Message
+}
+
+func NewProduct(name, description, sku, category, brand string, price Money, stock int) (*Product, error) {
 	if err := validateProductName(name); err != nil {
-		return nil, err
+		return nil, &ProductFieldError{Field: "name", Message: err.Error()}
 	}
 
-	if err := validateSKU(sku); err != nil {
-		return nil, err
+	if err := ValidateSKU(sku); err != nil {
+		return nil, &ProductFieldError{Field: "sku", Message: err.Error()}
 	}
 
 	if err := validatePrice(price); err != nil {
-		return nil, err
+		return nil, &ProductFieldError{Field: "price", Message: err.Error()}
 	}
 
 	if err := validateStock(stock); err != nil {
-		return nil, err
+		return nil, &ProductFieldError{Field: "stock", Message: err.Error()}
 	}
 
 	if strings.TrimSpace(category) == "" {
-		return nil, errors.New("category is required")
+		return nil, &ProductFieldError{Field: "category", Message: "category is required"}
 	}
 
 	now := time.Now()
@@ -145,25 +221,18 @@ func validateProductName(name string) error {
 	return nil
 }
 
-func validateSKU(sku string) error {
-	sku = strings.TrimSpace(sku)
-	if sku == "" {
-		return errors.New("SKU is required")
-	}
-	if len(sku) < 3 {
-		return errors.New("SKU must be at least 3 characters long")
-	}
-	if len(sku) > 50 {
-		return errors.New("SKU must be less than 50 characters")
-	}
-	return nil
-}
+// maxPriceMajorUnits is the exclusive upper bound for a price, in major units
+// of its currency (999,999.99 for two-decimal currencies)
+const maxPriceMajorUnits = 1_000_000
 
-func validatePrice(price float64) error {
-	if price < 0 {
+func validatePrice(price Money) error {
+	if price.IsZero() {
+		return errors.New("price is required")
+	}
+	if price.IsNegative() {
 		return errors.New("price cannot be negative")
 	}
-	if price > 999999.99 {
+	if price.MinorUnits() >= maxPriceMajorUnits*pow10(price.Exponent()).Int64() {
 		return errors.New("price cannot exceed 999,999.99")
 	}
 	return nil
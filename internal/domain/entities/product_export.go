@@ -0,0 +1,107 @@
+package entities
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ExportFormat is the file format of a catalog export
+type ExportFormat string
+
+const (
+	// ExportCSV writes a header row and one row per product
+	ExportCSV ExportFormat = "csv"
+	// ExportJSONLines writes one JSON object per product and line
+	ExportJSONLines ExportFormat = "jsonl"
+	// ExportXLSX writes a single-sheet Excel workbook
+	ExportXLSX ExportFormat = "xlsx"
+)
+
+// IsValid reports whether f is a supported export format
+func (f ExportFormat) IsValid() bool {
+	return f == ExportCSV || f == ExportJSONLines || f == ExportXLSX
+}
+
+// Columns an export can include besides the import fields. Exports use the
+// import field names, so an exported CSV imports back as is.
+const (
+	ExportColumnID        = "id"
+	ExportColumnStatus    = "status"
+	ExportColumnCreatedAt = "created_at"
+	ExportColumnUpdatedAt = "updated_at"
+)
+
+// DefaultExportColumns are exported when a request selects none. Attributes
+// are only exported when selected as "attributes.<key>".
+var DefaultExportColumns = []string{
+	ExportColumnID, ImportFieldSKU, ImportFieldGTIN, ImportFieldName, ImportFieldDescription,
+	ImportFieldPrice, ImportFieldCurrency, ImportFieldCategory, ImportFieldBrand, ImportFieldStock,
+	ExportColumnStatus, ImportFieldTags, ExportColumnCreatedAt, ExportColumnUpdatedAt,
+}
+
+// ParseExportColumns checks a column selection, returning the default
+// columns when it is empty
+func ParseExportColumns(columns []string) ([]string, error) {
+	if len(columns) == 0 {
+		return slices.Clone(DefaultExportColumns), nil
+	}
+
+	parsed := make([]string, 0, len(columns))
+	for _, column := range columns {
+		column = strings.TrimSpace(column)
+		if !isExportColumn(column) {
+			return nil, fmt.Errorf("unknown export column %q", column)
+		}
+		if slices.Contains(parsed, column) {
+			return nil, fmt.Errorf("export column %q is selected more than once", column)
+		}
+		parsed = append(parsed, column)
+	}
+	return parsed, nil
+}
+
+func isExportColumn(column string) bool {
+	return slices.Contains(DefaultExportColumns, column) || isImportField(column)
+}
+
+// ExportValue returns the value of a product in an export column: the price
+// as Money, timestamps as time.Time, tags as []string and attributes as
+// stored, or nil when the product has no such attribute. Formats render the
+// values as they see fit.
+func ExportValue(product *Product, column string) any {
+	switch column {
+	case ExportColumnID:
+		return product.ID
+	case ImportFieldSKU:
+		return product.SKU
+	case ImportFieldGTIN:
+		return product.GTIN
+	case ImportFieldName:
+		return product.Name
+	case ImportFieldDescription:
+		return product.Description
+	case ImportFieldPrice:
+		return product.Price
+	case ImportFieldCurrency:
+		return product.Price.Currency()
+	case ImportFieldCategory:
+		return product.Category
+	case ImportFieldBrand:
+		return product.Brand
+	case ImportFieldStock:
+		return product.Stock
+	case ExportColumnStatus:
+		return string(product.Status)
+	case ImportFieldTags:
+		return product.Tags
+	case ExportColumnCreatedAt:
+		return product.CreatedAt
+	case ExportColumnUpdatedAt:
+		return product.UpdatedAt
+	}
+	if key, ok := strings.CutPrefix(column, importAttributePrefix); ok {
+		return product.Attributes[key]
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseExportColumns(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns []string
+		want    []string
+		wantErr string
+	}{
+		{
+			name: "default columns",
+			want: DefaultExportColumns,
+		},
+		{
+			name:    "selected columns in order",
+			columns: []string{"sku", " price ", "attributes.color", "id"},
+			want:    []string{"sku", "price", "attributes.color", "id"},
+		},
+		{
+			name:    "unknown column",
+			columns: []string{"sku", "weight"},
+			wantErr: `unknown export column "weight"`,
+		},
+		{
+			name:    "empty attribute key",
+			columns: []string{"attributes."},
+			wantErr: `unknown export column "attributes."`,
+		},
+		{
+			name:    "duplicate column",
+			columns: []string{"sku", "sku"},
+			wantErr: `export column "sku" is selected more than once`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			columns, err := ParseExportColumns(tt.columns)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, columns)
+		})
+	}
+}
+
+func TestExportValue(t *testing.T) {
+	price, err := ParseMoney("19.99", "EUR")
+	require.NoError(t, err)
+	product := &Product{
+		ID:         7,
+		SKU:        "LAP-001",
+		Price:      price,
+		Stock:      3,
+		Status:     ProductStatusActive,
+		Tags:       []string{"sale"},
+		Attributes: Attributes{"color": "Black"},
+	}
+
+	assert.Equal(t, uint(7), ExportValue(product, "id"))
+	assert.Equal(t, price, ExportValue(product, "price"))
+	assert.Equal(t, "EUR", ExportValue(product, "currency"))
+	assert.Equal(t, 3, ExportValue(product, "stock"))
+	assert.Equal(t, "active", ExportValue(product, "status"))
+	assert.Equal(t, []string{"sale"}, ExportValue(product, "tags"))
+	assert.Equal(t, "Black", ExportValue(product, "attributes.color"))
+	assert.Nil(t, ExportValue(product, "attributes.weight"))
+}
@@ -0,0 +1,356 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type ImportStatus string
+
+const (
+	// ImportPending is queued and has not read any row yet
+	ImportPending ImportStatus = "pending"
+	// ImportRunning is reading rows
+	ImportRunning ImportStatus = "running"
+	// ImportCompleted read every row; failed rows are in its error report
+	ImportCompleted ImportStatus = "completed"
+	// ImportFailed stopped before the end of the file, e.g. on an unreadable
+	// header or a malformed CSV line
+	ImportFailed ImportStatus = "failed"
+)
+
+// Product fields an import column can map to. Attributes map as
+// "attributes.<key>".
+const (
+	ImportFieldSKU         = "sku"
+	ImportFieldGTIN        = "gtin"
+	ImportFieldName        = "name"
+	ImportFieldDescription = "description"
+	ImportFieldPrice       = "price"
+	ImportFieldCurrency    = "currency"
+	ImportFieldCategory    = "category"
+	ImportFieldBrand       = "brand"
+	ImportFieldStock       = "stock"
+	ImportFieldTags        = "tags"
+
+	importAttributePrefix = "attributes."
+)
+
+var (
+	importFields = []string{
+		ImportFieldSKU, ImportFieldGTIN, ImportFieldName, ImportFieldDescription, ImportFieldPrice,
+		ImportFieldCurrency, ImportFieldCategory, ImportFieldBrand, ImportFieldStock, ImportFieldTags,
+	}
+
+	// requiredImportFields are the columns NewProduct needs
+	requiredImportFields = []string{ImportFieldSKU, ImportFieldName, ImportFieldPrice, ImportFieldCategory}
+)
+
+// ImportTagSeparator separates the tags of a product in its tags column
+const ImportTagSeparator = "|"
+
+// ImportMapping maps product fields to the header of the CSV column holding
+// them, e.g. {"sku": "Item Number"}. Fields it leaves out are read from the
+// column whose header is the field name itself.
+type ImportMapping map[string]string
+
+// Validate checks that every mapped field is a product field or attribute
+// and names a column
+func (m ImportMapping) Validate() error {
+	for field, column := range m {
+		if !isImportField(field) {
+			return fmt.Errorf("unknown import field %q", field)
+		}
+		if strings.TrimSpace(column) == "" {
+			return fmt.Errorf("import field %q maps to an empty column name", field)
+		}
+	}
+	return nil
+}
+
+func isImportField(field string) bool {
+	if key, ok := strings.CutPrefix(field, importAttributePrefix); ok {
+		return key != ""
+	}
+	return slices.Contains(importFields, field)
+}
+
+// ImportColumns locates the product fields in the columns of an import file
+type ImportColumns struct {
+	fields     map[string]int
+	attributes map[string]int
+}
+
+// ResolveColumns matches the mapping against the header row of an import
+// file. Headers compare case-insensitively. Every column NewProduct needs
+// must be present; columns that map to nothing are ignored.
+func (m ImportMapping) ResolveColumns(header []string) (*ImportColumns, error) {
+	positions := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if i == 0 {
+			// spreadsheet exports often start with a byte order mark
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		if _, duplicate := positions[name]; duplicate {
+			return nil, fmt.Errorf("column %q appears more than once", header[i])
+		}
+		positions[name] = i
+	}
+
+	columns := &ImportColumns{fields: map[string]int{}, attributes: map[string]int{}}
+	locate := func(field string) (int, bool) {
+		column, mapped := m[field]
+		if !mapped {
+			column = field
+		}
+		position, ok := positions[strings.ToLower(strings.TrimSpace(column))]
+		return position, ok
+	}
+
+	for _, field := range importFields {
+		if position, ok := locate(field); ok {
+			columns.fields[field] = position
+		} else if _, mapped := m[field]; mapped {
+			return nil, fmt.Errorf("column %q mapped to %s is missing", m[field], field)
+		}
+	}
+	for _, field := range requiredImportFields {
+		if _, ok := columns.fields[field]; !ok {
+			return nil, fmt.Errorf("required column %q is missing", field)
+		}
+	}
+
+	// Attributes come from mapped fields and from headers in field form
+	for field := range m {
+		if key, ok := strings.CutPrefix(field, importAttributePrefix); ok {
+			position, found := locate(field)
+			if !found {
+				return nil, fmt.Errorf("column %q mapped to %s is missing", m[field], field)
+			}
+			columns.attributes[key] = position
+		}
+	}
+	for name, position := range positions {
+		if key, ok := strings.CutPrefix(name, importAttributePrefix); ok && key != "" {
+			if _, mapped := m[name]; !mapped {
+				columns.attributes[key] = position
+			}
+		}
+	}
+
+	return columns, nil
+}
+
+// Record reads one data row of the file, at 1-based line number line
+func (c *ImportColumns) Record(line int, values []string) ImportRecord {
+	record := ImportRecord{Line: line, fields: map[string]string{}, attributes: map[string]string{}}
+	for field, position := range c.fields {
+		if position < len(values) {
+			record.fields[field] = strings.TrimSpace(values[position])
+		}
+	}
+	for key, position := range c.attributes {
+		if position < len(values) {
+			if value := strings.TrimSpace(values[position]); value != "" {
+				record.attributes[key] = value
+			}
+		}
+	}
+	return record
+}
+
+// ImportRecord is one data row of an import file read through its columns
+type ImportRecord struct {
+	Line       int
+	fields     map[string]string
+	attributes map[string]string
+}
+
+// SKU returns the SKU of the row as written
+func (r ImportRecord) SKU() string {
+	return r.fields[ImportFieldSKU]
+}
+
+// Product validates the row as a new product through NewProduct. Prices are
+// in the row's currency column, or currency when the file has none. The
+// returned error is an *ImportFieldError.
+func (r ImportRecord) Product(currency string) (*Product, error) {
+	if rowCurrency := r.fields[ImportFieldCurrency]; rowCurrency != "" {
+		currency = rowCurrency
+	}
+	if currency == "" {
+		return nil, &ImportFieldError{Field: ImportFieldCurrency, Message: "currency is required"}
+	}
+	price, err := ParseMoney(r.fields[ImportFieldPrice], currency)
+	if err != nil {
+		return nil, &ImportFieldError{Field: ImportFieldPrice, Message: err.Error()}
+	}
+
+	stock := 0
+	if value := r.fields[ImportFieldStock]; value != "" {
+		stock, err = strconv.Atoi(value)
+		if err != nil {
+			return nil, &ImportFieldError{Field: ImportFieldStock, Message: fmt.Sprintf("stock %q is not a whole number", value)}
+		}
+	}
+
+	product, err := NewProduct(r.fields[ImportFieldName], r.fields[ImportFieldDescription], r.SKU(),
+		r.fields[ImportFieldCategory], r.fields[ImportFieldBrand], price, stock)
+	if err != nil {
+		var fieldErr *ProductFieldError
+		if errors.As(err, &fieldErr) {
+			return nil, &ImportFieldError{Field: fieldErr.Field, Message: fieldErr.Message}
+		}
+		return nil, err
+	}
+	if err := product.SetGTIN(r.fields[ImportFieldGTIN]); err != nil {
+		return nil, &ImportFieldError{Field: ImportFieldGTIN, Message: err.Error()}
+	}
+	return product, nil
+}
+
+// Attributes returns the non-empty attribute cells of the row
+func (r ImportRecord) Attributes() Attributes {
+	if len(r.attributes) == 0 {
+		return nil
+	}
+	attributes := make(Attributes, len(r.attributes))
+	for key, value := range r.attributes {
+		attributes[key] = value
+	}
+	return attributes
+}
+
+// Tags returns the tags of the row, or nil when the file has no tags column
+func (r ImportRecord) Tags() []string {
+	value, ok := r.fields[ImportFieldTags]
+	if !ok {
+		return nil
+	}
+	tags := []string{}
+	for _, tag := range strings.Split(value, ImportTagSeparator) {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// Changes returns the edits that bring an existing product in line with the
+// row, given the row validated as product. Only the columns present in the
+// file are changed.
+func (r ImportRecord) Changes(product *Product) ProductChanges {
+	changes := ProductChanges{
+		Name:       &product.Name,
+		Category:   &product.Category,
+		Price:      &product.Price,
+		Attributes: r.Attributes(),
+		Tags:       r.Tags(),
+	}
+	if _, ok := r.fields[ImportFieldDescription]; ok {
+		changes.Description = &product.Description
+	}
+	if _, ok := r.fields[ImportFieldGTIN]; ok {
+		changes.GTIN = &product.GTIN
+	}
+	if _, ok := r.fields[ImportFieldBrand]; ok && product.Brand != "" {
+		changes.Brand = &product.Brand
+	}
+	if _, ok := r.fields[ImportFieldStock]; ok {
+		changes.Stock = &product.Stock
+	}
+	return changes
+}
+
+// ImportFieldError reports which field of an import row is invalid
+type ImportFieldError struct {
+	Field   string
+	Message string
+}
+
+func (e *ImportFieldError) Error() string {
+	return e.Message
+}
+
+// ImportRowError is one line of an import's error report
+type ImportRowError struct {
+	Row     int    `json:"row"`
+	SKU     string `json:"sku,omitempty"`
+	Field   string `json:"field,omitempty"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// ProductImport is a bulk upsert of products by SKU from a CSV file. A dry
+// run validates every row the same way but writes nothing.
+type ProductImport struct {
+	ID         uint          `json:"id"`
+	FileName   string        `json:"file_name"`
+	Status     ImportStatus  `json:"status"`
+	DryRun     bool          `json:"dry_run"`
+	Mapping    ImportMapping `json:"mapping,omitempty"`
+	Currency   string        `json:"currency,omitempty"`
+	TotalRows  int           `json:"total_rows"`
+	Created    int           `json:"created"`
+	Updated    int           `json:"updated"`
+	Failed     int           `json:"failed"`
+	Error      string        `json:"error,omitempty"`
+	Actor      string        `json:"actor,omitempty"`
+	RequestID  string        `json:"request_id,omitempty"`
+	CreatedAt  time.Time     `json:"created_at"`
+	StartedAt  *time.Time    `json:"started_at,omitempty"`
+	FinishedAt *time.Time    `json:"finished_at,omitempty"`
+}
+
+// NewProductImport queues an import of fileName. currency, when set, prices
+// the rows of files without a currency column. Invalid options are reported
+// as an *ImportFieldError.
+func NewProductImport(fileName string, mapping ImportMapping, currency string, dryRun bool, actor, requestID string, now time.Time) (*ProductImport, error) {
+	if err := mapping.Validate(); err != nil {
+		return nil, &ImportFieldError{Field: "mapping", Message: err.Error()}
+	}
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency != "" && !IsSupportedCurrency(currency) {
+		return nil, &ImportFieldError{Field: ImportFieldCurrency, Message: fmt.Sprintf("unsupported currency %q", currency)}
+	}
+
+	return &ProductImport{
+		FileName:  strings.TrimSpace(fileName),
+		Status:    ImportPending,
+		DryRun:    dryRun,
+		Mapping:   mapping,
+		Currency:  currency,
+		Actor:     actor,
+		RequestID: requestID,
+		CreatedAt: now,
+	}, nil
+}
+
+// Start marks the import as reading rows
+func (i *ProductImport) Start(now time.Time) {
+	i.Status = ImportRunning
+	i.StartedAt = &now
+}
+
+// Complete marks the import as having read every row
+func (i *ProductImport) Complete(now time.Time) {
+	i.Status = ImportCompleted
+	i.FinishedAt = &now
+}
+
+// Fail stops the import for reason; rows already imported stay imported
+func (i *ProductImport) Fail(reason string, now time.Time) {
+	i.Status = ImportFailed
+	i.Error = reason
+	i.FinishedAt = &now
+}
+
+// IsFinished reports whether the import stopped reading rows
+func (i *ProductImport) IsFinished() bool {
+	return i.Status == ImportCompleted || i.Status == ImportFailed
+}
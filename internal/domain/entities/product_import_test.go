@@ -0,0 +1,161 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportMapping_ResolveColumns(t *testing.T) {
+	tests := []struct {
+		name      string
+		mapping   ImportMapping
+		header    []string
+		row       []string
+		wantSKU   string
+		wantName  string
+		wantAttrs Attributes
+		wantErr   string
+	}{
+		{
+			name:     "headers named after fields",
+			header:   []string{"\ufeffSKU", "Name", "Price", "Category", "attributes.color"},
+			row:      []string{"lap-001", " Laptop ", "10.00", "Electronics", "Black"},
+			wantSKU:  "lap-001",
+			wantName: "Laptop",
+			wantAttrs: Attributes{
+				"color": "Black",
+			},
+		},
+		{
+			name:      "mapped headers",
+			mapping:   ImportMapping{"sku": "Item Number", "attributes.weight": "Weight (kg)"},
+			header:    []string{"Item Number", "name", "price", "category", "weight (kg)"},
+			row:       []string{"LAP-001", "Laptop", "10.00", "Electronics", ""},
+			wantSKU:   "LAP-001",
+			wantName:  "Laptop",
+			wantAttrs: nil,
+		},
+		{
+			name:    "missing required column",
+			header:  []string{"sku", "name", "category"},
+			wantErr: `required column "price" is missing`,
+		},
+		{
+			name:    "missing mapped column",
+			mapping: ImportMapping{"stock": "Qty"},
+			header:  []string{"sku", "name", "price", "category"},
+			wantErr: `column "Qty" mapped to stock is missing`,
+		},
+		{
+			name:    "duplicate column",
+			header:  []string{"sku", "name", "price", "category", "SKU"},
+			wantErr: `column "SKU" appears more than once`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			columns, err := tt.mapping.ResolveColumns(tt.header)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			record := columns.Record(2, tt.row)
+			assert.Equal(t, tt.wantSKU, record.SKU())
+			assert.Equal(t, tt.wantName, record.fields[ImportFieldName])
+			assert.Equal(t, tt.wantAttrs, record.Attributes())
+		})
+	}
+}
+
+func TestImportRecord_Product(t *testing.T) {
+	header := []string{"sku", "name", "price", "currency", "category", "stock"}
+	columns, err := ImportMapping{}.ResolveColumns(header)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		row       []string
+		currency  string
+		wantField string
+		wantErr   string
+	}{
+		{name: "valid row", row: []string{"lap-001", "Laptop", "10.00", "", "Electronics", "3"}, currency: "USD"},
+		{name: "row currency wins", row: []string{"lap-001", "Laptop", "10", "JPY", "Electronics", ""}, currency: "USD"},
+		{name: "no currency", row: []string{"lap-001", "Laptop", "10.00", "", "Electronics", ""}, wantField: "currency", wantErr: "currency is required"},
+		{name: "bad price", row: []string{"lap-001", "Laptop", "ten", "", "Electronics", ""}, currency: "USD", wantField: "price", wantErr: `invalid amount "ten"`},
+		{name: "bad stock", row: []string{"lap-001", "Laptop", "10.00", "", "Electronics", "many"}, currency: "USD", wantField: "stock", wantErr: `stock "many" is not a whole number`},
+		{name: "negative stock", row: []string{"lap-001", "Laptop", "10.00", "", "Electronics", "-1"}, currency: "USD", wantField: "stock", wantErr: "stock cannot be negative"},
+		{name: "short sku", row: []string{"LA", "Laptop", "10.00", "", "Electronics", ""}, currency: "USD", wantField: "sku", wantErr: "SKU must be at least 3 characters long"},
+		{name: "no category", row: []string{"lap-001", "Laptop", "10.00", "", "", ""}, currency: "USD", wantField: "category", wantErr: "category is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			product, err := columns.Record(2, tt.row).Product(tt.currency)
+
+			if tt.wantErr != "" {
+				var fieldErr *ImportFieldError
+				require.ErrorAs(t, err, &fieldErr)
+				assert.Equal(t, tt.wantField, fieldErr.Field)
+				assert.Equal(t, tt.wantErr, fieldErr.Message)
+				assert.Nil(t, product)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "LAP-001", product.SKU)
+			assert.Equal(t, ProductStatusActive, product.Status)
+		})
+	}
+}
+
+func TestImportRecord_Changes(t *testing.T) {
+	// Only the columns of the file change an existing product
+	columns, err := ImportMapping{}.ResolveColumns([]string{"sku", "name", "price", "category", "tags"})
+	require.NoError(t, err)
+	record := columns.Record(2, []string{"LAP-001", "Laptop", "10.00", "Electronics", "Summer Sale| new |"})
+
+	product, err := record.Product("USD")
+	require.NoError(t, err)
+	changes := record.Changes(product)
+
+	assert.Equal(t, "Laptop", *changes.Name)
+	assert.Equal(t, "Electronics", *changes.Category)
+	assert.True(t, changes.Price.Equal(MustParseMoney("10.00", "USD")))
+	assert.Nil(t, changes.Description)
+	assert.Nil(t, changes.Brand)
+	assert.Nil(t, changes.Stock)
+	assert.Nil(t, changes.Attributes)
+	assert.Equal(t, []string{"Summer Sale", "new"}, changes.Tags)
+}
+
+func TestNewProductImport(t *testing.T) {
+	now := time.Now()
+
+	productImport, err := NewProductImport(" products.csv ", ImportMapping{"sku": "Item"}, "eur", true, "alice", "req-1", now)
+	require.NoError(t, err)
+	assert.Equal(t, "products.csv", productImport.FileName)
+	assert.Equal(t, ImportPending, productImport.Status)
+	assert.Equal(t, "EUR", productImport.Currency)
+	assert.True(t, productImport.DryRun)
+	assert.False(t, productImport.IsFinished())
+
+	productImport.Start(now)
+	productImport.Complete(now.Add(time.Minute))
+	assert.Equal(t, ImportCompleted, productImport.Status)
+	assert.True(t, productImport.IsFinished())
+
+	_, err = NewProductImport("products.csv", ImportMapping{"weight": "Weight"}, "", false, "", "", now)
+	var fieldErr *ImportFieldError
+	require.ErrorAs(t, err, &fieldErr)
+	assert.Equal(t, "mapping", fieldErr.Field)
+
+	_, err = NewProductImport("products.csv", nil, "XXX", false, "", "", now)
+	require.ErrorAs(t, err, &fieldErr)
+	assert.Equal(t, "currency", fieldErr.Field)
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewProduct(t *testing.T) {
@@ -15,7 +16,7 @@ func TestNewProduct(t *testing.T) {
 		sku           string
 		category      string
 		brand         string
-		price         float64
+		price         Money
 		stock         int
 		expectError   bool
 		errorContains string
@@ -27,7 +28,7 @@ func TestNewProduct(t *testing.T) {
 			sku:         "IPH15-128GB",
 			category:    "Electronics",
 			brand:       "Apple",
-			price:       999.99,
+			price:       MustParseMoney("999.99", "USD"),
 			stock:       100,
 			expectError: false,
 		},
@@ -38,7 +39,7 @@ func TestNewProduct(t *testing.T) {
 			sku:           "SKU123",
 			category:      "Category",
 			brand:         "Brand",
-			price:         100.0,
+			price:         MustParseMoney("100.0", "USD"),
 			stock:         10,
 			expectError:   true,
 			errorContains: "product name is required",
@@ -50,7 +51,7 @@ func TestNewProduct(t *testing.T) {
 			sku:           "SKU123",
 			category:      "Category",
 			brand:         "Brand",
-			price:         100.0,
+			price:         MustParseMoney("100.0", "USD"),
 			stock:         10,
 			expectError:   true,
 			errorContains: "product name must be at least 2 characters",
@@ -62,7 +63,7 @@ func TestNewProduct(t *testing.T) {
 			sku:           "",
 			category:      "Category",
 			brand:         "Brand",
-			price:         100.0,
+			price:         MustParseMoney("100.0", "USD"),
 			stock:         10,
 			expectError:   true,
 			errorContains: "SKU is required",
@@ -74,7 +75,7 @@ func TestNewProduct(t *testing.T) {
 			sku:           "AB",
 			category:      "Category",
 			brand:         "Brand",
-			price:         100.0,
+			price:         MustParseMoney("100.0", "USD"),
 			stock:         10,
 			expectError:   true,
 			errorContains: "SKU must be at least 3 characters",
@@ -86,7 +87,7 @@ func TestNewProduct(t *testing.T) {
 			sku:           "SKU123",
 			category:      "Category",
 			brand:         "Brand",
-			price:         -10.0,
+			price:         MustParseMoney("-10.0", "USD"),
 			stock:         10,
 			expectError:   true,
 			errorContains: "price cannot be negative",
@@ -98,7 +99,7 @@ func TestNewProduct(t *testing.T) {
 			sku:           "SKU123",
 			category:      "Category",
 			brand:         "Brand",
-			price:         100.0,
+			price:         MustParseMoney("100.0", "USD"),
 			stock:         -5,
 			expectError:   true,
 			errorContains: "stock cannot be negative",
@@ -110,7 +111,7 @@ func TestNewProduct(t *testing.T) {
 			sku:           "SKU123",
 			category:      "",
 			brand:         "Brand",
-			price:         100.0,
+			price:         MustParseMoney("100.0", "USD"),
 			stock:         10,
 			expectError:   true,
 			errorContains: "category is required",
@@ -122,7 +123,7 @@ func TestNewProduct(t *testing.T) {
 			sku:         "SKU123",
 			category:    "Category",
 			brand:       "",
-			price:       100.0,
+			price:       MustParseMoney("100.0", "USD"),
 			stock:       10,
 			expectError: false,
 		},
@@ -262,12 +263,15 @@ func TestProduct_IsAvailable(t *testing.T) {
 
 func TestProduct_Activate(t *testing.T) {
 	product := &Product{
+		Name:      "iPhone 15",
+		Price:     MustParseMoney("999.99", "USD"),
+		Category:  "Electronics",
 		Status:    ProductStatusInactive,
 		UpdatedAt: time.Now().Add(-time.Hour),
 	}
 	oldUpdatedAt := product.UpdatedAt
 
-	product.Activate()
+	require.NoError(t, product.Activate())
 
 	assert.Equal(t, ProductStatusActive, product.Status)
 	assert.True(t, product.UpdatedAt.After(oldUpdatedAt))
@@ -280,7 +284,7 @@ func TestProduct_Deactivate(t *testing.T) {
 	}
 	oldUpdatedAt := product.UpdatedAt
 
-	product.Deactivate()
+	require.NoError(t, product.Deactivate())
 
 	assert.Equal(t, ProductStatusInactive, product.Status)
 	assert.True(t, product.UpdatedAt.After(oldUpdatedAt))
@@ -293,7 +297,7 @@ func TestProduct_Discontinue(t *testing.T) {
 	}
 	oldUpdatedAt := product.UpdatedAt
 
-	product.Discontinue()
+	require.NoError(t, product.Discontinue())
 
 	assert.Equal(t, ProductStatusDiscontinued, product.Status)
 	assert.True(t, product.UpdatedAt.After(oldUpdatedAt))
@@ -485,27 +489,27 @@ func TestProduct_AddStock(t *testing.T) {
 func TestProduct_UpdatePrice(t *testing.T) {
 	tests := []struct {
 		name          string
-		initialPrice  float64
-		newPrice      float64
+		initialPrice  Money
+		newPrice      Money
 		expectError   bool
 		errorContains string
 	}{
 		{
 			name:         "valid price update",
-			initialPrice: 100.0,
-			newPrice:     150.0,
+			initialPrice: MustParseMoney("100.0", "USD"),
+			newPrice:     MustParseMoney("150.0", "USD"),
 			expectError:  false,
 		},
 		{
 			name:         "update to zero price",
-			initialPrice: 100.0,
-			newPrice:     0.0,
+			initialPrice: MustParseMoney("100.0", "USD"),
+			newPrice:     MustParseMoney("0.0", "USD"),
 			expectError:  false,
 		},
 		{
 			name:          "negative price",
-			initialPrice:  100.0,
-			newPrice:      -50.0,
+			initialPrice:  MustParseMoney("100.0", "USD"),
+			newPrice:      MustParseMoney("-50.0", "USD"),
 			expectError:   true,
 			errorContains: "price cannot be negative",
 		},
@@ -574,7 +578,7 @@ func TestValidateSKU(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := validateSKU(tt.sku)
+			err := ValidateSKU(tt.sku)
 			if tt.expectError {
 				assert.Error(t, err)
 			} else {
@@ -587,14 +591,17 @@ func TestValidateSKU(t *testing.T) {
 func TestValidatePrice(t *testing.T) {
 	tests := []struct {
 		name        string
-		price       float64
+		price       Money
 		expectError bool
 	}{
-		{"valid price", 99.99, false},
-		{"zero price", 0.0, false},
-		{"maximum price", 999999.99, false},
-		{"negative price", -10.0, true},
-		{"price too high", 1000000.0, true},
+		{"valid price", MustParseMoney("99.99", "USD"), false},
+		{"zero price", MustParseMoney("0", "USD"), false},
+		{"maximum price", MustParseMoney("999999.99", "USD"), false},
+		{"negative price", MustParseMoney("-10", "USD"), true},
+		{"price too high", MustParseMoney("1000000", "USD"), true},
+		{"maximum price without minor units", MustParseMoney("999999", "JPY"), false},
+		{"price too high without minor units", MustParseMoney("1000000", "JPY"), true},
+		{"missing price", Money{}, true},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,366 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+)
+
+type DiscountType string
+
+const (
+	// DiscountPercentage takes a percentage off the running price
+	DiscountPercentage DiscountType = "percentage"
+	// DiscountFixedAmount takes a fixed amount off the running price
+	DiscountFixedAmount DiscountType = "fixed_amount"
+)
+
+const (
+	maxPromotionNameLength = 255
+	maxPromotionTargets    = 500
+)
+
+// Promotion is a discount rule. It targets products by category, brand, SKU
+// or tag: within one dimension any listed value matches, and every dimension
+// that lists values must match. A category target covers the category's
+// descendants too. A promotion without targets applies to every product.
+//
+// Promotions are evaluated from the highest priority down. The first eligible
+// promotion always applies; a non-stackable one stops evaluation there, and
+// once a promotion has applied only stackable ones may follow.
+type Promotion struct {
+	ID           uint         `json:"id"`
+	Name         string       `json:"name"`
+	Description  string       `json:"description"`
+	DiscountType DiscountType `json:"discount_type"`
+	// Percentage is the percent taken off, e.g. 15 for 15%, for percentage discounts
+	Percentage *big.Rat `json:"-"`
+	// Amount is the amount taken off for fixed-amount discounts; it only
+	// applies to prices in the same currency
+	Amount     Money               `json:"amount"`
+	Categories []PromotionCategory `json:"categories"`
+	Brands     []PromotionBrand    `json:"brands"`
+	SKUs       []string            `json:"skus"`
+	Tags       []string            `json:"tags"`
+	StartsAt   *time.Time          `json:"starts_at,omitempty"`
+	EndsAt     *time.Time          `json:"ends_at,omitempty"`
+	Priority   int                 `json:"priority"`
+	Stackable  bool                `json:"stackable"`
+	Active     bool                `json:"active"`
+	CreatedAt  time.Time           `json:"created_at"`
+	UpdatedAt  time.Time           `json:"updated_at"`
+}
+
+// PromotionCategory is a category a promotion targets. Promotions store the
+// category's ID; Path is its current path, so the promotion follows the
+// category through renames and moves.
+type PromotionCategory struct {
+	ID   uint   `json:"id"`
+	Path string `json:"path"`
+}
+
+// PromotionBrand is a registered brand a promotion targets. Promotions store
+// the brand's ID; Name is its current canonical name, so the promotion
+// follows the brand through renames and merges.
+type PromotionBrand struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+}
+
+// Validate checks the promotion and normalizes its name and targets
+func (p *Promotion) Validate() error {
+	p.Name = strings.TrimSpace(p.Name)
+	if p.Name == "" {
+		return errors.New("promotion name is required")
+	}
+	if len(p.Name) > maxPromotionNameLength {
+		return errors.New("promotion name must be less than 255 characters")
+	}
+
+	switch p.DiscountType {
+	case DiscountPercentage:
+		if p.Percentage == nil || p.Percentage.Sign() <= 0 || p.Percentage.Cmp(big.NewRat(100, 1)) > 0 {
+			return errors.New("percentage must be greater than 0 and at most 100")
+		}
+		p.Amount = Money{}
+	case DiscountFixedAmount:
+		if p.Amount.IsZero() || p.Amount.IsNegative() || p.Amount.MinorUnits() == 0 {
+			return errors.New("amount must be greater than zero")
+		}
+		p.Percentage = nil
+	default:
+		return fmt.Errorf("discount type must be %q or %q", DiscountPercentage, DiscountFixedAmount)
+	}
+
+	if p.StartsAt != nil && p.EndsAt != nil && !p.EndsAt.After(*p.StartsAt) {
+		return errors.New("ends_at must be after starts_at")
+	}
+
+	p.Categories = normalizePromotionCategories(p.Categories)
+	p.Brands = normalizePromotionBrands(p.Brands)
+	p.SKUs = normalizeTargets(p.SKUs)
+	// Tags compare in the slug form products store them in
+	tags, err := NormalizeTags(normalizeTargets(p.Tags))
+	if err != nil {
+		return err
+	}
+	p.Tags = tags
+	if len(p.Categories)+len(p.Brands)+len(p.SKUs)+len(p.Tags) > maxPromotionTargets {
+		return fmt.Errorf("a promotion can have at most %d targets", maxPromotionTargets)
+	}
+
+	return nil
+}
+
+// PercentageString returns the percentage as a decimal string, e.g. "12.5"
+func (p *Promotion) PercentageString() string {
+	if p.Percentage == nil {
+		return ""
+	}
+	return strings.TrimRight(strings.TrimRight(p.Percentage.FloatString(4), "0"), ".")
+}
+
+// IsLiveAt reports whether the promotion is enabled and its window contains at
+func (p *Promotion) IsLiveAt(at time.Time) bool {
+	if !p.Active {
+		return false
+	}
+	if p.StartsAt != nil && at.Before(*p.StartsAt) {
+		return false
+	}
+	if p.EndsAt != nil && !at.Before(*p.EndsAt) {
+		return false
+	}
+	return true
+}
+
+// Targets reports whether the promotion covers the product described by target
+func (p *Promotion) Targets(target PromotionTarget) bool {
+	return coversCategory(p.Categories, target.CategoryPath) &&
+		coversBrand(p.Brands, target.BrandID) &&
+		matchesAny(p.SKUs, target.SKU) &&
+		matchesAnyOf(p.Tags, target.Tags)
+}
+
+// discountOn returns the discount the promotion takes off price, capped so
+// the price never goes below zero. ok is false when it cannot apply to price.
+func (p *Promotion) discountOn(price Money) (discount Money, ok bool, err error) {
+	switch p.DiscountType {
+	case DiscountPercentage:
+		if price.MinorUnits() == 0 {
+			return Money{}, false, nil
+		}
+		// converting into the same currency at percentage/100 scales the amount
+		rate := new(big.Rat).Quo(p.Percentage, big.NewRat(100, 1))
+		discount, err = price.Convert(price.Currency(), rate)
+		if err != nil {
+			return Money{}, false, err
+		}
+	case DiscountFixedAmount:
+		if p.Amount.Currency() != price.Currency() {
+			return Money{}, false, nil
+		}
+		discount = p.Amount
+	default:
+		return Money{}, false, nil
+	}
+
+	if cmp, _ := discount.Cmp(price); cmp > 0 {
+		discount = price
+	}
+	if discount.MinorUnits() == 0 {
+		return Money{}, false, nil
+	}
+	return discount, true, nil
+}
+
+// PromotionTarget describes the product attributes promotions target.
+// CategoryPath is the path of the product's category; BrandID is nil for
+// products without a brand.
+type PromotionTarget struct {
+	SKU          string
+	CategoryPath string
+	BrandID      *uint
+	Tags         []string
+}
+
+// AppliedPromotion explains one discount taken off a price
+type AppliedPromotion struct {
+	PromotionID  uint         `json:"promotion_id"`
+	Name         string       `json:"name"`
+	DiscountType DiscountType `json:"discount_type"`
+	Percentage   string       `json:"percentage,omitempty"`
+	Discount     Money        `json:"discount"`
+	PriceAfter   Money        `json:"price_after"`
+}
+
+// PriceEvaluation is the outcome of evaluating promotions against a price
+type PriceEvaluation struct {
+	BasePrice      Money              `json:"base_price"`
+	EffectivePrice Money              `json:"effective_price"`
+	Applied        []AppliedPromotion `json:"applied"`
+	EvaluatedAt    time.Time          `json:"evaluated_at"`
+}
+
+// EvaluatePromotions works out the effective price of a product priced at
+// base at the given time, following the priority and stacking rules described
+// on Promotion. Ties in priority go to the promotion created first.
+func EvaluatePromotions(base Money, target PromotionTarget, promotions []*Promotion, at time.Time) (*PriceEvaluation, error) {
+	evaluation := &PriceEvaluation{
+		BasePrice:      base,
+		EffectivePrice: base,
+		Applied:        []AppliedPromotion{},
+		EvaluatedAt:    at,
+	}
+	if base.IsZero() {
+		return evaluation, nil
+	}
+
+	eligible := make([]*Promotion, 0, len(promotions))
+	for _, promotion := range promotions {
+		if promotion.IsLiveAt(at) && promotion.Targets(target) {
+			eligible = append(eligible, promotion)
+		}
+	}
+	sort.SliceStable(eligible, func(i, j int) bool {
+		if eligible[i].Priority != eligible[j].Priority {
+			return eligible[i].Priority > eligible[j].Priority
+		}
+		return eligible[i].ID < eligible[j].ID
+	})
+
+	for _, promotion := range eligible {
+		if len(evaluation.Applied) > 0 && !promotion.Stackable {
+			continue
+		}
+
+		discount, ok, err := promotion.discountOn(evaluation.EffectivePrice)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+
+		price, err := evaluation.EffectivePrice.Sub(discount)
+		if err != nil {
+			return nil, err
+		}
+		evaluation.EffectivePrice = price
+		evaluation.Applied = append(evaluation.Applied, AppliedPromotion{
+			PromotionID:  promotion.ID,
+			Name:         promotion.Name,
+			DiscountType: promotion.DiscountType,
+			Percentage:   promotion.PercentageString(),
+			Discount:     discount,
+			PriceAfter:   price,
+		})
+
+		if !promotion.Stackable {
+			break
+		}
+	}
+
+	return evaluation, nil
+}
+
+func normalizePromotionCategories(categories []PromotionCategory) []PromotionCategory {
+	seen := make(map[uint]bool, len(categories))
+	normalized := make([]PromotionCategory, 0, len(categories))
+	for _, category := range categories {
+		if seen[category.ID] {
+			continue
+		}
+		seen[category.ID] = true
+		normalized = append(normalized, category)
+	}
+	return normalized
+}
+
+// coversCategory reports whether the category at path is one of categories
+// or sits below one of them; no categories cover every path
+func coversCategory(categories []PromotionCategory, path string) bool {
+	if len(categories) == 0 {
+		return true
+	}
+	for _, category := range categories {
+		if path == category.Path || strings.HasPrefix(path, category.Path+categoryPathSeparator) {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizePromotionBrands(brands []PromotionBrand) []PromotionBrand {
+	seen := make(map[uint]bool, len(brands))
+	normalized := make([]PromotionBrand, 0, len(brands))
+	for _, brand := range brands {
+		if seen[brand.ID] {
+			continue
+		}
+		seen[brand.ID] = true
+		normalized = append(normalized, brand)
+	}
+	return normalized
+}
+
+// coversBrand reports whether brandID is one of brands; no brands cover every
+// product, including those without a brand
+func coversBrand(brands []PromotionBrand, brandID *uint) bool {
+	if len(brands) == 0 {
+		return true
+	}
+	if brandID == nil {
+		return false
+	}
+	for _, brand := range brands {
+		if brand.ID == *brandID {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeTargets(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	normalized := make([]string, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		key := strings.ToLower(value)
+		if value == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		normalized = append(normalized, value)
+	}
+	return normalized
+}
+
+// matchesAny reports whether value is one of values; an empty list matches anything
+func matchesAny(values []string, value string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, candidate := range values {
+		if strings.EqualFold(candidate, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesAnyOf reports whether any of have is one of values; an empty list matches anything
+func matchesAnyOf(values, have []string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, value := range have {
+		if matchesAny(values, value) {
+			return true
+		}
+	}
+	return false
+}
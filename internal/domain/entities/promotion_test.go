@@ -0,0 +1,157 @@
+package entities
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func percentOff(id uint, name string, percentage int64, priority int, stackable bool) *Promotion {
+	return &Promotion{
+		ID:           id,
+		Name:         name,
+		DiscountType: DiscountPercentage,
+		Percentage:   big.NewRat(percentage, 1),
+		Priority:     priority,
+		Stackable:    stackable,
+		Active:       true,
+	}
+}
+
+func amountOff(id uint, name, amount string, priority int, stackable bool) *Promotion {
+	return &Promotion{
+		ID:           id,
+		Name:         name,
+		DiscountType: DiscountFixedAmount,
+		Amount:       MustParseMoney(amount, "USD"),
+		Priority:     priority,
+		Stackable:    stackable,
+		Active:       true,
+	}
+}
+
+func TestPromotion_Validate(t *testing.T) {
+	start := time.Now()
+	end := start.Add(-time.Hour)
+
+	tests := []struct {
+		name      string
+		promotion *Promotion
+		expectErr bool
+	}{
+		{"valid percentage", percentOff(0, "Sale", 15, 0, false), false},
+		{"valid fixed amount", amountOff(0, "Sale", "5.00", 0, false), false},
+		{"missing name", percentOff(0, "  ", 15, 0, false), true},
+		{"percentage over 100", percentOff(0, "Sale", 101, 0, false), true},
+		{"zero percentage", percentOff(0, "Sale", 0, 0, false), true},
+		{"negative amount", amountOff(0, "Sale", "-5.00", 0, false), true},
+		{"unknown type", &Promotion{Name: "Sale", DiscountType: "bogo"}, true},
+		{"window ends before start", func() *Promotion {
+			p := percentOff(0, "Sale", 10, 0, false)
+			p.StartsAt, p.EndsAt = &start, &end
+			return p
+		}(), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.promotion.Validate()
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPromotion_Targets(t *testing.T) {
+	promotion := percentOff(1, "Apple phones", 10, 0, false)
+	promotion.Categories = []PromotionCategory{{ID: 2, Path: "electronics/phones"}, {ID: 7, Path: "wearables"}}
+	promotion.Brands = []PromotionBrand{{ID: 4, Name: "Apple"}}
+	apple, samsung := uint(4), uint(5)
+
+	assert.True(t, promotion.Targets(PromotionTarget{CategoryPath: "electronics/phones", BrandID: &apple}))
+	assert.True(t, promotion.Targets(PromotionTarget{CategoryPath: "electronics/phones/smartphones", BrandID: &apple}), "descendants are covered")
+	assert.False(t, promotion.Targets(PromotionTarget{CategoryPath: "electronics/phones", BrandID: &samsung}), "every listed dimension must match")
+	assert.False(t, promotion.Targets(PromotionTarget{CategoryPath: "electronics/phones"}), "products without a brand are not covered")
+	assert.False(t, promotion.Targets(PromotionTarget{CategoryPath: "electronics", BrandID: &apple}), "ancestors are not covered")
+	assert.False(t, promotion.Targets(PromotionTarget{CategoryPath: "electronics/phones-cases", BrandID: &apple}), "siblings sharing a prefix are not covered")
+
+	tagged := percentOff(2, "Clearance", 10, 0, false)
+	tagged.Tags = []string{"clearance"}
+	assert.True(t, tagged.Targets(PromotionTarget{Tags: []string{"new", "Clearance"}}))
+	assert.False(t, tagged.Targets(PromotionTarget{}))
+
+	assert.True(t, percentOff(3, "Sitewide", 5, 0, false).Targets(PromotionTarget{SKU: "ANY-1"}), "no targets means every product")
+}
+
+func TestEvaluatePromotions(t *testing.T) {
+	now := time.Now()
+	base := MustParseMoney("100.00", "USD")
+	target := PromotionTarget{SKU: "IPH15-128GB", CategoryPath: "electronics/phones"}
+
+	t.Run("no promotions keeps the base price", func(t *testing.T) {
+		evaluation, err := EvaluatePromotions(base, target, nil, now)
+		require.NoError(t, err)
+		assert.Equal(t, base, evaluation.EffectivePrice)
+		assert.Empty(t, evaluation.Applied)
+	})
+
+	t.Run("highest priority non-stackable wins alone", func(t *testing.T) {
+		promotions := []*Promotion{
+			percentOff(1, "Low", 50, 1, false),
+			percentOff(2, "High", 10, 5, false),
+		}
+		evaluation, err := EvaluatePromotions(base, target, promotions, now)
+		require.NoError(t, err)
+		assert.Equal(t, MustParseMoney("90.00", "USD"), evaluation.EffectivePrice)
+		require.Len(t, evaluation.Applied, 1)
+		assert.Equal(t, "High", evaluation.Applied[0].Name)
+	})
+
+	t.Run("stackable promotions compound in priority order", func(t *testing.T) {
+		promotions := []*Promotion{
+			amountOff(1, "Five off", "5.00", 1, true),
+			percentOff(2, "Ten percent", 10, 2, true),
+			percentOff(3, "Exclusive", 30, 0, false),
+		}
+		evaluation, err := EvaluatePromotions(base, target, promotions, now)
+		require.NoError(t, err)
+		// 100 - 10% = 90, then - 5 = 85; the lower-priority exclusive one is skipped
+		assert.Equal(t, MustParseMoney("85.00", "USD"), evaluation.EffectivePrice)
+		require.Len(t, evaluation.Applied, 2)
+		assert.Equal(t, MustParseMoney("10.00", "USD"), evaluation.Applied[0].Discount)
+		assert.Equal(t, MustParseMoney("90.00", "USD"), evaluation.Applied[0].PriceAfter)
+		assert.Equal(t, "Five off", evaluation.Applied[1].Name)
+	})
+
+	t.Run("windows, inactive and mismatched currencies are skipped", func(t *testing.T) {
+		future := now.Add(time.Hour)
+		upcoming := percentOff(1, "Upcoming", 10, 0, true)
+		upcoming.StartsAt = &future
+		inactive := percentOff(2, "Inactive", 10, 0, true)
+		inactive.Active = false
+		euros := amountOff(3, "Euros", "5.00", 0, true)
+		euros.Amount = MustParseMoney("5.00", "EUR")
+
+		evaluation, err := EvaluatePromotions(base, target, []*Promotion{upcoming, inactive, euros}, now)
+		require.NoError(t, err)
+		assert.Equal(t, base, evaluation.EffectivePrice)
+		assert.Empty(t, evaluation.Applied)
+
+		evaluation, err = EvaluatePromotions(base, target, []*Promotion{upcoming}, future)
+		require.NoError(t, err)
+		assert.Equal(t, MustParseMoney("90.00", "USD"), evaluation.EffectivePrice)
+	})
+
+	t.Run("discounts never go below zero", func(t *testing.T) {
+		evaluation, err := EvaluatePromotions(base, target, []*Promotion{amountOff(1, "Huge", "150.00", 0, true)}, now)
+		require.NoError(t, err)
+		assert.Equal(t, MustParseMoney("0", "USD"), evaluation.EffectivePrice)
+		assert.Equal(t, base, evaluation.Applied[0].Discount)
+	})
+}
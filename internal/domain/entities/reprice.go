@@ -0,0 +1,56 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// RepriceRule changes a price either by a percentage or by a fixed amount
+// in the price's currency
+type RepriceRule struct {
+	percent *big.Rat
+	amount  *Money
+}
+
+// NewRepriceRule parses exactly one of a decimal percentage, e.g. "-15" or
+// "2.5", and an amount to add, which may be negative
+func NewRepriceRule(percent string, amount *Money) (*RepriceRule, error) {
+	percent = strings.TrimSpace(percent)
+	switch {
+	case percent != "" && amount != nil:
+		return nil, errors.New("set either a percent or an amount, not both")
+	case amount != nil:
+		if amount.IsZero() || amount.MinorUnits() == 0 {
+			return nil, errors.New("amount cannot be zero")
+		}
+		return &RepriceRule{amount: amount}, nil
+	case percent == "":
+		return nil, errors.New("a percent or an amount is required")
+	}
+
+	rat, ok := new(big.Rat).SetString(percent)
+	if !ok {
+		return nil, fmt.Errorf("invalid percent %q", percent)
+	}
+	if rat.Sign() == 0 {
+		return nil, errors.New("percent cannot be zero")
+	}
+	if rat.Cmp(big.NewRat(-100, 1)) <= 0 {
+		return nil, errors.New("percent must be greater than -100")
+	}
+	return &RepriceRule{percent: rat}, nil
+}
+
+// Apply returns price changed by the rule, rounded half away from zero to
+// the currency's minor unit
+func (r *RepriceRule) Apply(price Money) (Money, error) {
+	if r.amount != nil {
+		return price.Add(*r.amount)
+	}
+
+	factor := new(big.Rat).Quo(r.percent, big.NewRat(100, 1))
+	factor.Add(factor, big.NewRat(1, 1))
+	return price.Convert(price.Currency(), factor)
+}
@@ -0,0 +1,65 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRepriceRule(t *testing.T) {
+	amount := MustParseMoney("-5.00", "USD")
+	zero := MustParseMoney("0.00", "USD")
+
+	tests := []struct {
+		name    string
+		percent string
+		amount  *Money
+		wantErr bool
+	}{
+		{name: "percent", percent: "-15"},
+		{name: "fractional percent", percent: " 2.5 "},
+		{name: "amount", amount: &amount},
+		{name: "neither", wantErr: true},
+		{name: "both", percent: "10", amount: &amount, wantErr: true},
+		{name: "not a number", percent: "ten", wantErr: true},
+		{name: "zero percent", percent: "0", wantErr: true},
+		{name: "minus a hundred percent", percent: "-100", wantErr: true},
+		{name: "zero amount", amount: &zero, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewRepriceRule(tt.percent, tt.amount)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRepriceRule_Apply(t *testing.T) {
+	discount, err := NewRepriceRule("-15", nil)
+	require.NoError(t, err)
+
+	price, err := discount.Apply(MustParseMoney("19.99", "USD"))
+	require.NoError(t, err)
+	assert.Equal(t, MustParseMoney("16.99", "USD"), price, "16.9915 rounds to the cent")
+
+	price, err = discount.Apply(MustParseMoney("1999", "JPY"))
+	require.NoError(t, err)
+	assert.Equal(t, MustParseMoney("1699", "JPY"), price)
+
+	amount := MustParseMoney("2.50", "USD")
+	markup, err := NewRepriceRule("", &amount)
+	require.NoError(t, err)
+
+	price, err = markup.Apply(MustParseMoney("10.00", "USD"))
+	require.NoError(t, err)
+	assert.Equal(t, MustParseMoney("12.50", "USD"), price)
+
+	_, err = markup.Apply(MustParseMoney("10.00", "EUR"))
+	assert.Error(t, err, "amounts only apply to prices in their currency")
+}
@@ -0,0 +1,237 @@
+package entities
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+type RevisionStatus string
+
+const (
+	// RevisionPending waits for a reviewer
+	RevisionPending RevisionStatus = "pending"
+	// RevisionApproved was accepted and can be published
+	RevisionApproved RevisionStatus = "approved"
+	// RevisionRejected was turned down by a reviewer
+	RevisionRejected RevisionStatus = "rejected"
+	// RevisionPublished has been applied to the live product
+	RevisionPublished RevisionStatus = "published"
+)
+
+// maxRevisionCommentLength matches the comment columns
+const maxRevisionCommentLength = 500
+
+// ProductChanges are the edits of a product update. Nil fields are left
+// unchanged; a nil attribute value removes that attribute and an empty,
+// non-nil Tags removes every tag.
+type ProductChanges struct {
+	Name        *string    `json:"name,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Category    *string    `json:"category,omitempty"`
+	Brand       *string    `json:"brand,omitempty"`
+	GTIN        *string    `json:"gtin,omitempty"`
+	Price       *Money     `json:"price,omitempty"`
+	Stock       *int       `json:"stock,omitempty"`
+	Attributes  Attributes `json:"attributes"`
+	Tags        []string   `json:"tags"`
+}
+
+// IsEmpty reports whether the changes leave the product as it is
+func (c ProductChanges) IsEmpty() bool {
+	return c.Name == nil && c.Description == nil && c.Category == nil && c.Brand == nil &&
+		c.GTIN == nil && c.Price == nil && c.Stock == nil && c.Attributes == nil && c.Tags == nil
+}
+
+// ProductRevision is a staged edit of a product. It is drafted against the
+// live product, reviewed, and only changes the product once published.
+type ProductRevision struct {
+	ID            uint           `json:"id"`
+	ProductID     uint           `json:"product_id"`
+	Changes       ProductChanges `json:"changes"`
+	Comment       string         `json:"comment,omitempty"`
+	Status        RevisionStatus `json:"status"`
+	Author        string         `json:"author,omitempty"`
+	Reviewer      string         `json:"reviewer,omitempty"`
+	ReviewComment string         `json:"review_comment,omitempty"`
+	ReviewedAt    *time.Time     `json:"reviewed_at,omitempty"`
+	Publisher     string         `json:"publisher,omitempty"`
+	PublishedAt   *time.Time     `json:"published_at,omitempty"`
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+
+	// BaseUpdatedAt is the version of the live product the revision was
+	// drafted against
+	BaseUpdatedAt time.Time `json:"base_updated_at"`
+}
+
+// NewProductRevision drafts changes to product for review
+func NewProductRevision(product *Product, changes ProductChanges, comment, author string, now time.Time) (*ProductRevision, error) {
+	if changes.IsEmpty() {
+		return nil, errors.New("a revision must change at least one field")
+	}
+	comment, err := validateRevisionComment(comment)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProductRevision{
+		ProductID:     product.ID,
+		Changes:       changes,
+		Comment:       comment,
+		Status:        RevisionPending,
+		Author:        author,
+		BaseUpdatedAt: product.UpdatedAt,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
+
+func (r *ProductRevision) IsPending() bool {
+	return r.Status == RevisionPending
+}
+
+func (r *ProductRevision) IsApproved() bool {
+	return r.Status == RevisionApproved
+}
+
+// IsOpen reports whether the revision can still be published
+func (r *ProductRevision) IsOpen() bool {
+	return r.IsPending() || r.IsApproved()
+}
+
+// IsAuthoredBy reports whether actor drafted the revision. Revisions drafted
+// without a known actor have no author to compare with.
+func (r *ProductRevision) IsAuthoredBy(actor string) bool {
+	return r.Author != "" && r.Author == actor
+}
+
+// IsStale reports whether the live product changed after the revision was
+// drafted, so the reviewed diff no longer describes what publishing does
+func (r *ProductRevision) IsStale(live *Product) bool {
+	return !live.UpdatedAt.Equal(r.BaseUpdatedAt)
+}
+
+// Approve accepts a pending revision; authors cannot approve their own
+func (r *ProductRevision) Approve(reviewer, comment string, now time.Time) error {
+	return r.review(RevisionApproved, reviewer, comment, now)
+}
+
+// Reject turns down a pending revision
+func (r *ProductRevision) Reject(reviewer, comment string, now time.Time) error {
+	return r.review(RevisionRejected, reviewer, comment, now)
+}
+
+func (r *ProductRevision) review(status RevisionStatus, reviewer, comment string, now time.Time) error {
+	if !r.IsPending() {
+		return fmt.Errorf("revision is %s, only pending revisions can be reviewed", r.Status)
+	}
+	if status == RevisionApproved && r.IsAuthoredBy(reviewer) {
+		return errors.New("authors cannot approve their own revisions")
+	}
+	comment, err := validateRevisionComment(comment)
+	if err != nil {
+		return err
+	}
+
+	r.Status = status
+	r.Reviewer = reviewer
+	r.ReviewComment = comment
+	r.ReviewedAt = &now
+	r.UpdatedAt = now
+	return nil
+}
+
+// MarkPublished records that an approved revision was applied to the product
+func (r *ProductRevision) MarkPublished(publisher string, now time.Time) error {
+	if !r.IsApproved() {
+		return fmt.Errorf("revision is %s, only approved revisions can be published", r.Status)
+	}
+	r.Status = RevisionPublished
+	r.Publisher = publisher
+	r.PublishedAt = &now
+	r.UpdatedAt = now
+	return nil
+}
+
+func validateRevisionComment(comment string) (string, error) {
+	comment = strings.TrimSpace(comment)
+	if len(comment) > maxRevisionCommentLength {
+		return "", fmt.Errorf("comment must be at most %d characters", maxRevisionCommentLength)
+	}
+	return comment, nil
+}
+
+// FieldChange is one field that differs between the live product and a
+// revision of it. Attributes are compared per key as "attributes.<key>".
+type FieldChange struct {
+	Field    string      `json:"field"`
+	Live     interface{} `json:"live"`
+	Proposed interface{} `json:"proposed"`
+}
+
+// DiffProducts lists the editable fields that differ between live and
+// proposed, in a stable order
+func DiffProducts(live, proposed *Product) []FieldChange {
+	var diff []FieldChange
+	add := func(field string, liveValue, proposedValue interface{}) {
+		diff = append(diff, FieldChange{Field: field, Live: liveValue, Proposed: proposedValue})
+	}
+
+	if live.Name != proposed.Name {
+		add("name", live.Name, proposed.Name)
+	}
+	if live.Description != proposed.Description {
+		add("description", live.Description, proposed.Description)
+	}
+	if live.CategoryID != proposed.CategoryID || live.Category != proposed.Category {
+		add("category", live.Category, proposed.Category)
+	}
+	if live.Brand != proposed.Brand {
+		add("brand", live.Brand, proposed.Brand)
+	}
+	if live.GTIN != proposed.GTIN {
+		add("gtin", live.GTIN, proposed.GTIN)
+	}
+	if !live.Price.Equal(proposed.Price) {
+		add("price", live.Price, proposed.Price)
+	}
+	if live.Stock != proposed.Stock {
+		add("stock", live.Stock, proposed.Stock)
+	}
+
+	keys := make([]string, 0, len(live.Attributes)+len(proposed.Attributes))
+	for key := range live.Attributes {
+		keys = append(keys, key)
+	}
+	for key := range proposed.Attributes {
+		if _, ok := live.Attributes[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		liveValue, proposedValue := live.Attributes[key], proposed.Attributes[key]
+		if !sameAttributeValue(liveValue, proposedValue) {
+			add("attributes."+key, liveValue, proposedValue)
+		}
+	}
+
+	if !slices.Equal(live.Tags, proposed.Tags) {
+		add("tags", live.Tags, proposed.Tags)
+	}
+	return diff
+}
+
+// sameAttributeValue compares attribute values by their JSON form, as stored
+// values decode to float64 while freshly normalized numbers are json.Number
+func sameAttributeValue(a, b interface{}) bool {
+	encodedA, errA := json.Marshal(a)
+	encodedB, errB := json.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(encodedA, encodedB)
+}
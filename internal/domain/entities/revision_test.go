@@ -0,0 +1,112 @@
+package entities
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProductRevision(t *testing.T) {
+	updatedAt := time.Now().Add(-time.Hour)
+	product := &Product{ID: 3, UpdatedAt: updatedAt}
+	name := "Laptop Pro"
+
+	revision, err := NewProductRevision(product, ProductChanges{Name: &name}, " rename ", "alice", time.Now())
+
+	require.NoError(t, err)
+	assert.Equal(t, RevisionPending, revision.Status)
+	assert.Equal(t, uint(3), revision.ProductID)
+	assert.Equal(t, "rename", revision.Comment)
+	assert.Equal(t, "alice", revision.Author)
+	assert.Equal(t, updatedAt, revision.BaseUpdatedAt)
+
+	_, err = NewProductRevision(product, ProductChanges{}, "", "alice", time.Now())
+	assert.ErrorContains(t, err, "at least one field")
+}
+
+func TestProductRevision_Review(t *testing.T) {
+	now := time.Now()
+
+	t.Run("approve", func(t *testing.T) {
+		revision := &ProductRevision{Status: RevisionPending, Author: "alice"}
+		require.NoError(t, revision.Approve("bob", "looks good", now))
+		assert.Equal(t, RevisionApproved, revision.Status)
+		assert.Equal(t, "bob", revision.Reviewer)
+		assert.Equal(t, "looks good", revision.ReviewComment)
+		assert.Equal(t, &now, revision.ReviewedAt)
+	})
+
+	t.Run("authors cannot approve their own revision", func(t *testing.T) {
+		revision := &ProductRevision{Status: RevisionPending, Author: "alice"}
+		assert.Error(t, revision.Approve("alice", "", now))
+		assert.Equal(t, RevisionPending, revision.Status)
+	})
+
+	t.Run("authors can reject their own revision", func(t *testing.T) {
+		revision := &ProductRevision{Status: RevisionPending, Author: "alice"}
+		require.NoError(t, revision.Reject("alice", "withdrawn", now))
+		assert.Equal(t, RevisionRejected, revision.Status)
+	})
+
+	t.Run("only pending revisions are reviewed", func(t *testing.T) {
+		revision := &ProductRevision{Status: RevisionRejected, Author: "alice"}
+		assert.Error(t, revision.Approve("bob", "", now))
+	})
+
+	t.Run("only approved revisions are published", func(t *testing.T) {
+		pending := &ProductRevision{Status: RevisionPending}
+		assert.Error(t, pending.MarkPublished("bob", now))
+
+		approved := &ProductRevision{Status: RevisionApproved}
+		require.NoError(t, approved.MarkPublished("bob", now))
+		assert.Equal(t, RevisionPublished, approved.Status)
+		assert.Equal(t, "bob", approved.Publisher)
+	})
+}
+
+func TestProductRevision_IsStale(t *testing.T) {
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	revision := &ProductRevision{BaseUpdatedAt: base}
+
+	assert.False(t, revision.IsStale(&Product{UpdatedAt: base.In(time.Local)}))
+	assert.True(t, revision.IsStale(&Product{UpdatedAt: base.Add(time.Second)}))
+}
+
+func TestProductChanges_JSONKeepsEmptyTags(t *testing.T) {
+	data, err := json.Marshal(ProductChanges{Tags: []string{}})
+	require.NoError(t, err)
+
+	var decoded ProductChanges
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.NotNil(t, decoded.Tags)
+	assert.False(t, decoded.IsEmpty())
+}
+
+func TestDiffProducts(t *testing.T) {
+	live := &Product{
+		Name:       "Laptop",
+		Category:   "Electronics",
+		Price:      MustParseMoney("999.00", "USD"),
+		Stock:      5,
+		Attributes: Attributes{"storage_gb": float64(256), "color": "silver"},
+		Tags:       []string{"sale"},
+	}
+	proposed := *live
+	proposed.Name = "Laptop Pro"
+	proposed.Price = MustParseMoney("1099.00", "USD")
+	proposed.Attributes = Attributes{"storage_gb": json.Number("256"), "weight_kg": json.Number("1.4")}
+	proposed.Tags = []string{"new", "sale"}
+
+	diff := DiffProducts(live, &proposed)
+
+	assert.Equal(t, []FieldChange{
+		{Field: "name", Live: "Laptop", Proposed: "Laptop Pro"},
+		{Field: "price", Live: MustParseMoney("999.00", "USD"), Proposed: MustParseMoney("1099.00", "USD")},
+		{Field: "attributes.color", Live: "silver", Proposed: nil},
+		{Field: "attributes.weight_kg", Live: nil, Proposed: json.Number("1.4")},
+		{Field: "tags", Live: []string{"sale"}, Proposed: []string{"new", "sale"}},
+	}, diff)
+}
@@ -0,0 +1,217 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	maxSKUPatternLength  = 200
+	maxSKUTemplateLength = 100
+
+	// defaultSKUCodeWidth is how many characters {BRAND} and {CATEGORY}
+	// take from the name, and defaultSKUSequenceWidth how many digits {SEQ}
+	// is zero-padded to
+	defaultSKUCodeWidth     = 3
+	defaultSKUSequenceWidth = 4
+	maxSKUCodeWidth         = 10
+	maxSKUSequenceWidth     = 12
+)
+
+// SKU template placeholders
+const (
+	SKUPlaceholderBrand    = "BRAND"
+	SKUPlaceholderCategory = "CATEGORY"
+	SKUPlaceholderSequence = "SEQ"
+)
+
+// ValidateSKU checks the length of a SKU; categories may restrict their
+// SKUs further with a pattern
+func ValidateSKU(sku string) error {
+	sku = strings.TrimSpace(sku)
+	if sku == "" {
+		return errors.New("SKU is required")
+	}
+	if len(sku) < 3 {
+		return errors.New("SKU must be at least 3 characters long")
+	}
+	if len(sku) > 50 {
+		return errors.New("SKU must be less than 50 characters")
+	}
+	return nil
+}
+
+// CompileSKUPattern compiles the pattern a category's SKUs must match. The
+// pattern must match the whole SKU, as if it were wrapped in ^ and $.
+func CompileSKUPattern(pattern string) (*regexp.Regexp, error) {
+	if len(pattern) > maxSKUPatternLength {
+		return nil, fmt.Errorf("SKU pattern must be at most %d characters", maxSKUPatternLength)
+	}
+	compiled, err := regexp.Compile(`^(?:` + pattern + `)$`)
+	if err != nil {
+		return nil, fmt.Errorf("SKU pattern is not a valid regular expression: %w", err)
+	}
+	return compiled, nil
+}
+
+// skuTemplatePart is a literal run of a SKU template or one placeholder
+type skuTemplatePart struct {
+	literal     string
+	placeholder string
+	width       int
+}
+
+// SKUTemplate generates SKUs from placeholders between literal characters,
+// e.g. "{BRAND}-{CATEGORY}-{SEQ}" gives "APP-LAP-0042":
+//   - {BRAND} and {CATEGORY} are the first letters and digits of the brand
+//     and category names, uppercased
+//   - {SEQ} is the category's next sequence number, zero-padded
+//
+// A width after a colon, as in {BRAND:4} or {SEQ:6}, overrides the default
+// of 3 characters for names and 4 digits for the sequence. Every template
+// holds {SEQ}, so that each SKU it generates is new.
+type SKUTemplate struct {
+	source string
+	parts  []skuTemplatePart
+}
+
+// ParseSKUTemplate parses a SKU template. Literals can hold letters, digits,
+// hyphens, underscores and dots.
+func ParseSKUTemplate(template string) (*SKUTemplate, error) {
+	if len(template) > maxSKUTemplateLength {
+		return nil, fmt.Errorf("SKU template must be at most %d characters", maxSKUTemplateLength)
+	}
+
+	parsed := &SKUTemplate{source: template}
+	hasSequence := false
+	for rest := template; rest != ""; {
+		start := strings.IndexByte(rest, '{')
+		if start != 0 {
+			literal := rest
+			if start > 0 {
+				literal = rest[:start]
+			}
+			if err := validateSKUTemplateLiteral(literal); err != nil {
+				return nil, err
+			}
+			parsed.parts = append(parsed.parts, skuTemplatePart{literal: literal})
+			rest = rest[len(literal):]
+			continue
+		}
+
+		end := strings.IndexByte(rest, '}')
+		if end < 0 {
+			return nil, errors.New("SKU template has an unclosed {")
+		}
+		part, err := parseSKUPlaceholder(rest[1:end])
+		if err != nil {
+			return nil, err
+		}
+		hasSequence = hasSequence || part.placeholder == SKUPlaceholderSequence
+		parsed.parts = append(parsed.parts, part)
+		rest = rest[end+1:]
+	}
+
+	if !hasSequence {
+		return nil, fmt.Errorf("SKU template must contain {%s}", SKUPlaceholderSequence)
+	}
+	return parsed, nil
+}
+
+func validateSKUTemplateLiteral(literal string) error {
+	for _, r := range literal {
+		if !isSKUCodeRune(r) && r != '-' && r != '_' && r != '.' {
+			return fmt.Errorf("SKU template cannot contain %q outside a placeholder", r)
+		}
+	}
+	return nil
+}
+
+func parseSKUPlaceholder(placeholder string) (skuTemplatePart, error) {
+	name, widthText, hasWidth := strings.Cut(placeholder, ":")
+
+	var width, maxWidth int
+	switch name {
+	case SKUPlaceholderBrand, SKUPlaceholderCategory:
+		width, maxWidth = defaultSKUCodeWidth, maxSKUCodeWidth
+	case SKUPlaceholderSequence:
+		width, maxWidth = defaultSKUSequenceWidth, maxSKUSequenceWidth
+	default:
+		return skuTemplatePart{}, fmt.Errorf("unknown SKU template placeholder {%s}, use {%s}, {%s} or {%s}",
+			placeholder, SKUPlaceholderBrand, SKUPlaceholderCategory, SKUPlaceholderSequence)
+	}
+
+	if hasWidth {
+		parsed, err := strconv.Atoi(widthText)
+		if err != nil || parsed < 1 || parsed > maxWidth {
+			return skuTemplatePart{}, fmt.Errorf("width of {%s} must be between 1 and %d", name, maxWidth)
+		}
+		width = parsed
+	}
+	return skuTemplatePart{placeholder: name, width: width}, nil
+}
+
+// UsesBrand reports whether the template needs the product's brand
+func (t *SKUTemplate) UsesBrand() bool {
+	for _, part := range t.parts {
+		if part.placeholder == SKUPlaceholderBrand {
+			return true
+		}
+	}
+	return false
+}
+
+// Render generates the SKU of the product with the given brand and category
+// names and sequence number
+func (t *SKUTemplate) Render(brand, category string, sequence int64) (string, error) {
+	var sku strings.Builder
+	for _, part := range t.parts {
+		switch part.placeholder {
+		case "":
+			sku.WriteString(strings.ToUpper(part.literal))
+		case SKUPlaceholderBrand, SKUPlaceholderCategory:
+			name := brand
+			if part.placeholder == SKUPlaceholderCategory {
+				name = category
+			}
+			code := skuCode(name, part.width)
+			if code == "" {
+				return "", fmt.Errorf("{%s} needs a %s name with letters or digits", part.placeholder, strings.ToLower(part.placeholder))
+			}
+			sku.WriteString(code)
+		case SKUPlaceholderSequence:
+			fmt.Fprintf(&sku, "%0*d", part.width, sequence)
+		}
+	}
+
+	if err := ValidateSKU(sku.String()); err != nil {
+		return "", fmt.Errorf("generated SKU %s is invalid: %w", sku.String(), err)
+	}
+	return sku.String(), nil
+}
+
+// String returns the template as written
+func (t *SKUTemplate) String() string {
+	return t.source
+}
+
+// skuCode takes the first width ASCII letters and digits of name, uppercased
+func skuCode(name string, width int) string {
+	var code strings.Builder
+	for _, r := range strings.ToUpper(name) {
+		if code.Len() == width {
+			break
+		}
+		if isSKUCodeRune(r) {
+			code.WriteRune(r)
+		}
+	}
+	return code.String()
+}
+
+func isSKUCodeRune(r rune) bool {
+	return (r >= 'A' && r <= 'Z') || (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9')
+}
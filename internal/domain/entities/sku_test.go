@@ -0,0 +1,79 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSKUTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		wantErr  bool
+	}{
+		{name: "brand, category and sequence", template: "{BRAND}-{CATEGORY}-{SEQ}"},
+		{name: "widths", template: "{BRAND:4}_{SEQ:6}"},
+		{name: "sequence only", template: "SKU.{SEQ}"},
+		{name: "no sequence", template: "{BRAND}-{CATEGORY}", wantErr: true},
+		{name: "unknown placeholder", template: "{COLOR}-{SEQ}", wantErr: true},
+		{name: "unclosed placeholder", template: "{BRAND-{SEQ}", wantErr: true},
+		{name: "width out of range", template: "{SEQ:13}", wantErr: true},
+		{name: "invalid literal", template: "{BRAND} {SEQ}", wantErr: true},
+		{name: "stray brace", template: "SKU}-{SEQ}", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseSKUTemplate(tt.template)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSKUTemplate_Render(t *testing.T) {
+	template, err := ParseSKUTemplate("{BRAND}-{CATEGORY:4}-{SEQ}")
+	require.NoError(t, err)
+	assert.True(t, template.UsesBrand())
+
+	sku, err := template.Render("Hewlett-Packard", "Lap tops", 42)
+	require.NoError(t, err)
+	assert.Equal(t, "HEW-LAPT-0042", sku)
+
+	sku, err = template.Render("HP", "Laptops", 123456)
+	require.NoError(t, err)
+	assert.Equal(t, "HP-LAPT-123456", sku, "short names and large sequences are not cut")
+
+	_, err = template.Render("", "Laptops", 1)
+	assert.Error(t, err, "the template needs a brand")
+
+	lower, err := ParseSKUTemplate("sku-{SEQ:2}")
+	require.NoError(t, err)
+	assert.False(t, lower.UsesBrand())
+	sku, err = lower.Render("", "", 7)
+	require.NoError(t, err)
+	assert.Equal(t, "SKU-07", sku)
+}
+
+func TestCategory_SKURules(t *testing.T) {
+	category, err := NewCategory("Laptops", nil)
+	require.NoError(t, err)
+	assert.NoError(t, category.CheckSKU("anything"), "categories without a pattern accept any SKU")
+
+	assert.Error(t, category.SetSKURules("LAP-(", ""))
+	assert.Error(t, category.SetSKURules("", "{BRAND}"))
+
+	require.NoError(t, category.SetSKURules(`LAP-\d{4}`, "LAP-{SEQ}"))
+	assert.NoError(t, category.CheckSKU("LAP-0042"))
+	assert.Error(t, category.CheckSKU("LAP-0042-X"), "the pattern matches the whole SKU")
+	assert.Error(t, category.CheckSKU("PHN-0042"))
+
+	require.NoError(t, category.SetSKURules("", ""))
+	assert.Empty(t, category.SKUPattern)
+	assert.Empty(t, category.SKUTemplate)
+}
@@ -0,0 +1,73 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	maxTagLength   = 50
+	maxProductTags = 20
+)
+
+// Tag is an ad-hoc label such as "summer-sale" that merchandisers attach to
+// any number of products. Names are slugs, so "Summer Sale" and
+// "summer-sale" are the same tag.
+type Tag struct {
+	ID           uint      `json:"id"`
+	Name         string    `json:"name"`
+	ProductCount int64     `json:"product_count"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// NewTag creates a tag under its normalized name
+func NewTag(name string) (*Tag, error) {
+	tag := &Tag{CreatedAt: time.Now()}
+	if err := tag.Rename(name); err != nil {
+		return nil, err
+	}
+	return tag, nil
+}
+
+// Rename changes the tag's name, normalizing it like NormalizeTag
+func (t *Tag) Rename(name string) error {
+	normalized, err := NormalizeTag(name)
+	if err != nil {
+		return err
+	}
+	t.Name = normalized
+	return nil
+}
+
+// NormalizeTag turns name into its slug form, e.g. "New Arrivals" becomes
+// "new-arrivals"
+func NormalizeTag(name string) (string, error) {
+	tag := Slugify(name)
+	if tag == "" {
+		return "", errors.New("tag must contain letters or digits")
+	}
+	if len(tag) > maxTagLength {
+		return "", fmt.Errorf("tag must be at most %d characters", maxTagLength)
+	}
+	return tag, nil
+}
+
+// NormalizeTags normalizes each tag and drops duplicates, keeping the first
+// occurrence. The result is never nil.
+func NormalizeTags(tags []string) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		name, err := NormalizeTag(tag)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tag %q: %w", tag, err)
+		}
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		normalized = append(normalized, name)
+	}
+	return normalized, nil
+}
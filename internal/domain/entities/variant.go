@@ -0,0 +1,339 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	maxOptionNameLength  = 50
+	maxOptionValueLength = 50
+	maxOptionsPerProduct = 3
+	maxValuesPerOption   = 50
+	maxVariantsPerMatrix = 500
+)
+
+// ProductOption is an axis along which a parent product varies, e.g. Size
+// with the values S, M and L. Values keep the order they were given in.
+type ProductOption struct {
+	ID        uint     `json:"id"`
+	ProductID uint     `json:"product_id"`
+	Name      string   `json:"name"`
+	Position  int      `json:"position"`
+	Values    []string `json:"values"`
+}
+
+// ProductVariant is one purchasable combination of option values of a parent
+// product, with its own SKU and stock. Without a price override it sells at
+// the parent's price.
+type ProductVariant struct {
+	ID            uint              `json:"id"`
+	ProductID     uint              `json:"product_id"`
+	SKU           string            `json:"sku"`
+	Options       map[string]string `json:"options"`
+	PriceOverride Money             `json:"price_override"`
+	Stock         int               `json:"stock"`
+	Active        bool              `json:"active"`
+	CreatedAt     time.Time         `json:"created_at"`
+	UpdatedAt     time.Time         `json:"updated_at"`
+}
+
+// NewProductOptions validates a product's option axes, assigning positions
+// in the given order
+func NewProductOptions(productID uint, definitions []ProductOption) ([]*ProductOption, error) {
+	if len(definitions) > maxOptionsPerProduct {
+		return nil, fmt.Errorf("a product can have at most %d options", maxOptionsPerProduct)
+	}
+
+	seenNames := make(map[string]bool, len(definitions))
+	options := make([]*ProductOption, 0, len(definitions))
+	for i, definition := range definitions {
+		name := strings.TrimSpace(definition.Name)
+		if name == "" {
+			return nil, errors.New("option name is required")
+		}
+		if len(name) > maxOptionNameLength {
+			return nil, fmt.Errorf("option name must be less than %d characters", maxOptionNameLength)
+		}
+		if seenNames[strings.ToLower(name)] {
+			return nil, fmt.Errorf("option %q is defined twice", name)
+		}
+		seenNames[strings.ToLower(name)] = true
+
+		if len(definition.Values) == 0 {
+			return nil, fmt.Errorf("option %q needs at least one value", name)
+		}
+		if len(definition.Values) > maxValuesPerOption {
+			return nil, fmt.Errorf("option %q can have at most %d values", name, maxValuesPerOption)
+		}
+
+		seenValues := make(map[string]bool, len(definition.Values))
+		values := make([]string, 0, len(definition.Values))
+		for _, value := range definition.Values {
+			value = strings.TrimSpace(value)
+			if value == "" {
+				return nil, fmt.Errorf("option %q has an empty value", name)
+			}
+			if len(value) > maxOptionValueLength {
+				return nil, fmt.Errorf("option values must be less than %d characters", maxOptionValueLength)
+			}
+			if seenValues[strings.ToLower(value)] {
+				return nil, fmt.Errorf("option %q lists %q twice", name, value)
+			}
+			seenValues[strings.ToLower(value)] = true
+			values = append(values, value)
+		}
+
+		options = append(options, &ProductOption{
+			ProductID: productID,
+			Name:      name,
+			Position:  i,
+			Values:    values,
+		})
+	}
+
+	return options, nil
+}
+
+// NewProductVariant creates a variant of parent. options must pick exactly
+// one valid value for every option axis of the parent.
+func NewProductVariant(parent *Product, axes []*ProductOption, sku string, options map[string]string, priceOverride Money, stock int) (*ProductVariant, error) {
+	if err := ValidateSKU(sku); err != nil {
+		return nil, err
+	}
+	if err := validateStock(stock); err != nil {
+		return nil, err
+	}
+	if !priceOverride.IsZero() {
+		if err := validatePrice(priceOverride); err != nil {
+			return nil, err
+		}
+	}
+
+	normalized, err := normalizeVariantOptions(axes, options)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &ProductVariant{
+		ProductID:     parent.ID,
+		SKU:           strings.TrimSpace(sku),
+		Options:       normalized,
+		PriceOverride: priceOverride,
+		Stock:         stock,
+		Active:        true,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
+
+// Price returns the price the variant sells at
+func (v *ProductVariant) Price(parentPrice Money) Money {
+	if v.PriceOverride.IsZero() {
+		return parentPrice
+	}
+	return v.PriceOverride
+}
+
+// IsAvailable reports whether the variant can be sold
+func (v *ProductVariant) IsAvailable() bool {
+	return v.Active && v.Stock > 0
+}
+
+// UpdatePriceOverride sets the variant's own price; a zero Money clears it
+func (v *ProductVariant) UpdatePriceOverride(price Money) error {
+	if !price.IsZero() {
+		if err := validatePrice(price); err != nil {
+			return err
+		}
+	}
+	v.PriceOverride = price
+	v.UpdatedAt = time.Now()
+	return nil
+}
+
+// UpdateStock sets the variant's stock
+func (v *ProductVariant) UpdateStock(stock int) error {
+	if err := validateStock(stock); err != nil {
+		return err
+	}
+	v.Stock = stock
+	v.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetActive enables or disables the variant
+func (v *ProductVariant) SetActive(active bool) {
+	v.Active = active
+	v.UpdatedAt = time.Now()
+}
+
+// OptionKey identifies the variant's combination of option values
+func (v *ProductVariant) OptionKey() string {
+	return optionKey(v.Options)
+}
+
+// GenerateVariantMatrix creates a variant for every combination of option
+// values of parent that does not have one yet. SKUs are the parent SKU
+// followed by each value in option order, e.g. TSHIRT-M-RED.
+func GenerateVariantMatrix(parent *Product, axes []*ProductOption, existing []*ProductVariant, stock int) ([]*ProductVariant, error) {
+	if len(axes) == 0 {
+		return nil, errors.New("the product has no options to generate variants from")
+	}
+
+	total := 1
+	for _, axis := range axes {
+		total *= len(axis.Values)
+	}
+	if total > maxVariantsPerMatrix {
+		return nil, fmt.Errorf("the options produce %d variants; at most %d are allowed", total, maxVariantsPerMatrix)
+	}
+
+	taken := make(map[string]bool, len(existing))
+	for _, variant := range existing {
+		taken[variant.OptionKey()] = true
+	}
+
+	ordered := sortedAxes(axes)
+	combination := make(map[string]string, len(ordered))
+	var generated []*ProductVariant
+	var walk func(depth int) error
+	walk = func(depth int) error {
+		if depth == len(ordered) {
+			if taken[optionKey(combination)] {
+				return nil
+			}
+			variant, err := NewProductVariant(parent, axes, variantSKU(parent.SKU, ordered, combination), combination, Money{}, stock)
+			if err != nil {
+				return err
+			}
+			generated = append(generated, variant)
+			return nil
+		}
+		for _, value := range ordered[depth].Values {
+			combination[ordered[depth].Name] = value
+			if err := walk(depth + 1); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk(0); err != nil {
+		return nil, err
+	}
+
+	return generated, nil
+}
+
+// CheckVariantsFitOptions reports an error when an existing variant does not
+// pick exactly one valid value for every axis, e.g. after an axis was removed
+func CheckVariantsFitOptions(axes []*ProductOption, variants []*ProductVariant) error {
+	for _, variant := range variants {
+		if _, err := normalizeVariantOptions(axes, variant.Options); err != nil {
+			return fmt.Errorf("variant %s no longer fits the options: %w", variant.SKU, err)
+		}
+	}
+	return nil
+}
+
+// VariantSummary is the availability of a parent product derived from its variants
+type VariantSummary struct {
+	VariantCount   int
+	AvailableCount int
+	TotalStock     int
+}
+
+// SummarizeVariants derives a parent's stock and availability from its variants
+func SummarizeVariants(variants []*ProductVariant) VariantSummary {
+	summary := VariantSummary{VariantCount: len(variants)}
+	for _, variant := range variants {
+		if !variant.Active {
+			continue
+		}
+		summary.TotalStock += variant.Stock
+		if variant.IsAvailable() {
+			summary.AvailableCount++
+		}
+	}
+	return summary
+}
+
+// normalizeVariantOptions checks options against the axes and returns them
+// keyed by the axes' canonical names and values
+func normalizeVariantOptions(axes []*ProductOption, options map[string]string) (map[string]string, error) {
+	if len(axes) == 0 {
+		return nil, errors.New("the product has no options; define options before adding variants")
+	}
+	if len(options) != len(axes) {
+		return nil, fmt.Errorf("a variant must pick a value for each of the %d options", len(axes))
+	}
+
+	normalized := make(map[string]string, len(axes))
+	for name, value := range options {
+		axis := findAxis(axes, name)
+		if axis == nil {
+			return nil, fmt.Errorf("unknown option %q", name)
+		}
+		canonical := ""
+		for _, candidate := range axis.Values {
+			if strings.EqualFold(candidate, strings.TrimSpace(value)) {
+				canonical = candidate
+				break
+			}
+		}
+		if canonical == "" {
+			return nil, fmt.Errorf("%q is not a value of option %q", value, axis.Name)
+		}
+		if _, duplicate := normalized[axis.Name]; duplicate {
+			return nil, fmt.Errorf("option %q is given twice", axis.Name)
+		}
+		normalized[axis.Name] = canonical
+	}
+	return normalized, nil
+}
+
+func findAxis(axes []*ProductOption, name string) *ProductOption {
+	for _, axis := range axes {
+		if strings.EqualFold(axis.Name, strings.TrimSpace(name)) {
+			return axis
+		}
+	}
+	return nil
+}
+
+func sortedAxes(axes []*ProductOption) []*ProductOption {
+	ordered := append([]*ProductOption(nil), axes...)
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].Position < ordered[j].Position })
+	return ordered
+}
+
+// optionKey is a case-insensitive, order-independent key for a combination
+func optionKey(options map[string]string) string {
+	parts := make([]string, 0, len(options))
+	for name, value := range options {
+		parts = append(parts, strings.ToLower(name)+"="+strings.ToLower(value))
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ";")
+}
+
+func variantSKU(parentSKU string, axes []*ProductOption, combination map[string]string) string {
+	var sku strings.Builder
+	sku.WriteString(strings.TrimSpace(parentSKU))
+	for _, axis := range axes {
+		sku.WriteByte('-')
+		for _, r := range strings.ToUpper(combination[axis.Name]) {
+			switch {
+			case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+				sku.WriteRune(r)
+			case r == ' ' || r == '-' || r == '_' || r == '/' || r == '.':
+				sku.WriteByte('_')
+			}
+		}
+	}
+	return sku.String()
+}
@@ -0,0 +1,128 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func tshirtWithOptions(t *testing.T) (*Product, []*ProductOption) {
+	t.Helper()
+
+	parent, err := NewProduct("T-Shirt", "Cotton tee", "TSHIRT", "Apparel", "Acme", MustParseMoney("20.00", "USD"), 0)
+	require.NoError(t, err)
+	parent.ID = 1
+
+	options, err := NewProductOptions(parent.ID, []ProductOption{
+		{Name: "Size", Values: []string{"S", "M", "L"}},
+		{Name: "Color", Values: []string{"Red", "Navy Blue"}},
+	})
+	require.NoError(t, err)
+	return parent, options
+}
+
+func TestNewProductOptions(t *testing.T) {
+	tests := []struct {
+		name        string
+		definitions []ProductOption
+		expectErr   bool
+	}{
+		{"valid", []ProductOption{{Name: "Size", Values: []string{"S", "M"}}}, false},
+		{"no options clears them", nil, false},
+		{"missing name", []ProductOption{{Name: " ", Values: []string{"S"}}}, true},
+		{"no values", []ProductOption{{Name: "Size"}}, true},
+		{"duplicate option", []ProductOption{{Name: "Size", Values: []string{"S"}}, {Name: "size", Values: []string{"M"}}}, true},
+		{"duplicate value", []ProductOption{{Name: "Size", Values: []string{"S", "s"}}}, true},
+		{"too many options", []ProductOption{
+			{Name: "A", Values: []string{"1"}}, {Name: "B", Values: []string{"1"}},
+			{Name: "C", Values: []string{"1"}}, {Name: "D", Values: []string{"1"}},
+		}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewProductOptions(1, tt.definitions)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNewProductVariant(t *testing.T) {
+	parent, options := tshirtWithOptions(t)
+
+	variant, err := NewProductVariant(parent, options, "TSHIRT-M-RED", map[string]string{"size": "m", "COLOR": "red"}, Money{}, 4)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"Size": "M", "Color": "Red"}, variant.Options, "options are stored under their canonical names")
+	assert.Equal(t, parent.Price, variant.Price(parent.Price))
+	assert.True(t, variant.IsAvailable())
+
+	_, err = NewProductVariant(parent, options, "TSHIRT-M", map[string]string{"Size": "M"}, Money{}, 1)
+	assert.Error(t, err, "every option needs a value")
+
+	_, err = NewProductVariant(parent, options, "TSHIRT-XL-RED", map[string]string{"Size": "XL", "Color": "Red"}, Money{}, 1)
+	assert.Error(t, err, "values must come from the option")
+
+	_, err = NewProductVariant(parent, nil, "TSHIRT-1", map[string]string{}, Money{}, 1)
+	assert.Error(t, err, "a product without options cannot have variants")
+}
+
+func TestProductVariant_PriceOverride(t *testing.T) {
+	parent, options := tshirtWithOptions(t)
+
+	variant, err := NewProductVariant(parent, options, "TSHIRT-L-RED", map[string]string{"Size": "L", "Color": "Red"}, MustParseMoney("24.00", "USD"), 1)
+	require.NoError(t, err)
+	assert.Equal(t, MustParseMoney("24.00", "USD"), variant.Price(parent.Price))
+
+	require.NoError(t, variant.UpdatePriceOverride(Money{}))
+	assert.Equal(t, parent.Price, variant.Price(parent.Price))
+
+	assert.Error(t, variant.UpdatePriceOverride(MustParseMoney("-1.00", "USD")))
+}
+
+func TestGenerateVariantMatrix(t *testing.T) {
+	parent, options := tshirtWithOptions(t)
+
+	existing, err := NewProductVariant(parent, options, "TSHIRT-S-RED", map[string]string{"Size": "S", "Color": "Red"}, Money{}, 2)
+	require.NoError(t, err)
+
+	generated, err := GenerateVariantMatrix(parent, options, []*ProductVariant{existing}, 10)
+	require.NoError(t, err)
+	require.Len(t, generated, 5, "3 sizes x 2 colors minus the existing variant")
+
+	skus := make([]string, 0, len(generated))
+	for _, variant := range generated {
+		skus = append(skus, variant.SKU)
+		assert.Equal(t, 10, variant.Stock)
+	}
+	assert.Equal(t, []string{"TSHIRT-S-NAVY_BLUE", "TSHIRT-M-RED", "TSHIRT-M-NAVY_BLUE", "TSHIRT-L-RED", "TSHIRT-L-NAVY_BLUE"}, skus)
+
+	_, err = GenerateVariantMatrix(parent, nil, nil, 0)
+	assert.Error(t, err)
+}
+
+func TestCheckVariantsFitOptions(t *testing.T) {
+	parent, options := tshirtWithOptions(t)
+
+	variant, err := NewProductVariant(parent, options, "TSHIRT-S-RED", map[string]string{"Size": "S", "Color": "Red"}, Money{}, 2)
+	require.NoError(t, err)
+
+	assert.NoError(t, CheckVariantsFitOptions(options, []*ProductVariant{variant}))
+	assert.Error(t, CheckVariantsFitOptions(options[:1], []*ProductVariant{variant}), "dropping an axis orphans the variant")
+}
+
+func TestSummarizeVariants(t *testing.T) {
+	summary := SummarizeVariants([]*ProductVariant{
+		{Stock: 3, Active: true},
+		{Stock: 0, Active: true},
+		{Stock: 7, Active: false},
+	})
+
+	assert.Equal(t, 3, summary.VariantCount)
+	assert.Equal(t, 1, summary.AvailableCount)
+	assert.Equal(t, 3, summary.TotalStock, "inactive variants do not count towards stock")
+}
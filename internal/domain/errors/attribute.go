@@ -0,0 +1,19 @@
+package errors
+
+// Attribute domain errors
+var (
+	ErrAttributeDefinitionNotFound = &DomainError{
+		Code:    "ATTRIBUTE_DEFINITION_NOT_FOUND",
+		Message: "Attribute definition not found",
+	}
+
+	ErrInvalidAttributeDefinition = &DomainError{
+		Code:    "INVALID_ATTRIBUTE_DEFINITION",
+		Message: "Invalid attribute definition",
+	}
+
+	ErrInvalidProductAttributes = &DomainError{
+		Code:    "INVALID_PRODUCT_ATTRIBUTES",
+		Message: "Product attributes do not match the category schema",
+	}
+)
@@ -0,0 +1,20 @@
+package errors
+
+// Audit trail and product history domain errors
+var (
+	ErrInvalidAuditQuery = &DomainError{
+		Code:    "INVALID_AUDIT_QUERY",
+		Message: "Invalid audit query",
+	}
+
+	ErrFailedToQueryAudit = &DomainError{
+		Code:    "FAILED_TO_QUERY_AUDIT",
+		Message: "failed to query the audit trail",
+	}
+
+	ErrProductVersionNotFound = &DomainError{
+		Code:    "PRODUCT_VERSION_NOT_FOUND",
+		Message: "No recorded version of the product at that time",
+		Field:   "as_of",
+	}
+)
@@ -0,0 +1,29 @@
+package errors
+
+// Product batch domain errors
+var (
+	ErrInvalidBatch = &DomainError{
+		Code:    "INVALID_BATCH",
+		Message: "Invalid product batch",
+	}
+
+	ErrBatchTooLarge = &DomainError{
+		Code:    "BATCH_TOO_LARGE",
+		Message: "The batch has too many operations",
+	}
+
+	ErrInvalidBatchOperation = &DomainError{
+		Code:    "INVALID_BATCH_OPERATION",
+		Message: "Invalid batch operation",
+	}
+
+	ErrBatchRolledBack = &DomainError{
+		Code:    "BATCH_ROLLED_BACK",
+		Message: "Not applied because another operation of the atomic batch failed",
+	}
+
+	ErrFailedToRunBatch = &DomainError{
+		Code:    "FAILED_TO_RUN_BATCH",
+		Message: "failed to run product batch",
+	}
+)
@@ -0,0 +1,24 @@
+package errors
+
+// Brand domain errors
+var (
+	ErrBrandNotFound = &DomainError{
+		Code:    "BRAND_NOT_FOUND",
+		Message: "Brand not found",
+	}
+
+	ErrBrandAlreadyExists = &DomainError{
+		Code:    "BRAND_ALREADY_EXISTS",
+		Message: "A brand with this name or alias already exists",
+	}
+
+	ErrInvalidBrand = &DomainError{
+		Code:    "INVALID_BRAND",
+		Message: "Invalid brand",
+	}
+
+	ErrInvalidProductBrand = &DomainError{
+		Code:    "INVALID_PRODUCT_BRAND",
+		Message: "Product brand is not a registered active brand",
+	}
+)
@@ -0,0 +1,24 @@
+package errors
+
+// Category domain errors
+var (
+	ErrCategoryNotFound = &DomainError{
+		Code:    "CATEGORY_NOT_FOUND",
+		Message: "Category not found",
+	}
+
+	ErrCategoryAlreadyExists = &DomainError{
+		Code:    "CATEGORY_ALREADY_EXISTS",
+		Message: "A category with this path already exists",
+	}
+
+	ErrInvalidCategoryChange = &DomainError{
+		Code:    "INVALID_CATEGORY_CHANGE",
+		Message: "Invalid category change",
+	}
+
+	ErrCategoryInUse = &DomainError{
+		Code:    "CATEGORY_IN_USE",
+		Message: "Category still has products, subcategories or promotions",
+	}
+)
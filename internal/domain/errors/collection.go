@@ -0,0 +1,34 @@
+package errors
+
+// Tag and collection domain errors
+var (
+	ErrTagNotFound = &DomainError{
+		Code:    "TAG_NOT_FOUND",
+		Message: "Tag not found",
+	}
+
+	ErrTagAlreadyExists = &DomainError{
+		Code:    "TAG_ALREADY_EXISTS",
+		Message: "A tag with this name already exists",
+	}
+
+	ErrInvalidTag = &DomainError{
+		Code:    "INVALID_TAG",
+		Message: "Invalid tag",
+	}
+
+	ErrCollectionNotFound = &DomainError{
+		Code:    "COLLECTION_NOT_FOUND",
+		Message: "Collection not found",
+	}
+
+	ErrCollectionAlreadyExists = &DomainError{
+		Code:    "COLLECTION_ALREADY_EXISTS",
+		Message: "A collection with this name already exists",
+	}
+
+	ErrInvalidCollection = &DomainError{
+		Code:    "INVALID_COLLECTION",
+		Message: "Invalid collection",
+	}
+)
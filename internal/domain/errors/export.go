@@ -0,0 +1,14 @@
+package errors
+
+// Product export domain errors
+var (
+	ErrInvalidExport = &DomainError{
+		Code:    "INVALID_EXPORT",
+		Message: "Invalid product export",
+	}
+
+	ErrFailedToExportProducts = &DomainError{
+		Code:    "FAILED_TO_EXPORT_PRODUCTS",
+		Message: "failed to export products",
+	}
+)
@@ -0,0 +1,14 @@
+package errors
+
+// Product feed domain errors
+var (
+	ErrInvalidFeedFormat = &DomainError{
+		Code:    "INVALID_FEED_FORMAT",
+		Message: "Unsupported product feed format",
+	}
+
+	ErrFailedToGenerateFeed = &DomainError{
+		Code:    "FAILED_TO_GENERATE_FEED",
+		Message: "failed to generate product feed",
+	}
+)
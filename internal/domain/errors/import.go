@@ -0,0 +1,24 @@
+package errors
+
+// Product import domain errors
+var (
+	ErrImportNotFound = &DomainError{
+		Code:    "IMPORT_NOT_FOUND",
+		Message: "Product import not found",
+	}
+
+	ErrInvalidImport = &DomainError{
+		Code:    "INVALID_IMPORT",
+		Message: "Invalid product import",
+	}
+
+	ErrImportNotFinished = &DomainError{
+		Code:    "IMPORT_NOT_FINISHED",
+		Message: "The import is still running, its error report is not complete yet",
+	}
+
+	ErrFailedToImportProducts = &DomainError{
+		Code:    "FAILED_TO_IMPORT_PRODUCTS",
+		Message: "failed to import products",
+	}
+)
@@ -0,0 +1,34 @@
+package errors
+
+// Background job domain errors
+var (
+	ErrJobNotFound = &DomainError{
+		Code:    "JOB_NOT_FOUND",
+		Message: "Job not found",
+	}
+
+	ErrInvalidJob = &DomainError{
+		Code:    "INVALID_JOB",
+		Message: "Invalid job",
+	}
+
+	ErrInvalidReprice = &DomainError{
+		Code:    "INVALID_REPRICE",
+		Message: "Invalid bulk repricing",
+	}
+
+	ErrJobAlreadyFinished = &DomainError{
+		Code:    "JOB_ALREADY_FINISHED",
+		Message: "The job already finished and cannot be cancelled",
+	}
+
+	ErrJobResultNotAvailable = &DomainError{
+		Code:    "JOB_RESULT_NOT_AVAILABLE",
+		Message: "The job has no file to download, it has not succeeded or produces none",
+	}
+
+	ErrFailedToEnqueueJob = &DomainError{
+		Code:    "FAILED_TO_ENQUEUE_JOB",
+		Message: "failed to enqueue job",
+	}
+)
@@ -0,0 +1,16 @@
+package errors
+
+// Product lifecycle domain errors
+var (
+	ErrInvalidStatusTransition = &DomainError{
+		Code:    "INVALID_STATUS_TRANSITION",
+		Message: "The product cannot move to this status from its current status",
+		Field:   "status",
+	}
+
+	ErrTransitionGuardFailed = &DomainError{
+		Code:    "TRANSITION_GUARD_FAILED",
+		Message: "The product does not meet the conditions for this status",
+		Field:   "status",
+	}
+)
@@ -0,0 +1,29 @@
+package errors
+
+// Media domain errors
+var (
+	ErrMediaNotFound = &DomainError{
+		Code:    "MEDIA_NOT_FOUND",
+		Message: "Product media not found",
+	}
+
+	ErrInvalidMedia = &DomainError{
+		Code:    "INVALID_MEDIA",
+		Message: "Invalid product media",
+	}
+
+	ErrUnsupportedMediaFormat = &DomainError{
+		Code:    "UNSUPPORTED_MEDIA_FORMAT",
+		Message: "Only JPEG, PNG, GIF and WebP images and PDF documents are supported",
+	}
+
+	ErrMediaTooLarge = &DomainError{
+		Code:    "MEDIA_TOO_LARGE",
+		Message: "The uploaded file exceeds the size limit",
+	}
+
+	ErrFailedToStoreMedia = &DomainError{
+		Code:    "FAILED_TO_STORE_MEDIA",
+		Message: "Failed to store product media",
+	}
+)
@@ -0,0 +1,66 @@
+package errors
+
+// Pricing domain errors
+var (
+	ErrUnsupportedCurrency = &DomainError{
+		Code:    "UNSUPPORTED_CURRENCY",
+		Message: "Currency is not supported",
+		Field:   "currency",
+	}
+
+	ErrPriceListNotFound = &DomainError{
+		Code:    "PRICE_LIST_NOT_FOUND",
+		Message: "Price list not found",
+	}
+
+	ErrPriceListAlreadyExists = &DomainError{
+		Code:    "PRICE_LIST_ALREADY_EXISTS",
+		Message: "Price list with this code already exists",
+		Field:   "code",
+	}
+
+	ErrInvalidPriceList = &DomainError{
+		Code:    "INVALID_PRICE_LIST",
+		Message: "Invalid price list",
+	}
+
+	ErrProductPriceNotFound = &DomainError{
+		Code:    "PRODUCT_PRICE_NOT_FOUND",
+		Message: "Product has no price in this price list and currency",
+	}
+
+	ErrExchangeRateNotFound = &DomainError{
+		Code:    "EXCHANGE_RATE_NOT_FOUND",
+		Message: "No exchange rate is configured for this currency pair",
+	}
+
+	ErrInvalidExchangeRate = &DomainError{
+		Code:    "INVALID_EXCHANGE_RATE",
+		Message: "Invalid exchange rate",
+		Field:   "rate",
+	}
+
+	ErrFailedToUpdatePricing = &DomainError{
+		Code:    "FAILED_TO_UPDATE_PRICING",
+		Message: "failed to update price lists or exchange rates",
+	}
+)
+
+// Price history domain errors
+var (
+	ErrPriceChangeNotFound = &DomainError{
+		Code:    "PRICE_CHANGE_NOT_FOUND",
+		Message: "Price change not found",
+	}
+
+	ErrPriceChangeNotCancellable = &DomainError{
+		Code:    "PRICE_CHANGE_NOT_CANCELLABLE",
+		Message: "Only scheduled price changes can be cancelled",
+	}
+
+	ErrInvalidPriceSchedule = &DomainError{
+		Code:    "INVALID_PRICE_SCHEDULE",
+		Message: "Invalid price schedule",
+		Field:   "effective_from",
+	}
+)
@@ -40,6 +40,18 @@ var (
 		Field:   "sku",
 	}
 
+	ErrInvalidProductGTIN = &DomainError{
+		Code:    "INVALID_GTIN",
+		Message: "Invalid GTIN",
+		Field:   "gtin",
+	}
+
+	ErrProductGTINAlreadyExists = &DomainError{
+		Code:    "PRODUCT_GTIN_ALREADY_EXISTS",
+		Message: "Product with this GTIN already exists",
+		Field:   "gtin",
+	}
+
 	ErrInvalidProductPrice = &DomainError{
 		Code:    "INVALID_PRICE",
 		Message: "Invalid product price",
@@ -94,6 +106,11 @@ var (
 		Message: "failed to create product",
 	}
 
+	ErrFailedToGenerateSKU = &DomainError{
+		Code:    "FAILED_TO_GENERATE_SKU",
+		Message: "failed to generate product SKU",
+	}
+
 	ErrFailedToUpdateProduct = &DomainError{
 		Code:    "FAILED_TO_UPDATE_PRODUCT",
 		Message: "failed to update product",
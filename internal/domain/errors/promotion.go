@@ -0,0 +1,19 @@
+package errors
+
+// Promotion domain errors
+var (
+	ErrPromotionNotFound = &DomainError{
+		Code:    "PROMOTION_NOT_FOUND",
+		Message: "Promotion not found",
+	}
+
+	ErrInvalidPromotion = &DomainError{
+		Code:    "INVALID_PROMOTION",
+		Message: "Invalid promotion",
+	}
+
+	ErrFailedToEvaluatePromotions = &DomainError{
+		Code:    "FAILED_TO_EVALUATE_PROMOTIONS",
+		Message: "failed to evaluate promotions",
+	}
+)
@@ -0,0 +1,36 @@
+package errors
+
+// Product revision domain errors
+var (
+	ErrRevisionNotFound = &DomainError{
+		Code:    "REVISION_NOT_FOUND",
+		Message: "Revision not found",
+	}
+
+	ErrInvalidRevision = &DomainError{
+		Code:    "INVALID_REVISION",
+		Message: "Invalid revision",
+	}
+
+	ErrRevisionNotPending = &DomainError{
+		Code:    "REVISION_NOT_PENDING",
+		Message: "Only pending revisions can be approved or rejected",
+		Field:   "status",
+	}
+
+	ErrRevisionNotApproved = &DomainError{
+		Code:    "REVISION_NOT_APPROVED",
+		Message: "Only approved revisions can be published",
+		Field:   "status",
+	}
+
+	ErrRevisionSelfApproval = &DomainError{
+		Code:    "REVISION_SELF_APPROVAL",
+		Message: "Revisions must be approved by someone other than their author",
+	}
+
+	ErrRevisionStale = &DomainError{
+		Code:    "REVISION_STALE",
+		Message: "The product changed after this revision was drafted",
+	}
+)
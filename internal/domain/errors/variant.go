@@ -0,0 +1,24 @@
+package errors
+
+// Variant domain errors
+var (
+	ErrVariantNotFound = &DomainError{
+		Code:    "VARIANT_NOT_FOUND",
+		Message: "Product variant not found",
+	}
+
+	ErrVariantAlreadyExists = &DomainError{
+		Code:    "VARIANT_ALREADY_EXISTS",
+		Message: "A product or variant with this SKU or option combination already exists",
+	}
+
+	ErrInvalidVariant = &DomainError{
+		Code:    "INVALID_VARIANT",
+		Message: "Invalid product variant",
+	}
+
+	ErrInvalidProductOptions = &DomainError{
+		Code:    "INVALID_PRODUCT_OPTIONS",
+		Message: "Invalid product options",
+	}
+)
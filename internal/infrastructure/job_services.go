@@ -0,0 +1,67 @@
+package infrastructure
+
+import (
+	"product-service/internal/adapters/persistence/attribute_repository"
+	"product-service/internal/adapters/persistence/brand_repository"
+	"product-service/internal/adapters/persistence/category_repository"
+	"product-service/internal/adapters/persistence/job_repository"
+	"product-service/internal/adapters/persistence/product_repository"
+	"product-service/internal/application/ports"
+	"product-service/internal/application/usecases"
+	"product-service/internal/config"
+	"product-service/pkg/logger"
+)
+
+// JobServices are the background jobs and the use cases they run on: catalog
+// exports, feed generation, CSV imports and bulk repricing. Servers and
+// dedicated workers wire them the same way, so a job behaves the same
+// wherever it is claimed.
+type JobServices struct {
+	JobRepo ports.JobRepository
+	Jobs    usecases.JobUseCases
+	Exports usecases.ExportUseCases
+	Feeds   usecases.FeedUseCases
+	Imports usecases.ImportUseCases
+	// Worker runs the queued jobs; it is not started
+	Worker *JobWorker
+}
+
+// NewJobServices wires the job use cases and a worker for them from cfg
+func NewJobServices(cfg *config.Config, connections *DatabaseConnections, media ports.MediaStorage, log logger.Logger) *JobServices {
+	db := connections.GetGormDB()
+	productRepo := product_repository.NewGormProductRepository(db)
+	attributeRepo := attribute_repository.NewGormAttributeRepository(db)
+	categoryRepo := category_repository.NewGormCategoryRepository(db)
+	brandRepo := brand_repository.NewGormBrandRepository(db)
+	jobRepo := job_repository.NewGormJobRepository(db)
+
+	exports := usecases.NewExportUseCases(productRepo, attributeRepo, categoryRepo, brandRepo, log)
+	feeds := usecases.NewFeedUseCases(product_repository.NewGormFeedRepository(db), productRepo, product_repository.NewGormMediaRepository(db), media, usecases.FeedOptions{
+		Directory:  cfg.Feeds.Directory,
+		Title:      cfg.Feeds.Title,
+		StoreURL:   cfg.Feeds.StoreURL,
+		ProductURL: cfg.Feeds.ProductURL,
+	}, log)
+	imports := usecases.NewImportUseCases(product_repository.NewGormImportRepository(db), jobRepo, productRepo, attributeRepo, categoryRepo, brandRepo, usecases.ImportOptions{
+		MaxUploadSize:  cfg.Imports.MaxUploadSize,
+		SpoolDirectory: cfg.Imports.SpoolDirectory,
+	}, log)
+	jobs := usecases.NewJobUseCases(jobRepo, productRepo, product_repository.NewGormPriceHistoryRepository(db), attributeRepo, categoryRepo, brandRepo, exports, feeds, imports, usecases.JobOptions{
+		MaxAttempts: cfg.Jobs.MaxAttempts,
+		Directory:   cfg.Jobs.Directory,
+	}, log)
+
+	return &JobServices{
+		JobRepo: jobRepo,
+		Jobs:    jobs,
+		Exports: exports,
+		Feeds:   feeds,
+		Imports: imports,
+		Worker: NewJobWorker(jobRepo, jobs, JobWorkerOptions{
+			Concurrency:  cfg.Jobs.Workers,
+			PollInterval: cfg.Jobs.PollInterval,
+			Lease:        cfg.Jobs.Lease,
+			RetryBackoff: cfg.Jobs.RetryBackoff,
+		}, log),
+	}
+}
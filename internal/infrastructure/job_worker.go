@@ -0,0 +1,259 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"product-service/internal/application/ports"
+	"product-service/internal/domain/entities"
+	"product-service/pkg/logger"
+	"product-service/pkg/requestctx"
+)
+
+// interruptedError is recorded on jobs a worker stopped because it was
+// shutting down; they are retried like any failed attempt
+const interruptedError = "interrupted by worker shutdown"
+
+// JobRunner does the work of background jobs
+type JobRunner interface {
+	// JobTypes lists the job types the runner handles
+	JobTypes() []entities.JobType
+	// RunJob runs a claimed job and returns its result
+	RunJob(ctx context.Context, job *entities.Job, progress func(done, total int64)) (any, error)
+}
+
+// JobWorkerOptions configures a JobWorker
+type JobWorkerOptions struct {
+	// Name identifies the worker's process on the jobs it holds; the host
+	// name and process ID when empty
+	Name         string
+	Concurrency  int
+	PollInterval time.Duration
+	Lease        time.Duration
+	// RetryBackoff is the delay before a failed job is retried, doubled
+	// with every attempt
+	RetryBackoff time.Duration
+}
+
+// JobWorker runs jobs from the shared queue. Each of its goroutines claims
+// one job at a time and renews the job's lease while it runs, so that the
+// job is taken over by another worker if this process dies.
+type JobWorker struct {
+	repo    ports.JobRepository
+	runner  JobRunner
+	options JobWorkerOptions
+	logger  logger.Logger
+
+	stopClaiming context.CancelFunc
+	// jobs is the context running jobs derive from; Stop cancels it only
+	// when draining takes too long
+	jobs      context.Context
+	abortJobs context.CancelFunc
+	wg        sync.WaitGroup
+}
+
+// NewJobWorker creates a worker for the job types of runner
+func NewJobWorker(repo ports.JobRepository, runner JobRunner, options JobWorkerOptions, log logger.Logger) *JobWorker {
+	if options.Name == "" {
+		host, err := os.Hostname()
+		if err != nil {
+			host = "worker"
+		}
+		options.Name = fmt.Sprintf("%s-%d", host, os.Getpid())
+	}
+	if options.Concurrency < 1 {
+		options.Concurrency = 1
+	}
+	if options.PollInterval <= 0 {
+		options.PollInterval = time.Second
+	}
+	if options.Lease <= 0 {
+		options.Lease = time.Minute
+	}
+
+	return &JobWorker{
+		repo:    repo,
+		runner:  runner,
+		options: options,
+		logger:  log.With("component", "job_worker", "worker", options.Name),
+	}
+}
+
+// Start launches the worker's goroutines. They look for due jobs every
+// PollInterval while idle, until ctx is cancelled or Stop is called.
+func (w *JobWorker) Start(ctx context.Context) {
+	claimCtx, stopClaiming := context.WithCancel(ctx)
+	w.stopClaiming = stopClaiming
+	w.jobs, w.abortJobs = context.WithCancel(context.WithoutCancel(ctx))
+
+	for i := 0; i < w.options.Concurrency; i++ {
+		w.wg.Add(1)
+		go w.loop(claimCtx)
+	}
+
+	w.logger.Info("Job worker started", "concurrency", w.options.Concurrency, "types", w.runner.JobTypes())
+}
+
+// Stop stops claiming jobs and waits for the running ones to finish. Jobs
+// still running when ctx is done are interrupted and queued again.
+func (w *JobWorker) Stop(ctx context.Context) {
+	if w.stopClaiming == nil {
+		return
+	}
+	w.stopClaiming()
+
+	drained := make(chan struct{})
+	go func() {
+		w.wg.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		w.logger.Info("Job worker stopped")
+	case <-ctx.Done():
+		w.abortJobs()
+		<-drained
+		w.logger.Warn("Job worker stopped, interrupting running jobs")
+	}
+	w.abortJobs()
+}
+
+func (w *JobWorker) loop(ctx context.Context) {
+	defer w.wg.Done()
+
+	for {
+		if !w.runNext(ctx) {
+			select {
+			case <-ctx.Done():
+			case <-time.After(w.options.PollInterval):
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// runNext claims a due job and runs it, reporting whether there was one
+func (w *JobWorker) runNext(ctx context.Context) bool {
+	job, err := w.repo.Claim(ctx, w.runner.JobTypes(), w.options.Name, w.options.Lease, time.Now())
+	if err != nil {
+		if ctx.Err() == nil {
+			w.logger.Error("Failed to claim job", "error", err)
+		}
+		return false
+	}
+	if job == nil {
+		return false
+	}
+
+	w.run(job)
+	return true
+}
+
+// run runs job under its lease and records how the attempt ended
+func (w *JobWorker) run(job *entities.Job) {
+	log := w.logger.With("job_id", job.ID, "job_type", job.Type, "attempt", job.Attempts)
+	ctx := requestctx.WithActor(requestctx.WithRequestID(w.jobs, job.RequestID), job.Actor)
+	jobCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	log.Info("Job started")
+	start := time.Now()
+
+	var mu sync.Mutex
+	progress := func(done, total int64) {
+		mu.Lock()
+		defer mu.Unlock()
+		job.ReportProgress(done, total)
+	}
+
+	var lost, cancelRequested atomic.Bool
+	stopHeartbeat := make(chan struct{})
+	heartbeatDone := make(chan struct{})
+	go func() {
+		defer close(heartbeatDone)
+
+		ticker := time.NewTicker(w.options.Lease / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stopHeartbeat:
+				return
+			case <-ticker.C:
+			}
+
+			mu.Lock()
+			snapshot := *job
+			mu.Unlock()
+			held, err := w.repo.Heartbeat(ctx, &snapshot, w.options.Lease, time.Now())
+			switch {
+			case err != nil:
+				log.Warn("Failed to renew job lease", "error", err)
+			case !held:
+				lost.Store(true)
+				cancel()
+				return
+			case snapshot.CancelRequested:
+				cancelRequested.Store(true)
+				cancel()
+			}
+		}
+	}()
+
+	result, err := w.execute(jobCtx, job, progress)
+	close(stopHeartbeat)
+	<-heartbeatDone
+
+	now := time.Now()
+	switch {
+	case lost.Load():
+		log.Warn("Job lease lost to another worker, dropping the attempt")
+		return
+	case err == nil:
+		if err := job.Succeed(result, now); err != nil {
+			job.Fail(fmt.Sprintf("failed to encode result: %v", err), w.backoff(job), now)
+		}
+	case cancelRequested.Load():
+		job.MarkCancelled(now)
+	case w.jobs.Err() != nil:
+		job.Fail(interruptedError, w.backoff(job), now)
+	default:
+		job.Fail(err.Error(), w.backoff(job), now)
+	}
+
+	held, err := w.repo.Finish(context.WithoutCancel(ctx), job, w.options.Name)
+	switch {
+	case err != nil:
+		log.Error("Failed to save job outcome", "error", err, "status", job.Status)
+	case !held:
+		log.Warn("Job lease lost to another worker before its outcome was saved", "status", job.Status)
+	default:
+		log.Info("Job finished", "status", job.Status, "error", job.Error, "duration", time.Since(start))
+	}
+}
+
+// execute runs the job, turning a panic into a failed attempt
+func (w *JobWorker) execute(ctx context.Context, job *entities.Job, progress func(done, total int64)) (result any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+
+	return w.runner.RunJob(ctx, job, progress)
+}
+
+// backoff doubles RetryBackoff with every attempt job made
+func (w *JobWorker) backoff(job *entities.Job) time.Duration {
+	backoff := w.options.RetryBackoff
+	for i := 1; i < job.Attempts && backoff < time.Hour; i++ {
+		backoff *= 2
+	}
+	return backoff
+}
@@ -0,0 +1,102 @@
+package infrastructure
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"product-service/pkg/logger"
+)
+
+// JobFunc is one run of a periodic background job
+type JobFunc func(ctx context.Context) error
+
+type scheduledJob struct {
+	name     string
+	interval time.Duration
+	run      JobFunc
+}
+
+// Scheduler runs periodic background jobs inside the server process. Each job
+// runs on its own ticker, so a slow job never delays the others, and runs of
+// the same job never overlap.
+type Scheduler struct {
+	jobs   []scheduledJob
+	logger logger.Logger
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewScheduler creates a scheduler with no jobs
+func NewScheduler(log logger.Logger) *Scheduler {
+	return &Scheduler{
+		logger: log.With("component", "scheduler"),
+	}
+}
+
+// Every registers fn to run every interval once the scheduler starts
+func (s *Scheduler) Every(name string, interval time.Duration, fn JobFunc) {
+	s.jobs = append(s.jobs, scheduledJob{name: name, interval: interval, run: fn})
+}
+
+// Start launches every registered job. Each job runs once immediately, then
+// on every tick, until ctx is cancelled or Stop is called.
+func (s *Scheduler) Start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+
+	for _, job := range s.jobs {
+		if job.interval <= 0 {
+			s.logger.Warn("Skipping scheduled job without a positive interval", "job", job.name)
+			continue
+		}
+		s.wg.Add(1)
+		go s.loop(ctx, job)
+	}
+
+	s.logger.Info("Scheduler started", "jobs", len(s.jobs))
+}
+
+// Stop cancels the running jobs and waits for in-flight runs to finish
+func (s *Scheduler) Stop() {
+	if s.cancel == nil {
+		return
+	}
+
+	s.cancel()
+	s.wg.Wait()
+	s.logger.Info("Scheduler stopped")
+}
+
+func (s *Scheduler) loop(ctx context.Context, job scheduledJob) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(job.interval)
+	defer ticker.Stop()
+
+	for {
+		s.runOnce(ctx, job)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) runOnce(ctx context.Context, job scheduledJob) {
+	log := s.logger.With("job", job.name)
+
+	defer func() {
+		if r := recover(); r != nil {
+			log.Error("Scheduled job panicked", "panic", r)
+		}
+	}()
+
+	start := time.Now()
+	if err := job.run(ctx); err != nil && ctx.Err() == nil {
+		log.Error("Scheduled job failed", "error", err, "duration", time.Since(start))
+		return
+	}
+	log.Debug("Scheduled job finished", "duration", time.Since(start))
+}
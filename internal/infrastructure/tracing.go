@@ -0,0 +1,129 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"product-service/internal/config"
+	"product-service/pkg/logger"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// TracerProvider owns the OpenTelemetry SDK provider for the process lifetime
+type TracerProvider struct {
+	provider *sdktrace.TracerProvider
+	logger   logger.Logger
+}
+
+// NewTracerProvider configures the global OpenTelemetry tracer provider and the
+// W3C trace context propagator. With the "none" exporter spans are still created
+// (so trace IDs reach the logs) but nothing is exported.
+func NewTracerProvider(ctx context.Context, cfg *config.Config, log logger.Logger) (*TracerProvider, error) {
+	log = log.With("component", "tracing")
+
+	res, err := resource.New(ctx,
+		resource.WithFromEnv(),
+		resource.WithHost(),
+		resource.WithAttributes(
+			semconv.ServiceName(cfg.Tracing.ServiceName),
+			semconv.ServiceVersion(cfg.Version),
+			semconv.DeploymentEnvironment(cfg.Environment),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tracing resource: %w", err)
+	}
+
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.Tracing.SampleRatio))),
+	}
+
+	exporter, err := newSpanExporter(ctx, cfg.Tracing)
+	if err != nil {
+		return nil, err
+	}
+	if exporter != nil {
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	}
+
+	provider := sdktrace.NewTracerProvider(opts...)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	log.Info("Tracing initialized",
+		"exporter", cfg.Tracing.Exporter,
+		"endpoint", cfg.Tracing.Endpoint,
+		"sample_ratio", cfg.Tracing.SampleRatio)
+
+	return &TracerProvider{
+		provider: provider,
+		logger:   log,
+	}, nil
+}
+
+func newSpanExporter(ctx context.Context, cfg config.TracingConfig) (sdktrace.SpanExporter, error) {
+	switch strings.ToLower(cfg.Exporter) {
+	case "", "none":
+		return nil, nil
+	case "stdout":
+		exporter, err := stdouttrace.New(stdouttrace.WithPrettyPrint())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create stdout trace exporter: %w", err)
+		}
+		return exporter, nil
+	case "otlp":
+		return newOTLPExporter(ctx, cfg)
+	default:
+		return nil, fmt.Errorf("unsupported tracing exporter: %s", cfg.Exporter)
+	}
+}
+
+func newOTLPExporter(ctx context.Context, cfg config.TracingConfig) (sdktrace.SpanExporter, error) {
+	switch strings.ToLower(cfg.Protocol) {
+	case "", "grpc":
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err := otlptracegrpc.New(ctx, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OTLP gRPC trace exporter: %w", err)
+		}
+		return exporter, nil
+	case "http":
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		exporter, err := otlptracehttp.New(ctx, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OTLP HTTP trace exporter: %w", err)
+		}
+		return exporter, nil
+	default:
+		return nil, fmt.Errorf("unsupported OTLP protocol: %s", cfg.Protocol)
+	}
+}
+
+// Shutdown flushes pending spans and stops the exporter
+func (t *TracerProvider) Shutdown(ctx context.Context) error {
+	t.logger.Info("Shutting down tracer provider...")
+	if err := t.provider.Shutdown(ctx); err != nil {
+		return fmt.Errorf("failed to shutdown tracer provider: %w", err)
+	}
+	return nil
+}
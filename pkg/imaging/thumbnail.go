@@ -0,0 +1,136 @@
+// Package imaging reads the dimensions of uploaded images and renders
+// thumbnails with the standard library codecs (JPEG, PNG and GIF).
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+
+	// registers the GIF decoder with image.Decode
+	_ "image/gif"
+)
+
+// maxPixels guards against decompression bombs: small files that decode to
+// huge images
+const maxPixels = 50_000_000
+
+// ErrUnsupportedFormat is returned for images no registered codec can decode
+var ErrUnsupportedFormat = errors.New("imaging: unsupported image format")
+
+// Thumbnail is an encoded thumbnail
+type Thumbnail struct {
+	Data        []byte
+	ContentType string
+	Width       int
+	Height      int
+}
+
+// Size returns the dimensions of an encoded image without decoding its pixels
+func Size(data []byte) (width, height int, err error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return 0, 0, unsupported(err)
+	}
+	return config.Width, config.Height, nil
+}
+
+// MakeThumbnail scales an encoded image down to fit a maxSize square, keeping
+// its aspect ratio; smaller images keep their size. JPEGs stay JPEGs, PNGs
+// and GIFs become PNGs so transparency survives.
+func MakeThumbnail(data []byte, maxSize int) (*Thumbnail, error) {
+	width, height, err := Size(data)
+	if err != nil {
+		return nil, err
+	}
+	if width*height > maxPixels {
+		return nil, fmt.Errorf("imaging: %dx%d image exceeds %d pixels", width, height, maxPixels)
+	}
+
+	source, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, unsupported(err)
+	}
+
+	thumbWidth, thumbHeight := fit(width, height, maxSize)
+	scaled := scale(source, thumbWidth, thumbHeight)
+
+	var buffer bytes.Buffer
+	contentType := "image/png"
+	if format == "jpeg" {
+		contentType = "image/jpeg"
+		err = jpeg.Encode(&buffer, scaled, &jpeg.Options{Quality: 85})
+	} else {
+		err = png.Encode(&buffer, scaled)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("imaging: encode thumbnail: %w", err)
+	}
+
+	return &Thumbnail{
+		Data:        buffer.Bytes(),
+		ContentType: contentType,
+		Width:       thumbWidth,
+		Height:      thumbHeight,
+	}, nil
+}
+
+// fit returns the largest size within a maxSize square with the aspect ratio
+// of width x height, never enlarging
+func fit(width, height, maxSize int) (int, int) {
+	if width <= maxSize && height <= maxSize {
+		return width, height
+	}
+	if width >= height {
+		return maxSize, max(1, height*maxSize/width)
+	}
+	return max(1, width*maxSize/height), maxSize
+}
+
+// scale resizes source with a box filter: each target pixel averages the
+// source pixels it covers
+func scale(source image.Image, width, height int) *image.RGBA {
+	bounds := source.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), source, bounds.Min, draw.Src)
+
+	sourceWidth, sourceHeight := bounds.Dx(), bounds.Dy()
+	target := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0, y1 := y*sourceHeight/height, max((y+1)*sourceHeight/height, y*sourceHeight/height+1)
+		for x := 0; x < width; x++ {
+			x0, x1 := x*sourceWidth/width, max((x+1)*sourceWidth/width, x*sourceWidth/width+1)
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				offset := rgba.PixOffset(x0, sy)
+				for sx := x0; sx < x1; sx++ {
+					r += uint64(rgba.Pix[offset])
+					g += uint64(rgba.Pix[offset+1])
+					b += uint64(rgba.Pix[offset+2])
+					a += uint64(rgba.Pix[offset+3])
+					offset += 4
+					n++
+				}
+			}
+
+			offset := target.PixOffset(x, y)
+			target.Pix[offset] = uint8(r / n)
+			target.Pix[offset+1] = uint8(g / n)
+			target.Pix[offset+2] = uint8(b / n)
+			target.Pix[offset+3] = uint8(a / n)
+		}
+	}
+	return target
+}
+
+func unsupported(err error) error {
+	if errors.Is(err, image.ErrFormat) {
+		return ErrUnsupportedFormat
+	}
+	return fmt.Errorf("imaging: %w", err)
+}
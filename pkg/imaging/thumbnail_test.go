@@ -0,0 +1,76 @@
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodedImage(t *testing.T, width, height int, encode func(*bytes.Buffer, image.Image) error) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.RGBA{R: 200, G: 100, B: 50, A: 255})
+		}
+	}
+	var buffer bytes.Buffer
+	require.NoError(t, encode(&buffer, img))
+	return buffer.Bytes()
+}
+
+func pngEncode(buffer *bytes.Buffer, img image.Image) error {
+	return png.Encode(buffer, img)
+}
+
+func jpegEncode(buffer *bytes.Buffer, img image.Image) error {
+	return jpeg.Encode(buffer, img, nil)
+}
+
+func TestMakeThumbnail(t *testing.T) {
+	tests := []struct {
+		name           string
+		width, height  int
+		encode         func(*bytes.Buffer, image.Image) error
+		expectedWidth  int
+		expectedHeight int
+		expectedType   string
+	}{
+		{"landscape png", 800, 400, pngEncode, 320, 160, "image/png"},
+		{"portrait jpeg", 300, 900, jpegEncode, 106, 320, "image/jpeg"},
+		{"small image keeps its size", 64, 48, pngEncode, 64, 48, "image/png"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			data := encodedImage(t, tt.width, tt.height, tt.encode)
+
+			// When
+			thumbnail, err := MakeThumbnail(data, 320)
+
+			// Then
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedWidth, thumbnail.Width)
+			assert.Equal(t, tt.expectedHeight, thumbnail.Height)
+			assert.Equal(t, tt.expectedType, thumbnail.ContentType)
+
+			width, height, err := Size(thumbnail.Data)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedWidth, width)
+			assert.Equal(t, tt.expectedHeight, height)
+		})
+	}
+}
+
+func TestMakeThumbnail_UnsupportedFormat(t *testing.T) {
+	_, err := MakeThumbnail([]byte("%PDF-1.7 not an image"), 320)
+
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
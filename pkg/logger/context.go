@@ -0,0 +1,56 @@
+package logger
+
+import "context"
+
+type ctxKey int
+
+const (
+	loggerKey ctxKey = iota
+	fieldsKey
+)
+
+// WithContext returns a copy of ctx carrying l and fields. The fields are kept
+// on the context rather than baked into l so component loggers can pick them
+// up through Logger.Ctx. A key that is already present is overwritten.
+func WithContext(ctx context.Context, l Logger, fields ...interface{}) context.Context {
+	ctx = context.WithValue(ctx, fieldsKey, mergeFields(ContextFields(ctx), fields))
+	return context.WithValue(ctx, loggerKey, l)
+}
+
+// FromContext returns the logger stored in ctx by WithContext enriched with
+// the context fields and the active trace, or a no-op logger when there is none
+func FromContext(ctx context.Context) Logger {
+	if l, ok := ctx.Value(loggerKey).(Logger); ok {
+		return l.Ctx(ctx)
+	}
+	return NewNop()
+}
+
+// ContextFields returns the key/value pairs stored in ctx by WithContext
+func ContextFields(ctx context.Context) []interface{} {
+	fields, _ := ctx.Value(fieldsKey).([]interface{})
+	return fields
+}
+
+// mergeFields appends next to base, replacing the value of keys already
+// present in base. base is never modified.
+func mergeFields(base, next []interface{}) []interface{} {
+	merged := make([]interface{}, len(base), len(base)+len(next))
+	copy(merged, base)
+
+	for i := 0; i+1 < len(next); i += 2 {
+		replaced := false
+		for j := 0; j+1 < len(merged); j += 2 {
+			if merged[j] == next[i] {
+				merged[j+1] = next[i+1]
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			merged = append(merged, next[i], next[i+1])
+		}
+	}
+
+	return merged
+}
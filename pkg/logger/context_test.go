@@ -0,0 +1,65 @@
+package logger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestWithContext_StoresFields(t *testing.T) {
+	// Given
+	base := NewNop()
+
+	// When
+	ctx := WithContext(context.Background(), base, "request_id", "req-1", "route", "/api/v1/products")
+
+	// Then
+	assert.Equal(t, []interface{}{"request_id", "req-1", "route", "/api/v1/products"}, ContextFields(ctx))
+	assert.NotNil(t, FromContext(ctx))
+}
+
+func TestWithContext_OverwritesExistingKeys(t *testing.T) {
+	// Given
+	base := NewNop()
+	parent := WithContext(context.Background(), base, "request_id", "req-1", "actor", "alice")
+
+	// When
+	child := WithContext(parent, base, "actor", "bob", "tenant", "acme")
+
+	// Then
+	assert.Equal(t, []interface{}{"request_id", "req-1", "actor", "bob", "tenant", "acme"}, ContextFields(child))
+	assert.Equal(t, []interface{}{"request_id", "req-1", "actor", "alice"}, ContextFields(parent))
+}
+
+func TestFromContext_WithoutLogger(t *testing.T) {
+	// When
+	log := FromContext(context.Background())
+
+	// Then
+	assert.NotNil(t, log)
+	assert.Nil(t, ContextFields(context.Background()))
+}
+
+func TestTraceFields(t *testing.T) {
+	// Given
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	spanCtx := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), spanCtx)
+
+	// When
+	fields := TraceFields(ctx)
+
+	// Then
+	assert.Equal(t, []interface{}{
+		"trace_id", "4bf92f3577b34da6a3ce929d0e0e4736",
+		"span_id", "00f067aa0ba902b7",
+	}, fields)
+	assert.Nil(t, TraceFields(context.Background()))
+}
@@ -1,6 +1,7 @@
 package logger
 
 import (
+	"context"
 	"strings"
 
 	"go.uber.org/zap"
@@ -15,6 +16,9 @@ type Logger interface {
 	Fatal(msg string, args ...interface{})
 
 	With(fields ...interface{}) Logger
+	// Ctx returns a logger enriched with the fields stored in ctx by
+	// WithContext and the trace/span IDs of the active span
+	Ctx(ctx context.Context) Logger
 	Sync() error
 }
 
@@ -40,6 +44,15 @@ func New(env string) Logger {
 	}
 }
 
+// NewNop returns a logger that discards everything
+func NewNop() Logger {
+	base := zap.NewNop()
+	return &zapLogger{
+		sugar: base.Sugar(),
+		base:  base,
+	}
+}
+
 func getZapConfig(env string) zap.Config {
 	switch strings.ToLower(env) {
 	case "development", "dev":
@@ -101,6 +114,19 @@ func (l *zapLogger) With(fields ...interface{}) Logger {
 	}
 }
 
+func (l *zapLogger) Ctx(ctx context.Context) Logger {
+	contextFields := ContextFields(ctx)
+	traceFields := TraceFields(ctx)
+	if len(contextFields) == 0 && len(traceFields) == 0 {
+		return l
+	}
+
+	fields := make([]interface{}, 0, len(contextFields)+len(traceFields))
+	fields = append(fields, contextFields...)
+	fields = append(fields, traceFields...)
+	return l.With(fields...)
+}
+
 func (l *zapLogger) Sync() error {
 	return l.sugar.Sync()
 }
@@ -0,0 +1,21 @@
+package logger
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TraceFields returns the trace_id and span_id of the span stored in ctx as
+// key/value pairs ready to be passed to a log call. It returns nil when ctx
+// carries no valid span.
+func TraceFields(ctx context.Context) []interface{} {
+	spanCtx := trace.SpanContextFromContext(ctx)
+	if !spanCtx.IsValid() {
+		return nil
+	}
+	return []interface{}{
+		"trace_id", spanCtx.TraceID().String(),
+		"span_id", spanCtx.SpanID().String(),
+	}
+}
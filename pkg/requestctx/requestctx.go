@@ -0,0 +1,47 @@
+// Package requestctx carries per-request identity (request ID, actor, tenant)
+// through context.Context so that layers below the HTTP adapter can read it
+// without depending on Echo.
+package requestctx
+
+import "context"
+
+type ctxKey int
+
+const (
+	requestIDKey ctxKey = iota
+	actorKey
+	tenantKey
+)
+
+// WithRequestID returns a copy of ctx carrying the request ID
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+// RequestID returns the request ID stored in ctx, or "" if there is none
+func RequestID(ctx context.Context) string {
+	value, _ := ctx.Value(requestIDKey).(string)
+	return value
+}
+
+// WithActor returns a copy of ctx carrying the acting user or system
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey, actor)
+}
+
+// Actor returns the actor stored in ctx, or "" if there is none
+func Actor(ctx context.Context) string {
+	value, _ := ctx.Value(actorKey).(string)
+	return value
+}
+
+// WithTenant returns a copy of ctx carrying the tenant ID
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey, tenant)
+}
+
+// Tenant returns the tenant stored in ctx, or "" if there is none
+func Tenant(ctx context.Context) string {
+	value, _ := ctx.Value(tenantKey).(string)
+	return value
+}
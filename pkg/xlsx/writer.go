@@ -0,0 +1,200 @@
+// Package xlsx writes single-sheet Excel workbooks (Office Open XML) with the
+// standard library. Rows are compressed into the worksheet as they are
+// written, so memory use does not grow with the number of rows.
+package xlsx
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ContentType is the media type of an .xlsx file
+const ContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+// ErrClosed is returned when writing to a closed Writer
+var ErrClosed = errors.New("xlsx: writer is closed")
+
+// Number is a cell holding a number already formatted in decimal notation,
+// e.g. a price, written without going through float64
+type Number string
+
+const (
+	contentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`
+
+	packageRelationships = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`
+
+	workbookRelationships = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`</Relationships>`
+
+	workbookTemplate = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets>` +
+		`</workbook>`
+
+	sheetStart = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+
+	sheetEnd = `</sheetData></worksheet>`
+)
+
+// maxSheetName is the longest sheet name Excel accepts
+const maxSheetName = 31
+
+// Writer streams rows into the only worksheet of a workbook. Close must be
+// called to complete the file.
+type Writer struct {
+	archive *zip.Writer
+	sheet   *bufio.Writer
+	rows    int
+	closed  bool
+}
+
+// NewWriter starts a workbook on w whose worksheet is called sheetName
+func NewWriter(w io.Writer, sheetName string) (*Writer, error) {
+	archive := zip.NewWriter(w)
+
+	parts := []struct{ name, content string }{
+		{"[Content_Types].xml", contentTypes},
+		{"_rels/.rels", packageRelationships},
+		{"xl/_rels/workbook.xml.rels", workbookRelationships},
+		{"xl/workbook.xml", fmt.Sprintf(workbookTemplate, escape(sanitizeSheetName(sheetName)))},
+	}
+	for _, part := range parts {
+		file, err := archive.Create(part.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.WriteString(file, part.content); err != nil {
+			return nil, err
+		}
+	}
+
+	// The worksheet is the last part, so rows can be appended until Close
+	file, err := archive.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+	sheet := bufio.NewWriter(file)
+	if _, err := sheet.WriteString(sheetStart); err != nil {
+		return nil, err
+	}
+	return &Writer{archive: archive, sheet: sheet}, nil
+}
+
+// WriteRow appends a row. Strings are written as text, integers, floats and
+// Number as numbers, bools as booleans and nil as an empty cell; other values
+// are written as text in their fmt.Sprint form.
+func (w *Writer) WriteRow(cells ...any) error {
+	if w.closed {
+		return ErrClosed
+	}
+	w.rows++
+
+	var row strings.Builder
+	fmt.Fprintf(&row, `<row r="%d">`, w.rows)
+	for i, cell := range cells {
+		ref := columnName(i) + strconv.Itoa(w.rows)
+		switch value := cell.(type) {
+		case nil:
+			continue
+		case string:
+			writeText(&row, ref, value)
+		case Number:
+			fmt.Fprintf(&row, `<c r="%s"><v>%s</v></c>`, ref, escape(string(value)))
+		case bool:
+			flag := 0
+			if value {
+				flag = 1
+			}
+			fmt.Fprintf(&row, `<c r="%s" t="b"><v>%d</v></c>`, ref, flag)
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+			fmt.Fprintf(&row, `<c r="%s"><v>%d</v></c>`, ref, value)
+		case float32:
+			fmt.Fprintf(&row, `<c r="%s"><v>%s</v></c>`, ref, strconv.FormatFloat(float64(value), 'f', -1, 32))
+		case float64:
+			fmt.Fprintf(&row, `<c r="%s"><v>%s</v></c>`, ref, strconv.FormatFloat(value, 'f', -1, 64))
+		default:
+			writeText(&row, ref, fmt.Sprint(value))
+		}
+	}
+	row.WriteString(`</row>`)
+
+	_, err := w.sheet.WriteString(row.String())
+	return err
+}
+
+// Close ends the worksheet and writes the zip directory. It does not close
+// the underlying writer.
+func (w *Writer) Close() error {
+	if w.closed {
+		return ErrClosed
+	}
+	w.closed = true
+
+	if _, err := w.sheet.WriteString(sheetEnd); err != nil {
+		return err
+	}
+	if err := w.sheet.Flush(); err != nil {
+		return err
+	}
+	return w.archive.Close()
+}
+
+// writeText writes an inline string cell, keeping leading and trailing
+// spaces
+func writeText(row *strings.Builder, ref, text string) {
+	fmt.Fprintf(row, `<c r="%s" t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, ref, escape(text))
+}
+
+// escape makes text safe for XML content and attributes. Characters XML does
+// not allow, such as most control characters, become U+FFFD.
+func escape(text string) string {
+	var escaped strings.Builder
+	_ = xml.EscapeText(&escaped, []byte(text))
+	return escaped.String()
+}
+
+// columnName returns the letters of a zero-based column index: A, B, ...,
+// Z, AA, AB, ...
+func columnName(index int) string {
+	name := ""
+	for index++; index > 0; index = (index - 1) / 26 {
+		name = string(rune('A'+(index-1)%26)) + name
+	}
+	return name
+}
+
+// sanitizeSheetName drops the characters Excel rejects in sheet names and
+// shortens the name to the longest it accepts
+func sanitizeSheetName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`[]:*?/\`, r) {
+			return -1
+		}
+		return r
+	}, name)
+	if name = strings.TrimSpace(name); name == "" {
+		return "Sheet1"
+	}
+	if runes := []rune(name); len(runes) > maxSheetName {
+		name = string(runes[:maxSheetName])
+	}
+	return name
+}
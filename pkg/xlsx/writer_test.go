@@ -0,0 +1,90 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readParts unzips a workbook into its parts, checking each is well-formed XML
+func readParts(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	parts := map[string]string{}
+	for _, file := range archive.File {
+		reader, err := file.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+
+		decoder := xml.NewDecoder(bytes.NewReader(content))
+		for {
+			_, err := decoder.Token()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err, "part %s", file.Name)
+		}
+		parts[file.Name] = string(content)
+	}
+	return parts
+}
+
+func TestWriter(t *testing.T) {
+	// Given
+	var buffer bytes.Buffer
+	writer, err := NewWriter(&buffer, "Products")
+	require.NoError(t, err)
+
+	// When
+	require.NoError(t, writer.WriteRow("sku", "price", "stock", "active"))
+	require.NoError(t, writer.WriteRow(" <LAP&001> ", Number("19.99"), 3, true, nil, "last"))
+	require.NoError(t, writer.Close())
+
+	// Then
+	parts := readParts(t, buffer.Bytes())
+	assert.Contains(t, parts, "[Content_Types].xml")
+	assert.Contains(t, parts, "_rels/.rels")
+	assert.Contains(t, parts, "xl/_rels/workbook.xml.rels")
+	assert.Contains(t, parts["xl/workbook.xml"], `<sheet name="Products" sheetId="1" r:id="rId1"/>`)
+
+	sheet := parts["xl/worksheets/sheet1.xml"]
+	assert.Contains(t, sheet, `<row r="1"><c r="A1" t="inlineStr"><is><t xml:space="preserve">sku</t></is></c>`)
+	assert.Contains(t, sheet, `<c r="A2" t="inlineStr"><is><t xml:space="preserve"> &lt;LAP&amp;001&gt; </t></is></c>`)
+	assert.Contains(t, sheet, `<c r="B2"><v>19.99</v></c>`)
+	assert.Contains(t, sheet, `<c r="C2"><v>3</v></c>`)
+	assert.Contains(t, sheet, `<c r="D2" t="b"><v>1</v></c>`)
+	assert.NotContains(t, sheet, `r="E2"`)
+	assert.Contains(t, sheet, `<c r="F2" t="inlineStr">`)
+}
+
+func TestWriter_Closed(t *testing.T) {
+	writer, err := NewWriter(io.Discard, "Sheet")
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	assert.ErrorIs(t, writer.WriteRow("late"), ErrClosed)
+	assert.ErrorIs(t, writer.Close(), ErrClosed)
+}
+
+func TestColumnName(t *testing.T) {
+	tests := map[int]string{0: "A", 25: "Z", 26: "AA", 51: "AZ", 52: "BA", 701: "ZZ", 702: "AAA"}
+
+	for index, expected := range tests {
+		assert.Equal(t, expected, columnName(index), "column %d", index)
+	}
+}
+
+func TestSanitizeSheetName(t *testing.T) {
+	assert.Equal(t, "Q12024", sanitizeSheetName("Q1/2024"))
+	assert.Equal(t, "Sheet1", sanitizeSheetName(" [] "))
+	assert.Equal(t, "abcdefghijklmnopqrstuvwxyzabcde", sanitizeSheetName("abcdefghijklmnopqrstuvwxyzabcdefgh"))
+}